@@ -0,0 +1,59 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestAssertions(t *testing.T) {
+	report, err := runLandTest(t, "-d", "fixtures/assert")
+	if err == nil || err.Error() != "8 of 9 tests failed" {
+		t.Errorf("expected the error of the failed tests, actual %v", err)
+	}
+
+	testCases := []struct {
+		Method  string
+		Status  string
+		Message string
+		Line    int
+	}{
+		{"passes", "passed", "", 0},
+		{"assertFails", "failed", "Assertion Failed: Expected: true, Actual: false", 23},
+		{"assertEqualsFails", "failed", "Assertion Failed: numbers: Expected: 1, Actual: 2", 28},
+		{"assertNotEqualsFails", "failed", "Assertion Failed: Expected: not a, Actual: a", 33},
+		{"areEqualFails", "failed", "Assertion Failed: Expected: a, Actual: b", 38},
+		{"isNullFails", "failed", "Assertion Failed: not null: Expected: null, Actual: a", 43},
+		{"isInstanceOfTypeFails", "failed", "Assertion Failed: Expected: Contact, Actual: Account", 48},
+		// the failed assertion stops the test method, so the second assertion is not evaluated
+		{"failStopsMethod", "failed", "Assertion Failed: first", 53},
+		// the assertion failure is not the exception, so it is not caught by the catch block
+		{"failIsNotCaught", "failed", "Assertion Failed: uncatchable", 60},
+	}
+	methods := testMethodResults(report)
+	for _, testCase := range testCases {
+		method, ok := methods["AssertionTest."+testCase.Method]
+		if !ok {
+			t.Errorf("%s: not run", testCase.Method)
+			continue
+		}
+		if method.Status != testCase.Status {
+			t.Errorf("%s: expected %s, actual %s", testCase.Method, testCase.Status, method.Status)
+		}
+		if testCase.Message == "" {
+			if len(method.Failures) != 0 {
+				t.Errorf("%s: expected no failures, actual %d", testCase.Method, len(method.Failures))
+			}
+			continue
+		}
+		if len(method.Failures) != 1 {
+			t.Errorf("%s: expected 1 failure, actual %d", testCase.Method, len(method.Failures))
+			continue
+		}
+		failure := method.Failures[0]
+		if failure.Message != testCase.Message {
+			t.Errorf("%s: expected %s, actual %s", testCase.Method, testCase.Message, failure.Message)
+		}
+		if failure.Line != testCase.Line {
+			t.Errorf("%s: expected line %d, actual %d", testCase.Method, testCase.Line, failure.Line)
+		}
+	}
+}
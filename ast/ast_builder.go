@@ -9,6 +9,37 @@ import (
 	"github.com/tzmfreedom/goland/parser"
 )
 
+// TriggerContextName is the name of the trigger context such as `Trigger.new`
+const TriggerContextName = "Trigger"
+
+// SoqlDateLiterals are date literals without number such as TODAY.
+// They are written without the number unlike LAST_N_DAYS:30.
+var SoqlDateLiterals = []string{
+	"YESTERDAY",
+	"TODAY",
+	"TOMORROW",
+	"LAST_WEEK",
+	"THIS_WEEK",
+	"NEXT_WEEK",
+	"LAST_MONTH",
+	"THIS_MONTH",
+	"NEXT_MONTH",
+	"LAST_90_DAYS",
+	"NEXT_90_DAYS",
+	"LAST_QUARTER",
+	"THIS_QUARTER",
+	"NEXT_QUARTER",
+	"LAST_YEAR",
+	"THIS_YEAR",
+	"NEXT_YEAR",
+	"LAST_FISCAL_QUARTER",
+	"THIS_FISCAL_QUARTER",
+	"NEXT_FISCAL_QUARTER",
+	"LAST_FISCAL_YEAR",
+	"THIS_FISCAL_YEAR",
+	"NEXT_FISCAL_YEAR",
+}
+
 type Builder struct {
 	*parser.BaseapexVisitor
 	Source string
@@ -43,7 +74,10 @@ func (v *Builder) VisitTypeDeclaration(ctx *parser.TypeDeclarationContext) inter
 	} else if n := ctx.InterfaceDeclaration(); n != nil {
 		return n.Accept(v)
 	} else if n := ctx.EnumDeclaration(); n != nil {
-		return n.Accept(v)
+		decl := n.Accept(v).(*EnumDeclaration)
+		decl.Modifiers = setParentNodeToModifiers(modifiers, decl)
+		decl.Annotations = setParentNodeToAnnotations(annotations, decl)
+		return decl
 	}
 	panic("not pass")
 }
@@ -122,15 +156,29 @@ func (v *Builder) VisitClassDeclaration(ctx *parser.ClassDeclarationContext) int
 }
 
 func (v *Builder) VisitEnumDeclaration(ctx *parser.EnumDeclarationContext) interface{} {
-	return v.VisitChildren(ctx)
+	n := &EnumDeclaration{
+		Name:     ctx.ApexIdentifier().GetText(),
+		Values:   []string{},
+		Location: v.newLocation(ctx),
+	}
+	if constants := ctx.EnumConstants(); constants != nil {
+		n.Values = constants.Accept(v).([]string)
+	}
+	return n
 }
 
 func (v *Builder) VisitEnumConstants(ctx *parser.EnumConstantsContext) interface{} {
-	return v.VisitChildren(ctx)
+	constants := ctx.AllEnumConstant()
+	values := make([]string, len(constants))
+	for i, c := range constants {
+		values[i] = c.Accept(v).(string)
+	}
+	return values
 }
 
+// VisitEnumConstant returns the name of the value, apex enum has neither the arguments nor the body
 func (v *Builder) VisitEnumConstant(ctx *parser.EnumConstantContext) interface{} {
-	return v.VisitChildren(ctx)
+	return ctx.ApexIdentifier().GetText()
 }
 
 func (v *Builder) VisitEnumBodyDeclarations(ctx *parser.EnumBodyDeclarationsContext) interface{} {
@@ -212,9 +260,10 @@ func (v *Builder) VisitClassBodyDeclaration(ctx *parser.ClassBodyDeclarationCont
 			decl.Modifiers = declarationModifiers
 			decl.Annotations = declarationAnnotations
 			return decl
-		//case *EnumDeclaration:
-		//	decl.Modifiers = declarationModifiers
-		//	return decl
+		case *EnumDeclaration:
+			decl.Modifiers = declarationModifiers
+			decl.Annotations = declarationAnnotations
+			return decl
 		case *PropertyDeclaration:
 			decl.Modifiers = declarationModifiers
 			decl.Annotations = declarationAnnotations
@@ -578,7 +627,7 @@ func (v *Builder) VisitLiteral(ctx *parser.LiteralContext) interface{} {
 		return &DoubleLiteral{Value: val, Location: v.newLocation(ctx)}
 	} else if lit := ctx.StringLiteral(); lit != nil {
 		str := lit.GetText()
-		return &StringLiteral{Value: str[1 : len(str)-1], Location: v.newLocation(ctx)}
+		return &StringLiteral{Value: unescapeString(str[1 : len(str)-1]), Location: v.newLocation(ctx)}
 	} else if lit := ctx.BooleanLiteral(); lit != nil {
 		return &BooleanLiteral{Value: strings.ToLower(lit.GetText()) == "true", Location: v.newLocation(ctx)}
 	} else if lit := ctx.NullLiteral(); lit != nil {
@@ -593,6 +642,11 @@ func (v *Builder) VisitAnnotation(ctx *parser.AnnotationContext) interface{} {
 	// TODO: implement annotationName
 	annotation.Name = name.Name[0]
 	annotation.Location = v.newLocation(ctx)
+	if pairs := ctx.ElementValuePairs(); pairs != nil {
+		annotation.Parameters = pairs.Accept(v).([]Node)
+	} else if value := ctx.ElementValue(); value != nil {
+		annotation.Parameters = []Node{value.Accept(v).(Node)}
+	}
 	return annotation
 }
 
@@ -610,10 +664,11 @@ func (v *Builder) VisitElementValuePairs(ctx *parser.ElementValuePairsContext) i
 }
 
 func (v *Builder) VisitElementValuePair(ctx *parser.ElementValuePairContext) interface{} {
-	ctx.ApexIdentifier().GetText()
-	ctx.ElementValue().Accept(v)
-	// TODO: implement
-	return nil
+	return &ElementValuePair{
+		Name:     ctx.ApexIdentifier().GetText(),
+		Value:    ctx.ElementValue().Accept(v).(Node),
+		Location: v.newLocation(ctx),
+	}
 }
 
 func (v *Builder) VisitElementValue(ctx *parser.ElementValueContext) interface{} {
@@ -823,10 +878,10 @@ func (v *Builder) VisitWhenStatement(ctx *parser.WhenStatementContext) interface
 }
 
 func (v *Builder) VisitWhenExpression(ctx *parser.WhenExpressionContext) interface{} {
-	if literals := ctx.AllLiteral(); len(literals) != 0 {
-		expressions := make([]Node, len(literals))
-		for i, l := range literals {
-			expressions[i] = l.Accept(v).(Node)
+	if values := ctx.AllWhenValue(); len(values) != 0 {
+		expressions := make([]Node, len(values))
+		for i, value := range values {
+			expressions[i] = value.Accept(v).(Node)
 		}
 		return expressions
 	}
@@ -836,6 +891,17 @@ func (v *Builder) VisitWhenExpression(ctx *parser.WhenExpressionContext) interfa
 	return []Node{n}
 }
 
+func (v *Builder) VisitWhenValue(ctx *parser.WhenValueContext) interface{} {
+	if l := ctx.Literal(); l != nil {
+		return l.Accept(v)
+	}
+	// the enum value such as `when RED`
+	return &Name{
+		Value:    []string{ctx.ApexIdentifier().GetText()},
+		Location: v.newLocation(ctx),
+	}
+}
+
 func (v *Builder) VisitForControl(ctx *parser.ForControlContext) interface{} {
 	if c := ctx.EnhancedForControl(); c != nil {
 		return c.Accept(v)
@@ -906,7 +972,11 @@ func (v *Builder) VisitConstantExpression(ctx *parser.ConstantExpressionContext)
 
 func (v *Builder) VisitApexDbExpressionShort(ctx *parser.ApexDbExpressionShortContext) interface{} {
 	n := &Dml{Location: v.newLocation(ctx)}
-	n.Type = ctx.GetDml().GetText()
+	if dml := ctx.GetDml(); dml != nil {
+		n.Type = strings.ToLower(dml.GetText())
+	} else {
+		n.Type = "upsert"
+	}
 	if ident := ctx.ApexIdentifier(); ident != nil {
 		n.UpsertKey = ident.Accept(v).(string)
 	}
@@ -1031,6 +1101,14 @@ func (v *Builder) VisitFieldAccess(ctx *parser.FieldAccessContext) interface{} {
 func (v *Builder) VisitPrimary(ctx *parser.PrimaryContext) interface{} {
 	if e := ctx.Expression(); e != nil {
 		return e.Accept(v)
+	} else if t := ctx.TRIGGER(); t != nil {
+		n := &Name{Location: v.newLocation(ctx)}
+		if t := ctx.NEW(); t != nil {
+			n.Value = []string{TriggerContextName, t.GetText()}
+		} else {
+			n.Value = []string{TriggerContextName, ctx.ApexIdentifier().Accept(v).(string)}
+		}
+		return n
 	} else if t := ctx.THIS(); t != nil {
 		return &Name{
 			Value:    []string{t.GetText()},
@@ -1277,6 +1355,12 @@ func (v *Builder) VisitApexIdentifier(ctx *parser.ApexIdentifierContext) interfa
 		return t.GetText()
 	} else if t := ctx.ALL(); t != nil {
 		return t.GetText()
+	} else if t := ctx.ROWS(); t != nil {
+		return t.GetText()
+	} else if t := ctx.INCLUDES(); t != nil {
+		return t.GetText()
+	} else if t := ctx.EXCLUDES(); t != nil {
+		return t.GetText()
 	} else if t := ctx.FIELDS(); t != nil {
 		return t.GetText()
 	} else if t := ctx.RUNAS(); t != nil {
@@ -1302,6 +1386,12 @@ func (v *Builder) VisitTypeIdentifier(ctx *parser.TypeIdentifierContext) interfa
 		return t.GetText()
 	} else if t := ctx.SCOPE(); t != nil {
 		return t.GetText()
+	} else if t := ctx.ROWS(); t != nil {
+		return t.GetText()
+	} else if t := ctx.INCLUDES(); t != nil {
+		return t.GetText()
+	} else if t := ctx.EXCLUDES(); t != nil {
+		return t.GetText()
 	}
 	return nil
 }
@@ -1332,6 +1422,7 @@ func (v *Builder) VisitQuery(ctx *parser.QueryContext) interface{} {
 	if offset := ctx.OffsetClause(); offset != nil {
 		n.Offset = offset.Accept(v).(Node)
 	}
+	n.AllRows = ctx.AllRowsClause() != nil
 	return n
 }
 
@@ -1350,7 +1441,16 @@ func (v *Builder) VisitFieldList(ctx *parser.FieldListContext) interface{} {
 
 func (v *Builder) VisitSelectField(ctx *parser.SelectFieldContext) interface{} {
 	if t := ctx.SoqlField(); t != nil {
-		return t.Accept(v)
+		field := t.Accept(v)
+		if alias := ctx.GetAlias(); alias != nil {
+			switch f := field.(type) {
+			case *SelectField:
+				f.Alias = alias.GetText()
+			case *SoqlFunction:
+				f.Alias = alias.GetText()
+			}
+		}
+		return field
 	}
 	if t := ctx.Subquery(); t != nil {
 		return t.Accept(v)
@@ -1377,10 +1477,11 @@ func (v *Builder) VisitSoqlFieldReference(ctx *parser.SoqlFieldReferenceContext)
 }
 
 func (v *Builder) VisitSoqlFunctionCall(ctx *parser.SoqlFunctionCallContext) interface{} {
-	n := &SoqlFunction{}
+	n := &SoqlFunction{Location: v.newLocation(ctx)}
 	n.Name = ctx.ApexIdentifier().GetText()
+	n.Parameters = []Node{}
 	for _, f := range ctx.AllSoqlField() {
-		f.Accept(v)
+		n.Parameters = append(n.Parameters, f.Accept(v).(Node))
 	}
 	return n
 }
@@ -1405,14 +1506,24 @@ func (v *Builder) VisitWhereFields(ctx *parser.WhereFieldsContext) interface{} {
 }
 
 func (v *Builder) VisitWhereField(ctx *parser.WhereFieldContext) interface{} {
+	if f := ctx.WhereFields(); f != nil {
+		return f.Accept(v)
+	}
 	n := &WhereCondition{Location: v.newLocation(ctx)}
 	n.Field = ctx.SoqlField().Accept(v).(Node)
 	n.Not = ctx.SOQL_NOT() != nil
-	n.Op = ctx.GetOp().GetText()
+	n.Op = ctx.SoqlOperator().Accept(v).(string)
 	n.Expression = ctx.SoqlValue().Accept(v).(Node)
 	return n
 }
 
+func (v *Builder) VisitSoqlOperator(ctx *parser.SoqlOperatorContext) interface{} {
+	if ctx.SOQL_NOT() != nil {
+		return "NOT IN"
+	}
+	return strings.ToUpper(ctx.GetText())
+}
+
 func (v *Builder) VisitLimitClause(ctx *parser.LimitClauseContext) interface{} {
 	if l := ctx.IntegerLiteral(); l != nil {
 		val, err := strconv.Atoi(l.GetText())
@@ -1426,13 +1537,21 @@ func (v *Builder) VisitLimitClause(ctx *parser.LimitClauseContext) interface{} {
 
 func (v *Builder) VisitOrderClause(ctx *parser.OrderClauseContext) interface{} {
 	n := &Order{Location: v.newLocation(ctx)}
-	fields := make([]Node, len(ctx.AllSoqlField()))
-	for i, f := range ctx.AllSoqlField() {
-		fields[i] = f.Accept(v).(Node)
+	fields := ctx.AllOrderField()
+	n.Fields = make([]*OrderField, len(fields))
+	for i, f := range fields {
+		n.Fields[i] = f.Accept(v).(*OrderField)
+	}
+	return n
+}
+
+func (v *Builder) VisitOrderField(ctx *parser.OrderFieldContext) interface{} {
+	n := &OrderField{Field: ctx.SoqlField().Accept(v).(Node), Asc: true}
+	if ascDesc := ctx.GetAsc_desc(); ascDesc != nil {
+		n.Asc = strings.ToLower(ascDesc.GetText()) == "asc"
 	}
-	n.Asc = ctx.GetAsc_desc().GetText() == "asc"
 	if nulls := ctx.GetNulls(); nulls != nil {
-		n.Nulls = nulls.GetText()
+		n.Nulls = strings.ToUpper(nulls.GetText())
 	}
 	return n
 }
@@ -1442,15 +1561,46 @@ func (v *Builder) VisitBindVariable(ctx *parser.BindVariableContext) interface{}
 }
 
 func (v *Builder) VisitSoqlValue(ctx *parser.SoqlValueContext) interface{} {
+	if ident := ctx.ApexIdentifier(); ident != nil {
+		n := &SoqlDateLiteral{
+			Name:     strings.ToUpper(ident.GetText()),
+			Location: v.newLocation(ctx),
+		}
+		// the date literal with number such as LAST_N_DAYS:30
+		if l := ctx.Literal(); l != nil {
+			val, err := strconv.Atoi(l.GetText())
+			if err != nil {
+				panic(err)
+			}
+			n.N = val
+		}
+		return n
+	}
 	if l := ctx.Literal(); l != nil {
 		return l.Accept(v)
 	}
 	if b := ctx.BindVariable(); b != nil {
 		return b.Accept(v)
 	}
+	if s := ctx.Subquery(); s != nil {
+		return s.Accept(v)
+	}
+	if l := ctx.SoqlValueList(); l != nil {
+		return l.Accept(v)
+	}
 	return nil
 }
 
+func (v *Builder) VisitSoqlValueList(ctx *parser.SoqlValueListContext) interface{} {
+	literals := ctx.AllLiteral()
+	n := &SoqlValueList{Location: v.newLocation(ctx)}
+	n.Values = make([]Node, len(literals))
+	for i, l := range literals {
+		n.Values[i] = l.Accept(v).(Node)
+	}
+	return n
+}
+
 func (v *Builder) VisitWithClause(ctx *parser.WithClauseContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -1493,16 +1643,42 @@ func (v *Builder) VisitViewClause(ctx *parser.ViewClauseContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *Builder) VisitSoslLiteral(ctx *parser.SoslLiteralContext) interface{} {
+func (v *Builder) VisitAllRowsClause(ctx *parser.AllRowsClauseContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *Builder) VisitSoslLiteral(ctx *parser.SoslLiteralContext) interface{} {
+	return ctx.SoslQuery().Accept(v)
+}
+
 func (v *Builder) VisitSoslQuery(ctx *parser.SoslQueryContext) interface{} {
-	return v.VisitChildren(ctx)
+	n := &Sosl{
+		SearchGroup:      "ALL",
+		ReturningObjects: []*SoslReturningObject{},
+		Location:         v.newLocation(ctx),
+	}
+	if lit, ok := ctx.Literal().Accept(v).(*StringLiteral); ok {
+		n.Search = lit.Value
+	}
+	if searchGroup := ctx.GetSearchGroup(); searchGroup != nil {
+		n.SearchGroup = strings.ToUpper(searchGroup.GetText())
+	}
+	for _, r := range ctx.AllSoslReturningObject() {
+		n.ReturningObjects = append(n.ReturningObjects, r.Accept(v).(*SoslReturningObject))
+	}
+	return n
 }
 
 func (v *Builder) VisitSoslReturningObject(ctx *parser.SoslReturningObjectContext) interface{} {
-	return v.VisitChildren(ctx)
+	identifiers := ctx.AllIdentifier()
+	n := &SoslReturningObject{
+		Name:   identifiers[0].GetText(),
+		Fields: []string{},
+	}
+	for _, identifier := range identifiers[1:] {
+		n.Fields = append(n.Fields, identifier.GetText())
+	}
+	return n
 }
 
 type LocationContext interface {
@@ -68,6 +68,20 @@ func (t *ClassType) HasConstructor() bool {
 	return false
 }
 
+func (t *ClassType) IsAnnotated(name string) bool {
+	for _, annotation := range t.Annotations {
+		if strings.EqualFold(annotation.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// AnnotationParameter returns the value of the annotation parameter such as SeeAllData of @isTest(SeeAllData=true)
+func (t *ClassType) AnnotationParameter(annotation, name string) (Node, bool) {
+	return annotationParameter(t.Annotations, annotation, name)
+}
+
 func (t *ClassType) Is(name string) bool {
 	name = strings.ToLower(name)
 	for _, modifier := range t.Modifiers {
@@ -246,6 +260,25 @@ func (m *Method) IsAnnotated(name string) bool {
 	return false
 }
 
+// AnnotationParameter returns the value of the annotation parameter such as SeeAllData of @isTest(SeeAllData=true)
+func (m *Method) AnnotationParameter(annotation, name string) (Node, bool) {
+	return annotationParameter(m.Annotations, annotation, name)
+}
+
+func annotationParameter(annotations []*Annotation, annotation, name string) (Node, bool) {
+	for _, a := range annotations {
+		if !strings.EqualFold(a.Name, annotation) {
+			continue
+		}
+		for _, p := range a.Parameters {
+			if pair, ok := p.(*ElementValuePair); ok && strings.EqualFold(pair.Name, name) {
+				return pair.Value, true
+			}
+		}
+	}
+	return nil, false
+}
+
 func (m *Method) AccessModifier() string {
 	if m.IsPublic() {
 		return "public"
@@ -35,6 +35,14 @@ type Annotation struct {
 	Parent     Node
 }
 
+type ElementValuePair struct {
+	Name     string
+	Value    Node
+	Location *Location
+	Parent   Node
+	*NoopAccepter
+}
+
 type InterfaceDeclaration struct {
 	Annotations []*Annotation
 	Modifiers   []*Modifier
@@ -44,6 +52,15 @@ type InterfaceDeclaration struct {
 	Parent      Node
 }
 
+type EnumDeclaration struct {
+	Annotations []*Annotation
+	Modifiers   []*Modifier
+	Name        string
+	Values      []string
+	Location    *Location
+	Parent      Node
+}
+
 type IntegerLiteral struct {
 	Value    int
 	Location *Location
@@ -249,24 +266,44 @@ type Soql struct {
 	Limit        Node
 	Offset       Node
 	ExactlyOne   bool
+	AllRows      bool
 	Location     *Location
 	Parent       Node
 }
 
 type SelectField struct {
 	Value    []string
+	Alias    string
 	Location *Location
 	Parent   Node
 	*NoopAccepter
 }
 
-type SoqlFunction struct {
+type SoqlDateLiteral struct {
 	Name     string
+	N        int
 	Location *Location
 	Parent   Node
 	*NoopAccepter
 }
 
+// SoqlValueList is the list of literals in the condition such as `Name IN ('a', 'b')`
+type SoqlValueList struct {
+	Values   []Node
+	Location *Location
+	Parent   Node
+	*NoopAccepter
+}
+
+type SoqlFunction struct {
+	Name       string
+	Parameters []Node
+	Alias      string
+	Location   *Location
+	Parent     Node
+	*NoopAccepter
+}
+
 type WhereBinaryOperator struct {
 	Left     Node
 	Right    Node
@@ -287,22 +324,35 @@ type WhereCondition struct {
 }
 
 type Order struct {
-	Field    []Node
-	Asc      bool
-	Nulls    string
+	Fields   []*OrderField
 	Location *Location
 	Parent   Node
 	*NoopAccepter
 }
 
+// OrderField is the field of ORDER BY clause with its sort direction
+type OrderField struct {
+	Field Node
+	Asc   bool
+	Nulls string
+}
+
 type Group struct {
 	Fields []Node
 	Having Node
 }
 
 type Sosl struct {
-	Location *Location
-	Parent   Node
+	Search           string
+	SearchGroup      string
+	ReturningObjects []*SoslReturningObject
+	Location         *Location
+	Parent           Node
+}
+
+type SoslReturningObject struct {
+	Name   string
+	Fields []string
 }
 
 type StringLiteral struct {
@@ -495,6 +545,7 @@ type Visitor interface {
 	VisitModifier(*Modifier) (interface{}, error)
 	VisitAnnotation(*Annotation) (interface{}, error)
 	VisitInterfaceDeclaration(*InterfaceDeclaration) (interface{}, error)
+	VisitEnumDeclaration(*EnumDeclaration) (interface{}, error)
 	VisitIntegerLiteral(*IntegerLiteral) (interface{}, error)
 	VisitParameter(*Parameter) (interface{}, error)
 	VisitArrayAccess(*ArrayAccess) (interface{}, error)
@@ -591,6 +642,19 @@ func (n *Annotation) GetChildren() []interface{} {
 	}
 }
 
+func (n *EnumDeclaration) Accept(v Visitor) (interface{}, error) {
+	return v.VisitEnumDeclaration(n)
+}
+
+func (n *EnumDeclaration) GetChildren() []interface{} {
+	return []interface{}{
+		n.Name,
+		n.Annotations,
+		n.Values,
+		n.Modifiers,
+	}
+}
+
 func (n *InterfaceDeclaration) Accept(v Visitor) (interface{}, error) {
 	return v.VisitInterfaceDeclaration(n)
 }
@@ -1216,6 +1280,13 @@ func (n *Modifier) GetType() string {
 func (n *Annotation) GetType() string {
 	return "Annotation"
 }
+
+func (n *ElementValuePair) GetType() string {
+	return "ElementValuePair"
+}
+func (n *EnumDeclaration) GetType() string {
+	return "EnumDeclaration"
+}
 func (n *InterfaceDeclaration) GetType() string {
 	return "InterfaceDeclaration"
 }
@@ -1382,6 +1453,14 @@ func (n *SelectField) GetType() string {
 	return "SelectField"
 }
 
+func (n *SoqlDateLiteral) GetType() string {
+	return "SoqlDateLiteral"
+}
+
+func (n *SoqlValueList) GetType() string {
+	return "SoqlValueList"
+}
+
 func (n *SoqlFunction) GetType() string {
 	return "SoqlFunction"
 }
@@ -1399,6 +1478,13 @@ func (n *Modifier) GetParent() Node {
 func (n *Annotation) GetParent() Node {
 	return n.Parent
 }
+
+func (n *ElementValuePair) GetParent() Node {
+	return n.Parent
+}
+func (n *EnumDeclaration) GetParent() Node {
+	return n.Parent
+}
 func (n *InterfaceDeclaration) GetParent() Node {
 	return n.Parent
 }
@@ -1565,6 +1651,14 @@ func (n *SelectField) GetParent() Node {
 	return n.Parent
 }
 
+func (n *SoqlDateLiteral) GetParent() Node {
+	return n.Parent
+}
+
+func (n *SoqlValueList) GetParent() Node {
+	return n.Parent
+}
+
 func (n *SoqlFunction) GetParent() Node {
 	return n.Parent
 }
@@ -1585,6 +1679,14 @@ func (n *Annotation) SetParent(parent Node) {
 	n.Parent = parent
 }
 
+func (n *ElementValuePair) SetParent(parent Node) {
+	n.Parent = parent
+}
+
+func (n *EnumDeclaration) SetParent(parent Node) {
+	n.Parent = parent
+}
+
 func (n *InterfaceDeclaration) SetParent(parent Node) {
 	n.Parent = parent
 }
@@ -1801,6 +1903,14 @@ func (n *SelectField) SetParent(parent Node) {
 	n.Parent = parent
 }
 
+func (n *SoqlDateLiteral) SetParent(parent Node) {
+	n.Parent = parent
+}
+
+func (n *SoqlValueList) SetParent(parent Node) {
+	n.Parent = parent
+}
+
 func (n *SoqlFunction) SetParent(parent Node) {
 	n.Parent = parent
 }
@@ -1821,6 +1931,14 @@ func (n *Annotation) GetLocation() *Location {
 	return n.Location
 }
 
+func (n *ElementValuePair) GetLocation() *Location {
+	return n.Location
+}
+
+func (n *EnumDeclaration) GetLocation() *Location {
+	return n.Location
+}
+
 func (n *InterfaceDeclaration) GetLocation() *Location {
 	return n.Location
 }
@@ -2041,6 +2159,14 @@ func (n *SelectField) GetLocation() *Location {
 	return n.Location
 }
 
+func (n *SoqlDateLiteral) GetLocation() *Location {
+	return n.Location
+}
+
+func (n *SoqlValueList) GetLocation() *Location {
+	return n.Location
+}
+
 func (n *SoqlFunction) GetLocation() *Location {
 	return n.Location
 }
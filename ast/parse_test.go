@@ -822,6 +822,22 @@ public void action(){
 						},
 						Op: "OR",
 					},
+					Order: &Order{
+						Fields: []*OrderField{
+							{
+								Field: &SelectField{
+									Value: []string{"id"},
+								},
+								Asc: true,
+							},
+							{
+								Field: &SelectField{
+									Value: []string{"Name"},
+								},
+								Asc: true,
+							},
+						},
+					},
 				},
 			}),
 		},
@@ -851,6 +867,452 @@ true;
 				},
 			},
 		},
+		{
+			`class Foo {
+public void action(){
+[SELECT Id FROM Account ALL ROWS];
+[SELECT Id FROM Account];
+}
+}`,
+			createExpectedClass([]Node{
+				&Soql{
+					SelectFields: []Node{
+						&SelectField{
+							Value: []string{"Id"},
+						},
+					},
+					FromObject: "Account",
+					AllRows:    true,
+				},
+				&Soql{
+					SelectFields: []Node{
+						&SelectField{
+							Value: []string{"Id"},
+						},
+					},
+					FromObject: "Account",
+				},
+			}),
+		},
+		{
+			`class Foo {
+public void action(){
+[SELECT StageName stage, COUNT() total, SUM(Amount) amount FROM Opportunity];
+// SUM(Amount) total
+foo('SUM(Amount) total');
+}
+}`,
+			createExpectedClass([]Node{
+				&Soql{
+					SelectFields: []Node{
+						&SelectField{
+							Value: []string{"StageName"},
+							Alias: "stage",
+						},
+						&SoqlFunction{
+							Name:       "COUNT",
+							Parameters: []Node{},
+							Alias:      "total",
+						},
+						&SoqlFunction{
+							Name: "SUM",
+							Parameters: []Node{
+								&SelectField{
+									Value: []string{"Amount"},
+								},
+							},
+							Alias: "amount",
+						},
+					},
+					FromObject: "Opportunity",
+				},
+				&MethodInvocation{
+					NameOrExpression: &Name{
+						Value: []string{"foo"},
+					},
+					Parameters: []Node{
+						&StringLiteral{
+							Value: "SUM(Amount) total",
+						},
+					},
+				},
+			}),
+		},
+		{
+			`class Foo {
+public void action(){
+[SELECT Id FROM Account ORDER BY Name DESC NULLS LAST, Id, CreatedDate DESC LIMIT 1];
+[SELECT Name, COUNT(Id) FROM Account GROUP BY Name ORDER BY COUNT(Id) ASC NULLS FIRST, Name];
+}
+}`,
+			createExpectedClass([]Node{
+				&Soql{
+					SelectFields: []Node{
+						&SelectField{
+							Value: []string{"Id"},
+						},
+					},
+					FromObject: "Account",
+					Order: &Order{
+						Fields: []*OrderField{
+							{
+								Field: &SelectField{Value: []string{"Name"}},
+								Asc:   false,
+								Nulls: "LAST",
+							},
+							{
+								Field: &SelectField{Value: []string{"Id"}},
+								Asc:   true,
+							},
+							{
+								Field: &SelectField{Value: []string{"CreatedDate"}},
+								Asc:   false,
+							},
+						},
+					},
+					Limit: &IntegerLiteral{
+						Value: 1,
+					},
+				},
+				&Soql{
+					SelectFields: []Node{
+						&SelectField{
+							Value: []string{"Name"},
+						},
+						&SoqlFunction{
+							Name: "COUNT",
+							Parameters: []Node{
+								&SelectField{Value: []string{"Id"}},
+							},
+						},
+					},
+					FromObject: "Account",
+					Order: &Order{
+						Fields: []*OrderField{
+							{
+								Field: &SoqlFunction{
+									Name: "COUNT",
+									Parameters: []Node{
+										&SelectField{Value: []string{"Id"}},
+									},
+								},
+								Asc:   true,
+								Nulls: "FIRST",
+							},
+							{
+								Field: &SelectField{Value: []string{"Name"}},
+								Asc:   true,
+							},
+						},
+					},
+				},
+			}),
+		},
+		{
+			`class Foo {
+public void action(){
+[SELECT Id, (SELECT Name FROM Contacts ORDER BY Name DESC, Id), Parent.Owner.Name FROM Account];
+}
+}`,
+			createExpectedClass([]Node{
+				&Soql{
+					SelectFields: []Node{
+						&SelectField{
+							Value: []string{"Id"},
+						},
+						&Soql{
+							SelectFields: []Node{
+								&SelectField{
+									Value: []string{"Name"},
+								},
+							},
+							FromObject: "Contacts",
+							Order: &Order{
+								Fields: []*OrderField{
+									{
+										Field: &SelectField{Value: []string{"Name"}},
+										Asc:   false,
+									},
+									{
+										Field: &SelectField{Value: []string{"Id"}},
+										Asc:   true,
+									},
+								},
+							},
+						},
+						&SelectField{
+							Value: []string{"Parent", "Owner", "Name"},
+						},
+					},
+					FromObject: "Account",
+				},
+			}),
+		},
+		{
+			`class Foo {
+public void action(){
+[SELECT Id FROM Account WHERE Id NOT IN :ids AND Id IN (SELECT AccountId FROM Contact) AND Name IN ('a', 'b') AND Tags__c INCLUDES ('c') AND CreatedDate = TODAY];
+// Name IN ('a')
+foo('CreatedDate = TODAY');
+}
+}`,
+			createExpectedClass([]Node{
+				&Soql{
+					SelectFields: []Node{
+						&SelectField{
+							Value: []string{"Id"},
+						},
+					},
+					FromObject: "Account",
+					Where: &WhereBinaryOperator{
+						Left: &WhereBinaryOperator{
+							Left: &WhereBinaryOperator{
+								Left: &WhereBinaryOperator{
+									Left: &WhereCondition{
+										Field: &SelectField{
+											Value: []string{"Id"},
+										},
+										Op: "NOT IN",
+										Expression: &Name{
+											Value: []string{"ids"},
+										},
+									},
+									Right: &WhereCondition{
+										Field: &SelectField{
+											Value: []string{"Id"},
+										},
+										Op: "IN",
+										Expression: &Soql{
+											SelectFields: []Node{
+												&SelectField{
+													Value: []string{"AccountId"},
+												},
+											},
+											FromObject: "Contact",
+										},
+									},
+									Op: "AND",
+								},
+								Right: &WhereCondition{
+									Field: &SelectField{
+										Value: []string{"Name"},
+									},
+									Op: "IN",
+									Expression: &SoqlValueList{
+										Values: []Node{
+											&StringLiteral{Value: "a"},
+											&StringLiteral{Value: "b"},
+										},
+									},
+								},
+								Op: "AND",
+							},
+							Right: &WhereCondition{
+								Field: &SelectField{
+									Value: []string{"Tags__c"},
+								},
+								Op: "INCLUDES",
+								Expression: &SoqlValueList{
+									Values: []Node{
+										&StringLiteral{Value: "c"},
+									},
+								},
+							},
+							Op: "AND",
+						},
+						Right: &WhereCondition{
+							Field: &SelectField{
+								Value: []string{"CreatedDate"},
+							},
+							Op: "=",
+							Expression: &SoqlDateLiteral{
+								Name: "TODAY",
+							},
+						},
+						Op: "AND",
+					},
+				},
+				&MethodInvocation{
+					NameOrExpression: &Name{
+						Value: []string{"foo"},
+					},
+					Parameters: []Node{
+						&StringLiteral{
+							Value: "CreatedDate = TODAY",
+						},
+					},
+				},
+			}),
+		},
+		{
+			`class Foo {
+public void action(){
+[FIND 'foo' IN NAME FIELDS RETURNING Account(Id, Name), Contact];
+[FIND 'foo' RETURNING Account];
+[FIND 'foo' IN ALL FIELDS RETURNING Account];
+// FIND 'foo' IN NAME FIELDS RETURNING Account
+foo('IN NAME FIELDS RETURNING Account');
+}
+}`,
+			createExpectedClass([]Node{
+				&Sosl{
+					Search:      "foo",
+					SearchGroup: "NAME",
+					ReturningObjects: []*SoslReturningObject{
+						{
+							Name:   "Account",
+							Fields: []string{"Id", "Name"},
+						},
+						{
+							Name:   "Contact",
+							Fields: []string{},
+						},
+					},
+				},
+				&Sosl{
+					Search:      "foo",
+					SearchGroup: "ALL",
+					ReturningObjects: []*SoslReturningObject{
+						{
+							Name:   "Account",
+							Fields: []string{},
+						},
+					},
+				},
+				&Sosl{
+					Search:      "foo",
+					SearchGroup: "ALL",
+					ReturningObjects: []*SoslReturningObject{
+						{
+							Name:   "Account",
+							Fields: []string{},
+						},
+					},
+				},
+				&MethodInvocation{
+					NameOrExpression: &Name{
+						Value: []string{"foo"},
+					},
+					Parameters: []Node{
+						&StringLiteral{
+							Value: "IN NAME FIELDS RETURNING Account",
+						},
+					},
+				},
+			}),
+		},
+		{
+			`class Foo {
+public void action(){
+switch on c {
+  when RED, GREEN {
+    foo('when BLUE {');
+  }
+  when 'RED' {
+    // when BLUE {
+  }
+  when Account a {
+  }
+  when else {
+  }
+}
+}
+}`,
+			createExpectedClass([]Node{
+				&Switch{
+					Expression: &Name{
+						Value: []string{"c"},
+					},
+					WhenStatements: []*When{
+						{
+							Condition: []Node{
+								&Name{
+									Value: []string{"RED"},
+								},
+								&Name{
+									Value: []string{"GREEN"},
+								},
+							},
+							Statements: &Block{
+								Statements: []Node{
+									&MethodInvocation{
+										NameOrExpression: &Name{
+											Value: []string{"foo"},
+										},
+										Parameters: []Node{
+											&StringLiteral{
+												Value: "when BLUE {",
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							Condition: []Node{
+								&StringLiteral{
+									Value: "RED",
+								},
+							},
+							Statements: &Block{
+								Statements: []Node{},
+							},
+						},
+						{
+							Condition: []Node{
+								&WhenType{
+									TypeRef: &TypeRef{
+										Name:       []string{"Account"},
+										Parameters: []*TypeRef{},
+									},
+									Identifier: "a",
+								},
+							},
+							Statements: &Block{
+								Statements: []Node{},
+							},
+						},
+					},
+					ElseStatement: &Block{
+						Statements: []Node{},
+					},
+				},
+			}),
+		},
+		{
+			`class Foo {
+public void action(){
+foo(Trigger.new, trigger.isInsert);
+// Trigger.new
+foo('Trigger.new');
+}
+}`,
+			createExpectedClass([]Node{
+				&MethodInvocation{
+					NameOrExpression: &Name{
+						Value: []string{"foo"},
+					},
+					Parameters: []Node{
+						&Name{
+							Value: []string{"Trigger", "new"},
+						},
+						&Name{
+							Value: []string{"Trigger", "isInsert"},
+						},
+					},
+				},
+				&MethodInvocation{
+					NameOrExpression: &Name{
+						Value: []string{"foo"},
+					},
+					Parameters: []Node{
+						&StringLiteral{
+							Value: "Trigger.new",
+						},
+					},
+				},
+			}),
+		},
 	}
 	for _, testCase := range testCases {
 		actual, err := ParseString(testCase.Code)
@@ -862,6 +1324,24 @@ true;
 	}
 }
 
+func TestParseTriggerContextLocation(t *testing.T) {
+	code := `class Foo {
+public void action(){
+  foo(Trigger.new);
+}
+}`
+	actual, err := ParseString(code)
+	if err != nil {
+		panic(err)
+	}
+	method := actual.(*ClassDeclaration).Declarations[0].(*MethodDeclaration)
+	invocation := method.Statements.Statements[0].(*MethodInvocation)
+	loc := invocation.Parameters[0].GetLocation()
+	if loc.Line != 3 || loc.Column != 6 {
+		t.Errorf("expected 3:6, actual %d:%d", loc.Line, loc.Column)
+	}
+}
+
 func equalNode(t *testing.T, expected Node, actual Node) {
 	e := ToString(expected)
 	a := ToString(actual)
@@ -92,7 +92,55 @@ func (v *TosVisitor) VisitModifier(n *Modifier) (interface{}, error) {
 }
 
 func (v *TosVisitor) VisitAnnotation(n *Annotation) (interface{}, error) {
-	return n.Name, nil
+	if len(n.Parameters) == 0 {
+		return n.Name, nil
+	}
+	parameters := make([]string, len(n.Parameters))
+	for i, p := range n.Parameters {
+		if pair, ok := p.(*ElementValuePair); ok {
+			value, err := pair.Value.Accept(v)
+			if err != nil {
+				return nil, err
+			}
+			parameters[i] = fmt.Sprintf("%s=%s", pair.Name, value.(string))
+			continue
+		}
+		value, err := p.Accept(v)
+		if err != nil {
+			return nil, err
+		}
+		parameters[i] = value.(string)
+	}
+	return fmt.Sprintf("%s(%s)", n.Name, strings.Join(parameters, " ")), nil
+}
+
+func (v *TosVisitor) VisitEnumDeclaration(n *EnumDeclaration) (interface{}, error) {
+	modifiers := make([]string, len(n.Modifiers))
+	for i, m := range n.Modifiers {
+		r, err := m.Accept(v)
+		if err != nil {
+			return nil, err
+		}
+		modifiers[i] = r.(string)
+	}
+	values := make([]string, len(n.Values))
+	v.AddIndent(func() {
+		for i, value := range n.Values {
+			values[i] = v.withIndent(value)
+		}
+	})
+	body := ""
+	if len(values) != 0 {
+		body = fmt.Sprintf("%s\n", strings.Join(values, ",\n"))
+	}
+	return fmt.Sprintf(
+		`%s enum %s {
+%s%s`,
+		v.withIndent(strings.Join(modifiers, " ")),
+		n.Name,
+		body,
+		v.withIndent("}"),
+	), nil
 }
 
 func (v *TosVisitor) VisitInterfaceDeclaration(n *InterfaceDeclaration) (interface{}, error) {
@@ -182,6 +230,9 @@ func (v *TosVisitor) VisitDml(n *Dml) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	if n.UpsertKey != "" {
+		return fmt.Sprintf("%s %s %s", n.Type, r.(string), n.UpsertKey), nil
+	}
 	return fmt.Sprintf("%s %s", n.Type, r.(string)), nil
 }
 
@@ -509,6 +560,17 @@ func (v *TosVisitor) VisitNew(n *New) (interface{}, error) {
 		}
 		parameters[i] = r.(string)
 	}
+	if n.Init != nil && len(n.Init.Records) > 0 {
+		records := make([]string, len(n.Init.Records))
+		for i, r := range n.Init.Records {
+			record, err := r.Accept(v)
+			if err != nil {
+				return nil, err
+			}
+			records[i] = record.(string)
+		}
+		return fmt.Sprintf("new %s{%s}", t.(string), strings.Join(records, ", ")), nil
+	}
 	return fmt.Sprintf(
 		"new %s(%s)",
 		t.(string),
@@ -574,9 +636,16 @@ func (v *TosVisitor) VisitSoql(n *Soql) (interface{}, error) {
 			for i, f := range n.SelectFields {
 				switch val := f.(type) {
 				case *SelectField:
-					fields[i] = v.withIndent(strings.Join(val.Value, "."))
+					fields[i] = strings.Join(val.Value, ".")
+					if val.Alias != "" {
+						fields[i] += " " + val.Alias
+					}
+					fields[i] = v.withIndent(fields[i])
 				case *SoqlFunction:
-					fields[i] = v.withIndent(val.Name + "()")
+					fields[i] = v.withIndent(v.createSoqlFunction(val))
+				case *Soql:
+					subquery, _ := val.Accept(v)
+					fields[i] = v.withIndent(v.createSubquery(subquery.(string)))
 				}
 			}
 
@@ -596,6 +665,17 @@ func (v *TosVisitor) VisitSoql(n *Soql) (interface{}, error) {
 		where = "\n" + indent + "WHERE\n" + where
 	}
 	orderBy := ""
+	if order, ok := n.Order.(*Order); ok {
+		fields := make([]string, len(order.Fields))
+		v.AddIndent(func() {
+			v.AddIndent(func() {
+				for i, f := range order.Fields {
+					fields[i] = v.withIndent(v.createOrderField(f))
+				}
+			})
+		})
+		orderBy = "\n" + indent + "ORDER BY\n" + strings.Join(fields, ",\n")
+	}
 	groupBy := ""
 	limit := ""
 	if n.Limit != nil {
@@ -609,12 +689,16 @@ func (v *TosVisitor) VisitSoql(n *Soql) (interface{}, error) {
 			})
 		})
 	}
+	allRows := ""
+	if n.AllRows {
+		allRows = "\n" + indent + "ALL ROWS"
+	}
 
 	return fmt.Sprintf(`[
 %sSELECT
 %s
 %sFROM
-%s%s%s%s%s%s`,
+%s%s%s%s%s%s%s`,
 		indent,
 		strings.Join(fields, ",\n"),
 		indent,
@@ -623,6 +707,7 @@ func (v *TosVisitor) VisitSoql(n *Soql) (interface{}, error) {
 		orderBy,
 		groupBy,
 		limit,
+		allRows,
 		"\n"+v.withIndent("]"),
 	), nil
 }
@@ -635,7 +720,22 @@ func (v *TosVisitor) createWhere(n Node) string {
 		case *SelectField:
 			field = strings.Join(f.Value, ".")
 		case *SoqlFunction:
-			field = f.Name + "()"
+			field = v.createSoqlFunction(f)
+		}
+		if val.Not {
+			field = "NOT " + field
+		}
+		switch e := val.Expression.(type) {
+		case *SoqlDateLiteral:
+			return fmt.Sprintf("%s %s %s", field, val.Op, v.createSoqlDateLiteral(e))
+		case *SoqlValueList:
+			return fmt.Sprintf("%s %s %s", field, val.Op, v.createSoqlValueList(e))
+		case *Soql:
+			subquery, err := e.Accept(v)
+			if err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%s %s %s", field, val.Op, v.createSubquery(subquery.(string)))
 		}
 		value, err := val.Expression.Accept(v)
 		if err != nil {
@@ -655,12 +755,84 @@ func (v *TosVisitor) createWhere(n Node) string {
 	return ""
 }
 
+func (v *TosVisitor) createSoqlFunction(n *SoqlFunction) string {
+	parameters := make([]string, len(n.Parameters))
+	for i, p := range n.Parameters {
+		if f, ok := p.(*SelectField); ok {
+			parameters[i] = strings.Join(f.Value, ".")
+		}
+	}
+	function := fmt.Sprintf("%s(%s)", n.Name, strings.Join(parameters, ", "))
+	if n.Alias != "" {
+		function += " " + n.Alias
+	}
+	return function
+}
+
+// createSubquery encloses the subquery in parentheses instead of brackets
+func (v *TosVisitor) createSubquery(soql string) string {
+	soql = strings.TrimSpace(soql)
+	return "(" + soql[1:len(soql)-1] + ")"
+}
+
+func (v *TosVisitor) createOrderField(n *OrderField) string {
+	var field string
+	switch f := n.Field.(type) {
+	case *SelectField:
+		field = strings.Join(f.Value, ".")
+	case *SoqlFunction:
+		field = v.createSoqlFunction(f)
+	}
+	if n.Asc {
+		field += " ASC"
+	} else {
+		field += " DESC"
+	}
+	if n.Nulls != "" {
+		field += " NULLS " + n.Nulls
+	}
+	return field
+}
+
+func (v *TosVisitor) createSoqlValueList(n *SoqlValueList) string {
+	values := make([]string, len(n.Values))
+	for i, value := range n.Values {
+		r, err := value.Accept(v)
+		if err != nil {
+			panic(err)
+		}
+		values[i] = r.(string)
+	}
+	return "(" + strings.Join(values, ", ") + ")"
+}
+
+func (v *TosVisitor) createSoqlDateLiteral(n *SoqlDateLiteral) string {
+	for _, name := range SoqlDateLiterals {
+		if n.Name == name {
+			return n.Name
+		}
+	}
+	return fmt.Sprintf("%s:%d", n.Name, n.N)
+}
+
 func (v *TosVisitor) VisitSosl(n *Sosl) (interface{}, error) {
-	return VisitSosl(v, n)
+	returningObjects := make([]string, len(n.ReturningObjects))
+	for i, r := range n.ReturningObjects {
+		returningObjects[i] = r.Name
+		if len(r.Fields) > 0 {
+			returningObjects[i] += "(" + strings.Join(r.Fields, ", ") + ")"
+		}
+	}
+	return fmt.Sprintf(
+		"[FIND '%s' IN %s FIELDS RETURNING %s]",
+		n.Search,
+		n.SearchGroup,
+		strings.Join(returningObjects, ", "),
+	), nil
 }
 
 func (v *TosVisitor) VisitStringLiteral(n *StringLiteral) (interface{}, error) {
-	return "'" + n.Value + "'", nil
+	return "'" + escapeString(n.Value) + "'", nil
 }
 
 func (v *TosVisitor) VisitSwitch(n *Switch) (interface{}, error) {
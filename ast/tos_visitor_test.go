@@ -13,6 +13,10 @@ func TestToString(t *testing.T) {
 			&StringLiteral{Value: "foo"},
 			"'foo'",
 		},
+		{
+			&StringLiteral{Value: "O'Brien\n"},
+			`'O\'Brien\n'`,
+		},
 		{
 			&IntegerLiteral{Value: 1},
 			"1",
@@ -142,6 +146,19 @@ public class foo extends bar implements baz {
 			},
 			`@annotation
 public Integer foo (String s) {
+}`,
+		},
+		{
+			&EnumDeclaration{
+				Name: "Color",
+				Modifiers: []*Modifier{
+					{Name: "public"},
+				},
+				Values: []string{"RED", "GREEN"},
+			},
+			`public enum Color {
+    RED,
+    GREEN
 }`,
 		},
 	}
@@ -1,5 +1,10 @@
 package ast
 
+import (
+	"strconv"
+	"strings"
+)
+
 func IsDecendants(n Node, typeName string) bool {
 	parent := n.GetParent()
 	if parent == nil {
@@ -18,3 +23,83 @@ func IsParent(n Node, typeName string) bool {
 	}
 	return false
 }
+
+var escapeSequences = map[byte]rune{
+	'b':  '\b',
+	't':  '\t',
+	'n':  '\n',
+	'f':  '\f',
+	'r':  '\r',
+	'"':  '"',
+	'\'': '\'',
+	'\\': '\\',
+}
+
+// unescapeString replaces the escape sequences of the string literal with the characters
+func unescapeString(str string) string {
+	if !strings.Contains(str, "\\") {
+		return str
+	}
+	var buf strings.Builder
+	for i := 0; i < len(str); i++ {
+		if str[i] != '\\' || i+1 == len(str) {
+			buf.WriteByte(str[i])
+			continue
+		}
+		i++
+		if r, ok := escapeSequences[str[i]]; ok {
+			buf.WriteRune(r)
+			continue
+		}
+		if str[i] == 'u' {
+			j := i + 1
+			for j < len(str) && str[j] == 'u' {
+				j++
+			}
+			if j+4 <= len(str) {
+				if code, err := strconv.ParseUint(str[j:j+4], 16, 32); err == nil {
+					buf.WriteRune(rune(code))
+					i = j + 3
+					continue
+				}
+			}
+		}
+		if isOctalDigit(str[i]) {
+			// \d, \dd or \[0-3]dd
+			max := i + 2
+			if str[i] <= '3' {
+				max = i + 3
+			}
+			j := i + 1
+			for j < len(str) && j < max && isOctalDigit(str[j]) {
+				j++
+			}
+			code, _ := strconv.ParseUint(str[i:j], 8, 8)
+			buf.WriteRune(rune(code))
+			i = j - 1
+			continue
+		}
+		buf.WriteByte('\\')
+		buf.WriteByte(str[i])
+	}
+	return buf.String()
+}
+
+func isOctalDigit(c byte) bool {
+	return '0' <= c && c <= '7'
+}
+
+// escapeString returns the string with the escape sequences of the string literal
+func escapeString(str string) string {
+	return stringEscaper.Replace(str)
+}
+
+var stringEscaper = strings.NewReplacer(
+	"\\", "\\\\",
+	"'", "\\'",
+	"\b", "\\b",
+	"\t", "\\t",
+	"\n", "\\n",
+	"\f", "\\f",
+	"\r", "\\r",
+)
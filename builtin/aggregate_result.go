@@ -0,0 +1,116 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tzmfreedom/goland/ast"
+)
+
+// AggregateResultType is the record type of the aggregate query.
+// The values are stored in instance fields, so `get('expr0')` of SObject is available.
+var AggregateResultType = &ast.ClassType{
+	Name:       "AggregateResult",
+	SuperClass: SObjectType,
+}
+
+var aggregateFunctions = []string{
+	"count",
+	"count_distinct",
+	"sum",
+	"avg",
+	"min",
+	"max",
+}
+
+func isAggregateFunction(n ast.Node) bool {
+	f, ok := n.(*ast.SoqlFunction)
+	if !ok {
+		return false
+	}
+	for _, name := range aggregateFunctions {
+		if strings.EqualFold(f.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAggregateQuery returns whether the query returns List<AggregateResult>
+func IsAggregateQuery(n *ast.Soql) bool {
+	if n.Group != nil && len(n.Group.Fields) > 0 {
+		return true
+	}
+	for _, f := range n.SelectFields {
+		if isAggregateFunction(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCountQuery returns whether the query is `SELECT COUNT() FROM ...` which returns Integer
+func IsCountQuery(n *ast.Soql) bool {
+	if len(n.SelectFields) != 1 || (n.Group != nil && len(n.Group.Fields) > 0) {
+		return false
+	}
+	f, ok := n.SelectFields[0].(*ast.SoqlFunction)
+	return ok && strings.EqualFold(f.Name, "count") && len(f.Parameters) == 0
+}
+
+// aggregateKeys returns keys of AggregateResult for each select field.
+// Aggregate functions without alias are named expr0, expr1, ...
+func aggregateKeys(n *ast.Soql) []string {
+	keys := make([]string, len(n.SelectFields))
+	exprIndex := 0
+	for i, selectField := range n.SelectFields {
+		switch f := selectField.(type) {
+		case *ast.SelectField:
+			if f.Alias != "" {
+				keys[i] = f.Alias
+			} else {
+				keys[i] = f.Value[len(f.Value)-1]
+			}
+		case *ast.SoqlFunction:
+			if f.Alias != "" {
+				keys[i] = f.Alias
+			} else {
+				keys[i] = fmt.Sprintf("expr%d", exprIndex)
+				exprIndex++
+			}
+		}
+	}
+	return keys
+}
+
+// soqlFunctionType returns the field type of the soql function result
+func soqlFunctionType(sObjectType string, f *ast.SoqlFunction) string {
+	if isDateFunction(f.Name) && !strings.EqualFold(f.Name, "convertTimezone") {
+		return dateFunctionType(f.Name)
+	}
+	switch strings.ToLower(f.Name) {
+	case "count", "count_distinct":
+		return "int"
+	case "sum", "avg":
+		return "double"
+	}
+	if len(f.Parameters) == 0 {
+		return ""
+	}
+	if field, ok := f.Parameters[0].(*ast.SelectField); ok {
+		return sObjectFieldType(sObjectType, field.Value)
+	}
+	return ""
+}
+
+func init() {
+	AggregateResultType.Constructors = []*ast.Method{}
+	AggregateResultType.InstanceFields = ast.NewFieldMap()
+	AggregateResultType.StaticFields = ast.NewFieldMap()
+	AggregateResultType.InstanceMethods = ast.NewMethodMap()
+	AggregateResultType.StaticMethods = ast.NewMethodMap()
+	AggregateResultType.ToString = func(o *ast.Object) string {
+		return SObjectType.ToString(o)
+	}
+	primitiveClassMap.Set("AggregateResult", AggregateResultType)
+}
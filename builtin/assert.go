@@ -0,0 +1,189 @@
+package builtin
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/tzmfreedom/goland/ast"
+)
+
+// assertion returns whether the assertion passes, and the expected and actual values for the failure message.
+// params do not include the optional message argument.
+type assertion func(params []*ast.Object, checker EqualChecker) (bool, string, string)
+
+// createAssertMethods returns the assertion method and its overload with the optional message argument
+func createAssertMethods(name string, parameters []*ast.Parameter, check assertion) []*ast.Method {
+	withMessage := append(append([]*ast.Parameter{}, parameters...), objectTypeParameter)
+	native := func(params []*ast.Object, extra map[string]interface{}) {
+		message := ""
+		if len(params) > len(parameters) {
+			message = String(params[len(parameters)])
+		}
+		checker := extra["interpreter"].(EqualChecker)
+		ok, expected, actual := check(params[:len(parameters)], checker)
+		if !ok {
+			assertFail(extra, message, expected, actual)
+		}
+	}
+	return []*ast.Method{
+		ast.CreateMethod(
+			name,
+			nil,
+			parameters,
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				native(params, extra)
+				return nil
+			},
+		),
+		ast.CreateMethod(
+			name,
+			nil,
+			withMessage,
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				native(params, extra)
+				return nil
+			},
+		),
+	}
+}
+
+// assertFail records the test error and stops the test method by AssertException.
+// AssertException is not an apex exception, so it can not be caught by try-catch.
+func assertFail(extra map[string]interface{}, message, expected, actual string) {
+	node := extra["node"].(ast.Node)
+	reason := "Assertion Failed"
+	lines := []string{}
+	if message != "" {
+		reason += ": " + message
+		lines = append(lines, fmt.Sprintf("      message:  %s", message))
+	}
+	if expected != "" || actual != "" {
+		reason += fmt.Sprintf(": Expected: %s, Actual: %s", expected, actual)
+		lines = append(lines, fmt.Sprintf("      expected: %s", expected), fmt.Sprintf("      actual:   %s", actual))
+	}
+	if len(lines) == 0 {
+		lines = append(lines, "      "+reason)
+	}
+	testError := &TestError{
+		Node:    node,
+		Message: strings.Join(lines, "\n"),
+		Reason:  reason,
+	}
+	extra["errors"] = append(extra["errors"].([]*TestError), testError)
+
+	loc := node.GetLocation()
+	fmt.Fprintf(extra["stdout"].(io.Writer), "%s at %d:%d\n", testError.Error(), loc.Line, loc.Column)
+	panic(testError)
+}
+
+func assertEquals(params []*ast.Object, checker EqualChecker) (bool, string, string) {
+	return checker.Equals(params[0], params[1]), String(params[0]), String(params[1])
+}
+
+func assertNotEquals(params []*ast.Object, checker EqualChecker) (bool, string, string) {
+	return !checker.Equals(params[0], params[1]), "not " + String(params[0]), String(params[1])
+}
+
+func assertTrue(params []*ast.Object, checker EqualChecker) (bool, string, string) {
+	return params[0] != Null && params[0].BoolValue(), "true", String(params[0])
+}
+
+func assertFalse(params []*ast.Object, checker EqualChecker) (bool, string, string) {
+	return params[0] != Null && !params[0].BoolValue(), "false", String(params[0])
+}
+
+func assertNull(params []*ast.Object, checker EqualChecker) (bool, string, string) {
+	return params[0] == Null, "null", String(params[0])
+}
+
+func assertNotNull(params []*ast.Object, checker EqualChecker) (bool, string, string) {
+	return params[0] != Null, "not null", String(params[0])
+}
+
+func assertInstanceOfType(params []*ast.Object, checker EqualChecker) (bool, string, string) {
+	expected := params[1].Value().(*ast.ClassType)
+	return isInstanceOfType(params[0], expected), expected.String(), instanceTypeName(params[0])
+}
+
+func assertNotInstanceOfType(params []*ast.Object, checker EqualChecker) (bool, string, string) {
+	notExpected := params[1].Value().(*ast.ClassType)
+	return !isInstanceOfType(params[0], notExpected), "not " + notExpected.String(), instanceTypeName(params[0])
+}
+
+func assertFailure(params []*ast.Object, checker EqualChecker) (bool, string, string) {
+	return false, "", ""
+}
+
+func isInstanceOfType(o *ast.Object, classType *ast.ClassType) bool {
+	return o != Null && Equals(classType, o.ClassType)
+}
+
+func instanceTypeName(o *ast.Object) string {
+	if o == Null {
+		return "null"
+	}
+	return o.ClassType.String()
+}
+
+var typeTypeParameter = &ast.Parameter{
+	Type: TypeType,
+	Name: "_",
+}
+
+func init() {
+	staticMethods := ast.NewMethodMap()
+	staticMethods.Set("areEqual", createAssertMethods(
+		"areEqual",
+		[]*ast.Parameter{objectTypeParameter, objectTypeParameter},
+		assertEquals,
+	))
+	staticMethods.Set("areNotEqual", createAssertMethods(
+		"areNotEqual",
+		[]*ast.Parameter{objectTypeParameter, objectTypeParameter},
+		assertNotEquals,
+	))
+	staticMethods.Set("isTrue", createAssertMethods(
+		"isTrue",
+		[]*ast.Parameter{booleanTypeParameter},
+		assertTrue,
+	))
+	staticMethods.Set("isFalse", createAssertMethods(
+		"isFalse",
+		[]*ast.Parameter{booleanTypeParameter},
+		assertFalse,
+	))
+	staticMethods.Set("isNull", createAssertMethods(
+		"isNull",
+		[]*ast.Parameter{objectTypeParameter},
+		assertNull,
+	))
+	staticMethods.Set("isNotNull", createAssertMethods(
+		"isNotNull",
+		[]*ast.Parameter{objectTypeParameter},
+		assertNotNull,
+	))
+	staticMethods.Set("isInstanceOfType", createAssertMethods(
+		"isInstanceOfType",
+		[]*ast.Parameter{objectTypeParameter, typeTypeParameter},
+		assertInstanceOfType,
+	))
+	staticMethods.Set("isNotInstanceOfType", createAssertMethods(
+		"isNotInstanceOfType",
+		[]*ast.Parameter{objectTypeParameter, typeTypeParameter},
+		assertNotInstanceOfType,
+	))
+	staticMethods.Set("fail", createAssertMethods(
+		"fail",
+		[]*ast.Parameter{},
+		assertFailure,
+	))
+
+	assertClass := ast.CreateClass(
+		"Assert",
+		[]*ast.Method{},
+		ast.NewMethodMap(),
+		staticMethods,
+	)
+	primitiveClassMap.Set("Assert", assertClass)
+}
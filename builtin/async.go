@@ -0,0 +1,80 @@
+package builtin
+
+import (
+	"github.com/tzmfreedom/goland/ast"
+)
+
+// AsyncExecutor runs future methods and queueable jobs.
+// The jobs are queued and run synchronously by Test.stopTest() or at the end of the transaction.
+type AsyncExecutor interface {
+	EnqueueJob(queueable *ast.Object) error
+	FlushAsyncJobs() (*ast.Object, error)
+}
+
+var queueableContextType = ast.CreateClass(
+	"QueueableContext",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+// NewQueueableContext returns the context which is passed to execute of the queueable job
+func NewQueueableContext() *ast.Object {
+	return ast.CreateObject(queueableContextType)
+}
+
+var queueableType = createQueueableType()
+
+func createQueueableType() *ast.ClassType {
+	instanceMethods := ast.NewMethodMap()
+	instanceMethods.Set(
+		"execute",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"execute",
+				nil,
+				[]*ast.Parameter{
+					{
+						Type: queueableContextType,
+						Name: "_",
+					},
+				},
+				nil,
+			),
+		},
+	)
+	classType := ast.CreateClass(
+		"Queueable",
+		[]*ast.Method{},
+		instanceMethods,
+		nil,
+	)
+	classType.Interface = true
+	return classType
+}
+
+var enqueueJobMethod = ast.CreateMethod(
+	"enqueueJob",
+	IdType,
+	[]*ast.Parameter{
+		{
+			Type: queueableType,
+			Name: "_",
+		},
+	},
+	func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+		if err := UseLimit(extra, LimitQueueableJobs, 1); err != nil {
+			panic(err)
+		}
+		executor := extra["interpreter"].(AsyncExecutor)
+		if err := executor.EnqueueJob(params[0]); err != nil {
+			panic(err)
+		}
+		return NewId(GenerateId("AsyncApexJob"))
+	},
+)
+
+func init() {
+	primitiveClassMap.Set("Queueable", queueableType)
+	primitiveClassMap.Set("QueueableContext", queueableContextType)
+}
@@ -0,0 +1,27 @@
+package builtin
+
+import (
+	"time"
+)
+
+var nowFunc = time.Now
+
+// FiscalYearStartMonth is the first month of the fiscal year used by fiscal date literals
+var FiscalYearStartMonth = time.January
+
+// Now returns the current time used by Datetime.now(), Date.today() and soql date literals
+func Now() time.Time {
+	return nowFunc()
+}
+
+// SetNow freezes the current time
+func SetNow(t time.Time) {
+	nowFunc = func() time.Time {
+		return t
+	}
+}
+
+// ResetNow unfreezes the current time
+func ResetNow() {
+	nowFunc = time.Now
+}
@@ -1,6 +1,10 @@
 package builtin
 
 import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
 	"github.com/tzmfreedom/goland/ast"
 )
 
@@ -12,6 +16,66 @@ var queryLocatorType = ast.CreateClass(
 	ast.NewMethodMap(),
 )
 
+var savepointType = ast.CreateClass(
+	"Savepoint",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+// savepointCount numbers savepoint names, which are unique in all transactions
+var savepointCount int64
+
+func executeDml(extra map[string]interface{}, dmlType, sObjectType string, records []*ast.Object, upsertKey string) *ast.Object {
+	executor := extra["interpreter"].(DmlExecutor)
+	r, err := executor.ExecuteDml(dmlType, sObjectType, records, upsertKey)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ValidateDml returns the raise of DmlException when the records can not be saved by the DML operation,
+// such as the insert of the record with the id and the update of the record without the id.
+func ValidateDml(dmlType string, records []*ast.Object) *ast.Object {
+	dmlType = strings.ToLower(dmlType)
+	errors := []*DmlError{}
+	for i, record := range records {
+		id := ""
+		if obj := recordId(record); obj != Null {
+			id = obj.StringValue()
+		}
+		switch dmlType {
+		case "insert":
+			if id != "" {
+				errors = append(errors, &DmlError{
+					Index:      i,
+					Id:         id,
+					StatusCode: "INVALID_FIELD_FOR_INSERT_UPDATE",
+					Message:    "cannot specify Id in an insert call",
+					Fields:     []string{"Id"},
+				})
+			}
+		case "update", "delete", "undelete":
+			if id == "" {
+				article := "a"
+				if dmlType != "delete" {
+					article = "an"
+				}
+				errors = append(errors, &DmlError{
+					Index:      i,
+					StatusCode: "MISSING_ARGUMENT",
+					Message:    fmt.Sprintf("Id not specified in %s %s call", article, dmlType),
+				})
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return CreateRaise(NewDmlException(dmlType, errors))
+}
+
 func init() {
 	staticMethods := ast.NewMethodMap()
 
@@ -23,7 +87,7 @@ func init() {
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 				obj := params[0]
 				records := []*ast.Object{obj}
-				return DatabaseDriver.Execute("insert", obj.ClassType.Name, records, "")
+				return executeDml(extra, "insert", obj.ClassType.Name, records, "")
 			},
 		),
 		ast.CreateMethod(
@@ -39,7 +103,7 @@ func init() {
 				obj := params[0]
 				records := obj.Extra["records"].([]*ast.Object)
 				sObjectType := records[0].ClassType.Name
-				return DatabaseDriver.Execute("insert", sObjectType, records, "")
+				return executeDml(extra, "insert", sObjectType, records, "")
 			},
 		),
 	})
@@ -52,7 +116,7 @@ func init() {
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 				obj := params[0]
 				records := []*ast.Object{obj}
-				return DatabaseDriver.Execute("update", obj.ClassType.Name, records, "")
+				return executeDml(extra, "update", obj.ClassType.Name, records, "")
 			},
 		),
 		ast.CreateMethod(
@@ -68,7 +132,7 @@ func init() {
 				obj := params[0]
 				records := obj.Extra["records"].([]*ast.Object)
 				sObjectType := records[0].ClassType.Name
-				return DatabaseDriver.Execute("update", sObjectType, records, "")
+				return executeDml(extra, "update", sObjectType, records, "")
 			},
 		),
 	})
@@ -81,7 +145,7 @@ func init() {
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 				obj := params[0]
 				records := []*ast.Object{obj}
-				return DatabaseDriver.Execute("delete", obj.ClassType.Name, records, "")
+				return executeDml(extra, "delete", obj.ClassType.Name, records, "")
 			},
 		),
 		ast.CreateMethod(
@@ -97,7 +161,36 @@ func init() {
 				obj := params[0]
 				records := obj.Extra["records"].([]*ast.Object)
 				sObjectType := records[0].ClassType.Name
-				return DatabaseDriver.Execute("delete", sObjectType, records, "")
+				return executeDml(extra, "delete", sObjectType, records, "")
+			},
+		),
+	})
+
+	staticMethods.Set("undelete", []*ast.Method{
+		ast.CreateMethod(
+			"undelete",
+			saveResultType,
+			[]*ast.Parameter{SObjectTypeParameter},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				obj := params[0]
+				records := []*ast.Object{obj}
+				return executeDml(extra, "undelete", obj.ClassType.Name, records, "")
+			},
+		),
+		ast.CreateMethod(
+			"undelete",
+			saveResultType,
+			[]*ast.Parameter{
+				{
+					Type: CreateListType(SObjectType),
+					Name: "_",
+				},
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				obj := params[0]
+				records := obj.Extra["records"].([]*ast.Object)
+				sObjectType := records[0].ClassType.Name
+				return executeDml(extra, "undelete", sObjectType, records, "")
 			},
 		),
 	})
@@ -114,7 +207,7 @@ func init() {
 				obj := params[0]
 				key := params[1].StringValue()
 				records := []*ast.Object{obj}
-				return DatabaseDriver.Execute("upsert", obj.ClassType.Name, records, key)
+				return executeDml(extra, "upsert", obj.ClassType.Name, records, key)
 			},
 		),
 		ast.CreateMethod(
@@ -132,27 +225,42 @@ func init() {
 				key := params[1].StringValue()
 				records := obj.Extra["records"].([]*ast.Object)
 				sObjectType := records[0].ClassType.Name
-				return DatabaseDriver.Execute("upsert", sObjectType, records, key)
+				return executeDml(extra, "upsert", sObjectType, records, key)
 			},
 		),
 	})
 
 	method := ast.CreateMethod(
-		"setSavePoint",
-		nil, // TODO: implement
+		"setSavepoint",
+		savepointType,
 		[]*ast.Parameter{},
 		func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-			return Null
+			name := fmt.Sprintf("savepoint%d", atomic.AddInt64(&savepointCount, 1))
+			if err := extra["database"].(Driver).SetSavepoint(name); err != nil {
+				panic(err)
+			}
+			savepoint := ast.CreateObject(savepointType)
+			savepoint.Extra["name"] = name
+			return savepoint
 		},
 	)
-	staticMethods.Set("setSavePoint", []*ast.Method{method})
+	staticMethods.Set("setSavepoint", []*ast.Method{method})
 
 	method = ast.CreateMethod(
 		"rollback",
 		nil,
-		[]*ast.Parameter{objectTypeParameter}, // TODO: savepoint
+		[]*ast.Parameter{
+			{
+				Type: savepointType,
+				Name: "_",
+			},
+		},
 		func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-			return Null
+			name := params[0].Extra["name"].(string)
+			if err := extra["database"].(Driver).RollbackToSavepoint(name); err != nil {
+				panic(err)
+			}
+			return nil
 		},
 	)
 	staticMethods.Set("rollback", []*ast.Method{method})
@@ -202,6 +310,22 @@ func init() {
 			),
 		},
 	)
+	instanceMethods.Set(
+		"isCreated",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"isCreated",
+				BooleanType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if isCreated, ok := this.Extra["isCreated"]; ok {
+						return isCreated
+					}
+					return NewBoolean(false)
+				},
+			),
+		},
+	)
 	instanceMethods.Set(
 		"isSuccess",
 		[]*ast.Method{
@@ -225,6 +349,7 @@ func init() {
 	)
 	classMap.Set("SaveResult", saveResultType)
 	classMap.Set("QueryLocator", queryLocatorType)
+	primitiveClassMap.Set("Savepoint", savepointType)
 
 	batchableContext := ast.CreateClass(
 		"BatchableContext",
@@ -43,7 +43,7 @@ func init() {
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 					obj := ast.CreateObject(DateType)
-					obj.Extra["value"] = time.Now()
+					obj.Extra["value"] = Now()
 					return obj
 				},
 			),
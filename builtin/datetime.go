@@ -144,11 +144,11 @@ func init() {
 		[]*ast.Method{
 			ast.CreateMethod(
 				"now",
-				DateType,
+				DatetimeType,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 					obj := ast.CreateObject(DatetimeType)
-					obj.Extra["value"] = time.Now()
+					obj.Extra["value"] = Now()
 					return obj
 				},
 			),
@@ -0,0 +1,184 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+)
+
+// dialect absorbs the differences of sql between database drivers
+type dialect interface {
+	// bind converts `?` placeholders to the placeholders of the driver
+	bind(query string) string
+	quote(identifier string) string
+	columnType(fieldType string) string
+	// noLimit returns LIMIT clause which is required for OFFSET without LIMIT
+	noLimit() string
+	// like returns case insensitive LIKE operator
+	like() string
+	// datePart returns integer expression of the date part, the format is the one of strftime
+	datePart(format, field string) string
+	dateOnly(field string) string
+	// tableExists returns the query which selects the table name with a bind parameter
+	tableExists() string
+	createSearchIndex(table string) string
+	// match returns the condition of full-text search with a bind parameter
+	match(column string) string
+	matchArgument(term string) string
+}
+
+type sqliteDialect struct{}
+
+func (d *sqliteDialect) bind(query string) string {
+	return query
+}
+
+func (d *sqliteDialect) quote(identifier string) string {
+	return "`" + identifier + "`"
+}
+
+func (d *sqliteDialect) columnType(fieldType string) string {
+	return dbTypeMapper[fieldType]
+}
+
+func (d *sqliteDialect) noLimit() string {
+	return " LIMIT -1"
+}
+
+func (d *sqliteDialect) like() string {
+	return "LIKE"
+}
+
+func (d *sqliteDialect) datePart(format, field string) string {
+	return fmt.Sprintf("CAST(strftime('%s', %s) AS INTEGER)", format, field)
+}
+
+func (d *sqliteDialect) dateOnly(field string) string {
+	return fmt.Sprintf("date(%s)", field)
+}
+
+func (d *sqliteDialect) tableExists() string {
+	return "SELECT name FROM sqlite_master WHERE type = 'table' AND name = ? COLLATE NOCASE"
+}
+
+func (d *sqliteDialect) createSearchIndex(table string) string {
+	return fmt.Sprintf(
+		"CREATE VIRTUAL TABLE IF NOT EXISTS %s USING fts4(sobject_type, record_id, name_fields, email_fields, phone_fields, all_fields, notindexed=sobject_type, notindexed=record_id)",
+		table,
+	)
+}
+
+func (d *sqliteDialect) match(column string) string {
+	return fmt.Sprintf("%s MATCH ?", column)
+}
+
+func (d *sqliteDialect) matchArgument(term string) string {
+	return toMatchExpression(term)
+}
+
+type postgresDialect struct{}
+
+// bind converts `?` to `$1`, `$2`, ... except in string literals
+func (d *postgresDialect) bind(query string) string {
+	bound := ""
+	n := 0
+	quoted := false
+	for _, c := range query {
+		switch {
+		case c == '\'':
+			quoted = !quoted
+		case c == '?' && !quoted:
+			n++
+			bound += fmt.Sprintf("$%d", n)
+			continue
+		}
+		bound += string(c)
+	}
+	return bound
+}
+
+// quote returns lower case identifier because unquoted identifiers in queries are folded to lower case
+func (d *postgresDialect) quote(identifier string) string {
+	return `"` + strings.ToLower(identifier) + `"`
+}
+
+func (d *postgresDialect) columnType(fieldType string) string {
+	columnType := dbTypeMapper[fieldType]
+	if columnType == "REAL" {
+		return "DOUBLE PRECISION"
+	}
+	return columnType
+}
+
+func (d *postgresDialect) noLimit() string {
+	return " LIMIT ALL"
+}
+
+func (d *postgresDialect) like() string {
+	return "ILIKE"
+}
+
+var postgresDateParts = map[string]string{
+	"%Y": "YEAR",
+	"%m": "MONTH",
+	"%d": "DAY",
+	"%w": "DOW",
+	"%j": "DOY",
+	"%H": "HOUR",
+}
+
+func (d *postgresDialect) datePart(format, field string) string {
+	return fmt.Sprintf("CAST(EXTRACT(%s FROM CAST(%s AS TIMESTAMP)) AS INTEGER)", postgresDateParts[format], field)
+}
+
+func (d *postgresDialect) dateOnly(field string) string {
+	return fmt.Sprintf("SUBSTR(%s, 1, 10)", field)
+}
+
+func (d *postgresDialect) tableExists() string {
+	return "SELECT table_name FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = LOWER(?)"
+}
+
+func (d *postgresDialect) createSearchIndex(table string) string {
+	return fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s (sobject_type TEXT, record_id TEXT, name_fields TEXT, email_fields TEXT, phone_fields TEXT, all_fields TEXT)",
+		table,
+	)
+}
+
+func (d *postgresDialect) match(column string) string {
+	return fmt.Sprintf("to_tsvector('simple', %s) @@ to_tsquery('simple', ?)", column)
+}
+
+// matchArgument converts the sosl search term to tsquery such as `'acme':* & 'inc'`
+func (d *postgresDialect) matchArgument(term string) string {
+	query := ""
+	operator := ""
+	for _, t := range strings.Fields(toMatchExpression(term)) {
+		switch t {
+		case "AND":
+			operator = " & "
+			continue
+		case "OR":
+			operator = " | "
+			continue
+		case "NOT":
+			operator = " & !"
+			continue
+		}
+		lexeme := "'" + strings.Replace(strings.TrimSuffix(t, "*"), "'", "''", -1) + "'"
+		if strings.HasSuffix(t, "*") {
+			lexeme += ":*"
+		}
+		if query != "" {
+			if operator == "" {
+				operator = " & "
+			}
+			query += operator
+		} else if operator == " & !" {
+			query = "!"
+		}
+		query += lexeme
+		operator = ""
+	}
+	return query
+}
@@ -2,46 +2,133 @@ package builtin
 
 import (
 	"database/sql"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
 
 	"fmt"
 	"strings"
 
-	"math/rand"
-	"time"
-
 	"github.com/k0kubun/pp"
+	_ "github.com/lib/pq"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/tzmfreedom/goland/ast"
 )
 
+// Driver is the storage of sobject records used by SOQL, SOSL and DML
+type Driver interface {
+	Query(n *ast.Soql, interpreter ast.Visitor) []*ast.Object
+	Search(n *ast.Sosl, interpreter ast.Visitor) *ast.Object
+	Execute(dmlType string, sObjectType string, records []*ast.Object, upsertKey string) *ast.Object
+	FindUpsertTarget(sObjectType string, record *ast.Object, upsertKey string) (string, error)
+	CreateTables(sobjects map[string]Sobject) error
+	InsertRaw(sObjectType string, values map[string]interface{}) error
+	// SelectRaw returns the column values of the records which are not deleted, the null values are omitted
+	SelectRaw(sObjectType string) ([]map[string]string, error)
+	IndexSearchRecords(sObject Sobject) error
+	ClearRecords() error
+	QueryRaw(query string)
+	ExecuteRaw(query string, args ...interface{}) error
+	Begin() error
+	Rollback() error
+	SetSavepoint(name string) error
+	RollbackToSavepoint(name string) error
+	// Clone returns the driver of the database which is isolated from the transaction of the original driver
+	Clone() (Driver, error)
+	Close() error
+}
+
+const (
+	DefaultDatabaseSource = "./database.sqlite3"
+	// MemoryDatabaseSource is the source of the in-memory database which is discarded on exit
+	MemoryDatabaseSource = "memory"
+)
+
+var DatabaseDriver Driver = NewSqliteDriver(DefaultDatabaseSource)
+
 type databaseDriver struct {
-	db *sql.DB
+	db      *sql.DB
+	dialect dialect
+	source  string
+	// temporary is true when the database file is the copy made by Clone, which is removed on Close
+	temporary bool
+}
+
+// NewDatabaseDriver returns the driver of the source.
+// The source is `memory`, `postgres://...` or the path of sqlite3 database file.
+func NewDatabaseDriver(source string) (Driver, error) {
+	switch {
+	case source == MemoryDatabaseSource:
+		return NewMemoryDriver(), nil
+	case IsPostgresSource(source):
+		return NewPostgresDriver(source)
+	}
+	return NewSqliteDriver(strings.TrimPrefix(source, "sqlite3://")), nil
+}
+
+// IsPostgresSource returns true when the source is the url of postgresql database
+func IsPostgresSource(source string) bool {
+	return strings.HasPrefix(source, "postgres://") || strings.HasPrefix(source, "postgresql://")
+}
+
+// NewSqliteDriver returns the driver of sqlite3 database file
+func NewSqliteDriver(path string) Driver {
+	db, _ := sql.Open("sqlite3", path)
+	return newDatabaseDriver(db, &sqliteDialect{}, path)
+}
+
+// NewMemoryDriver returns the driver of in-memory sqlite3 database, which needs no file on disk
+func NewMemoryDriver() Driver {
+	db, _ := sql.Open("sqlite3", ":memory:")
+	return newDatabaseDriver(db, &sqliteDialect{}, MemoryDatabaseSource)
+}
+
+// NewPostgresDriver returns the driver of postgresql database
+func NewPostgresDriver(url string) (Driver, error) {
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		return nil, err
+	}
+	return newDatabaseDriver(db, &postgresDialect{}, url), nil
+}
+
+func newDatabaseDriver(db *sql.DB, dialect dialect, source string) *databaseDriver {
+	// transactions and savepoints are managed by statements, and in-memory database exists only in its connection,
+	// so all statements must run on the same connection
+	db.SetMaxOpenConns(1)
+	return &databaseDriver{db: db, dialect: dialect, source: source}
 }
 
-var DatabaseDriver = NewDatabaseDriver()
+func (d *databaseDriver) exec(query string, args ...interface{}) (sql.Result, error) {
+	return d.db.Exec(d.dialect.bind(query), args...)
+}
 
-func NewDatabaseDriver() *databaseDriver {
-	// TODO: implment not sqlite3
-	db, _ := sql.Open("sqlite3", "./database.sqlite3")
-	return &databaseDriver{db}
+func (d *databaseDriver) query(query string, args ...interface{}) (*sql.Rows, error) {
+	return d.db.Query(d.dialect.bind(query), args...)
 }
 
 func (d *databaseDriver) Query(n *ast.Soql, interpreter ast.Visitor) []*ast.Object {
-	builder := SqlBuilder{interpreter: interpreter}
-	sql, selectFields, relations := builder.Build(n)
-	// pp.Println(sql)
+	builder := SqlBuilder{interpreter: interpreter, dialect: d.dialect}
+	query, args, selectFields, relations := builder.Build(n)
+	// pp.Println(query)
 
-	rows, err := d.db.Query(sql)
+	rows, err := d.query(query, args...)
 	if err != nil {
 		panic(err)
 	}
+	defer rows.Close()
+
+	if IsAggregateQuery(n) {
+		return aggregateRecords(n, rows)
+	}
 
 	classType, _ := PrimitiveClassMap().Get(n.FromObject)
 	records := []*ast.Object{}
 	for rows.Next() {
 		dispatches := make([]interface{}, len(selectFields))
 		for i, _ := range selectFields {
-			var temp string
+			var temp sql.NullString
 			dispatches[i] = &temp
 		}
 		err := rows.Scan(dispatches...)
@@ -52,22 +139,165 @@ func (d *databaseDriver) Query(n *ast.Soql, interpreter ast.Visitor) []*ast.Obje
 		for i, field := range selectFields {
 			tmpTable := field[0]
 			fieldName := field[1]
-			value := NewString(*dispatches[i].(*string))
 
-			if tmpTable == "t0" {
-				record.InstanceFields.Set(fieldName, value)
-				continue
+			sObjectType := n.FromObject
+			if tmpTable != "t0" {
+				sObjectType = relations[tmpTable].ReferenceTo
 			}
-			relationInfo := relations[tmpTable]
-			relationField, ok := record.InstanceFields.Get(relationInfo.RelationshipName)
-			if ok {
-				relationField.InstanceFields.Set(fieldName, value)
-			} else {
-				relationType, _ := PrimitiveClassMap().Get(relationInfo.ReferenceTo)
-				relationField = ast.CreateObject(relationType)
-				relationField.InstanceFields.Set(fieldName, value)
-				record.InstanceFields.Set(relationInfo.RelationshipName, relationField)
+			fieldType := sObjectFieldType(sObjectType, []string{fieldName})
+			value := fromDbValue(fieldType, *dispatches[i].(*sql.NullString))
+			relationObject(record, tmpTable, relations).InstanceFields.Set(fieldName, value)
+		}
+		records = append(records, record)
+	}
+	for _, selectField := range n.SelectFields {
+		if subquery, ok := selectField.(*ast.Soql); ok {
+			d.queryChildRecords(n.FromObject, subquery, records, interpreter)
+		}
+	}
+	return records
+}
+
+// relationObject returns the parent record of the temporary table, and creates it when it does not exist
+func relationObject(record *ast.Object, tmpTable string, relations map[string]Relation) *ast.Object {
+	if tmpTable == "t0" {
+		return record
+	}
+	relation := relations[tmpTable]
+	parent := relationObject(record, relation.Parent, relations)
+	obj, ok := parent.InstanceFields.Get(relation.RelationshipName)
+	if !ok || obj == Null {
+		relationType, _ := PrimitiveClassMap().Get(relation.ReferenceTo)
+		obj = ast.CreateObject(relationType)
+		parent.InstanceFields.Set(relation.RelationshipName, obj)
+	}
+	return obj
+}
+
+// queryChildRecords queries the child relationship subquery for all parent records at once,
+// and sets the child records to each parent record as list.
+// LIMIT and OFFSET of the subquery are applied to the children of each parent.
+func (d *databaseDriver) queryChildRecords(parentType string, n *ast.Soql, records []*ast.Object, interpreter ast.Visitor) {
+	childType, field, ok := findChildRelationship(parentType, n.FromObject)
+	if !ok {
+		panic(fmt.Sprintf("Didn't understand relationship '%s' in FROM part of query call", n.FromObject))
+	}
+	classType, _ := PrimitiveClassMap().Get(childType)
+	ids := []string{}
+	for _, record := range records {
+		if id := recordId(record); id != Null {
+			ids = append(ids, id.StringValue())
+		}
+	}
+	childrenMap := map[string][]*ast.Object{}
+	if len(ids) > 0 {
+		soql := *n
+		soql.FromObject = childType
+		soql.Limit = nil
+		soql.Offset = nil
+		// the reference field is needed to find the parent of each child
+		selectsReference := selectsField(n.SelectFields, field.Name)
+		if !selectsReference {
+			soql.SelectFields = append([]ast.Node{&ast.SelectField{Value: []string{field.Name}}}, n.SelectFields...)
+		}
+		condition := NewInCondition(field.Name, ids)
+		soql.Where = condition
+		if n.Where != nil {
+			soql.Where = &ast.WhereBinaryOperator{
+				Left:  n.Where,
+				Op:    "AND",
+				Right: condition,
+			}
+		}
+		for _, child := range d.Query(&soql, interpreter) {
+			parentId, _ := child.InstanceFields.Get(field.Name)
+			if !selectsReference {
+				delete(child.InstanceFields.Data, strings.ToLower(field.Name))
+			}
+			key := To18(parentId.StringValue())
+			childrenMap[key] = append(childrenMap[key], child)
+		}
+	}
+	for _, record := range records {
+		children := []*ast.Object{}
+		if id := recordId(record); id != Null {
+			children = limitRecords(childrenMap[To18(id.StringValue())], n.Limit, n.Offset, interpreter)
+		}
+		list := ast.CreateObject(CreateListType(classType))
+		list.Extra["records"] = children
+		record.InstanceFields.Set(n.FromObject, list)
+	}
+}
+
+// NewInCondition returns the condition of soql `field IN :ids`
+func NewInCondition(field string, ids []string) *ast.WhereCondition {
+	values := make([]ast.Node, len(ids))
+	for i, id := range ids {
+		values[i] = &ast.StringLiteral{Value: id}
+	}
+	return &ast.WhereCondition{
+		Field: &ast.SelectField{Value: []string{field}},
+		Op:    "IN",
+		Expression: &ast.New{
+			Type: CreateListType(IdType),
+			Init: &ast.Init{Records: values},
+		},
+	}
+}
+
+func selectsField(selectFields []ast.Node, name string) bool {
+	for _, f := range selectFields {
+		if field, ok := f.(*ast.SelectField); ok && len(field.Value) == 1 && strings.EqualFold(field.Value[0], name) {
+			return true
+		}
+	}
+	return false
+}
+
+// limitRecords returns the records in the range of LIMIT and OFFSET
+func limitRecords(records []*ast.Object, limit, offset ast.Node, interpreter ast.Visitor) []*ast.Object {
+	builder := &SqlBuilder{interpreter: interpreter}
+	if offset != nil {
+		n := builder.integerValue(offset)
+		if n > len(records) {
+			n = len(records)
+		}
+		records = records[n:]
+	}
+	if limit != nil {
+		if n := builder.integerValue(limit); n < len(records) {
+			records = records[:n]
+		}
+	}
+	if records == nil {
+		return []*ast.Object{}
+	}
+	return records
+}
+
+func aggregateRecords(n *ast.Soql, rows *sql.Rows) []*ast.Object {
+	keys := aggregateKeys(n)
+	records := []*ast.Object{}
+	for rows.Next() {
+		dispatches := make([]interface{}, len(n.SelectFields))
+		for i := range n.SelectFields {
+			var temp sql.NullString
+			dispatches[i] = &temp
+		}
+		err := rows.Scan(dispatches...)
+		if err != nil {
+			panic(err)
+		}
+		record := ast.CreateObject(AggregateResultType)
+		for i, selectField := range n.SelectFields {
+			var fieldType string
+			switch f := selectField.(type) {
+			case *ast.SelectField:
+				fieldType = sObjectFieldType(n.FromObject, f.Value)
+			case *ast.SoqlFunction:
+				fieldType = soqlFunctionType(n.FromObject, f)
 			}
+			record.InstanceFields.Set(keys[i], fromDbValue(fieldType, *dispatches[i].(*sql.NullString)))
 		}
 		records = append(records, record)
 	}
@@ -75,93 +305,104 @@ func (d *databaseDriver) Query(n *ast.Soql, interpreter ast.Visitor) []*ast.Obje
 }
 
 func (d *databaseDriver) QueryRaw(query string) {
-	rows, err := d.db.Query(query)
+	rows, err := d.query(query)
 	if err != nil {
 		panic(err)
 	}
 	pp.Println(rows)
 }
 
-func (d *databaseDriver) Begin() {
-	d.db.Exec("BEGIN;")
+func (d *databaseDriver) Begin() error {
+	_, err := d.exec("BEGIN;")
+	return err
+}
+
+func (d *databaseDriver) Rollback() error {
+	_, err := d.exec("ROLLBACK;")
+	return err
 }
 
-func (d *databaseDriver) Rollback() {
-	d.db.Exec("ROLLBACK;")
+func (d *databaseDriver) SetSavepoint(name string) error {
+	_, err := d.exec(fmt.Sprintf("SAVEPOINT %s;", name))
+	return err
+}
+
+// RollbackToSavepoint rolls back the changes after the savepoint, the savepoint remains
+func (d *databaseDriver) RollbackToSavepoint(name string) error {
+	_, err := d.exec(fmt.Sprintf("ROLLBACK TO SAVEPOINT %s;", name))
+	return err
+}
+
+func (d *databaseDriver) Close() error {
+	if err := d.db.Close(); err != nil {
+		return err
+	}
+	if d.temporary {
+		return os.Remove(d.source)
+	}
+	return nil
+}
+
+// Clone returns the driver of the copy of the database.
+// The in-memory database gets empty tables, the sqlite3 file is copied to a temporary file,
+// and the postgresql database is shared by another connection whose transaction is isolated.
+// The clones of postgresql can not clear the records in parallel, they wait for the locks of each other.
+func (d *databaseDriver) Clone() (Driver, error) {
+	if _, ok := d.dialect.(*postgresDialect); ok {
+		return NewPostgresDriver(d.source)
+	}
+	if d.source == MemoryDatabaseSource {
+		clone := NewMemoryDriver()
+		return clone, clone.CreateTables(sObjects)
+	}
+	f, err := ioutil.TempFile("", "land")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	src, err := os.Open(d.source)
+	if err != nil && !os.IsNotExist(err) {
+		os.Remove(f.Name())
+		return nil, err
+	}
+	if err == nil {
+		defer src.Close()
+		if _, err := io.Copy(f, src); err != nil {
+			os.Remove(f.Name())
+			return nil, err
+		}
+	}
+	clone := NewSqliteDriver(f.Name()).(*databaseDriver)
+	clone.temporary = true
+	return clone, nil
 }
 
 func (d *databaseDriver) Execute(dmlType string, sObjectType string, records []*ast.Object, upsertKey string) *ast.Object {
 	saveResults := make([]*ast.Object, len(records))
 	for i, record := range records {
-		var query string
+		var err error
 
-		switch dmlType {
+		switch strings.ToLower(dmlType) {
 		case "insert":
-			fields := []string{}
-			values := []string{}
-			rand.Seed(time.Now().UnixNano())
-			record.InstanceFields.Set("Id", NewString(string(rand.Int())))
-			for name, field := range record.InstanceFields.All() {
-				// TODO: convert type
-				if field == Null {
-					continue
-				}
-				fields = append(fields, name)
-				values = append(values, fmt.Sprintf("'%s'", field.StringValue()))
-			}
-			query = fmt.Sprintf(
-				"INSERT INTO %s(%s) VALUES (%s)",
-				sObjectType,
-				strings.Join(fields, ", "),
-				strings.Join(values, ", "),
-			)
+			err = d.insert(sObjectType, record)
 		case "update":
-			updateFields := []string{}
-			for name, field := range record.InstanceFields.All() {
-				// TODO: convert type
-				if field == Null {
-					continue
-				}
-				updateFields = append(updateFields, fmt.Sprintf("%s = '%s'", name, field.StringValue()))
-			}
-			id, ok := record.InstanceFields.Get("Id")
-			if !ok {
-				panic("id does not exist")
-			}
-			query = fmt.Sprintf(
-				"UPDATE %s SET %s WHERE id = '%s'",
-				sObjectType,
-				strings.Join(updateFields, ", "),
-				id.StringValue(),
-			)
+			err = d.update(sObjectType, record)
 		case "upsert":
-			// TODO: implement
+			err = d.upsert(sObjectType, record, upsertKey)
 		case "delete":
-			id, ok := record.InstanceFields.Get("Id")
-			if !ok {
-				panic("id does not exist")
-			}
-			query = fmt.Sprintf(
-				"DELETE FROM %s WHERE id = '%s'",
-				sObjectType,
-				id.StringValue(),
-			)
+			err = d.delete(sObjectType, record)
+		case "undelete":
+			err = d.undelete(sObjectType, record)
+		}
+		if err == nil {
+			err = d.updateSearchIndex(dmlType, sObjectType, record)
 		}
-		result, err := d.db.Exec(query)
 		if err != nil {
 			panic(err)
 		}
 		obj := ast.CreateObject(saveResultType)
-		obj.Extra["isSuccess"] = NewBoolean(err == nil)
-		if err == nil {
-			id, err := result.LastInsertId()
-			if err != nil {
-				panic(err)
-			}
-			obj.Extra["id"] = NewString(fmt.Sprintf("%d", id))
-		} else {
-			obj.Extra["errors"] = err.Error()
-		}
+		obj.Extra["isSuccess"] = NewBoolean(true)
+		obj.Extra["id"] = recordId(record)
 		saveResults[i] = obj
 	}
 	listObject := ast.CreateObject(ListType)
@@ -169,8 +410,187 @@ func (d *databaseDriver) Execute(dmlType string, sObjectType string, records []*
 	return listObject
 }
 
+func (d *databaseDriver) insert(sObjectType string, record *ast.Object) error {
+	fields := []string{}
+	placeholders := []string{}
+	values := []interface{}{}
+	record.InstanceFields.Set("Id", NewId(GenerateId(sObjectType)))
+	for name, field := range record.InstanceFields.All() {
+		if field == Null || name == "isdeleted" || !IsColumn(sObjectType, name) {
+			continue
+		}
+		fields = append(fields, name)
+		placeholders = append(placeholders, "?")
+		values = append(values, toDbValue(sObjectFieldType(sObjectType, []string{name}), field))
+	}
+	if isSoftDeletable(sObjectType) {
+		fields = append(fields, "IsDeleted")
+		placeholders = append(placeholders, "?")
+		values = append(values, 0)
+	}
+	query := fmt.Sprintf(
+		"INSERT INTO %s(%s) VALUES (%s)",
+		sObjectType,
+		strings.Join(fields, ", "),
+		strings.Join(placeholders, ", "),
+	)
+	_, err := d.exec(query, values...)
+	return err
+}
+
+func (d *databaseDriver) update(sObjectType string, record *ast.Object) error {
+	updateFields := []string{}
+	values := []interface{}{}
+	for name, field := range record.InstanceFields.All() {
+		if name == "id" || name == "isdeleted" || !IsColumn(sObjectType, name) {
+			continue
+		}
+		updateFields = append(updateFields, fmt.Sprintf("%s = ?", name))
+		values = append(values, toDbValue(sObjectFieldType(sObjectType, []string{name}), field))
+	}
+	id := recordId(record)
+	if id == Null {
+		return errors.New("id does not exist")
+	}
+	if len(updateFields) == 0 {
+		return nil
+	}
+	query := fmt.Sprintf(
+		"UPDATE %s SET %s WHERE id = ?",
+		sObjectType,
+		strings.Join(updateFields, ", "),
+	)
+	_, err := d.exec(query, append(values, id.StringValue())...)
+	return err
+}
+
+// upsert updates the record matched with Id or external id field, otherwise inserts it
+func (d *databaseDriver) upsert(sObjectType string, record *ast.Object, upsertKey string) error {
+	id, err := d.FindUpsertTarget(sObjectType, record, upsertKey)
+	if err != nil {
+		return err
+	}
+	if id == "" {
+		return d.insert(sObjectType, record)
+	}
+	record.InstanceFields.Set("Id", NewId(id))
+	return d.update(sObjectType, record)
+}
+
+// FindUpsertTarget returns the Id of the existing record whose upsertKey field matches the record.
+// It returns empty string when there is no such record.
+func (d *databaseDriver) FindUpsertTarget(sObjectType string, record *ast.Object, upsertKey string) (string, error) {
+	if upsertKey == "" {
+		upsertKey = "Id"
+	}
+	value, ok := record.InstanceFields.Get(upsertKey)
+	if !ok || value == Null {
+		return "", nil
+	}
+	query := fmt.Sprintf("SELECT id FROM %s WHERE %s = ?", sObjectType, upsertKey)
+	if isSoftDeletable(sObjectType) {
+		query += " AND " + notDeletedCondition(sObjectType)
+	}
+	rows, err := d.query(query, toDbValue(sObjectFieldType(sObjectType, []string{upsertKey}), value))
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return "", err
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) > 1 {
+		return "", fmt.Errorf("DUPLICATE_EXTERNAL_ID, %s: more than one record found for external id field: %s", upsertKey, value.StringValue())
+	}
+	if len(ids) == 0 {
+		return "", nil
+	}
+	return ids[0], nil
+}
+
+// delete moves the record to the recycle bin when the sobject has IsDeleted field
+func (d *databaseDriver) delete(sObjectType string, record *ast.Object) error {
+	id := recordId(record)
+	if id == Null {
+		return errors.New("id does not exist")
+	}
+	var query string
+	if isSoftDeletable(sObjectType) {
+		query = fmt.Sprintf("UPDATE %s SET IsDeleted = 1 WHERE id = ?", sObjectType)
+	} else {
+		query = fmt.Sprintf("DELETE FROM %s WHERE id = ?", sObjectType)
+	}
+	_, err := d.exec(query, id.StringValue())
+	return err
+}
+
+// undelete restores the record from the recycle bin
+func (d *databaseDriver) undelete(sObjectType string, record *ast.Object) error {
+	id := recordId(record)
+	if id == Null {
+		return errors.New("id does not exist")
+	}
+	if !isSoftDeletable(sObjectType) {
+		return fmt.Errorf("%s can not be undeleted", sObjectType)
+	}
+	query := fmt.Sprintf("UPDATE %s SET IsDeleted = 0 WHERE id = ? AND IsDeleted = 1", sObjectType)
+	result, err := d.exec(query, id.StringValue())
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("UNDELETE_FAILED, %s: record is not in the recycle bin", id.StringValue())
+	}
+	record.InstanceFields.Set("IsDeleted", NewBoolean(false))
+	return nil
+}
+
+func recordId(record *ast.Object) *ast.Object {
+	id, ok := record.InstanceFields.Get("Id")
+	if !ok {
+		return Null
+	}
+	return id
+}
+
+// IsColumn returns false for relationship fields such as Account or Contacts
+func IsColumn(sObjectType, name string) bool {
+	if _, ok := sObjects[sObjectType]; !ok {
+		return true
+	}
+	_, ok := sObjectField(sObjectType, name)
+	return ok
+}
+
+func isSoftDeletable(sObjectType string) bool {
+	sObject, ok := sObjects[sObjectType]
+	if !ok {
+		return false
+	}
+	for _, f := range sObject.Fields {
+		if strings.ToLower(f.Name) == "isdeleted" {
+			return true
+		}
+	}
+	return false
+}
+
+func notDeletedCondition(table string) string {
+	return fmt.Sprintf("COALESCE(%s.IsDeleted, 0) = 0", table)
+}
+
 func (d *databaseDriver) ExecuteRaw(query string, args ...interface{}) error {
-	_, err := d.db.Exec(query, args...)
+	_, err := d.exec(query, args...)
 	return err
 }
 
@@ -201,12 +621,8 @@ var dbTypeMapper = map[string]string{
 	"phone":                      "TEXT",
 }
 
-func CreateDatabase(src string) error {
-	loader := NewMetaFileLoader(src)
-	sobjects, err := loader.Load()
-	if err != nil {
-		return err
-	}
+// CreateTables creates the tables of the sobjects and the search index
+func (d *databaseDriver) CreateTables(sobjects map[string]Sobject) error {
 	for name, sobject := range sobjects {
 		fields := make([]string, len(sobject.Fields))
 		for i, field := range sobject.Fields {
@@ -216,18 +632,111 @@ func CreateDatabase(src string) error {
 				if _, ok := dbTypeMapper[field.Type]; !ok {
 					return fmt.Errorf("undefined type mapper %s", field.Type)
 				}
-				fields[i] = fmt.Sprintf("`%s` %s", field.Name, dbTypeMapper[field.Type])
+				fields[i] = fmt.Sprintf("%s %s", d.dialect.quote(field.Name), d.dialect.columnType(field.Type))
 			}
 		}
-		query := fmt.Sprintf("CREATE TABLE IF NOT EXISTS `%s` (%s);", name, strings.Join(fields, ", "))
-		err := DatabaseDriver.ExecuteRaw(query)
+		query := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s);", d.dialect.quote(name), strings.Join(fields, ", "))
+		err := d.ExecuteRaw(query)
+		if err != nil {
+			return err
+		}
+	}
+	return d.ensureSearchIndex()
+}
+
+// ClearRecords deletes the records of all sobjects, which is used to hide the org data from tests in the transaction
+func (d *databaseDriver) ClearRecords() error {
+	for _, name := range append(sortedSObjectNames(), searchIndexTable) {
+		exists, err := d.tableExists(name)
 		if err != nil {
 			return err
 		}
+		if !exists {
+			continue
+		}
+		if err := d.ExecuteRaw(fmt.Sprintf("DELETE FROM %s", d.dialect.quote(name))); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+func (d *databaseDriver) tableExists(name string) (bool, error) {
+	rows, err := d.query(d.dialect.tableExists(), name)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	return rows.Next(), rows.Err()
+}
+
+// InsertRaw inserts the values of the columns without triggers and search index
+func (d *databaseDriver) InsertRaw(sObjectType string, values map[string]interface{}) error {
+	fields := []string{}
+	placeholders := []string{}
+	args := []interface{}{}
+	for name, value := range values {
+		fields = append(fields, d.dialect.quote(name))
+		placeholders = append(placeholders, "?")
+		args = append(args, value)
+	}
+	query := fmt.Sprintf(
+		"INSERT INTO %s(%s) VALUES (%s);",
+		d.dialect.quote(sObjectType),
+		strings.Join(fields, ", "),
+		strings.Join(placeholders, ", "),
+	)
+	return d.ExecuteRaw(query, args...)
+}
+
+func (d *databaseDriver) SelectRaw(sObjectType string) ([]map[string]string, error) {
+	exists, err := d.tableExists(sObjectType)
+	if err != nil || !exists {
+		return nil, err
+	}
+	query := fmt.Sprintf("SELECT * FROM %s", d.dialect.quote(sObjectType))
+	if isSoftDeletable(sObjectType) {
+		query += " WHERE " + notDeletedCondition(d.dialect.quote(sObjectType))
+	}
+	rows, err := d.query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	records := []map[string]string{}
+	for rows.Next() {
+		dispatches := make([]interface{}, len(columns))
+		for i := range columns {
+			var temp sql.NullString
+			dispatches[i] = &temp
+		}
+		if err := rows.Scan(dispatches...); err != nil {
+			return nil, err
+		}
+		record := map[string]string{}
+		for i, column := range columns {
+			if value := *dispatches[i].(*sql.NullString); value.Valid {
+				record[column] = value.String
+			}
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+func CreateDatabase(src string) error {
+	loader := NewMetaFileLoader(src)
+	sobjects, err := loader.Load()
+	if err != nil {
+		return err
+	}
+	return DatabaseDriver.CreateTables(sobjects)
+}
+
 func Seed(username, password, endpoint, src string) error {
 	loader := NewMetaFileLoader(src)
 	sobjects, err := loader.Load()
@@ -237,8 +746,10 @@ func Seed(username, password, endpoint, src string) error {
 	client := NewSoapClient(username, password, endpoint)
 	for name, sobject := range sobjects {
 		fields := make([]string, len(sobject.Fields))
+		fieldTypes := map[string]string{}
 		for i, field := range sobject.Fields {
 			fields[i] = fmt.Sprintf("%s", field.Name)
+			fieldTypes[strings.ToLower(field.Name)] = field.Type
 		}
 		soql := fmt.Sprintf("SELECT %s FROM %s", strings.Join(fields, ","), name)
 		r, err := client.Query(soql)
@@ -246,30 +757,20 @@ func Seed(username, password, endpoint, src string) error {
 			return err
 		}
 		for _, record := range r.Records {
-			insertFields := make([]string, len(record.Fields)+1)
-			insertValues := make([]interface{}, len(record.Fields)+1)
-			placeholders := make([]string, len(record.Fields)+1)
-			insertFields[0] = "`id`"
-			insertValues[0] = "'" + record.Id + "'"
-			placeholders[0] = "?"
-			i := 1
+			values := map[string]interface{}{
+				"id": record.Id,
+			}
 			for key, insertField := range record.Fields {
-				insertFields[i] = "`" + key + "`"
-				insertValues[i] = "'" + insertField.(string) + "'"
-				placeholders[i] = "?"
-				i++
+				values[key] = toDbValue(fieldTypes[strings.ToLower(key)], NewString(insertField.(string)))
 			}
-			query := fmt.Sprintf(
-				"INSERT INTO `%s`(%s) VALUES (%s);",
-				name,
-				strings.Join(insertFields, ", "),
-				strings.Join(placeholders, ", "),
-			)
-			err := DatabaseDriver.ExecuteRaw(query, insertValues...)
+			err := DatabaseDriver.InsertRaw(name, values)
 			if err != nil {
 				return err
 			}
 		}
+		if err := DatabaseDriver.IndexSearchRecords(sobject); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -0,0 +1,258 @@
+package builtin
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/tzmfreedom/goland/ast"
+)
+
+func newTestDriver(t *testing.T) Driver {
+	return setupTestDriver(t, NewMemoryDriver())
+}
+
+// setupTestDriver creates the tables of Account on the driver, the records left by the previous test are deleted
+func setupTestDriver(t *testing.T, driver Driver) Driver {
+	sObjects = map[string]Sobject{
+		"Account": {
+			Name:      "Account",
+			KeyPrefix: "001",
+			Fields: []SobjectField{
+				{Name: "Id", Type: "id"},
+				{Name: "Name", Type: "string"},
+				{Name: "IsDeleted", Type: "boolean"},
+			},
+		},
+	}
+	if err := driver.CreateTables(sObjects); err != nil {
+		t.Fatal(err)
+	}
+	if err := driver.ExecuteRaw("DELETE FROM Account"); err != nil {
+		t.Fatal(err)
+	}
+	return driver
+}
+
+type testDriver struct {
+	Name string
+	Open func(t *testing.T) (Driver, func())
+	// ClonedRecords is the number of the records of the original database which the clone has
+	ClonedRecords int
+}
+
+// testDrivers returns sqlite, memory and postgres drivers.
+// The postgres driver is tested only when LAND_TEST_POSTGRES_URL is set, its records are deleted by the tests.
+func testDrivers() []testDriver {
+	return []testDriver{
+		{
+			Name: "sqlite",
+			Open: func(t *testing.T) (Driver, func()) {
+				f, err := ioutil.TempFile("", "land_test")
+				if err != nil {
+					t.Fatal(err)
+				}
+				f.Close()
+				driver := NewSqliteDriver(f.Name())
+				return driver, func() {
+					driver.Close()
+					os.Remove(f.Name())
+				}
+			},
+			ClonedRecords: 1,
+		},
+		{
+			Name: "memory",
+			Open: func(t *testing.T) (Driver, func()) {
+				driver := NewMemoryDriver()
+				return driver, func() { driver.Close() }
+			},
+			ClonedRecords: 0,
+		},
+		{
+			Name: "postgres",
+			Open: func(t *testing.T) (Driver, func()) {
+				url := os.Getenv("LAND_TEST_POSTGRES_URL")
+				if url == "" {
+					t.Skip("LAND_TEST_POSTGRES_URL is not set")
+				}
+				driver, err := NewPostgresDriver(url)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return driver, func() {
+					driver.ExecuteRaw("DELETE FROM Account")
+					driver.Close()
+				}
+			},
+			ClonedRecords: 1,
+		},
+	}
+}
+
+func countAccounts(t *testing.T, driver Driver) int {
+	soql := &ast.Soql{
+		SelectFields: []ast.Node{&ast.SelectField{Value: []string{"Id"}}},
+		FromObject:   "Account",
+	}
+	return len(driver.Query(soql, nil))
+}
+
+func TestDriverRollback(t *testing.T) {
+	for _, testDriver := range testDrivers() {
+		t.Run(testDriver.Name, func(t *testing.T) {
+			driver, closeDriver := testDriver.Open(t)
+			defer closeDriver()
+			setupTestDriver(t, driver)
+
+			if err := driver.Begin(); err != nil {
+				t.Fatal(err)
+			}
+			driver.Execute("insert", "Account", []*ast.Object{newTestAccount("foo")}, "")
+			if count := countAccounts(t, driver); count != 1 {
+				t.Errorf("expected 1 record before rollback, actual %d", count)
+			}
+			if err := driver.Rollback(); err != nil {
+				t.Fatal(err)
+			}
+			if count := countAccounts(t, driver); count != 0 {
+				t.Errorf("expected 0 records after rollback, actual %d", count)
+			}
+		})
+	}
+}
+
+func TestDriverSavepoint(t *testing.T) {
+	for _, testDriver := range testDrivers() {
+		t.Run(testDriver.Name, func(t *testing.T) {
+			driver, closeDriver := testDriver.Open(t)
+			defer closeDriver()
+			setupTestDriver(t, driver)
+
+			if err := driver.Begin(); err != nil {
+				t.Fatal(err)
+			}
+			defer driver.Rollback()
+			driver.Execute("insert", "Account", []*ast.Object{newTestAccount("foo")}, "")
+			if err := driver.SetSavepoint("sp1"); err != nil {
+				t.Fatal(err)
+			}
+			driver.Execute("insert", "Account", []*ast.Object{newTestAccount("bar")}, "")
+			if err := driver.RollbackToSavepoint("sp1"); err != nil {
+				t.Fatal(err)
+			}
+			if count := countAccounts(t, driver); count != 1 {
+				t.Errorf("expected 1 record after rollback to savepoint, actual %d", count)
+			}
+			// the savepoint remains after the rollback
+			driver.Execute("insert", "Account", []*ast.Object{newTestAccount("baz")}, "")
+			if err := driver.RollbackToSavepoint("sp1"); err != nil {
+				t.Fatal(err)
+			}
+			if count := countAccounts(t, driver); count != 1 {
+				t.Errorf("expected 1 record after second rollback to savepoint, actual %d", count)
+			}
+		})
+	}
+}
+
+func TestDriverClone(t *testing.T) {
+	for _, testDriver := range testDrivers() {
+		t.Run(testDriver.Name, func(t *testing.T) {
+			driver, closeDriver := testDriver.Open(t)
+			defer closeDriver()
+			setupTestDriver(t, driver)
+			driver.Execute("insert", "Account", []*ast.Object{newTestAccount("foo")}, "")
+
+			clone, err := driver.Clone()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer clone.Close()
+			if count := countAccounts(t, clone); count != testDriver.ClonedRecords {
+				t.Errorf("expected %d records in the clone, actual %d", testDriver.ClonedRecords, count)
+			}
+
+			if err := clone.Begin(); err != nil {
+				t.Fatal(err)
+			}
+			clone.Execute("insert", "Account", []*ast.Object{newTestAccount("bar")}, "")
+			if count := countAccounts(t, driver); count != 1 {
+				t.Errorf("expected the original to be isolated from the clone, actual %d records", count)
+			}
+			if err := clone.Rollback(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+// the clone of the sqlite3 database file is the temporary file, which is removed on close
+func TestSqliteCloneRemovedOnClose(t *testing.T) {
+	driver, closeDriver := testDrivers()[0].Open(t)
+	defer closeDriver()
+	setupTestDriver(t, driver)
+	clone, err := driver.Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := clone.(*databaseDriver).source
+	if _, err := os.Stat(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := clone.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected %s to be removed, actual %v", path, err)
+	}
+}
+
+func newTestAccount(name string) *ast.Object {
+	record := ast.CreateObject(&ast.ClassType{Name: "Account"})
+	record.InstanceFields.Set("Name", NewString(name))
+	return record
+}
+
+func TestUpsertSetsId(t *testing.T) {
+	driver := newTestDriver(t)
+	inserted := newTestAccount("foo")
+	driver.Execute("insert", "Account", []*ast.Object{inserted}, "")
+
+	upserted := newTestAccount("foo")
+	driver.Execute("upsert", "Account", []*ast.Object{upserted}, "Name")
+
+	id := recordId(upserted)
+	if id.ClassType != IdType {
+		t.Errorf("expected Id, actual %s", id.ClassType.Name)
+	}
+	if id.StringValue() != recordId(inserted).StringValue() {
+		t.Errorf("expected %s, actual %s", recordId(inserted).StringValue(), id.StringValue())
+	}
+}
+
+func TestQueryAllRows(t *testing.T) {
+	driver := newTestDriver(t)
+	record := newTestAccount("foo")
+	driver.Execute("insert", "Account", []*ast.Object{record}, "")
+	driver.Execute("delete", "Account", []*ast.Object{record}, "")
+
+	testCases := []struct {
+		AllRows  bool
+		Expected int
+	}{
+		{false, 0},
+		{true, 1},
+	}
+	for _, testCase := range testCases {
+		soql := &ast.Soql{
+			SelectFields: []ast.Node{&ast.SelectField{Value: []string{"Id"}}},
+			FromObject:   "Account",
+			AllRows:      testCase.AllRows,
+		}
+		records := driver.Query(soql, nil)
+		if len(records) != testCase.Expected {
+			t.Errorf("ALL ROWS %t: expected %d records, actual %d", testCase.AllRows, testCase.Expected, len(records))
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package builtin
+
+import (
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tzmfreedom/goland/ast"
+)
+
+const dbDateFormat = "2006-01-02"
+const dbDatetimeFormat = "2006-01-02T15:04:05.000Z"
+
+var dbDatetimeParseFormats = []string{
+	dbDatetimeFormat,
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	dbDateFormat,
+}
+
+// sObjectField returns the field definition of the sobject, field name is case insensitive
+func sObjectField(sObjectType, fieldName string) (SobjectField, bool) {
+	sObject, ok := sObjects[sObjectType]
+	if !ok {
+		return SobjectField{}, false
+	}
+	for _, f := range sObject.Fields {
+		if strings.EqualFold(f.Name, fieldName) {
+			return f, true
+		}
+	}
+	return SobjectField{}, false
+}
+
+// sObjectFieldType returns the field type of path such as ["Name"] or ["Account", "Owner", "Name"]
+func sObjectFieldType(sObjectType string, path []string) string {
+	for _, relationshipName := range path[:len(path)-1] {
+		sObject, ok := sObjects[sObjectType]
+		if !ok {
+			return ""
+		}
+		found := false
+		for _, f := range sObject.Fields {
+			if strings.EqualFold(f.RelationshipName, relationshipName) && len(f.ReferenceTo) > 0 {
+				sObjectType = f.ReferenceTo[0]
+				found = true
+				break
+			}
+		}
+		if !found {
+			return ""
+		}
+	}
+	f, ok := sObjectField(sObjectType, path[len(path)-1])
+	if !ok {
+		return ""
+	}
+	return f.Type
+}
+
+// toDbValue converts the apex object to the bind parameter for the column of fieldType
+func toDbValue(fieldType string, value *ast.Object) interface{} {
+	if value == nil || value == Null {
+		return nil
+	}
+	switch fieldType {
+	case "boolean":
+		switch value.ClassType {
+		case BooleanType:
+			return boolToDbValue(value.BoolValue())
+		case StringType:
+			return boolToDbValue(strings.EqualFold(value.StringValue(), "true"))
+		}
+	case "int":
+		switch value.ClassType {
+		case IntegerType:
+			return value.IntegerValue()
+		case DoubleType:
+			return int(value.DoubleValue())
+		}
+	case "double", "currency", "percent":
+		switch value.ClassType {
+		case IntegerType:
+			return float64(value.IntegerValue())
+		case DoubleType:
+			return value.DoubleValue()
+		}
+	case "date":
+		switch value.ClassType {
+		case DateType, DatetimeType:
+			return value.Value().(time.Time).Format(dbDateFormat)
+		}
+	case "datetime":
+		switch value.ClassType {
+		case DateType, DatetimeType:
+			return value.Value().(time.Time).UTC().Format(dbDatetimeFormat)
+		}
+	}
+
+	switch value.ClassType {
+	case BooleanType:
+		return boolToDbValue(value.BoolValue())
+	case IntegerType:
+		return value.IntegerValue()
+	case DoubleType:
+		return value.DoubleValue()
+	case StringType:
+		return value.StringValue()
+	case DateType:
+		return value.Value().(time.Time).Format(dbDateFormat)
+	case DatetimeType:
+		return value.Value().(time.Time).UTC().Format(dbDatetimeFormat)
+	}
+	return String(value)
+}
+
+func boolToDbValue(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// fromDbValue converts the column value to the apex object for fieldType
+func fromDbValue(fieldType string, value sql.NullString) *ast.Object {
+	if !value.Valid {
+		return Null
+	}
+	switch fieldType {
+	case "boolean":
+		return NewBoolean(value.String == "1" || strings.EqualFold(value.String, "true"))
+	case "int", "double", "currency", "percent":
+		if f, err := strconv.ParseFloat(value.String, 64); err == nil {
+			if fieldType == "int" {
+				return NewInteger(int(f))
+			}
+			return NewDouble(f)
+		}
+	case "id", "reference":
+		return NewId(value.String)
+	case "date", "datetime":
+		for _, layout := range dbDatetimeParseFormats {
+			tm, err := time.Parse(layout, value.String)
+			if err != nil {
+				continue
+			}
+			classType := DatetimeType
+			if fieldType == "date" {
+				classType = DateType
+			}
+			obj := ast.CreateObject(classType)
+			obj.Extra["value"] = tm
+			return obj
+		}
+	}
+	return NewString(value.String)
+}
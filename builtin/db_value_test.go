@@ -0,0 +1,57 @@
+package builtin
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tzmfreedom/goland/ast"
+)
+
+func TestToDbValue(t *testing.T) {
+	date := ast.CreateObject(DateType)
+	date.Extra["value"] = time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		FieldType string
+		Value     *ast.Object
+		Expected  interface{}
+	}{
+		{"string", NewString("O'Brien"), "O'Brien"},
+		{"boolean", NewBoolean(true), 1},
+		{"boolean", NewString("false"), 0},
+		{"int", NewDouble(1.5), 1},
+		{"currency", NewInteger(100), float64(100)},
+		{"date", date, "2019-01-02"},
+		{"datetime", date, "2019-01-02T00:00:00.000Z"},
+		{"string", Null, nil},
+	}
+	for _, testCase := range testCases {
+		actual := toDbValue(testCase.FieldType, testCase.Value)
+		if diff := cmp.Diff(testCase.Expected, actual); diff != "" {
+			t.Errorf("%s: %s", testCase.FieldType, diff)
+		}
+	}
+}
+
+func TestFromDbValue(t *testing.T) {
+	testCases := []struct {
+		FieldType string
+		Value     string
+		Expected  interface{}
+	}{
+		{"boolean", "1", true},
+		{"int", "100", 100},
+		{"currency", "1.5", 1.5},
+		{"string", "O'Brien", "O'Brien"},
+	}
+	for _, testCase := range testCases {
+		actual := fromDbValue(testCase.FieldType, sql.NullString{String: testCase.Value, Valid: true})
+		if diff := cmp.Diff(testCase.Expected, actual.Value()); diff != "" {
+			t.Errorf("%s: %s", testCase.FieldType, diff)
+		}
+	}
+	if fromDbValue("string", sql.NullString{}) != Null {
+		t.Errorf("expected null")
+	}
+}
@@ -0,0 +1,167 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tzmfreedom/goland/ast"
+)
+
+// EnumType is the superclass of the enums, which has the methods of the enum values
+var EnumType = createEnumType()
+
+func createEnumType() *ast.ClassType {
+	instanceMethods := ast.NewMethodMap()
+	instanceMethods.Set(
+		"name",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"name",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(EnumName(this))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"ordinal",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"ordinal",
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewInteger(this.Extra["ordinal"].(int))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"equals",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"equals",
+				BooleanType,
+				[]*ast.Parameter{objectTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewBoolean(this == params[0])
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"hashCode",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"hashCode",
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewInteger(this.Extra["ordinal"].(int))
+				},
+			),
+		},
+	)
+	return ast.CreateClass(
+		"Enum",
+		[]*ast.Method{},
+		instanceMethods,
+		ast.NewMethodMap(),
+	)
+}
+
+// CreateEnum creates the enum class whose values are the singleton objects in the order of the declaration.
+// The values are the public static final fields of the class.
+func CreateEnum(name string, names []string) *ast.ClassType {
+	classType := ast.CreateClass(
+		name,
+		[]*ast.Method{},
+		ast.NewMethodMap(),
+		ast.NewMethodMap(),
+	)
+	classType.SuperClass = EnumType
+	classType.ToString = EnumName
+
+	values := make([]*ast.Object, len(names))
+	for i, n := range names {
+		value := ast.CreateObject(classType)
+		value.Extra["name"] = n
+		value.Extra["ordinal"] = i
+		values[i] = value
+		classType.StaticFields.Set(n, &ast.Field{
+			Type: classType,
+			Modifiers: []*ast.Modifier{
+				ast.PublicModifier(),
+				{Name: "static"},
+				{Name: "final"},
+			},
+			Name: n,
+		})
+	}
+	classType.Extra = map[string]interface{}{
+		"values": values,
+	}
+
+	listType := CreateListType(classType)
+	classType.StaticMethods.Set(
+		"values",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"values",
+				listType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					list := ast.CreateObject(listType)
+					list.Extra["records"] = append([]*ast.Object{}, values...)
+					return list
+				},
+			),
+		},
+	)
+	classType.StaticMethods.Set(
+		"valueOf",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"valueOf",
+				classType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if value := EnumValue(classType, params[0].StringValue()); value != nil {
+						return value
+					}
+					message := fmt.Sprintf("No enum constant %s.%s", name, params[0].StringValue())
+					return CreateRaise(NewException(NoSuchElementExceptionType, message))
+				},
+			),
+		},
+	)
+	return classType
+}
+
+// IsEnum returns whether the class is the enum created by CreateEnum
+func IsEnum(classType *ast.ClassType) bool {
+	return classType != nil && classType.SuperClass == EnumType
+}
+
+// EnumValues returns the values of the enum in the order of the declaration
+func EnumValues(classType *ast.ClassType) []*ast.Object {
+	return classType.Extra["values"].([]*ast.Object)
+}
+
+// EnumValue returns the value of the name, the name is case insensitive like the identifier of apex.
+// It returns nil when the enum has no such value.
+func EnumValue(classType *ast.ClassType, name string) *ast.Object {
+	for _, value := range EnumValues(classType) {
+		if strings.EqualFold(EnumName(value), name) {
+			return value
+		}
+	}
+	return nil
+}
+
+// EnumName returns the name of the enum value
+func EnumName(o *ast.Object) string {
+	return o.Extra["name"].(string)
+}
@@ -2,11 +2,56 @@ package builtin
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/tzmfreedom/goland/ast"
 )
 
-var ExceptionType = &ast.ClassType{}
+// ExceptionType is virtual, the user defined exceptions extend it
+var ExceptionType = &ast.ClassType{
+	Name: "Exception",
+	Modifiers: []*ast.Modifier{
+		ast.PublicModifier(),
+		{Name: "virtual"},
+	},
+}
+
+// The standard exceptions thrown by the builtin classes and the interpreter
+var (
+	// CalloutExceptionType is thrown by the callout which fails or is not allowed
+	CalloutExceptionType         *ast.ClassType
+	DmlExceptionType             *ast.ClassType
+	QueryExceptionType           *ast.ClassType
+	NullPointerExceptionType     *ast.ClassType
+	ListExceptionType            *ast.ClassType
+	MathExceptionType            *ast.ClassType
+	TypeExceptionType            *ast.ClassType
+	StringExceptionType          *ast.ClassType
+	SObjectExceptionType         *ast.ClassType
+	LimitExceptionType           *ast.ClassType
+	JSONExceptionType            *ast.ClassType
+	IllegalArgumentExceptionType *ast.ClassType
+	NoSuchElementExceptionType   *ast.ClassType
+)
+
+// standardExceptions are the other exceptions of System namespace, which are thrown only by apex code
+var standardExceptions = []string{
+	"AsyncException",
+	"EmailException",
+	"FinalException",
+	"HandledException",
+	"InvalidHeaderException",
+	"InvalidParameterValueException",
+	"NoAccessException",
+	"NoDataFoundException",
+	"RequiredFeatureMissingException",
+	"SearchException",
+	"SecurityException",
+	"SerializationException",
+	"UnexpectedException",
+	"VisualforceException",
+	"XmlException",
+}
 
 var exceptionTypeParameter = &ast.Parameter{
 	Type: ExceptionType,
@@ -28,6 +73,94 @@ func createExceptionType() {
 			),
 		},
 	)
+	instanceMethods.Set(
+		"setMessage",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setMessage",
+				nil,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["message"] = params[0]
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getCause",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getCause",
+				ExceptionType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return this.Extra["exception"]
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"initCause",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"initCause",
+				nil,
+				[]*ast.Parameter{exceptionTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					// the cause is set only if it has not already been set
+					if cause, ok := this.Extra["exception"].(*ast.Object); !ok || cause == Null {
+						this.Extra["exception"] = params[0]
+					}
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getTypeName",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getTypeName",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(ExceptionTypeName(this.ClassType))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getLineNumber",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getLineNumber",
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					line, ok := this.Extra["line_number"].(int)
+					if !ok {
+						return NewInteger(-1)
+					}
+					return NewInteger(line)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getStackTraceString",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getStackTraceString",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					stackTrace, _ := this.Extra["stack_trace"].(string)
+					return NewString(stackTrace)
+				},
+			),
+		},
+	)
 
 	ExceptionType.Constructors = []*ast.Method{
 		{
@@ -52,22 +185,228 @@ func createExceptionType() {
 			Modifiers:  []*ast.Modifier{ast.PublicModifier()},
 			Parameters: []*ast.Parameter{exceptionTypeParameter},
 			NativeFunction: func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				// the message is the cause like `System.DmlException: message`
 				this.Extra["message"] = Null
+				if cause := params[0]; cause != Null {
+					this.Extra["message"] = NewString(ExceptionString(cause))
+				}
 				this.Extra["exception"] = params[0]
 				return nil
 			},
 		},
+		{
+			Modifiers:  []*ast.Modifier{ast.PublicModifier()},
+			Parameters: []*ast.Parameter{stringTypeParameter, exceptionTypeParameter},
+			NativeFunction: func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				this.Extra["message"] = params[0]
+				this.Extra["exception"] = params[1]
+				return nil
+			},
+		},
 	}
 	ExceptionType.InstanceFields = ast.NewFieldMap()
 	ExceptionType.StaticFields = ast.NewFieldMap()
 	ExceptionType.InstanceMethods = instanceMethods
 	ExceptionType.StaticMethods = ast.NewMethodMap()
-	ExceptionType.ToString = func(o *ast.Object) string {
-		return fmt.Sprintf("<%s> { message => %s } ", ExceptionType.Name, String(o.Extra["message"].(*ast.Object)))
+	ExceptionType.ToString = exceptionToString
+}
+
+func exceptionToString(o *ast.Object) string {
+	return fmt.Sprintf("<%s> { message => %s } ", o.ClassType.Name, String(o.Extra["message"].(*ast.Object)))
+}
+
+// createExceptionSubclass creates the builtin exception which has the constructors and the methods of Exception
+func createExceptionSubclass(name string) *ast.ClassType {
+	classType := ast.CreateClass(
+		name,
+		ExceptionType.Constructors,
+		ast.NewMethodMap(),
+		ast.NewMethodMap(),
+	)
+	classType.SuperClass = ExceptionType
+	classType.ToString = exceptionToString
+	return classType
+}
+
+// IsException returns whether the class is Exception or its subclass
+func IsException(classType *ast.ClassType) bool {
+	for t := classType; t != nil; t = t.SuperClass {
+		if t == ExceptionType {
+			return true
+		}
+	}
+	return false
+}
+
+// ExceptionTypeName returns the name of the exception class, the builtin exceptions are in System namespace
+func ExceptionTypeName(classType *ast.ClassType) string {
+	if classType.Location == nil {
+		return "System." + classType.Name
+	}
+	return classType.Name
+}
+
+// ExceptionMessage returns the message of the exception, the null message is empty
+func ExceptionMessage(exception *ast.Object) string {
+	message, ok := exception.Extra["message"].(*ast.Object)
+	if !ok || message == Null {
+		return ""
+	}
+	return message.StringValue()
+}
+
+// ExceptionString returns the type and the message of the exception like `System.DmlException: message`
+func ExceptionString(exception *ast.Object) string {
+	return fmt.Sprintf("%s: %s", ExceptionTypeName(exception.ClassType), ExceptionMessage(exception))
+}
+
+// InitException sets the null message and cause, which are set by the constructors of Exception.
+// The constructors of the subclass which do not call them leave the defaults.
+func InitException(exception *ast.Object) {
+	exception.Extra["message"] = Null
+	exception.Extra["exception"] = Null
+}
+
+// NewException creates the exception with the message, which is thrown by CreateRaise
+func NewException(classType *ast.ClassType, message string) *ast.Object {
+	exception := ast.CreateObject(classType)
+	exception.Extra["message"] = NewString(message)
+	exception.Extra["exception"] = Null
+	return exception
+}
+
+// DmlError is the failure of the record in the DML operation, which is held by DmlException
+type DmlError struct {
+	Index      int
+	Id         string
+	StatusCode string
+	Message    string
+	Fields     []string
+}
+
+// NewDmlException creates DmlException of the failed records.
+// The message is the first failure like `Update failed. First exception on row 0; first error: ...`
+func NewDmlException(dmlType string, errors []*DmlError) *ast.Object {
+	message := ""
+	if len(errors) > 0 {
+		first := errors[0]
+		operation := strings.Title(strings.ToLower(dmlType))
+		row := fmt.Sprintf("row %d", first.Index)
+		if first.Id != "" {
+			row += " with id " + first.Id
+		}
+		message = fmt.Sprintf(
+			"%s failed. First exception on %s; first error: %s, %s: [%s]",
+			operation,
+			row,
+			first.StatusCode,
+			first.Message,
+			strings.Join(first.Fields, ", "),
+		)
+	}
+	exception := NewException(DmlExceptionType, message)
+	exception.Extra["dml_errors"] = errors
+	return exception
+}
+
+// dmlError returns the failure at the index, DmlException created by apex code has no failures.
+// The index out of range is the raise object of ListException.
+func dmlError(this *ast.Object, index *ast.Object) (*DmlError, *ast.Object) {
+	errors, _ := this.Extra["dml_errors"].([]*DmlError)
+	i := index.IntegerValue()
+	if i < 0 || i >= len(errors) {
+		return nil, CreateRaise(NewException(ListExceptionType, fmt.Sprintf("List index out of bounds: %d", i)))
 	}
+	return errors[i], nil
+}
+
+func createDmlExceptionType() *ast.ClassType {
+	classType := createExceptionSubclass("DmlException")
+	classType.InstanceMethods.Set(
+		"getNumDml",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getNumDml",
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					errors, _ := this.Extra["dml_errors"].([]*DmlError)
+					return NewInteger(len(errors))
+				},
+			),
+		},
+	)
+	classType.InstanceMethods.Set(
+		"getDmlMessage",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getDmlMessage",
+				StringType,
+				[]*ast.Parameter{IntegerTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					e, raise := dmlError(this, params[0])
+					if raise != nil {
+						return raise
+					}
+					return NewString(e.Message)
+				},
+			),
+		},
+	)
+	classType.InstanceMethods.Set(
+		"getDmlFields",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getDmlFields",
+				CreateListType(schemaSObjectFieldType),
+				[]*ast.Parameter{IntegerTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					e, raise := dmlError(this, params[0])
+					if raise != nil {
+						return raise
+					}
+					fields := e.Fields
+					records := make([]*ast.Object, len(fields))
+					for i, field := range fields {
+						records[i] = NewSObjectField(field)
+					}
+					list := ast.CreateObject(CreateListType(schemaSObjectFieldType))
+					list.Extra["records"] = records
+					return list
+				},
+			),
+		},
+	)
+	return classType
 }
 
 func init() {
 	createExceptionType()
 	primitiveClassMap.Set("Exception", ExceptionType)
+
+	for _, e := range []struct {
+		classType **ast.ClassType
+		name      string
+	}{
+		{&CalloutExceptionType, "CalloutException"},
+		{&QueryExceptionType, "QueryException"},
+		{&NullPointerExceptionType, "NullPointerException"},
+		{&ListExceptionType, "ListException"},
+		{&MathExceptionType, "MathException"},
+		{&TypeExceptionType, "TypeException"},
+		{&StringExceptionType, "StringException"},
+		{&SObjectExceptionType, "SObjectException"},
+		{&LimitExceptionType, "LimitException"},
+		{&JSONExceptionType, "JSONException"},
+		{&IllegalArgumentExceptionType, "IllegalArgumentException"},
+		{&NoSuchElementExceptionType, "NoSuchElementException"},
+	} {
+		*e.classType = createExceptionSubclass(e.name)
+		primitiveClassMap.Set(e.name, *e.classType)
+	}
+	DmlExceptionType = createDmlExceptionType()
+	primitiveClassMap.Set("DmlException", DmlExceptionType)
+	for _, name := range standardExceptions {
+		primitiveClassMap.Set(name, createExceptionSubclass(name))
+	}
 }
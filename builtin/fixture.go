@@ -0,0 +1,364 @@
+package builtin
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/tzmfreedom/goland/ast"
+	"gopkg.in/yaml.v2"
+)
+
+// FixtureFormats are the formats of the fixture files, the file of the sobject is named like Account.csv
+var FixtureFormats = []string{"csv", "json", "yaml"}
+
+// fixtureRecord is the values of the fields, the null values are omitted.
+// The value of Id is the external key of the record, which is referenced by the reference fields of other records.
+type fixtureRecord map[string]string
+
+func fixtureFormat(file string) string {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".json":
+		return "json"
+	case ".yml", ".yaml":
+		return "yaml"
+	}
+	return "csv"
+}
+
+// readFixture reads the records of the fixture file, the format is detected by the extension and csv is the default
+func readFixture(file string) ([]fixtureRecord, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	switch fixtureFormat(file) {
+	case "json":
+		values := []map[string]interface{}{}
+		if err := json.Unmarshal(b, &values); err != nil {
+			return nil, fmt.Errorf("%s: %s", file, err)
+		}
+		return toFixtureRecords(values), nil
+	case "yaml":
+		values := []map[string]interface{}{}
+		if err := yaml.Unmarshal(b, &values); err != nil {
+			return nil, fmt.Errorf("%s: %s", file, err)
+		}
+		return toFixtureRecords(values), nil
+	}
+	rows, err := csv.NewReader(strings.NewReader(string(b))).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", file, err)
+	}
+	records := []fixtureRecord{}
+	if len(rows) == 0 {
+		return records, nil
+	}
+	header := rows[0]
+	for _, row := range rows[1:] {
+		record := fixtureRecord{}
+		for i, value := range row {
+			// the empty cell is null
+			if i < len(header) && value != "" {
+				record[strings.TrimSpace(header[i])] = value
+			}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func toFixtureRecords(values []map[string]interface{}) []fixtureRecord {
+	records := make([]fixtureRecord, len(values))
+	for i, value := range values {
+		record := fixtureRecord{}
+		for name, v := range value {
+			switch typed := v.(type) {
+			case nil:
+				continue
+			case string:
+				record[name] = typed
+			case float64:
+				record[name] = strconv.FormatFloat(typed, 'f', -1, 64)
+			default:
+				record[name] = fmt.Sprint(typed)
+			}
+		}
+		records[i] = record
+	}
+	return records
+}
+
+// writeFixture writes the records, the columns of csv are the fields in the order of the metafile
+func writeFixture(file string, sobject Sobject, records []fixtureRecord) error {
+	switch fixtureFormat(file) {
+	case "json":
+		b, err := json.MarshalIndent(records, "", "  ")
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(file, append(b, '\n'), 0644)
+	case "yaml":
+		b, err := yaml.Marshal(records)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(file, b, 0644)
+	}
+	header := []string{}
+	for _, field := range sobject.Fields {
+		for _, record := range records {
+			if _, ok := record[field.Name]; ok {
+				header = append(header, field.Name)
+				break
+			}
+		}
+	}
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := csv.NewWriter(f)
+	if err := w.Write(header); err != nil {
+		return err
+	}
+	for _, record := range records {
+		row := make([]string, len(header))
+		for i, name := range header {
+			row[i] = record[name]
+		}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// fixtureValue converts the value of the fixture to the apex object of the field
+func fixtureValue(field SobjectField, value string) *ast.Object {
+	return fromDbValue(field.Type, sql.NullString{String: value, Valid: true})
+}
+
+// fixtureId returns the id of the record for the external key.
+// The key which is the id of the sobject is used as it is, so the dumped records keep their ids.
+func fixtureId(sObjectType, key string) string {
+	if name, ok := SObjectTypeOf(key); ok && strings.EqualFold(name, sObjectType) && (len(key) == 15 || len(key) == 18) {
+		return To18(key)
+	}
+	return GenerateId(sObjectType)
+}
+
+// LoadFixtures inserts the records of the fixture files in the directory without triggers.
+// The reference fields whose values are the external keys of other records get the ids of them.
+func LoadFixtures(driver Driver, directory string) (map[string]int, error) {
+	files, err := ioutil.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+	fixtures := map[string][]fixtureRecord{}
+	sobjectNames := []string{}
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		name := strings.TrimSuffix(file.Name(), filepath.Ext(file.Name()))
+		sobject, ok := findSobject(name)
+		if !ok {
+			return nil, fmt.Errorf("%s: sobject %s is not found in the metafile", file.Name(), name)
+		}
+		if _, ok := fixtures[sobject.Name]; ok {
+			return nil, fmt.Errorf("%s: records of %s are already loaded from another file", file.Name(), sobject.Name)
+		}
+		records, err := readFixture(filepath.Join(directory, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		fixtures[sobject.Name] = records
+		sobjectNames = append(sobjectNames, sobject.Name)
+	}
+
+	// the ids are decided before insert, so the records can reference the records in any file
+	ids := map[string]string{}
+	recordIds := map[string][]string{}
+	for _, name := range sobjectNames {
+		for _, record := range fixtures[name] {
+			key := fixtureKey(record)
+			id := fixtureId(name, key)
+			if key != "" {
+				ids[key] = id
+			}
+			recordIds[name] = append(recordIds[name], id)
+		}
+	}
+
+	counts := map[string]int{}
+	for _, name := range sobjectNames {
+		sobject := sObjects[name]
+		for i, record := range fixtures[name] {
+			values := map[string]interface{}{
+				"id": recordIds[name][i],
+			}
+			for column, value := range record {
+				if strings.EqualFold(column, "Id") {
+					continue
+				}
+				field, ok := sObjectField(name, column)
+				if !ok {
+					return nil, fmt.Errorf("field %s is not found in %s", column, name)
+				}
+				if id, ok := ids[value]; ok && field.Type == "reference" {
+					value = id
+				}
+				values[field.Name] = toDbValue(field.Type, fixtureValue(field, value))
+			}
+			if isSoftDeletable(name) {
+				if _, ok := values["IsDeleted"]; !ok {
+					values["IsDeleted"] = 0
+				}
+			}
+			if err := driver.InsertRaw(name, values); err != nil {
+				return nil, err
+			}
+		}
+		if err := driver.IndexSearchRecords(sobject); err != nil {
+			return nil, err
+		}
+		counts[name] = len(fixtures[name])
+	}
+	return counts, nil
+}
+
+func fixtureKey(record fixtureRecord) string {
+	for column, value := range record {
+		if strings.EqualFold(column, "Id") {
+			return value
+		}
+	}
+	return ""
+}
+
+// DumpFixtures writes the records of the sobjects into the directory in the format.
+// All sobjects in the metafile are dumped when sObjectTypes is empty, and the sobjects without records are skipped.
+func DumpFixtures(driver Driver, directory, format string, sObjectTypes []string) (map[string]int, error) {
+	if format == "yml" {
+		format = "yaml"
+	}
+	if !contains(format, FixtureFormats) {
+		return nil, fmt.Errorf("unknown fixture format: %s", format)
+	}
+	if len(sObjectTypes) == 0 {
+		sObjectTypes = sortedSObjectNames()
+	}
+	if err := os.MkdirAll(directory, 0755); err != nil {
+		return nil, err
+	}
+	counts := map[string]int{}
+	for _, name := range sObjectTypes {
+		sobject, ok := findSobject(name)
+		if !ok {
+			return nil, fmt.Errorf("sobject %s is not found in the metafile", name)
+		}
+		rows, err := driver.SelectRaw(sobject.Name)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			continue
+		}
+		records := make([]fixtureRecord, len(rows))
+		for i, row := range rows {
+			record := fixtureRecord{}
+			for column, value := range row {
+				field, ok := sObjectField(sobject.Name, column)
+				if !ok || strings.EqualFold(field.Name, "IsDeleted") {
+					continue
+				}
+				if field.Type == "boolean" {
+					value = strconv.FormatBool(fixtureValue(field, value).BoolValue())
+				}
+				record[field.Name] = value
+			}
+			records[i] = record
+		}
+		file := filepath.Join(directory, sobject.Name+"."+format)
+		if err := writeFixture(file, sobject, records); err != nil {
+			return nil, err
+		}
+		counts[sobject.Name] = len(records)
+	}
+	return counts, nil
+}
+
+// loadTestData inserts the records of the static resource by DML of the interpreter for Test.loadData.
+// The external keys of the records are kept in the interpreter, so the resources loaded later can reference them.
+func loadTestData(extra map[string]interface{}, sObjectType, resourceName string) *ast.Object {
+	sobject, ok := findSobject(sObjectType)
+	if !ok {
+		panic(fmt.Errorf("sobject %s is not found in the metafile", sObjectType))
+	}
+	classType, _ := primitiveClassMap.Get(sobject.Name)
+	file, err := findStaticResource(resourceName)
+	if err != nil {
+		panic(err)
+	}
+	fixtures, err := readFixture(file)
+	if err != nil {
+		panic(err)
+	}
+	ids, ok := extra["test_data_ids"].(map[string]*ast.Object)
+	if !ok {
+		ids = map[string]*ast.Object{}
+		extra["test_data_ids"] = ids
+	}
+
+	records := make([]*ast.Object, len(fixtures))
+	for i, fixture := range fixtures {
+		record := ast.CreateObject(classType)
+		for name := range classType.InstanceFields.Data {
+			record.InstanceFields.Set(name, Null)
+		}
+		for column, value := range fixture {
+			if strings.EqualFold(column, "Id") {
+				continue
+			}
+			field, ok := sObjectField(sobject.Name, column)
+			if !ok {
+				panic(fmt.Errorf("field %s is not found in %s", column, sobject.Name))
+			}
+			if id, ok := ids[value]; ok && field.Type == "reference" {
+				record.InstanceFields.Set(field.Name, id)
+				continue
+			}
+			record.InstanceFields.Set(field.Name, fixtureValue(field, value))
+		}
+		records[i] = record
+	}
+	list := ast.CreateObject(CreateListType(SObjectType))
+	list.Extra["records"] = records
+	if len(records) == 0 {
+		return list
+	}
+
+	executor := extra["interpreter"].(DmlExecutor)
+	r, err := executor.ExecuteDml("insert", sobject.Name, records, "")
+	if err != nil {
+		panic(err)
+	}
+	if r != nil && r.ClassType == RaiseType {
+		return r
+	}
+	for i, fixture := range fixtures {
+		if key := fixtureKey(fixture); key != "" {
+			ids[key], _ = records[i].InstanceFields.Get("Id")
+		}
+	}
+	return list
+}
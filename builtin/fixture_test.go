@@ -0,0 +1,186 @@
+package builtin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeFixtureFiles(t *testing.T, files map[string]string) string {
+	directory, err := ioutil.TempDir("", "land_fixtures")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(directory, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return directory
+}
+
+var testFixtureFiles = map[string]string{
+	"Account.csv": `Id,Name,Website
+acme,Acme,https://acme.example.com
+001D000000IqhSL,"Kept, Inc.",
+`,
+	"Opportunity.json": `[
+  {"Id": "deal", "Name": "Deal", "AccountId": "acme", "StageName": "Closed Won", "Amount": 1500.5, "CloseDate": "2019-05-15"},
+  {"Name": "Lost", "AccountId": "001D000000IqhSL", "StageName": "Prospecting", "Email__c": null}
+]
+`,
+}
+
+func TestLoadFixtures(t *testing.T) {
+	driver := newTestDataDriver(t)
+	directory := writeFixtureFiles(t, testFixtureFiles)
+	defer os.RemoveAll(directory)
+
+	counts, err := LoadFixtures(driver, directory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := map[string]int{"Account": 2, "Opportunity": 2}; !reflect.DeepEqual(counts, expected) {
+		t.Errorf("expected %v, actual %v", expected, counts)
+	}
+
+	accounts, err := driver.SelectRaw("Account")
+	if err != nil {
+		t.Fatal(err)
+	}
+	accountIds := map[string]string{}
+	for _, account := range accounts {
+		accountIds[account["Name"]] = account["Id"]
+	}
+	// the id of the sobject is kept, and the other key gets the generated id
+	if id := accountIds["Kept, Inc."]; id != "001D000000IqhSLIAZ" {
+		t.Errorf("expected 001D000000IqhSLIAZ, actual %s", id)
+	}
+	if id, ok := SObjectTypeOf(accountIds["Acme"]); !ok || id != "Account" {
+		t.Errorf("expected the id of account, actual %s", accountIds["Acme"])
+	}
+
+	opportunities, err := driver.SelectRaw("Opportunity")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(opportunities) != 2 {
+		t.Fatalf("expected 2 opportunities, actual %d", len(opportunities))
+	}
+	for _, opportunity := range opportunities {
+		switch opportunity["Name"] {
+		case "Deal":
+			// the reference field gets the id of the external key
+			if opportunity["AccountId"] != accountIds["Acme"] {
+				t.Errorf("expected %s, actual %s", accountIds["Acme"], opportunity["AccountId"])
+			}
+			if opportunity["Amount"] != "1500.5" {
+				t.Errorf("expected 1500.5, actual %s", opportunity["Amount"])
+			}
+		case "Lost":
+			if opportunity["AccountId"] != "001D000000IqhSLIAZ" {
+				t.Errorf("expected 001D000000IqhSLIAZ, actual %s", opportunity["AccountId"])
+			}
+			if _, ok := opportunity["Email__c"]; ok {
+				t.Errorf("expected null email, actual %s", opportunity["Email__c"])
+			}
+		}
+	}
+}
+
+func TestLoadFixturesError(t *testing.T) {
+	testCases := []struct {
+		Files    map[string]string
+		Expected string
+	}{
+		{
+			map[string]string{"Unknown.csv": "Name\nfoo\n"},
+			"Unknown.csv: sobject Unknown is not found in the metafile",
+		},
+		{
+			map[string]string{"Account.csv": "Name,Unknown__c\nfoo,bar\n"},
+			"field Unknown__c is not found in Account",
+		},
+		{
+			map[string]string{"Account.csv": "Name\nfoo\n", "Account.json": "[]"},
+			"Account.json: records of Account are already loaded from another file",
+		},
+	}
+	for _, testCase := range testCases {
+		driver := newTestDataDriver(t)
+		directory := writeFixtureFiles(t, testCase.Files)
+		_, err := LoadFixtures(driver, directory)
+		if err == nil || err.Error() != testCase.Expected {
+			t.Errorf("expected %s, actual %v", testCase.Expected, err)
+		}
+		os.RemoveAll(directory)
+	}
+}
+
+// the dumped fixtures are loaded into the same records
+func TestDumpFixtures(t *testing.T) {
+	for _, format := range []string{"csv", "json", "yaml"} {
+		driver := newTestDataDriver(t)
+		directory := writeFixtureFiles(t, testFixtureFiles)
+		defer os.RemoveAll(directory)
+		if _, err := LoadFixtures(driver, directory); err != nil {
+			t.Fatal(err)
+		}
+
+		dumpDirectory := filepath.Join(directory, "dump")
+		counts, err := DumpFixtures(driver, dumpDirectory, format, []string{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := map[string]int{"Account": 2, "Opportunity": 2}; !reflect.DeepEqual(counts, expected) {
+			t.Errorf("%s: expected %v, actual %v", format, expected, counts)
+		}
+		if _, err := os.Stat(filepath.Join(dumpDirectory, "Account."+format)); err != nil {
+			t.Errorf("%s: %s", format, err)
+		}
+
+		loaded := newTestDataDriver(t)
+		if _, err := LoadFixtures(loaded, dumpDirectory); err != nil {
+			t.Fatalf("%s: %s", format, err)
+		}
+		for _, name := range []string{"Account", "Opportunity"} {
+			expected, err := driver.SelectRaw(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			actual, err := loaded.SelectRaw(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(expected, actual) {
+				t.Errorf("%s: expected %v, actual %v", format, expected, actual)
+			}
+		}
+	}
+}
+
+func TestDumpFixturesError(t *testing.T) {
+	driver := newTestDataDriver(t)
+	directory, err := ioutil.TempDir("", "land_fixtures")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	testCases := []struct {
+		Format      string
+		SObjectType string
+		Expected    string
+	}{
+		{"xml", "Account", "unknown fixture format: xml"},
+		{"csv", "Unknown", "sobject Unknown is not found in the metafile"},
+	}
+	for _, testCase := range testCases {
+		_, err := DumpFixtures(driver, directory, testCase.Format, []string{testCase.SObjectType})
+		if err == nil || err.Error() != testCase.Expected {
+			t.Errorf("expected %s, actual %v", testCase.Expected, err)
+		}
+	}
+}
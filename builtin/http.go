@@ -3,7 +3,6 @@ package builtin
 import (
 	"io/ioutil"
 	"net/http"
-
 	"strings"
 
 	"github.com/tzmfreedom/goland/ast"
@@ -39,13 +38,19 @@ func init() {
 				},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 					request := params[0]
+					if response := respondMock(request, extra); response != nil {
+						return response
+					}
+					if runningTest, _ := extra["running_test"].(bool); runningTest {
+						return CreateRaise(NewException(CalloutExceptionType, "Methods defined as TestMethod do not support Web service callouts"))
+					}
 					endpoint := request.Extra["endpoint"].(string)
 					method := request.Extra["method"].(string)
 					headers := request.Extra["headers"].(map[string]*ast.Object)
 					body := request.Extra["body"].(string)
 					req, err := http.NewRequest(method, endpoint, strings.NewReader(body))
 					if err != nil {
-						panic(err) // TODO: impl
+						return CreateRaise(NewException(CalloutExceptionType, err.Error()))
 					}
 					for header, value := range headers {
 						req.Header.Add(header, value.StringValue())
@@ -53,14 +58,21 @@ func init() {
 					client := &http.Client{}
 					res, err := client.Do(req)
 					if err != nil {
-						panic(err) // TODO: impl
+						return CreateRaise(NewException(CalloutExceptionType, err.Error()))
 					}
+					defer res.Body.Close()
 					buf, err := ioutil.ReadAll(res.Body)
 					if err != nil {
-						panic(err)
+						return CreateRaise(NewException(CalloutExceptionType, err.Error()))
 					}
-					responseObj := ast.CreateObject(httpResponseType)
+					responseObj := newHttpResponse()
 					responseObj.Extra["body"] = string(buf)
+					responseObj.Extra["status_code"] = res.StatusCode
+					responseObj.Extra["status"] = http.StatusText(res.StatusCode)
+					responseHeaders := responseObj.Extra["headers"].(map[string]*ast.Object)
+					for header := range res.Header {
+						responseHeaders[header] = NewString(res.Header.Get(header))
+					}
 					return responseObj
 				},
 			),
@@ -0,0 +1,303 @@
+package builtin
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tzmfreedom/goland/ast"
+)
+
+// MethodCaller calls the instance method of the apex object from the native method
+type MethodCaller interface {
+	CallMethod(receiver *ast.Object, name string, parameters []*ast.Object) (*ast.Object, error)
+}
+
+// StaticResourceDirectory is the directory of the static resources for StaticResourceCalloutMock.
+// The static resource is the file whose name without the extension is the name of the resource.
+var StaticResourceDirectory = "staticresources"
+
+var httpCalloutMockType = createHttpCalloutMockType()
+
+func createHttpCalloutMockType() *ast.ClassType {
+	instanceMethods := ast.NewMethodMap()
+	instanceMethods.Set(
+		"respond",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"respond",
+				httpResponseType,
+				[]*ast.Parameter{httpRequestTypeParameter},
+				nil,
+			),
+		},
+	)
+	classType := ast.CreateClass(
+		"HttpCalloutMock",
+		[]*ast.Method{},
+		instanceMethods,
+		nil,
+	)
+	classType.Interface = true
+	return classType
+}
+
+// respondMock calls respond of the mock set by Test.setMock(HttpCalloutMock.class, mock).
+// It returns nil when no mock is set.
+func respondMock(request *ast.Object, extra map[string]interface{}) *ast.Object {
+	mocks, ok := extra["mocks"].(map[string]*ast.Object)
+	if !ok {
+		return nil
+	}
+	mock, ok := mocks[strings.ToLower(httpCalloutMockType.Name)]
+	if !ok {
+		return nil
+	}
+	caller := extra["interpreter"].(MethodCaller)
+	response, err := caller.CallMethod(mock, "respond", []*ast.Object{request})
+	if err != nil {
+		panic(err)
+	}
+	return response
+}
+
+// findStaticResource returns the file of the static resource, the metadata file such as foo.resource-meta.xml is ignored
+func findStaticResource(name string) (string, error) {
+	candidates, err := filepath.Glob(filepath.Join(StaticResourceDirectory, name+".*"))
+	if err != nil {
+		return "", err
+	}
+	candidates = append([]string{filepath.Join(StaticResourceDirectory, name)}, candidates...)
+	for _, file := range candidates {
+		if strings.HasSuffix(file, "-meta.xml") {
+			continue
+		}
+		if info, err := os.Stat(file); err == nil && !info.IsDir() {
+			return file, nil
+		}
+	}
+	return "", fmt.Errorf("static resource %s is not found in %s", name, StaticResourceDirectory)
+}
+
+func readStaticResource(name string) (string, error) {
+	file, err := findStaticResource(name)
+	if err != nil {
+		return "", err
+	}
+	buf, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+// setMockResponseMethods sets the methods to build the response, which are common to the static resource mocks
+func setMockResponseMethods(instanceMethods *ast.MethodMap) {
+	instanceMethods.Set(
+		"setStatusCode",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setStatusCode",
+				nil,
+				[]*ast.Parameter{IntegerTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["status_code"] = params[0].IntegerValue()
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setStatus",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setStatus",
+				nil,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["status"] = params[0].StringValue()
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setHeader",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setHeader",
+				nil,
+				[]*ast.Parameter{
+					stringTypeParameter,
+					stringTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					headers := this.Extra["headers"].(map[string]*ast.Object)
+					setHttpHeader(headers, params[0].StringValue(), params[1])
+					return nil
+				},
+			),
+		},
+	)
+}
+
+func initMockResponse(mock *ast.Object) {
+	mock.Extra["status_code"] = 200
+	mock.Extra["status"] = "OK"
+	mock.Extra["headers"] = map[string]*ast.Object{}
+}
+
+// createMockResponse creates the response from the status and the headers set to the mock
+func createMockResponse(mock *ast.Object, body string) *ast.Object {
+	response := newHttpResponse()
+	response.Extra["body"] = body
+	response.Extra["status_code"] = mock.Extra["status_code"]
+	response.Extra["status"] = mock.Extra["status"]
+	headers := response.Extra["headers"].(map[string]*ast.Object)
+	for key, value := range mock.Extra["headers"].(map[string]*ast.Object) {
+		headers[key] = value
+	}
+	return response
+}
+
+var staticResourceCalloutMockType = createStaticResourceCalloutMockType()
+
+func createStaticResourceCalloutMockType() *ast.ClassType {
+	instanceMethods := ast.NewMethodMap()
+	setMockResponseMethods(instanceMethods)
+	instanceMethods.Set(
+		"setStaticResource",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setStaticResource",
+				nil,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["static_resource"] = params[0].StringValue()
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"respond",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"respond",
+				httpResponseType,
+				[]*ast.Parameter{httpRequestTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					name, ok := this.Extra["static_resource"].(string)
+					if !ok {
+						return CreateRaise(NewException(CalloutExceptionType, "Static resource is not set"))
+					}
+					body, err := readStaticResource(name)
+					if err != nil {
+						return CreateRaise(NewException(CalloutExceptionType, err.Error()))
+					}
+					return createMockResponse(this, body)
+				},
+			),
+		},
+	)
+	classType := ast.CreateClass(
+		"StaticResourceCalloutMock",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"StaticResourceCalloutMock",
+				nil,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					initMockResponse(this)
+					return nil
+				},
+			),
+		},
+		instanceMethods,
+		ast.NewMethodMap(),
+	)
+	classType.ImplementClasses = []*ast.ClassType{httpCalloutMockType}
+	return classType
+}
+
+var multiStaticResourceCalloutMockType = createMultiStaticResourceCalloutMockType()
+
+func createMultiStaticResourceCalloutMockType() *ast.ClassType {
+	instanceMethods := ast.NewMethodMap()
+	setMockResponseMethods(instanceMethods)
+	instanceMethods.Set(
+		"setStaticResource",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setStaticResource",
+				nil,
+				[]*ast.Parameter{
+					stringTypeParameter,
+					stringTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					resources := this.Extra["static_resources"].(map[string]string)
+					resources[params[0].StringValue()] = params[1].StringValue()
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"respond",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"respond",
+				httpResponseType,
+				[]*ast.Parameter{httpRequestTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					endpoint := params[0].Extra["endpoint"].(string)
+					resources := this.Extra["static_resources"].(map[string]string)
+					name, ok := resources[endpoint]
+					if !ok {
+						endpoints := make([]string, 0, len(resources))
+						for e := range resources {
+							endpoints = append(endpoints, e)
+						}
+						sort.Strings(endpoints)
+						message := fmt.Sprintf("No static resource is set for the endpoint %s in [%s]", endpoint, strings.Join(endpoints, ", "))
+						return CreateRaise(NewException(CalloutExceptionType, message))
+					}
+					body, err := readStaticResource(name)
+					if err != nil {
+						return CreateRaise(NewException(CalloutExceptionType, err.Error()))
+					}
+					return createMockResponse(this, body)
+				},
+			),
+		},
+	)
+	classType := ast.CreateClass(
+		"MultiStaticResourceCalloutMock",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"MultiStaticResourceCalloutMock",
+				nil,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					initMockResponse(this)
+					this.Extra["static_resources"] = map[string]string{}
+					return nil
+				},
+			),
+		},
+		instanceMethods,
+		ast.NewMethodMap(),
+	)
+	classType.ImplementClasses = []*ast.ClassType{httpCalloutMockType}
+	return classType
+}
+
+func init() {
+	primitiveClassMap.Set("HttpCalloutMock", httpCalloutMockType)
+	primitiveClassMap.Set("StaticResourceCalloutMock", staticResourceCalloutMockType)
+	primitiveClassMap.Set("MultiStaticResourceCalloutMock", multiStaticResourceCalloutMockType)
+}
@@ -21,7 +21,10 @@ func init() {
 			nil,
 			[]*ast.Parameter{},
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				this.Extra["endpoint"] = ""
+				this.Extra["method"] = "GET"
 				this.Extra["headers"] = map[string]*ast.Object{}
+				this.Extra["body"] = ""
 				return nil
 			},
 		),
@@ -42,9 +45,8 @@ func init() {
 					stringTypeParameter,
 				},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					key := params[0].StringValue()
 					headers := this.Extra["headers"].(map[string]*ast.Object)
-					headers[key] = params[1]
+					setHttpHeader(headers, params[0].StringValue(), params[1])
 					return nil
 				},
 			),
@@ -101,6 +103,59 @@ func init() {
 			),
 		},
 	)
+	instanceMethods.Set(
+		"getHeader",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getHeader",
+				StringType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					headers := this.Extra["headers"].(map[string]*ast.Object)
+					return getHttpHeader(headers, params[0].StringValue())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getMethod",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getMethod",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.Extra["method"].(string))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getBody",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getBody",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.Extra["body"].(string))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getEndpoint",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getEndpoint",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.Extra["endpoint"].(string))
+				},
+			),
+		},
+	)
 
 	primitiveClassMap.Set("HttpRequest", httpRequestType)
 }
@@ -1,13 +1,51 @@
 package builtin
 
-import "github.com/tzmfreedom/goland/ast"
+import (
+	"sort"
+	"strings"
+
+	"github.com/tzmfreedom/goland/ast"
+)
 
 var httpResponseType = &ast.ClassType{Name: "HttpResponse"}
 var httpResponseTypeParameter = &ast.Parameter{
-	Type: httpRequestType,
+	Type: httpResponseType,
 	Name: "_",
 }
 
+// newHttpResponse creates the response which has no status code, no headers and the empty body
+func newHttpResponse() *ast.Object {
+	response := ast.CreateObject(httpResponseType)
+	initHttpResponse(response)
+	return response
+}
+
+func initHttpResponse(response *ast.Object) {
+	response.Extra["body"] = ""
+	response.Extra["status_code"] = 0
+	response.Extra["status"] = ""
+	response.Extra["headers"] = map[string]*ast.Object{}
+}
+
+// setHttpHeader sets the header value, the existing header of the same name is replaced regardless of case
+func setHttpHeader(headers map[string]*ast.Object, key string, value *ast.Object) {
+	for k := range headers {
+		if strings.EqualFold(k, key) {
+			delete(headers, k)
+		}
+	}
+	headers[key] = value
+}
+
+func getHttpHeader(headers map[string]*ast.Object, key string) *ast.Object {
+	for k, value := range headers {
+		if strings.EqualFold(k, key) {
+			return value
+		}
+	}
+	return Null
+}
+
 func init() {
 	instanceMethods := ast.NewMethodMap()
 	staticMethods := ast.NewMethodMap()
@@ -17,10 +55,13 @@ func init() {
 			nil,
 			[]*ast.Parameter{},
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				initHttpResponse(this)
 				return nil
 			},
 		),
 	}
+	httpResponseType.InstanceFields = ast.NewFieldMap()
+	httpResponseType.StaticFields = ast.NewFieldMap()
 	httpResponseType.InstanceMethods = instanceMethods
 	httpResponseType.StaticMethods = staticMethods
 
@@ -37,6 +78,131 @@ func init() {
 			),
 		},
 	)
+	instanceMethods.Set(
+		"setBody",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setBody",
+				nil,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["body"] = params[0].StringValue()
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getStatusCode",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getStatusCode",
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewInteger(this.Extra["status_code"].(int))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setStatusCode",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setStatusCode",
+				nil,
+				[]*ast.Parameter{IntegerTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["status_code"] = params[0].IntegerValue()
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getStatus",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getStatus",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.Extra["status"].(string))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setStatus",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setStatus",
+				nil,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["status"] = params[0].StringValue()
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getHeader",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getHeader",
+				StringType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					headers := this.Extra["headers"].(map[string]*ast.Object)
+					return getHttpHeader(headers, params[0].StringValue())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setHeader",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setHeader",
+				nil,
+				[]*ast.Parameter{
+					stringTypeParameter,
+					stringTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					headers := this.Extra["headers"].(map[string]*ast.Object)
+					setHttpHeader(headers, params[0].StringValue(), params[1])
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getHeaderKeys",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getHeaderKeys",
+				CreateListType(StringType),
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					headers := this.Extra["headers"].(map[string]*ast.Object)
+					keys := make([]string, 0, len(headers))
+					for key := range headers {
+						keys = append(keys, key)
+					}
+					sort.Strings(keys)
+					records := make([]*ast.Object, len(keys))
+					for i, key := range keys {
+						records[i] = NewString(key)
+					}
+					list := ast.CreateObject(CreateListType(StringType))
+					list.Extra["records"] = records
+					return list
+				},
+			),
+		},
+	)
 
 	primitiveClassMap.Set("HttpResponse", httpResponseType)
 }
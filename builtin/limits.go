@@ -0,0 +1,89 @@
+package builtin
+
+import (
+	"fmt"
+
+	"github.com/tzmfreedom/goland/ast"
+)
+
+const (
+	LimitQueries       = "Queries"
+	LimitQueryRows     = "QueryRows"
+	LimitDmlStatements = "DmlStatements"
+	LimitDmlRows       = "DmlRows"
+	LimitFutureCalls   = "FutureCalls"
+	LimitQueueableJobs = "QueueableJobs"
+)
+
+type governorLimit struct {
+	name    string
+	limit   int
+	message string
+}
+
+var governorLimits = []governorLimit{
+	{LimitQueries, 100, "Too many SOQL queries"},
+	{LimitQueryRows, 50000, "Too many query rows"},
+	{LimitDmlStatements, 150, "Too many DML statements"},
+	{LimitDmlRows, 10000, "Too many DML rows"},
+	{LimitFutureCalls, 50, "Too many future calls"},
+	{LimitQueueableJobs, 50, "Too many queueable jobs added to the queue"},
+}
+
+// Limits is the usage of the governor limits in the transaction, which is stored in extra["limits"]
+type Limits map[string]int
+
+func NewLimits() Limits {
+	return Limits{}
+}
+
+// UseLimit adds the usage of the governor limit.
+// It returns LimitException, which can not be caught by apex, when the usage exceeds the limit.
+func UseLimit(extra map[string]interface{}, name string, n int) error {
+	limits, ok := extra["limits"].(Limits)
+	if !ok {
+		return nil
+	}
+	limits[name] += n
+	for _, l := range governorLimits {
+		if l.name == name && limits[name] > l.limit {
+			return fmt.Errorf("System.LimitException: %s: %d", l.message, limits[name])
+		}
+	}
+	return nil
+}
+
+func init() {
+	staticMethods := ast.NewMethodMap()
+	for _, l := range governorLimits {
+		l := l
+		staticMethods.Set("get"+l.name, []*ast.Method{
+			ast.CreateMethod(
+				"get"+l.name,
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					limits, _ := extra["limits"].(Limits)
+					return NewInteger(limits[l.name])
+				},
+			),
+		})
+		staticMethods.Set("getLimit"+l.name, []*ast.Method{
+			ast.CreateMethod(
+				"getLimit"+l.name,
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewInteger(l.limit)
+				},
+			),
+		})
+	}
+	limitsClass := ast.CreateClass(
+		"Limits",
+		[]*ast.Method{},
+		ast.NewMethodMap(),
+		staticMethods,
+	)
+	primitiveClassMap.Set("Limits", limitsClass)
+}
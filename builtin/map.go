@@ -80,16 +80,15 @@ func createMapType() *ast.ClassType {
 				nil,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					keySets := map[string]struct{}{}
-					for key, _ := range this.Extra["values"].(map[string]*ast.Object) {
-						keySets[key] = struct{}{}
-					}
 					setClass, ok := PrimitiveClassMap().Get("Set")
 					if !ok {
 						panic("Set is not defined")
 					}
 					object := ast.CreateObject(setClass)
-					object.Extra["values"] = keySets
+					InitSet(object)
+					for key, _ := range this.Extra["values"].(map[string]*ast.Object) {
+						AddSetValue(object, NewString(key))
+					}
 					object.Extra["generices"] = nil // TODO: implement
 					return object
 				},
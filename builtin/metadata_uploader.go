@@ -27,6 +27,12 @@ func createMetadata(username, password, endpoint string, standardObjects []strin
 			if string(*f.Type_) == "address" {
 				continue
 			}
+			picklistValues := []string{}
+			for _, entry := range f.PicklistValues {
+				if entry.Active {
+					picklistValues = append(picklistValues, entry.Value)
+				}
+			}
 			fields = append(
 				fields,
 				SobjectField{
@@ -36,6 +42,9 @@ func createMetadata(username, password, endpoint string, standardObjects []strin
 					Type:             string(*f.Type_),
 					Custom:           f.Custom,
 					ReferenceTo:      f.ReferenceTo,
+					Required:         f.Createable && !f.Nillable && !f.DefaultedOnCreate,
+					PicklistValues:   picklistValues,
+					Length:           int(f.Length),
 				},
 			)
 		}
@@ -44,6 +53,7 @@ func createMetadata(username, password, endpoint string, standardObjects []strin
 			Custom:        sobj.Custom,
 			CustomSetting: sobj.CustomSetting,
 			Label:         sobj.Label,
+			KeyPrefix:     sobj.KeyPrefix,
 			Fields:        fields,
 		}
 	}
@@ -9,8 +9,13 @@ import (
 
 var PrimitiveClasses []*ast.ClassType
 
+// NewClassMapWithPrimivie returns the copy of the builtin class map with the classes,
+// so that the classes are not registered to the builtin class map shared by interpreters
 func NewClassMapWithPrimivie(classTypes []*ast.ClassType) *ast.ClassMap {
-	classMap := PrimitiveClassMap()
+	classMap := ast.NewClassMap()
+	for name, classType := range primitiveClassMap.Data {
+		classMap.Data[name] = classType
+	}
 	for _, classType := range classTypes {
 		classMap.Set(classType.Name, classType)
 	}
@@ -2,8 +2,47 @@ package builtin
 
 import "github.com/tzmfreedom/goland/ast"
 
-var schemaSObjectType *ast.ClassType
+var schemaSObjectType = createSchemaSObjectType()
 var describeSObjectResultType *ast.ClassType
+var schemaSObjectFieldType = createSchemaSObjectFieldType()
+
+// NewSObjectType creates Schema.SObjectType of the sobject, such as the value of `Account.sObjectType`
+func NewSObjectType(name string) *ast.Object {
+	obj := ast.CreateObject(schemaSObjectType)
+	obj.Extra["type"] = name
+	obj.Extra["value"] = name
+	return obj
+}
+
+// NewSObjectField creates Schema.SObjectField of the field, which is printed as the field name
+func NewSObjectField(name string) *ast.Object {
+	obj := ast.CreateObject(schemaSObjectFieldType)
+	obj.Extra["name"] = name
+	return obj
+}
+
+func createSchemaSObjectFieldType() *ast.ClassType {
+	classType := ast.CreateClass(
+		"SObjectField",
+		nil,
+		ast.NewMethodMap(),
+		ast.NewMethodMap(),
+	)
+	classType.ToString = func(o *ast.Object) string {
+		return o.Extra["name"].(string)
+	}
+	return classType
+}
+
+// sObjectTypeExpression is the initializer of the static field `sObjectType` of the sobject class
+type sObjectTypeExpression struct {
+	ast.NullLiteral
+	name string
+}
+
+func (n *sObjectTypeExpression) Accept(v ast.Visitor) (interface{}, error) {
+	return NewSObjectType(n.name), nil
+}
 
 func init() {
 	schema := ast.CreateClass(
@@ -39,8 +78,32 @@ func init() {
 	primitiveClassMap.Set("Schema", schema)
 
 	classMap := ast.NewClassMap()
+	classMap.Set("SObjectType", schemaSObjectType)
+	classMap.Set("SObjectField", schemaSObjectFieldType)
 
-	schemaSObjectType = ast.CreateClass(
+	describeSObjectResultType = ast.CreateClass(
+		"DescribeSObjectResult",
+		nil,
+		&ast.MethodMap{
+			Data: map[string][]*ast.Method{},
+		},
+		ast.NewMethodMap(),
+	)
+	classMap.Set("DescribeSObjectResult", describeSObjectResultType)
+
+	sObjectTypeFields := ast.CreateClass(
+		"SObjectTypeFields",
+		nil,
+		ast.NewMethodMap(),
+		ast.NewMethodMap(),
+	)
+	classMap.Set("SObjectTypeFields", sObjectTypeFields)
+
+	nameSpaceStore.Set("Schema", classMap)
+}
+
+func createSchemaSObjectType() *ast.ClassType {
+	return ast.CreateClass(
 		"SObjectType",
 		nil,
 		nil,
@@ -80,25 +143,4 @@ func init() {
 			},
 		},
 	)
-	classMap.Set("SObjectType", schemaSObjectType)
-
-	describeSObjectResultType = ast.CreateClass(
-		"DescribeSObjectResult",
-		nil,
-		&ast.MethodMap{
-			Data: map[string][]*ast.Method{},
-		},
-		ast.NewMethodMap(),
-	)
-	classMap.Set("DescribeSObjectResult", describeSObjectResultType)
-
-	sObjectTypeFields := ast.CreateClass(
-		"SObjectTypeFields",
-		nil,
-		ast.NewMethodMap(),
-		ast.NewMethodMap(),
-	)
-	classMap.Set("SObjectTypeFields", sObjectTypeFields)
-
-	nameSpaceStore.Set("Schema", classMap)
 }
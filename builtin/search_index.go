@@ -0,0 +1,272 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tzmfreedom/goland/ast"
+)
+
+// searchIndexTable is the full-text search table for SOSL, which is kept in sync by DML
+const searchIndexTable = "_SearchIndex"
+
+const (
+	SearchGroupAll   = "ALL"
+	SearchGroupName  = "NAME"
+	SearchGroupEmail = "EMAIL"
+	SearchGroupPhone = "PHONE"
+)
+
+var searchGroupColumns = map[string]string{
+	SearchGroupAll:   "all_fields",
+	SearchGroupName:  "name_fields",
+	SearchGroupEmail: "email_fields",
+	SearchGroupPhone: "phone_fields",
+}
+
+var nameFieldNames = []string{
+	"Name",
+	"FirstName",
+	"LastName",
+	"Subject",
+	"Title",
+}
+
+var searchableFieldTypes = []string{
+	"string",
+	"textarea",
+	"email",
+	"phone",
+	"picklist",
+	"multipicklist",
+	"url",
+	"combobox",
+	"encryptedstring",
+}
+
+// ensureSearchIndex creates the search index table when it does not exist.
+// The table created in the transaction is lost by rollback, so it is checked every time.
+func (d *databaseDriver) ensureSearchIndex() error {
+	_, err := d.exec(d.dialect.createSearchIndex(searchIndexTable))
+	return err
+}
+
+// searchIndexColumns returns sql expressions which concatenate the fields of each search group
+func searchIndexColumns(d dialect, sObject Sobject) map[string]string {
+	fields := map[string][]string{}
+	for _, f := range sObject.Fields {
+		if !contains(f.Type, searchableFieldTypes) {
+			continue
+		}
+		column := fmt.Sprintf("COALESCE(%s, '')", d.quote(f.Name))
+		fields[SearchGroupAll] = append(fields[SearchGroupAll], column)
+		switch {
+		case f.Type == "email":
+			fields[SearchGroupEmail] = append(fields[SearchGroupEmail], column)
+		case f.Type == "phone":
+			fields[SearchGroupPhone] = append(fields[SearchGroupPhone], column)
+		case contains(f.Name, nameFieldNames):
+			fields[SearchGroupName] = append(fields[SearchGroupName], column)
+		}
+	}
+	columns := map[string]string{}
+	for group := range searchGroupColumns {
+		if len(fields[group]) == 0 {
+			columns[group] = "''"
+		} else {
+			columns[group] = strings.Join(fields[group], " || ' ' || ")
+		}
+	}
+	return columns
+}
+
+// indexSearchRecords updates search index of the sobject records.
+// All records of the sobject are indexed when id is empty.
+func (d *databaseDriver) indexSearchRecords(sObject Sobject, id string) error {
+	if err := d.ensureSearchIndex(); err != nil {
+		return err
+	}
+	deleteQuery := fmt.Sprintf("DELETE FROM %s WHERE sobject_type = ?", searchIndexTable)
+	deleteArgs := []interface{}{sObject.Name}
+	condition := ""
+	args := []interface{}{sObject.Name}
+	if id != "" {
+		deleteQuery += " AND record_id = ?"
+		deleteArgs = append(deleteArgs, id)
+		condition = " WHERE id = ?"
+		args = append(args, id)
+	}
+	if _, err := d.exec(deleteQuery, deleteArgs...); err != nil {
+		return err
+	}
+	columns := searchIndexColumns(d.dialect, sObject)
+	query := fmt.Sprintf(
+		"INSERT INTO %s(sobject_type, record_id, name_fields, email_fields, phone_fields, all_fields) SELECT CAST(? AS TEXT), id, %s, %s, %s, %s FROM %s%s",
+		searchIndexTable,
+		columns[SearchGroupName],
+		columns[SearchGroupEmail],
+		columns[SearchGroupPhone],
+		columns[SearchGroupAll],
+		d.dialect.quote(sObject.Name),
+		condition,
+	)
+	_, err := d.exec(query, args...)
+	return err
+}
+
+func (d *databaseDriver) removeSearchRecord(id string) error {
+	if err := d.ensureSearchIndex(); err != nil {
+		return err
+	}
+	query := fmt.Sprintf("DELETE FROM %s WHERE record_id = ?", searchIndexTable)
+	_, err := d.exec(query, id)
+	return err
+}
+
+// searchRecordIds returns ids of the sobject records which match the search term in the search group
+func (d *databaseDriver) searchRecordIds(sObjectType, searchGroup, term string) ([]string, error) {
+	if err := d.ensureSearchIndex(); err != nil {
+		return nil, err
+	}
+	column, ok := searchGroupColumns[strings.ToUpper(searchGroup)]
+	if !ok {
+		column = searchGroupColumns[SearchGroupAll]
+	}
+	query := fmt.Sprintf(
+		"SELECT record_id FROM %s WHERE sobject_type = ? AND %s LIMIT 2000",
+		searchIndexTable,
+		d.dialect.match(column),
+	)
+	rows, err := d.query(query, sObjectType, d.dialect.matchArgument(term))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// IndexSearchRecords rebuilds search index of all records of the sobject
+func (d *databaseDriver) IndexSearchRecords(sObject Sobject) error {
+	return d.indexSearchRecords(sObject, "")
+}
+
+// updateSearchIndex updates search index of the record after dml
+func (d *databaseDriver) updateSearchIndex(dmlType, sObjectType string, record *ast.Object) error {
+	sObject, ok := sObjects[sObjectType]
+	if !ok {
+		return nil
+	}
+	id := recordId(record)
+	if id == Null {
+		return nil
+	}
+	if strings.ToLower(dmlType) == "delete" {
+		return d.removeSearchRecord(id.StringValue())
+	}
+	return d.indexSearchRecords(sObject, id.StringValue())
+}
+
+// Search runs the sosl query and returns List<List<SObject>> in the order of returning objects
+func (d *databaseDriver) Search(n *ast.Sosl, interpreter ast.Visitor) *ast.Object {
+	lists := make([]*ast.Object, len(n.ReturningObjects))
+	for i, returning := range n.ReturningObjects {
+		classType, ok := PrimitiveClassMap().Get(returning.Name)
+		if !ok {
+			panic(fmt.Sprintf("sObject type '%s' is not supported", returning.Name))
+		}
+		ids, err := d.searchRecordIds(classType.Name, n.SearchGroup, n.Search)
+		if err != nil {
+			panic(err)
+		}
+		records := []*ast.Object{}
+		if len(ids) > 0 {
+			soql := &ast.Soql{
+				SelectFields: searchSelectFields(returning.Fields),
+				FromObject:   classType.Name,
+				Where:        idCondition(ids),
+			}
+			records = sortByIds(d.Query(soql, interpreter), ids)
+		}
+		list := ast.CreateObject(CreateListType(classType))
+		list.Extra["records"] = records
+		lists[i] = list
+	}
+	result := ast.CreateObject(CreateListType(CreateListType(SObjectType)))
+	result.Extra["records"] = lists
+	return result
+}
+
+// searchSelectFields returns select fields of the returning object, Id is always selected
+func searchSelectFields(fields []string) []ast.Node {
+	selectFields := []ast.Node{}
+	hasId := false
+	for _, f := range fields {
+		if strings.EqualFold(f, "id") {
+			hasId = true
+		}
+		selectFields = append(selectFields, &ast.SelectField{Value: strings.Split(f, ".")})
+	}
+	if !hasId {
+		selectFields = append(selectFields, &ast.SelectField{Value: []string{"Id"}})
+	}
+	return selectFields
+}
+
+// idCondition returns `Id = ...` conditions joined by OR as a balanced tree to keep sql expression shallow
+func idCondition(ids []string) ast.Node {
+	if len(ids) == 1 {
+		return &ast.WhereCondition{
+			Field:      &ast.SelectField{Value: []string{"Id"}},
+			Op:         "=",
+			Expression: &ast.StringLiteral{Value: ids[0]},
+		}
+	}
+	return &ast.WhereBinaryOperator{
+		Left:  idCondition(ids[:len(ids)/2]),
+		Op:    "OR",
+		Right: idCondition(ids[len(ids)/2:]),
+	}
+}
+
+func sortByIds(records []*ast.Object, ids []string) []*ast.Object {
+	recordMap := map[string]*ast.Object{}
+	for _, record := range records {
+		recordMap[recordId(record).StringValue()] = record
+	}
+	sorted := []*ast.Object{}
+	for _, id := range ids {
+		if record, ok := recordMap[id]; ok {
+			sorted = append(sorted, record)
+		}
+	}
+	return sorted
+}
+
+// toMatchExpression converts the sosl search term to fts match expression.
+// The trailing wildcard `*` is supported, `?` is treated as `*`.
+func toMatchExpression(term string) string {
+	term = strings.Replace(term, "?", "*", -1)
+	terms := strings.Fields(term)
+	for i, t := range terms {
+		switch strings.ToUpper(t) {
+		case "AND", "OR", "NOT":
+			terms[i] = strings.ToUpper(t)
+		default:
+			// fts supports only the trailing wildcard
+			wildcard := strings.HasSuffix(t, "*")
+			terms[i] = strings.Replace(t, "*", "", -1)
+			if wildcard {
+				terms[i] += "*"
+			}
+		}
+	}
+	return strings.Join(terms, " ")
+}
@@ -1,6 +1,10 @@
 package builtin
 
-import "github.com/tzmfreedom/goland/ast"
+import (
+	"fmt"
+
+	"github.com/tzmfreedom/goland/ast"
+)
 
 var setType = createSetType()
 
@@ -14,7 +18,7 @@ func createSetType() *ast.ClassType {
 				IntegerType,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					return NewInteger(len(this.Extra["values"].(map[string]struct{})))
+					return NewInteger(len(this.Extra["keys"].([]string)))
 				},
 			),
 		},
@@ -24,13 +28,10 @@ func createSetType() *ast.ClassType {
 		[]*ast.Method{
 			ast.CreateMethod(
 				"add",
-				IntegerType,
+				BooleanType,
 				[]*ast.Parameter{t1Parameter},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					key := params[0].StringValue()
-					values := this.Extra["values"].(map[string]struct{})
-					values[key] = struct{}{}
-					return nil
+					return NewBoolean(AddSetValue(this, params[0]))
 				},
 			),
 		},
@@ -43,7 +44,7 @@ func createSetType() *ast.ClassType {
 				nil,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					this.Extra["values"] = map[string]struct{}{}
+					InitSet(this)
 					return nil
 				},
 			),
@@ -57,12 +58,54 @@ func createSetType() *ast.ClassType {
 				Modifiers:  []*ast.Modifier{ast.PublicModifier()},
 				Parameters: []*ast.Parameter{},
 				NativeFunction: func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					InitSet(this)
 					return nil
 				},
 			},
 		},
+		InstanceFields:  ast.NewFieldMap(),
 		InstanceMethods: instanceMethods,
+		StaticFields:    ast.NewFieldMap(),
+		StaticMethods:   ast.NewMethodMap(),
+	}
+}
+
+// InitSet makes the set empty.
+// The elements are kept in values keyed by SetKey, and keys holds the order of the insertion.
+func InitSet(set *ast.Object) {
+	set.Extra["values"] = map[string]*ast.Object{}
+	set.Extra["keys"] = []string{}
+}
+
+// SetKey returns the key of the element in the set, the values of the different types are not equal
+func SetKey(value *ast.Object) string {
+	if v, ok := value.Extra["value"]; ok {
+		return fmt.Sprintf("%s:%v", value.ClassType.Name, v)
+	}
+	return fmt.Sprintf("%p", value)
+}
+
+// AddSetValue adds the element to the set, and returns false when the set already has the equal element
+func AddSetValue(set, value *ast.Object) bool {
+	values := set.Extra["values"].(map[string]*ast.Object)
+	key := SetKey(value)
+	if _, ok := values[key]; ok {
+		return false
+	}
+	values[key] = value
+	set.Extra["keys"] = append(set.Extra["keys"].([]string), key)
+	return true
+}
+
+// SetValues returns the elements of the set in the order of the insertion
+func SetValues(set *ast.Object) []*ast.Object {
+	values := set.Extra["values"].(map[string]*ast.Object)
+	keys := set.Extra["keys"].([]string)
+	elements := make([]*ast.Object, len(keys))
+	for i, key := range keys {
+		elements[i] = values[key]
 	}
+	return elements
 }
 
 func init() {
@@ -14,6 +14,7 @@ type Sobject struct {
 	Custom        bool
 	CustomSetting bool
 	Label         string
+	KeyPrefix     string
 	Fields        []SobjectField
 }
 
@@ -24,6 +25,10 @@ type SobjectField struct {
 	RelationshipName string
 	Custom           bool
 	ReferenceTo      []string
+	// Required is true when the field is createable, not nillable and not defaulted on create
+	Required       bool     `yaml:",omitempty"`
+	PicklistValues []string `yaml:",omitempty"`
+	Length         int      `yaml:",omitempty"`
 }
 
 var soapClient *soapforce.Client
@@ -46,16 +51,16 @@ var typeMapper = map[string]*ast.ClassType{
 	"picklist":      StringType,
 	"multipicklist": StringType,
 	"combobox":      StringType,
-	"reference":     StringType,
+	"reference":     IdType,
 	"boolean":       BooleanType,
 	"currency":      DoubleType,
 	"textarea":      StringType,
-	"int":           DoubleType,
+	"int":           IntegerType,
 	"double":        DoubleType,
 	"percent":       DoubleType,
-	"id":            StringType,
-	//"date":                       dateType,
-	//"datetime":                   dateType,
+	"id":            IdType,
+	"date":          DateType,
+	"datetime":      DatetimeType,
 	//"time":                       TimeType,
 	"url":                        StringType,
 	"email":                      StringType,
@@ -90,17 +95,22 @@ func LoadSObjectClass(src string) {
 				Modifiers: []*ast.Modifier{ast.PublicModifier()},
 			})
 		}
+		staticFields := ast.NewFieldMap()
+		sObjectTypeField := ast.CreateField("SObjectType", schemaSObjectType)
+		sObjectTypeField.Expression = &sObjectTypeExpression{name: sobj.Name}
+		staticFields.Set(sObjectTypeField.Name, sObjectTypeField)
 		primitiveClassMap.Set(name, &ast.ClassType{
 			Name:            sobj.Name,
 			SuperClass:      SObjectType,
 			Constructors:    []*ast.Method{},
 			InstanceFields:  fields,
-			StaticFields:    ast.NewFieldMap(),
+			StaticFields:    staticFields,
 			InstanceMethods: ast.NewMethodMap(),
 			StaticMethods:   ast.NewMethodMap(),
 			ToString:        SObjectType.ToString,
 		})
 	}
+	setRelationshipFields()
 }
 
 var SObjectType = &ast.ClassType{Name: "SObject"}
@@ -0,0 +1,231 @@
+package builtin
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/tzmfreedom/goland/ast"
+)
+
+const base62Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+const idSuffixChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZ012345"
+
+// 62^9, the number of unique part of id
+const idSequenceMax = 13537086546263552
+
+var standardKeyPrefixes = map[string]string{
+	"Account":             "001",
+	"Note":                "002",
+	"Contact":             "003",
+	"User":                "005",
+	"Opportunity":         "006",
+	"RecordType":          "012",
+	"Document":            "015",
+	"Asset":               "02i",
+	"ContentDocument":     "069",
+	"Product2":            "01t",
+	"Pricebook2":          "01s",
+	"Profile":             "00e",
+	"UserRole":            "00E",
+	"Group":               "00G",
+	"OpportunityLineItem": "00k",
+	"Attachment":          "00P",
+	"Lead":                "00Q",
+	"Task":                "00T",
+	"Event":               "00U",
+	"CampaignMember":      "00v",
+	"Case":                "500",
+	"Campaign":            "701",
+	"AsyncApexJob":        "707",
+	"Contract":            "800",
+	"Order":               "801",
+}
+
+var idSequence = uint64(time.Now().UnixNano()) % idSequenceMax
+
+// KeyPrefix returns 3 character key prefix of the sobject.
+// The prefix in the metafile is used first, then the standard prefix.
+// Custom objects without prefix get the prefix derived from the object name.
+func KeyPrefix(sObjectType string) string {
+	for name, sObject := range sObjects {
+		if strings.EqualFold(name, sObjectType) && sObject.KeyPrefix != "" {
+			return sObject.KeyPrefix
+		}
+	}
+	for name, prefix := range standardKeyPrefixes {
+		if strings.EqualFold(name, sObjectType) {
+			return prefix
+		}
+	}
+	h := fnv.New32a()
+	h.Write([]byte(strings.ToLower(sObjectType)))
+	sum := h.Sum32()
+	return fmt.Sprintf("a%c%c", base62Chars[sum%62], base62Chars[(sum/62)%62])
+}
+
+// SObjectTypeOf returns the sobject name of the id from its key prefix
+func SObjectTypeOf(id string) (string, bool) {
+	if len(id) < 3 {
+		return "", false
+	}
+	prefix := id[:3]
+	for name := range sObjects {
+		if KeyPrefix(name) == prefix {
+			return name, true
+		}
+	}
+	for name, standardPrefix := range standardKeyPrefixes {
+		if standardPrefix == prefix {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// GenerateId returns new 18 character id for the sobject
+func GenerateId(sObjectType string) string {
+	sequence := atomic.AddUint64(&idSequence, 1) % idSequenceMax
+	unique := make([]byte, 9)
+	for i := len(unique) - 1; i >= 0; i-- {
+		unique[i] = base62Chars[sequence%62]
+		sequence /= 62
+	}
+	return To18(KeyPrefix(sObjectType) + "000" + string(unique))
+}
+
+// To18 converts 15 character id to case insensitive 18 character id
+func To18(id string) string {
+	if len(id) != 15 {
+		return id
+	}
+	suffix := make([]byte, 3)
+	for i := range suffix {
+		flags := 0
+		for j := 0; j < 5; j++ {
+			c := id[i*5+j]
+			if c >= 'A' && c <= 'Z' {
+				flags |= 1 << uint(j)
+			}
+		}
+		suffix[i] = idSuffixChars[flags]
+	}
+	return id + string(suffix)
+}
+
+// To15 converts 18 character id to case sensitive 15 character id
+func To15(id string) string {
+	if len(id) != 18 {
+		return id
+	}
+	return id[:15]
+}
+
+func isValidId(id string) bool {
+	if len(id) != 15 && len(id) != 18 {
+		return false
+	}
+	for _, c := range id {
+		if !strings.ContainsRune(base62Chars, c) {
+			return false
+		}
+	}
+	return len(id) == 15 || To18(id[:15]) == id
+}
+
+var IdType = &ast.ClassType{
+	Name:       "Id",
+	SuperClass: StringType,
+	ToString: func(o *ast.Object) string {
+		return o.StringValue()
+	},
+}
+
+func NewId(value string) *ast.Object {
+	t := ast.CreateObject(IdType)
+	t.Extra["value"] = To18(value)
+	return t
+}
+
+func init() {
+	instanceMethods := ast.NewMethodMap()
+	instanceMethods.Set(
+		"getSObjectType",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getSObjectType",
+				schemaSObjectType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					name, ok := SObjectTypeOf(this.StringValue())
+					if !ok {
+						return Null
+					}
+					obj := ast.CreateObject(schemaSObjectType)
+					obj.Extra["type"] = name
+					obj.Extra["value"] = name
+					return obj
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"to15",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"to15",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(To15(this.StringValue()))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"equals",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"equals",
+				BooleanType,
+				[]*ast.Parameter{objectTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					other := params[0]
+					if other == Null {
+						return NewBoolean(false)
+					}
+					value, ok := other.Value().(string)
+					return NewBoolean(ok && To18(value) == this.StringValue())
+				},
+			),
+		},
+	)
+
+	staticMethods := ast.NewMethodMap()
+	staticMethods.Set(
+		"valueOf",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"valueOf",
+				IdType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					value := params[0].StringValue()
+					if !isValidId(value) {
+						return CreateRaise(NewException(StringExceptionType, fmt.Sprintf("Invalid id: %s", value)))
+					}
+					return NewId(value)
+				},
+			),
+		},
+	)
+
+	IdType.Constructors = []*ast.Method{}
+	IdType.InstanceFields = ast.NewFieldMap()
+	IdType.StaticFields = ast.NewFieldMap()
+	IdType.InstanceMethods = instanceMethods
+	IdType.StaticMethods = staticMethods
+	primitiveClassMap.Set("Id", IdType)
+}
@@ -0,0 +1,55 @@
+package builtin
+
+import (
+	"testing"
+)
+
+func TestTo18(t *testing.T) {
+	testCases := []struct {
+		Id       string
+		Expected string
+	}{
+		{"001D000000IqhSL", "001D000000IqhSLIAZ"},
+		{"001000000000000", "001000000000000AAA"},
+		{"001D000000IqhSLIAZ", "001D000000IqhSLIAZ"},
+		{"foo", "foo"},
+	}
+	for _, testCase := range testCases {
+		if actual := To18(testCase.Id); actual != testCase.Expected {
+			t.Errorf("%s: expected %s, actual %s", testCase.Id, testCase.Expected, actual)
+		}
+		if actual := To15(To18(testCase.Id)); len(testCase.Id) == 15 && actual != testCase.Id {
+			t.Errorf("%s: expected %s, actual %s", testCase.Id, testCase.Id, actual)
+		}
+	}
+}
+
+func TestGenerateId(t *testing.T) {
+	defer func(saved map[string]Sobject) { sObjects = saved }(sObjects)
+	sObjects = map[string]Sobject{
+		"Foo__c": {Name: "Foo__c", KeyPrefix: "a01"},
+	}
+	testCases := []struct {
+		SObjectType string
+		Prefix      string
+	}{
+		{"Account", "001"},
+		{"Contact", "003"},
+		{"Foo__c", "a01"},
+	}
+	for _, testCase := range testCases {
+		id := GenerateId(testCase.SObjectType)
+		if len(id) != 18 || !isValidId(id) {
+			t.Errorf("%s: invalid id %s", testCase.SObjectType, id)
+		}
+		if id[:3] != testCase.Prefix {
+			t.Errorf("%s: expected prefix %s, actual %s", testCase.SObjectType, testCase.Prefix, id[:3])
+		}
+		if sObjectType, ok := SObjectTypeOf(id); !ok || sObjectType != testCase.SObjectType {
+			t.Errorf("expected %s, actual %s", testCase.SObjectType, sObjectType)
+		}
+	}
+	if GenerateId("Account") == GenerateId("Account") {
+		t.Errorf("expected unique ids")
+	}
+}
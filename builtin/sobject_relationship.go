@@ -0,0 +1,114 @@
+package builtin
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/tzmfreedom/goland/ast"
+)
+
+// pluralize returns plural form of the sobject name such as Contacts or Opportunities
+func pluralize(name string) string {
+	lower := strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(lower, "y") && len(lower) > 1 && !strings.ContainsRune("aeiou", rune(lower[len(lower)-2])):
+		return name[:len(name)-1] + "ies"
+	case strings.HasSuffix(lower, "s"),
+		strings.HasSuffix(lower, "x"),
+		strings.HasSuffix(lower, "ch"),
+		strings.HasSuffix(lower, "sh"):
+		return name + "es"
+	}
+	return name + "s"
+}
+
+// childRelationshipName returns the child relationship name derived from the reference field of the child sobject.
+// Standard fields use the plural child name(Contacts), custom fields use the plural name with __r(Children__r).
+func childRelationshipName(childType string, field SobjectField) string {
+	if field.Custom || strings.HasSuffix(strings.ToLower(childType), "__c") {
+		return pluralize(strings.TrimSuffix(childType, "__c")) + "__r"
+	}
+	return pluralize(childType)
+}
+
+// findParentRelationship returns the reference field of the relationship name such as Account or Parent__r
+func findParentRelationship(sObjectType, relationshipName string) (SobjectField, bool) {
+	for name, sObject := range sObjects {
+		if !strings.EqualFold(name, sObjectType) {
+			continue
+		}
+		for _, f := range sObject.Fields {
+			if strings.EqualFold(f.RelationshipName, relationshipName) && len(f.ReferenceTo) > 0 {
+				return f, true
+			}
+		}
+	}
+	return SobjectField{}, false
+}
+
+// findChildRelationship returns the child sobject name and its reference field of the child relationship name
+func findChildRelationship(parentType, relationshipName string) (string, SobjectField, bool) {
+	for _, childType := range sortedSObjectNames() {
+		for _, f := range sObjects[childType].Fields {
+			if !referencesTo(f, parentType) {
+				continue
+			}
+			if strings.EqualFold(childRelationshipName(childType, f), relationshipName) {
+				return childType, f, true
+			}
+		}
+	}
+	return "", SobjectField{}, false
+}
+
+func referencesTo(f SobjectField, sObjectType string) bool {
+	for _, referenceTo := range f.ReferenceTo {
+		if strings.EqualFold(referenceTo, sObjectType) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedSObjectNames() []string {
+	names := make([]string, 0, len(sObjects))
+	for name := range sObjects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// setRelationshipFields adds parent relationship fields(Contact.Account) and
+// child relationship fields(Account.Contacts) to sobject classes
+func setRelationshipFields() {
+	for _, name := range sortedSObjectNames() {
+		classType, ok := primitiveClassMap.Get(name)
+		if !ok {
+			continue
+		}
+		for _, f := range sObjects[name].Fields {
+			if f.RelationshipName == "" || len(f.ReferenceTo) == 0 {
+				continue
+			}
+			parentType, ok := primitiveClassMap.Get(f.ReferenceTo[0])
+			if !ok {
+				continue
+			}
+			classType.InstanceFields.Set(f.RelationshipName, &ast.Field{
+				Type:      parentType,
+				Name:      f.RelationshipName,
+				Modifiers: []*ast.Modifier{ast.PublicModifier()},
+			})
+			childName := childRelationshipName(name, f)
+			if _, exists := parentType.InstanceFields.Get(childName); exists {
+				continue
+			}
+			parentType.InstanceFields.Set(childName, &ast.Field{
+				Type:      CreateListType(classType),
+				Name:      childName,
+				Modifiers: []*ast.Modifier{ast.PublicModifier()},
+			})
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	periodDay           = "DAY"
+	periodWeek          = "WEEK"
+	periodMonth         = "MONTH"
+	periodQuarter       = "QUARTER"
+	periodYear          = "YEAR"
+	periodFiscalQuarter = "FISCAL_QUARTER"
+	periodFiscalYear    = "FISCAL_YEAR"
+)
+
+var periods = []string{
+	periodDay,
+	periodWeek,
+	periodMonth,
+	periodQuarter,
+	periodYear,
+	periodFiscalQuarter,
+	periodFiscalYear,
+}
+
+// dateLiteralRange returns the range [start, end) of the soql date literal such as TODAY or LAST_N_DAYS:30
+func dateLiteralRange(name string, n int, now time.Time) (time.Time, time.Time, error) {
+	name = strings.ToUpper(name)
+	switch name {
+	case "YESTERDAY":
+		return periodRange(now, periodDay, -1, 1)
+	case "TODAY":
+		return periodRange(now, periodDay, 0, 1)
+	case "TOMORROW":
+		return periodRange(now, periodDay, 1, 1)
+	case "LAST_90_DAYS":
+		return periodRange(now, periodDay, -90, 91)
+	case "NEXT_90_DAYS":
+		return periodRange(now, periodDay, 1, 90)
+	case "LAST_N_DAYS":
+		// includes today
+		return periodRange(now, periodDay, -n, n+1)
+	}
+	for _, period := range periods {
+		switch name {
+		case "LAST_" + period:
+			return periodRange(now, period, -1, 1)
+		case "THIS_" + period:
+			return periodRange(now, period, 0, 1)
+		case "NEXT_" + period:
+			return periodRange(now, period, 1, 1)
+		case "LAST_N_" + period + "S":
+			return periodRange(now, period, -n, n)
+		case "NEXT_N_" + period + "S":
+			return periodRange(now, period, 1, n)
+		case "N_" + period + "S_AGO":
+			return periodRange(now, period, -n, 1)
+		}
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("unknown date literal %s", name)
+}
+
+// periodRange returns the range of count periods which starts offset periods after the current period
+func periodRange(now time.Time, period string, offset, count int) (time.Time, time.Time, error) {
+	start := addPeriod(periodStart(now, period), period, offset)
+	return start, addPeriod(start, period, count), nil
+}
+
+func periodStart(t time.Time, period string) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	firstDay := day.AddDate(0, 0, 1-day.Day())
+	switch period {
+	case periodWeek:
+		// week starts on Sunday
+		return day.AddDate(0, 0, -int(day.Weekday()))
+	case periodMonth:
+		return firstDay
+	case periodQuarter:
+		return firstDay.AddDate(0, -(int(t.Month())-1)%3, 0)
+	case periodYear:
+		return firstDay.AddDate(0, 1-int(t.Month()), 0)
+	case periodFiscalQuarter:
+		return firstDay.AddDate(0, -fiscalMonthOffset(t.Month())%3, 0)
+	case periodFiscalYear:
+		return firstDay.AddDate(0, -fiscalMonthOffset(t.Month()), 0)
+	}
+	return day
+}
+
+func addPeriod(t time.Time, period string, n int) time.Time {
+	switch period {
+	case periodWeek:
+		return t.AddDate(0, 0, 7*n)
+	case periodMonth:
+		return t.AddDate(0, n, 0)
+	case periodQuarter, periodFiscalQuarter:
+		return t.AddDate(0, 3*n, 0)
+	case periodYear, periodFiscalYear:
+		return t.AddDate(n, 0, 0)
+	}
+	return t.AddDate(0, 0, n)
+}
+
+// fiscalMonthOffset returns the number of months since the start of the fiscal year
+func fiscalMonthOffset(month time.Month) int {
+	return (int(month) - int(FiscalYearStartMonth) + 12) % 12
+}
+
+var dateFunctions = []string{
+	"calendar_month",
+	"calendar_quarter",
+	"calendar_year",
+	"day_in_month",
+	"day_in_week",
+	"day_in_year",
+	"day_only",
+	"fiscal_month",
+	"fiscal_quarter",
+	"fiscal_year",
+	"hour_in_day",
+	"week_in_month",
+	"week_in_year",
+	"converttimezone",
+}
+
+func isDateFunction(name string) bool {
+	for _, f := range dateFunctions {
+		if strings.EqualFold(f, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// createDateFunction returns sql expression of soql date function such as CALENDAR_YEAR(CloseDate)
+func createDateFunction(d dialect, name, field string) string {
+	datePart := func(format string) string {
+		return d.datePart(format, field)
+	}
+	month := datePart("%m")
+	fiscalMonth := fmt.Sprintf("((%s - %d + 12) %% 12 + 1)", month, int(FiscalYearStartMonth))
+	switch strings.ToLower(name) {
+	case "calendar_month":
+		return month
+	case "calendar_quarter":
+		return fmt.Sprintf("((%s + 2) / 3)", month)
+	case "calendar_year":
+		return datePart("%Y")
+	case "day_in_month":
+		return datePart("%d")
+	case "day_in_week":
+		// Sunday is 1
+		return fmt.Sprintf("(%s + 1)", datePart("%w"))
+	case "day_in_year":
+		return datePart("%j")
+	case "day_only":
+		return d.dateOnly(field)
+	case "fiscal_month":
+		return fiscalMonth
+	case "fiscal_quarter":
+		return fmt.Sprintf("((%s + 2) / 3)", fiscalMonth)
+	case "fiscal_year":
+		// fiscal year is named by the calendar year in which it ends
+		if FiscalYearStartMonth == time.January {
+			return datePart("%Y")
+		}
+		return fmt.Sprintf("(%s + CASE WHEN %s >= %d THEN 1 ELSE 0 END)", datePart("%Y"), month, int(FiscalYearStartMonth))
+	case "hour_in_day":
+		return datePart("%H")
+	case "week_in_month":
+		return fmt.Sprintf("((%s - 1) / 7 + 1)", datePart("%d"))
+	case "week_in_year":
+		return fmt.Sprintf("((%s - 1) / 7 + 1)", datePart("%j"))
+	}
+	// convertTimezone
+	return field
+}
+
+// dateFunctionType returns the field type of the soql date function result
+func dateFunctionType(name string) string {
+	if strings.EqualFold(name, "day_only") {
+		return "date"
+	}
+	return "int"
+}
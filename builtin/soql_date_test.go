@@ -0,0 +1,66 @@
+package builtin
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateLiteralRange(t *testing.T) {
+	// Wednesday
+	now := time.Date(2019, 5, 15, 10, 30, 0, 0, time.UTC)
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	testCases := []struct {
+		Name  string
+		N     int
+		Start time.Time
+		End   time.Time
+	}{
+		{"TODAY", 0, date(2019, 5, 15), date(2019, 5, 16)},
+		{"YESTERDAY", 0, date(2019, 5, 14), date(2019, 5, 15)},
+		{"LAST_N_DAYS", 3, date(2019, 5, 12), date(2019, 5, 16)},
+		{"THIS_WEEK", 0, date(2019, 5, 12), date(2019, 5, 19)},
+		{"LAST_MONTH", 0, date(2019, 4, 1), date(2019, 5, 1)},
+		{"THIS_QUARTER", 0, date(2019, 4, 1), date(2019, 7, 1)},
+		{"NEXT_N_YEARS", 2, date(2020, 1, 1), date(2022, 1, 1)},
+		{"N_MONTHS_AGO", 2, date(2019, 3, 1), date(2019, 4, 1)},
+	}
+	for _, testCase := range testCases {
+		start, end, err := dateLiteralRange(testCase.Name, testCase.N, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !start.Equal(testCase.Start) || !end.Equal(testCase.End) {
+			t.Errorf("%s: expected [%s, %s), actual [%s, %s)", testCase.Name, testCase.Start, testCase.End, start, end)
+		}
+	}
+}
+
+func TestFiscalDateLiteralRange(t *testing.T) {
+	defer func() {
+		FiscalYearStartMonth = time.January
+	}()
+	FiscalYearStartMonth = time.April
+	now := time.Date(2019, 2, 10, 0, 0, 0, 0, time.UTC)
+	start, end, err := dateLiteralRange("THIS_FISCAL_QUARTER", 0, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !start.Equal(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)) || !end.Equal(time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected Q4 of fiscal year, actual [%s, %s)", start, end)
+	}
+	start, _, err = dateLiteralRange("THIS_FISCAL_YEAR", 0, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !start.Equal(time.Date(2018, 4, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected 2018-04-01, actual %s", start)
+	}
+}
+
+func TestUnknownDateLiteral(t *testing.T) {
+	if _, _, err := dateLiteralRange("LAST_CENTURY", 0, time.Now()); err == nil {
+		t.Errorf("expected error")
+	}
+}
@@ -2,7 +2,10 @@ package builtin
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/tzmfreedom/goland/ast"
 )
@@ -11,58 +14,109 @@ type Relation struct {
 	RelationshipName string
 	FieldName        string
 	ReferenceTo      string
+	Parent           string
 }
 
 type SqlBuilder struct {
 	interpreter ast.Visitor
+	dialect     dialect
+	from        string
+	args        []interface{}
 }
 
-func (b *SqlBuilder) Build(n *ast.Soql) (string, [][]string, map[string]Relation) {
+// Build returns sql with bind parameters, the parameters, select fields and relations
+func (b *SqlBuilder) Build(n *ast.Soql) (string, []interface{}, [][]string, map[string]Relation) {
+	b.from = n.FromObject
+	b.args = []interface{}{}
 	tmpTableMap := map[string]string{}
-	selectClause, selectFields := createSelectClause(n, tmpTableMap)
+	selectClause, selectFields := b.createSelectClause(n, tmpTableMap)
 	whereClause := b.createWhere(n.Where, tmpTableMap)
+	if !n.AllRows && isSoftDeletable(n.FromObject) {
+		if whereClause != "" {
+			whereClause = fmt.Sprintf("(%s) AND %s", whereClause, notDeletedCondition("t0"))
+		} else {
+			whereClause = notDeletedCondition("t0")
+		}
+	}
 	if whereClause != "" {
 		whereClause = " WHERE " + whereClause
 	}
-	groupByClause := b.createGroupBy(n.Group.Fields, tmpTableMap)
-	havingClause := b.createHaving(n.Group.Having, tmpTableMap)
-	if havingClause != "" {
-		whereClause = " HAVING " + havingClause
+	groupByClause := ""
+	havingClause := ""
+	if n.Group != nil {
+		groupByClause = b.createGroupBy(n.Group.Fields, tmpTableMap)
+		havingClause = b.createHaving(n.Group.Having, tmpTableMap)
+		if havingClause != "" {
+			havingClause = " HAVING " + havingClause
+		}
 	}
+	orderByClause := b.createOrderBy(n.Order, tmpTableMap)
+	limitClause := b.createLimit(n.Limit, n.Offset)
 
 	relations := createRelations(n.FromObject, tmpTableMap)
 
 	leftJoinClause := createLeftJoins(relations)
 
 	sql := fmt.Sprintf(
-		"SELECT %s FROM %s t0%s%s%s%s",
+		"SELECT %s FROM %s t0%s%s%s%s%s%s",
 		selectClause,
 		n.FromObject,
 		leftJoinClause,
 		whereClause,
 		groupByClause,
 		havingClause,
+		orderByClause,
+		limitClause,
 	)
-	return sql, selectFields, relations
+	return sql, b.args, selectFields, relations
+}
+
+// fieldPath returns the temporary table and the field name of the field such as ["Name"] or ["Account", "Owner", "Name"].
+// tmpTableMap holds the temporary table for each relationship path such as "account.owner"
+func (b *SqlBuilder) fieldPath(value []string, tmpTableMap map[string]string) []string {
+	tmpTable := "t0"
+	for i := 1; i < len(value); i++ {
+		path := strings.ToLower(strings.Join(value[:i], "."))
+		t, ok := tmpTableMap[path]
+		if !ok {
+			t = fmt.Sprintf("t%d", len(tmpTableMap)+1)
+			tmpTableMap[path] = t
+		}
+		tmpTable = t
+	}
+	return []string{tmpTable, value[len(value)-1]}
+}
+
+// createExpression returns sql expression of the field or the function
+func (b *SqlBuilder) createExpression(n ast.Node, tmpTableMap map[string]string) string {
+	switch f := n.(type) {
+	case *ast.SelectField:
+		return strings.Join(b.fieldPath(f.Value, tmpTableMap), ".")
+	case *ast.SoqlFunction:
+		parameters := make([]string, len(f.Parameters))
+		for i, p := range f.Parameters {
+			parameters[i] = b.createExpression(p, tmpTableMap)
+		}
+		if isDateFunction(f.Name) && len(parameters) == 1 {
+			return createDateFunction(b.dialect, f.Name, parameters[0])
+		}
+		switch strings.ToLower(f.Name) {
+		case "count":
+			if len(parameters) == 0 {
+				return "COUNT(*)"
+			}
+		case "count_distinct":
+			return fmt.Sprintf("COUNT(DISTINCT %s)", strings.Join(parameters, ", "))
+		}
+		return fmt.Sprintf("%s(%s)", strings.ToUpper(f.Name), strings.Join(parameters, ", "))
+	}
+	return ""
 }
 
 func (b *SqlBuilder) createGroupBy(groups []ast.Node, tmpTableMap map[string]string) string {
 	groupFields := make([]string, len(groups))
-	// TODO: case insensitive
 	for i, group := range groups {
-		v := group.(*ast.SelectField).Value
-		if len(v) == 1 {
-			groupFields[i] = fmt.Sprintf("t0.%s", v[0])
-		} else {
-			tmpTable, ok := tmpTableMap[v[0]]
-			if !ok {
-				// TODO: key from string to integer?
-				tmpTableIndex := len(tmpTable) + 1
-				tmpTable = fmt.Sprintf("t%d", tmpTableIndex)
-				tmpTableMap[v[0]] = tmpTable
-			}
-			groupFields[i] = fmt.Sprintf("%s.%s", tmpTable, strings.Join(v[1:], "."))
-		}
+		groupFields[i] = b.createExpression(group, tmpTableMap)
 	}
 	if len(groupFields) == 0 {
 		return ""
@@ -74,105 +128,314 @@ func (b *SqlBuilder) createHaving(n ast.Node, tmpTableMap map[string]string) str
 	return b.createWhere(n, tmpTableMap)
 }
 
+// createOrderBy returns ORDER BY clause.
+// Each field has its own ASC/DESC and NULLS FIRST/LAST, ASC is the default direction.
+func (b *SqlBuilder) createOrderBy(n ast.Node, tmpTableMap map[string]string) string {
+	order, ok := n.(*ast.Order)
+	if !ok || len(order.Fields) == 0 {
+		return ""
+	}
+	orderFields := make([]string, len(order.Fields))
+	for i, f := range order.Fields {
+		orderFields[i] = b.createExpression(f.Field, tmpTableMap)
+		if f.Asc {
+			orderFields[i] += " ASC"
+		} else {
+			orderFields[i] += " DESC"
+		}
+		if f.Nulls != "" {
+			orderFields[i] += " NULLS " + f.Nulls
+		}
+	}
+	return fmt.Sprintf(" ORDER BY %s", strings.Join(orderFields, ", "))
+}
+
+func (b *SqlBuilder) createLimit(limit, offset ast.Node) string {
+	clause := ""
+	if limit != nil {
+		clause = fmt.Sprintf(" LIMIT %d", b.integerValue(limit))
+	}
+	if offset != nil {
+		// OFFSET requires LIMIT in sqlite
+		if clause == "" {
+			clause = b.dialect.noLimit()
+		}
+		clause += fmt.Sprintf(" OFFSET %d", b.integerValue(offset))
+	}
+	return clause
+}
+
+func (b *SqlBuilder) integerValue(n ast.Node) int {
+	return b.evaluate(n).IntegerValue()
+}
+
+func (b *SqlBuilder) evaluate(n ast.Node) *ast.Object {
+	value, err := n.Accept(b.interpreter)
+	if err != nil {
+		panic(err)
+	}
+	return value.(*ast.Object)
+}
+
 func (b *SqlBuilder) createWhere(n ast.Node, tmpTableMap map[string]string) string {
 	switch val := n.(type) {
 	case *ast.WhereCondition:
-		var field string
-		switch f := val.Field.(type) {
-		case *ast.SelectField:
-			if len(f.Value) == 1 {
-				field = fmt.Sprintf("t0.%s", f.Value[0])
-			} else {
-				tmpTable, ok := tmpTableMap[f.Value[0]]
-				if !ok {
-					// TODO: key from string to integer?
-					tmpTableIndex := len(tmpTable) + 1
-					tmpTable = fmt.Sprintf("t%d", tmpTableIndex)
-					tmpTableMap[f.Value[0]] = tmpTable
-				}
-				field = fmt.Sprintf("%s.%s", tmpTable, strings.Join(f.Value[1:], "."))
-			}
-		case *ast.SoqlFunction:
-			field = f.Name + "()"
-		}
-		value, err := val.Expression.Accept(b.interpreter)
-		if err != nil {
-			panic(err)
+		condition := b.createCondition(val, tmpTableMap)
+		if val.Not {
+			return fmt.Sprintf("NOT (%s)", condition)
 		}
-		return fmt.Sprintf("%s %s '%s'", field, val.Op, String(value.(*ast.Object)))
+		return condition
 	case *ast.WhereBinaryOperator:
-		where := ""
-		if val.Left != nil {
-			where += b.createWhere(val.Left, tmpTableMap)
+		if val.Left == nil {
+			return b.createWhere(val.Right, tmpTableMap)
 		}
-		if val.Right != nil {
-			where += fmt.Sprintf("%s %s", val.Op, b.createWhere(val.Right, tmpTableMap))
+		if val.Right == nil {
+			return b.createWhere(val.Left, tmpTableMap)
 		}
-		return where
+		return fmt.Sprintf(
+			"(%s %s %s)",
+			b.createWhere(val.Left, tmpTableMap),
+			strings.ToUpper(val.Op),
+			b.createWhere(val.Right, tmpTableMap),
+		)
 	}
 	return ""
 }
 
-func createSelectClause(n *ast.Soql, tmpTableMap map[string]string) (string, [][]string) {
-	selectFields := make([][]string, len(n.SelectFields))
-	// TODO: case insensitive
-	tmpTableIndex := 1
-	for i, selectField := range n.SelectFields {
-		v := selectField.(*ast.SelectField).Value
-		if len(v) == 1 {
-			selectFields[i] = []string{"t0", v[0]}
-		} else {
-			relationshipName := v[0]
-			if _, ok := tmpTableMap[relationshipName]; !ok {
-				tmpTableMap[relationshipName] = fmt.Sprintf("t%d", tmpTableIndex)
-				tmpTableIndex++
+func (b *SqlBuilder) createCondition(n *ast.WhereCondition, tmpTableMap map[string]string) string {
+	var fieldType string
+	switch f := n.Field.(type) {
+	case *ast.SelectField:
+		fieldType = sObjectFieldType(b.from, f.Value)
+	case *ast.SoqlFunction:
+		fieldType = soqlFunctionType(b.from, f)
+	}
+	field := b.createExpression(n.Field, tmpTableMap)
+	op := strings.ToUpper(n.Op)
+
+	if d, ok := n.Expression.(*ast.SoqlDateLiteral); ok {
+		return b.createDateCondition(field, fieldType, op, d)
+	}
+
+	// semi-join and anti-join
+	if subquery, ok := n.Expression.(*ast.Soql); ok {
+		builder := &SqlBuilder{interpreter: b.interpreter, dialect: b.dialect}
+		query, args, _, _ := builder.Build(subquery)
+		b.args = append(b.args, args...)
+		if op == "NOT IN" {
+			return fmt.Sprintf("%s NOT IN (%s)", field, query)
+		}
+		return fmt.Sprintf("%s IN (%s)", field, query)
+	}
+
+	var value *ast.Object
+	var values []*ast.Object
+	list, ok := n.Expression.(*ast.SoqlValueList)
+	if ok {
+		values = make([]*ast.Object, len(list.Values))
+		for i, v := range list.Values {
+			values[i] = b.evaluate(v)
+		}
+	} else {
+		value = b.evaluate(n.Expression)
+		values, ok = collectionValues(value)
+		if !ok && (op == "IN" || op == "NOT IN" || op == "INCLUDES" || op == "EXCLUDES") {
+			values = []*ast.Object{value}
+			ok = true
+		}
+	}
+	if ok {
+		switch op {
+		case "INCLUDES":
+			return b.createIncludes(field, fieldType, values)
+		case "EXCLUDES":
+			return fmt.Sprintf("NOT %s", b.createIncludes(field, fieldType, values))
+		case "!=", "<>", "NOT IN":
+			return fmt.Sprintf("NOT %s", b.createIn(field, fieldType, values))
+		}
+		return b.createIn(field, fieldType, values)
+	}
+
+	dbValue := toDbValue(fieldType, value)
+	if dbValue == nil {
+		switch op {
+		case "=":
+			return fmt.Sprintf("%s IS NULL", field)
+		case "!=", "<>":
+			return fmt.Sprintf("%s IS NOT NULL", field)
+		}
+	}
+	b.args = append(b.args, dbValue)
+	if op == "LIKE" {
+		return fmt.Sprintf("%s %s ? ESCAPE '\\'", field, b.dialect.like())
+	}
+	return fmt.Sprintf("%s %s ?", field, op)
+}
+
+// createDateCondition returns the condition comparing the field with the range of the date literal
+func (b *SqlBuilder) createDateCondition(field, fieldType, op string, n *ast.SoqlDateLiteral) string {
+	start, end, err := dateLiteralRange(n.Name, n.N, Now())
+	if err != nil {
+		panic(err)
+	}
+	format := func(t time.Time) string {
+		if fieldType == "date" {
+			return t.Format(dbDateFormat)
+		}
+		return t.UTC().Format(dbDatetimeFormat)
+	}
+	switch op {
+	case "=":
+		b.args = append(b.args, format(start), format(end))
+		return fmt.Sprintf("(%s >= ? AND %s < ?)", field, field)
+	case "!=", "<>":
+		b.args = append(b.args, format(start), format(end))
+		return fmt.Sprintf("(%s < ? OR %s >= ?)", field, field)
+	case "<":
+		b.args = append(b.args, format(start))
+		return fmt.Sprintf("%s < ?", field)
+	case "<=":
+		b.args = append(b.args, format(end))
+		return fmt.Sprintf("%s < ?", field)
+	case ">":
+		b.args = append(b.args, format(end))
+		return fmt.Sprintf("%s >= ?", field)
+	case ">=":
+		b.args = append(b.args, format(start))
+		return fmt.Sprintf("%s >= ?", field)
+	}
+	panic(fmt.Sprintf("operator %s is not supported for date literal %s", op, n.Name))
+}
+
+// createIn returns IN condition, empty list matches no records
+func (b *SqlBuilder) createIn(field, fieldType string, values []*ast.Object) string {
+	if len(values) == 0 {
+		return "(1 = 0)"
+	}
+	placeholders := make([]string, len(values))
+	for i, value := range values {
+		placeholders[i] = "?"
+		b.args = append(b.args, toDbValue(fieldType, value))
+	}
+	return fmt.Sprintf("(%s IN (%s))", field, strings.Join(placeholders, ", "))
+}
+
+// createIncludes returns the condition of multi-select picklist which has any of values.
+// The value with semicolon such as 'a;b' matches the field which has both a and b.
+func (b *SqlBuilder) createIncludes(field, fieldType string, values []*ast.Object) string {
+	if len(values) == 0 {
+		return "(1 = 0)"
+	}
+	conditions := make([]string, len(values))
+	for i, value := range values {
+		items := strings.Split(String(value), ";")
+		itemConditions := make([]string, len(items))
+		for j, item := range items {
+			itemConditions[j] = fmt.Sprintf("(';' || COALESCE(%s, '') || ';') %s ?", field, b.dialect.like())
+			b.args = append(b.args, "%;"+strings.TrimSpace(item)+";%")
+		}
+		conditions[i] = "(" + strings.Join(itemConditions, " AND ") + ")"
+	}
+	return "(" + strings.Join(conditions, " OR ") + ")"
+}
+
+// collectionValues returns elements of List/Set bind variable.
+// SObjects in the list are converted to their ids.
+func collectionValues(o *ast.Object) ([]*ast.Object, bool) {
+	switch o.ClassType.Name {
+	case "List":
+		records := o.Extra["records"].([]*ast.Object)
+		values := make([]*ast.Object, len(records))
+		for i, record := range records {
+			if record.ClassType.SuperClass == SObjectType {
+				values[i] = recordId(record)
+			} else {
+				values[i] = record
 			}
-			tmpTable := tmpTableMap[relationshipName]
-			// TODO: recursive relation
-			selectFields[i] = []string{tmpTable, v[1]}
 		}
+		return values, true
+	case "Set":
+		return SetValues(o), true
 	}
+	return nil, false
+}
 
-	tempFields := make([]string, len(selectFields))
-	for i, selectField := range selectFields {
-		tempFields[i] = strings.Join(selectField, ".")
+func (b *SqlBuilder) createSelectClause(n *ast.Soql, tmpTableMap map[string]string) (string, [][]string) {
+	selectFields := [][]string{}
+	columns := []string{}
+	hasId := false
+	hasSubquery := false
+	for _, selectField := range n.SelectFields {
+		switch f := selectField.(type) {
+		case *ast.SelectField:
+			path := b.fieldPath(f.Value, tmpTableMap)
+			if path[0] == "t0" && strings.EqualFold(path[1], "id") {
+				hasId = true
+			}
+			selectFields = append(selectFields, path)
+		case *ast.SoqlFunction:
+			selectFields = append(selectFields, []string{"", f.Name})
+		case *ast.Soql:
+			// child records are queried after the parent records
+			hasSubquery = true
+			continue
+		}
+		columns = append(columns, b.createExpression(selectField, tmpTableMap))
+	}
+	// parent id is required to query child records
+	if hasSubquery && !hasId {
+		selectFields = append(selectFields, []string{"t0", "Id"})
+		columns = append(columns, "t0.Id")
 	}
-	return strings.Join(tempFields, ", "), selectFields
+	return strings.Join(columns, ", "), selectFields
 }
 
 func createRelations(from string, tmpTableMap map[string]string) map[string]Relation {
 	relations := map[string]Relation{}
-	sObject := sObjects[from]
-	for relationshipName, tmpTableName := range tmpTableMap {
-		var targetField SobjectField
-		for _, sObjectField := range sObject.Fields {
-			if sObjectField.RelationshipName == relationshipName {
-				targetField = sObjectField
-				break
+	for path, tmpTable := range tmpTableMap {
+		relationshipNames := strings.Split(path, ".")
+		parent := "t0"
+		sObjectType := from
+		for i, relationshipName := range relationshipNames {
+			f, ok := findParentRelationship(sObjectType, relationshipName)
+			if !ok {
+				panic(fmt.Sprintf("Didn't understand relationship '%s' in field path", relationshipName))
 			}
-		}
-		relations[tmpTableName] = Relation{
-			RelationshipName: relationshipName,
-			FieldName:        targetField.Name,
-			ReferenceTo:      targetField.ReferenceTo[0],
+			if i == len(relationshipNames)-1 {
+				relations[tmpTable] = Relation{
+					RelationshipName: relationshipName,
+					FieldName:        f.Name,
+					ReferenceTo:      f.ReferenceTo[0],
+					Parent:           parent,
+				}
+			}
+			parent = tmpTableMap[strings.Join(relationshipNames[:i+1], ".")]
+			sObjectType = f.ReferenceTo[0]
 		}
 	}
 	return relations
 }
 
 func createLeftJoins(relations map[string]Relation) string {
-	leftJoins := []string{}
-	for tmpTable, relation := range relations {
-		leftJoins = append(
-			leftJoins,
-			fmt.Sprintf(
-				"LEFT JOIN %s %s ON %s.%s = %s.id",
-				relation.ReferenceTo,
-				tmpTable,
-				"t0", // TODO: recursive relation
-				relation.FieldName,
-				tmpTable,
-			),
+	// parent tables must be joined before child tables, and they have smaller index
+	tmpTables := make([]string, 0, len(relations))
+	for tmpTable := range relations {
+		tmpTables = append(tmpTables, tmpTable)
+	}
+	sort.Slice(tmpTables, func(i, j int) bool {
+		return tmpTableIndex(tmpTables[i]) < tmpTableIndex(tmpTables[j])
+	})
+
+	leftJoins := make([]string, len(tmpTables))
+	for i, tmpTable := range tmpTables {
+		relation := relations[tmpTable]
+		leftJoins[i] = fmt.Sprintf(
+			"LEFT JOIN %s %s ON %s.%s = %s.id",
+			relation.ReferenceTo,
+			tmpTable,
+			relation.Parent,
+			relation.FieldName,
+			tmpTable,
 		)
 	}
 
@@ -181,3 +444,11 @@ func createLeftJoins(relations map[string]Relation) string {
 	}
 	return " " + strings.Join(leftJoins, " ")
 }
+
+func tmpTableIndex(tmpTable string) int {
+	index, err := strconv.Atoi(strings.TrimPrefix(tmpTable, "t"))
+	if err != nil {
+		panic(err)
+	}
+	return index
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"strings"
 
 	"regexp"
@@ -184,6 +185,9 @@ func createStringType(c *ast.ClassType) *ast.ClassType {
 			[]*ast.Parameter{IntegerTypeParameter},
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 				startIndex := params[0].IntegerValue()
+				if startIndex < 0 || startIndex > len(this.StringValue()) {
+					return CreateRaise(NewException(StringExceptionType, fmt.Sprintf("Starting position out of bounds: %d", startIndex)))
+				}
 				return NewString(this.StringValue()[startIndex:])
 			},
 		),
@@ -197,6 +201,12 @@ func createStringType(c *ast.ClassType) *ast.ClassType {
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 				startIndex := params[0].IntegerValue()
 				endIndex := params[1].IntegerValue()
+				if startIndex < 0 || startIndex > endIndex {
+					return CreateRaise(NewException(StringExceptionType, fmt.Sprintf("Starting position out of bounds: %d", startIndex)))
+				}
+				if endIndex > len(this.StringValue()) {
+					return CreateRaise(NewException(StringExceptionType, fmt.Sprintf("Ending position out of bounds: %d", endIndex)))
+				}
 				return NewString(this.StringValue()[startIndex:endIndex])
 			},
 		),
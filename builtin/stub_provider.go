@@ -0,0 +1,64 @@
+package builtin
+
+import (
+	"github.com/tzmfreedom/goland/ast"
+)
+
+// VoidType is the return type of void methods passed to StubProvider.handleMethodCall
+var VoidType = &ast.ClassType{Name: "void"}
+
+var stubProviderType = createStubProviderType()
+
+func createStubProviderType() *ast.ClassType {
+	instanceMethods := ast.NewMethodMap()
+	instanceMethods.Set(
+		"handleMethodCall",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"handleMethodCall",
+				ObjectType,
+				[]*ast.Parameter{
+					objectTypeParameter,
+					stringTypeParameter,
+					typeTypeParameter,
+					CreateListTypeParameter(TypeType),
+					CreateListTypeParameter(StringType),
+					CreateListTypeParameter(ObjectType),
+				},
+				nil,
+			),
+		},
+	)
+	classType := ast.CreateClass(
+		"StubProvider",
+		[]*ast.Method{},
+		instanceMethods,
+		nil,
+	)
+	classType.Interface = true
+	return classType
+}
+
+// NewStub creates the stub of the class, whose instance methods are handled by the StubProvider
+func NewStub(classType *ast.ClassType, provider *ast.Object) *ast.Object {
+	stub := ast.CreateObject(classType)
+	if classType.InstanceFields != nil {
+		for _, f := range classType.InstanceFields.Data {
+			stub.InstanceFields.Set(f.Name, Null)
+		}
+	}
+	stub.Extra["stub_provider"] = provider
+	return stub
+}
+
+// StubProvider returns the StubProvider of the stub created by Test.createStub
+func StubProvider(object *ast.Object) (*ast.Object, bool) {
+	provider, ok := object.Extra["stub_provider"].(*ast.Object)
+	return provider, ok
+}
+
+func init() {
+	primitiveClassMap.Set("StubProvider", stubProviderType)
+	// the builtin classes are in System namespace, such as System.StubProvider and System.Type
+	nameSpaceStore.Set("System", primitiveClassMap)
+}
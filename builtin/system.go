@@ -39,34 +39,22 @@ func init() {
 						},
 					},
 				},
-				"assertequals": {
-					&ast.Method{
-						Name:      "assertequals",
-						Modifiers: []*ast.Modifier{ast.PublicModifier()},
-						Parameters: []*ast.Parameter{
-							objectTypeParameter,
-							objectTypeParameter,
-						},
-						NativeFunction: func(this *ast.Object, parameter []*ast.Object, extra map[string]interface{}) interface{} {
-							expected := parameter[0]
-							actual := parameter[1]
-							checker := extra["interpreter"].(EqualChecker)
-							if !checker.Equals(expected, actual) {
-								node := extra["node"].(ast.Node)
-								errors := extra["errors"].([]*TestError)
-								message := fmt.Sprintf("      expected: %s\n      actual:   %s", String(expected), String(actual))
-								extra["errors"] = append(errors, &TestError{
-									Node:    node,
-									Message: message,
-								})
-
-								loc := node.GetLocation()
-								fmt.Fprintf(extra["stdout"].(io.Writer), "expected: %s, actual: %s at %d:%d\n", String(expected), String(actual), loc.Line, loc.Column)
-							}
-							return nil
-						},
-					},
-				},
+				"enqueuejob": {enqueueJobMethod},
+				"assert": createAssertMethods(
+					"assert",
+					[]*ast.Parameter{booleanTypeParameter},
+					assertTrue,
+				),
+				"assertequals": createAssertMethods(
+					"assertEquals",
+					[]*ast.Parameter{objectTypeParameter, objectTypeParameter},
+					assertEquals,
+				),
+				"assertnotequals": createAssertMethods(
+					"assertNotEquals",
+					[]*ast.Parameter{objectTypeParameter, objectTypeParameter},
+					assertNotEquals,
+				),
 			},
 		},
 	)
@@ -74,7 +62,13 @@ func init() {
 	primitiveClassMap.Set("system", system)
 }
 
+// TestError is the failure of the assertion, which is AssertException of apex
 type TestError struct {
 	Node    ast.Node
 	Message string
+	Reason  string
+}
+
+func (e *TestError) Error() string {
+	return "System.AssertException: " + e.Reason
 }
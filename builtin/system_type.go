@@ -0,0 +1,53 @@
+package builtin
+
+import (
+	"github.com/tzmfreedom/goland/ast"
+)
+
+// TypeType is System.Type which is the value of `Account.class`
+var TypeType = &ast.ClassType{Name: "Type"}
+
+func NewType(classType *ast.ClassType) *ast.Object {
+	t := ast.CreateObject(TypeType)
+	t.Extra["value"] = classType
+	return t
+}
+
+func init() {
+	instanceMethods := ast.NewMethodMap()
+	instanceMethods.Set(
+		"getName",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getName",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.Value().(*ast.ClassType).String())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"toString",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"toString",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.Value().(*ast.ClassType).String())
+				},
+			),
+		},
+	)
+	TypeType.Constructors = []*ast.Method{}
+	TypeType.InstanceFields = ast.NewFieldMap()
+	TypeType.StaticFields = ast.NewFieldMap()
+	TypeType.InstanceMethods = instanceMethods
+	TypeType.StaticMethods = ast.NewMethodMap()
+	TypeType.ToString = func(o *ast.Object) string {
+		return o.Value().(*ast.ClassType).String()
+	}
+	primitiveClassMap.Set("Type", TypeType)
+}
@@ -1,6 +1,10 @@
 package builtin
 
-import "github.com/tzmfreedom/goland/ast"
+import (
+	"strings"
+
+	"github.com/tzmfreedom/goland/ast"
+)
 
 var testType = createTestType()
 
@@ -22,6 +26,127 @@ func createTestType() *ast.ClassType {
 		},
 	)
 
+	staticMethods.Set(
+		"startTest",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"startTest",
+				nil,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if _, ok := extra["outer_limits"]; ok {
+						return nil
+					}
+					// the code between startTest and stopTest gets fresh governor limits
+					extra["outer_limits"] = extra["limits"]
+					extra["limits"] = NewLimits()
+					return nil
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"stopTest",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"stopTest",
+				nil,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					// queued future methods and queueable jobs run synchronously
+					executor := extra["interpreter"].(AsyncExecutor)
+					raise, err := executor.FlushAsyncJobs()
+					if err != nil {
+						panic(err)
+					}
+					if limits, ok := extra["outer_limits"]; ok {
+						extra["limits"] = limits
+						delete(extra, "outer_limits")
+					}
+					if raise != nil {
+						return raise
+					}
+					return nil
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"setMock",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setMock",
+				nil,
+				[]*ast.Parameter{typeTypeParameter, objectTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					// the mock replaces the callout of the interface, such as HttpCalloutMock for Http.send
+					mocks, ok := extra["mocks"].(map[string]*ast.Object)
+					if !ok {
+						mocks = map[string]*ast.Object{}
+						extra["mocks"] = mocks
+					}
+					mockType := params[0].Value().(*ast.ClassType)
+					mocks[strings.ToLower(mockType.Name)] = params[1]
+					return nil
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"createStub",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"createStub",
+				ObjectType,
+				[]*ast.Parameter{
+					typeTypeParameter,
+					{
+						Type: stubProviderType,
+						Name: "_",
+					},
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					classType := params[0].Value().(*ast.ClassType)
+					return NewStub(classType, params[1])
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"loadData",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"loadData",
+				CreateListType(SObjectType),
+				[]*ast.Parameter{
+					{
+						Type: schemaSObjectType,
+						Name: "_",
+					},
+					stringTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					sObjectType := params[0].Extra["type"].(string)
+					return loadTestData(extra, sObjectType, params[1].StringValue())
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"isRunningTest",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"isRunningTest",
+				BooleanType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					runningTest, _ := extra["running_test"].(bool)
+					return NewBoolean(runningTest)
+				},
+			),
+		},
+	)
+
 	classType := ast.CreateClass(
 		"Test",
 		[]*ast.Method{},
@@ -0,0 +1,252 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/tzmfreedom/goland/ast"
+)
+
+// testDataSequence numbers the generated values, which are unique in all generators
+var testDataSequence int64
+
+// TestDataGenerator creates valid records from the metafile.
+// The required fields are filled, the picklist fields take the values of the metadata
+// and the required reference fields get the parent records created by the generator.
+type TestDataGenerator struct {
+	// insert saves the records, such as DML of the interpreter or the database driver
+	insert func(sObjectType string, records []*ast.Object) error
+	// creating is the sobjects whose records are being created, which breaks the cycle of references
+	creating map[string]bool
+}
+
+func NewTestDataGenerator(insert func(sObjectType string, records []*ast.Object) error) *TestDataGenerator {
+	return &TestDataGenerator{
+		insert:   insert,
+		creating: map[string]bool{},
+	}
+}
+
+// InsertByDriver returns the insert function which saves the records into the database without triggers
+func InsertByDriver(driver Driver) func(string, []*ast.Object) error {
+	return func(sObjectType string, records []*ast.Object) (err error) {
+		// the driver panics on the error of the query
+		defer func() {
+			if r := recover(); r != nil {
+				e, ok := r.(error)
+				if !ok {
+					panic(r)
+				}
+				err = e
+			}
+		}()
+		driver.Execute("insert", sObjectType, records, "")
+		return nil
+	}
+}
+
+func findSobject(name string) (Sobject, bool) {
+	for sObjectName, sobject := range sObjects {
+		if strings.EqualFold(sObjectName, name) {
+			return sobject, true
+		}
+	}
+	return Sobject{}, false
+}
+
+// Create creates and inserts the records of the sobject
+func (g *TestDataGenerator) Create(sObjectType string, count int) ([]*ast.Object, error) {
+	if count < 0 {
+		return nil, fmt.Errorf("count must not be negative: %d", count)
+	}
+	sobject, ok := findSobject(sObjectType)
+	if !ok {
+		return nil, fmt.Errorf("sobject %s is not found in the metafile", sObjectType)
+	}
+	classType, ok := primitiveClassMap.Get(sobject.Name)
+	if !ok {
+		return nil, fmt.Errorf("sobject %s is not loaded", sobject.Name)
+	}
+	g.creating[strings.ToLower(sobject.Name)] = true
+	defer delete(g.creating, strings.ToLower(sobject.Name))
+
+	// the records share the parent records
+	parents := map[string]*ast.Object{}
+	records := make([]*ast.Object, count)
+	for i := range records {
+		record := ast.CreateObject(classType)
+		for name := range classType.InstanceFields.Data {
+			record.InstanceFields.Set(name, Null)
+		}
+		for _, field := range sobject.Fields {
+			if !isGeneratedField(field) {
+				continue
+			}
+			var value *ast.Object
+			if field.Type == "reference" {
+				parentId, err := g.parentId(field, parents)
+				if err != nil {
+					return nil, err
+				}
+				value = parentId
+			} else {
+				value = testDataValue(sobject, field, int(atomic.AddInt64(&testDataSequence, 1)))
+			}
+			record.InstanceFields.Set(field.Name, value)
+		}
+		records[i] = record
+	}
+	if count == 0 {
+		return records, nil
+	}
+	if err := g.insert(sobject.Name, records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// isGeneratedField returns whether the generator fills the field.
+// Name is filled even if the metafile has no required information.
+func isGeneratedField(field SobjectField) bool {
+	if strings.EqualFold(field.Name, "Id") || field.Type == "id" {
+		return false
+	}
+	return field.Required || strings.EqualFold(field.Name, "Name")
+}
+
+// parentId returns the id of the parent record for the reference field, the parent is created once for the records.
+// The id of the sobject which is not in the metafile or makes the cycle of references is generated without the record.
+func (g *TestDataGenerator) parentId(field SobjectField, parents map[string]*ast.Object) (*ast.Object, error) {
+	if len(field.ReferenceTo) == 0 {
+		return Null, nil
+	}
+	parentType := field.ReferenceTo[0]
+	if id, ok := parents[strings.ToLower(parentType)]; ok {
+		return id, nil
+	}
+	var id *ast.Object
+	if _, ok := findSobject(parentType); !ok || g.creating[strings.ToLower(parentType)] {
+		id = NewId(GenerateId(parentType))
+	} else {
+		records, err := g.Create(parentType, 1)
+		if err != nil {
+			return nil, err
+		}
+		id, _ = records[0].InstanceFields.Get("Id")
+	}
+	parents[strings.ToLower(parentType)] = id
+	return id, nil
+}
+
+// testDataValue returns the value of the field type, the sequence makes the value unique
+func testDataValue(sobject Sobject, field SobjectField, sequence int) *ast.Object {
+	switch field.Type {
+	case "boolean":
+		return NewBoolean(false)
+	case "int":
+		return NewInteger(sequence)
+	case "double", "currency", "percent":
+		return NewDouble(float64(sequence))
+	case "date":
+		obj := ast.CreateObject(DateType)
+		obj.Extra["value"] = Now()
+		return obj
+	case "datetime":
+		obj := ast.CreateObject(DatetimeType)
+		obj.Extra["value"] = Now()
+		return obj
+	case "picklist", "multipicklist", "combobox":
+		if len(field.PicklistValues) > 0 {
+			return NewString(field.PicklistValues[0])
+		}
+	case "email":
+		return NewString(fmt.Sprintf("test%d@example.com", sequence))
+	case "url":
+		return NewString(fmt.Sprintf("https://example.com/%d", sequence))
+	case "phone":
+		return NewString(fmt.Sprintf("555-%04d", sequence%10000))
+	}
+	label := sobject.Label
+	if label == "" {
+		label = sobject.Name
+	}
+	value := fmt.Sprintf("Test %s %d", label, sequence)
+	if field.Length > 0 && len(value) > field.Length {
+		value = value[len(value)-field.Length:]
+	}
+	return NewString(value)
+}
+
+var testDataFactoryType = createTestDataFactoryType()
+
+func createTestDataFactoryType() *ast.ClassType {
+	staticMethods := ast.NewMethodMap()
+	staticMethods.Set(
+		"create",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"create",
+				SObjectType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					records, raise := createTestData(extra, params[0].StringValue(), 1)
+					if raise != nil {
+						return raise
+					}
+					return records[0]
+				},
+			),
+			ast.CreateMethod(
+				"create",
+				CreateListType(SObjectType),
+				[]*ast.Parameter{stringTypeParameter, IntegerTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					records, raise := createTestData(extra, params[0].StringValue(), params[1].IntegerValue())
+					if raise != nil {
+						return raise
+					}
+					list := ast.CreateObject(CreateListType(SObjectType))
+					list.Extra["records"] = records
+					return list
+				},
+			),
+		},
+	)
+	return ast.CreateClass(
+		"TestDataFactory",
+		[]*ast.Method{},
+		ast.NewMethodMap(),
+		staticMethods,
+	)
+}
+
+// createTestData creates the records by DML of the interpreter, so the triggers run for them.
+// The exception thrown by the triggers is returned as the raise object.
+func createTestData(extra map[string]interface{}, sObjectType string, count int) ([]*ast.Object, *ast.Object) {
+	var raise *ast.Object
+	generator := NewTestDataGenerator(func(sObjectType string, records []*ast.Object) error {
+		executor := extra["interpreter"].(DmlExecutor)
+		r, err := executor.ExecuteDml("insert", sObjectType, records, "")
+		if err != nil {
+			return err
+		}
+		if r != nil && r.ClassType == RaiseType {
+			raise = r
+			return fmt.Errorf("failed to insert %s", sObjectType)
+		}
+		return nil
+	})
+	records, err := generator.Create(sObjectType, count)
+	if raise != nil {
+		return nil, raise
+	}
+	if err != nil {
+		panic(err)
+	}
+	return records, nil
+}
+
+func init() {
+	primitiveClassMap.Set("TestDataFactory", testDataFactoryType)
+}
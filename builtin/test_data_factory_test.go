@@ -0,0 +1,172 @@
+package builtin
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/tzmfreedom/goland/ast"
+)
+
+const testDataMetafile = `Account:
+  name: Account
+  label: Account
+  keyprefix: "001"
+  fields:
+  - name: Id
+    type: id
+  - name: IsDeleted
+    type: boolean
+  - name: Name
+    type: string
+    length: 10
+  - name: ParentId
+    type: reference
+    referenceto:
+    - Account
+    required: true
+  - name: Website
+    type: url
+    required: true
+Opportunity:
+  name: Opportunity
+  label: Opportunity
+  keyprefix: "006"
+  fields:
+  - name: Id
+    type: id
+  - name: IsDeleted
+    type: boolean
+  - name: Name
+    type: string
+  - name: AccountId
+    type: reference
+    referenceto:
+    - Account
+    required: true
+  - name: StageName
+    type: picklist
+    required: true
+    picklistvalues:
+    - Prospecting
+    - Closed Won
+  - name: CloseDate
+    type: date
+    required: true
+  - name: Email__c
+    type: email
+    required: true
+  - name: Amount
+    type: currency
+`
+
+func newTestDataDriver(t *testing.T) Driver {
+	f, err := ioutil.TempFile("", "land_metafile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString(testDataMetafile); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	LoadSObjectClass(f.Name())
+
+	driver := NewMemoryDriver()
+	if err := driver.CreateTables(sObjects); err != nil {
+		t.Fatal(err)
+	}
+	return driver
+}
+
+func TestTestDataGenerator(t *testing.T) {
+	driver := newTestDataDriver(t)
+	generator := NewTestDataGenerator(InsertByDriver(driver))
+	// the name of the sobject is case insensitive
+	records, err := generator.Create("opportunity", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected 3 records, actual %d", len(records))
+	}
+
+	field := func(record *ast.Object, name string) *ast.Object {
+		value, _ := record.InstanceFields.Get(name)
+		return value
+	}
+	emails := map[string]bool{}
+	for _, record := range records {
+		if stage := field(record, "StageName").StringValue(); stage != "Prospecting" {
+			t.Errorf("expected the first picklist value, actual %s", stage)
+		}
+		if field(record, "CloseDate") == Null {
+			t.Error("expected the required date, actual null")
+		}
+		if field(record, "Name") == Null {
+			t.Error("expected the name, actual null")
+		}
+		// the field which is not required is not filled
+		if field(record, "Amount") != Null {
+			t.Errorf("expected null amount, actual %s", String(field(record, "Amount")))
+		}
+		email := field(record, "Email__c").StringValue()
+		if !strings.HasSuffix(email, "@example.com") || emails[email] {
+			t.Errorf("expected the unique email, actual %s", email)
+		}
+		emails[email] = true
+		// the records share the parent record
+		if accountId := field(record, "AccountId").StringValue(); accountId != field(records[0], "AccountId").StringValue() {
+			t.Errorf("expected the same account, actual %s", accountId)
+		}
+	}
+
+	opportunities, err := driver.SelectRaw("Opportunity")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(opportunities) != 3 {
+		t.Errorf("expected 3 opportunities, actual %d", len(opportunities))
+	}
+	accounts, err := driver.SelectRaw("Account")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts) != 1 {
+		t.Fatalf("expected 1 account, actual %d", len(accounts))
+	}
+	account := accounts[0]
+	if account["Id"] != field(records[0], "AccountId").StringValue() {
+		t.Errorf("expected the account %s, actual %s", field(records[0], "AccountId").StringValue(), account["Id"])
+	}
+	// the self reference is the generated id without the record
+	if !strings.HasPrefix(account["ParentId"], "001") || account["ParentId"] == account["Id"] {
+		t.Errorf("expected the generated id of account, actual %s", account["ParentId"])
+	}
+	if len(account["Name"]) > 10 {
+		t.Errorf("expected the name within the length, actual %s", account["Name"])
+	}
+	if !strings.HasPrefix(account["Website"], "https://example.com/") {
+		t.Errorf("expected the url, actual %s", account["Website"])
+	}
+}
+
+func TestTestDataGeneratorError(t *testing.T) {
+	driver := newTestDataDriver(t)
+	generator := NewTestDataGenerator(InsertByDriver(driver))
+	testCases := []struct {
+		SObjectType string
+		Count       int
+		Expected    string
+	}{
+		{"Unknown", 1, "sobject Unknown is not found in the metafile"},
+		{"Account", -1, "count must not be negative: -1"},
+	}
+	for _, testCase := range testCases {
+		_, err := generator.Create(testCase.SObjectType, testCase.Count)
+		if err == nil || err.Error() != testCase.Expected {
+			t.Errorf("expected %s, actual %v", testCase.Expected, err)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package builtin
+
+import (
+	"strings"
+
+	"github.com/tzmfreedom/goland/ast"
+)
+
+// TriggerContextName is the name of the trigger context, `Trigger.xxx` is parsed as the field of it
+const TriggerContextName = ast.TriggerContextName
+
+var triggerBooleanFields = []string{
+	"isExecuting",
+	"isBefore",
+	"isAfter",
+	"isInsert",
+	"isUpdate",
+	"isDelete",
+	"isUndelete",
+}
+
+var TriggerType *ast.ClassType
+
+// CreateTriggerType returns trigger context type whose records are typed as sObjectType
+func CreateTriggerType(sObjectType *ast.ClassType) *ast.ClassType {
+	classType := ast.CreateClass(
+		TriggerContextName,
+		[]*ast.Method{},
+		ast.NewMethodMap(),
+		ast.NewMethodMap(),
+	)
+	fields := []*ast.Field{
+		ast.CreateField("new", CreateListType(sObjectType)),
+		ast.CreateField("old", CreateListType(sObjectType)),
+		ast.CreateField("newMap", CreateMapType(StringType, sObjectType)),
+		ast.CreateField("oldMap", CreateMapType(StringType, sObjectType)),
+		ast.CreateField("size", IntegerType),
+		ast.CreateField("operationType", StringType),
+	}
+	for _, name := range triggerBooleanFields {
+		fields = append(fields, ast.CreateField(name, BooleanType))
+	}
+	for _, f := range fields {
+		classType.InstanceFields.Set(f.Name, f)
+
+		// static fields are visible from classes called in the trigger
+		staticField := ast.CreateField(f.Name, f.Type)
+		if f.Type == BooleanType {
+			staticField.Expression = &ast.BooleanLiteral{Value: false}
+		} else {
+			staticField.Expression = &ast.NullLiteral{}
+		}
+		classType.StaticFields.Set(f.Name, staticField)
+	}
+	return classType
+}
+
+// NewTriggerContext creates trigger context object for timing(before/after) and dml(insert/update/...)
+func NewTriggerContext(sObjectType *ast.ClassType, timing, dml string, newRecords, oldRecords []*ast.Object) *ast.Object {
+	timing = strings.ToLower(timing)
+	dml = strings.ToLower(dml)
+
+	obj := ast.CreateObject(CreateTriggerType(sObjectType))
+	obj.InstanceFields.Set("new", newTriggerRecords(sObjectType, newRecords))
+	obj.InstanceFields.Set("old", newTriggerRecords(sObjectType, oldRecords))
+	newMap := newTriggerRecordMap(sObjectType, newRecords)
+	if timing == "before" && dml == "insert" {
+		// records have no Id before insert, so newMap is not available
+		newMap = Null
+	}
+	obj.InstanceFields.Set("newMap", newMap)
+	obj.InstanceFields.Set("oldMap", newTriggerRecordMap(sObjectType, oldRecords))
+	size := len(newRecords)
+	if newRecords == nil {
+		size = len(oldRecords)
+	}
+	obj.InstanceFields.Set("size", NewInteger(size))
+	obj.InstanceFields.Set("operationType", NewString(strings.ToUpper(timing+"_"+dml)))
+	obj.InstanceFields.Set("isExecuting", NewBoolean(true))
+	obj.InstanceFields.Set("isBefore", NewBoolean(timing == "before"))
+	obj.InstanceFields.Set("isAfter", NewBoolean(timing == "after"))
+	obj.InstanceFields.Set("isInsert", NewBoolean(dml == "insert"))
+	obj.InstanceFields.Set("isUpdate", NewBoolean(dml == "update"))
+	obj.InstanceFields.Set("isDelete", NewBoolean(dml == "delete"))
+	obj.InstanceFields.Set("isUndelete", NewBoolean(dml == "undelete"))
+	return obj
+}
+
+func newTriggerRecords(sObjectType *ast.ClassType, records []*ast.Object) *ast.Object {
+	if records == nil {
+		return Null
+	}
+	list := ast.CreateObject(CreateListType(sObjectType))
+	list.Extra["records"] = records
+	return list
+}
+
+func newTriggerRecordMap(sObjectType *ast.ClassType, records []*ast.Object) *ast.Object {
+	if records == nil {
+		return Null
+	}
+	values := map[string]*ast.Object{}
+	for _, record := range records {
+		id, ok := record.InstanceFields.Get("Id")
+		if !ok || id == Null {
+			continue
+		}
+		values[id.StringValue()] = record
+	}
+	obj := ast.CreateObject(CreateMapType(StringType, sObjectType))
+	obj.Extra["values"] = values
+	return obj
+}
+
+// DmlExecutor executes dml operation with triggers
+type DmlExecutor interface {
+	ExecuteDml(dmlType, sObjectType string, records []*ast.Object, upsertKey string) (*ast.Object, error)
+}
+
+func init() {
+	TriggerType = CreateTriggerType(SObjectType)
+	primitiveClassMap.Set(TriggerContextName, TriggerType)
+}
@@ -82,6 +82,10 @@ func Equals(t, other *ast.ClassType) bool {
 	if t == ObjectType {
 		return true
 	}
+	// string literal is assignable to id
+	if t == IdType && other == StringType {
+		return true
+	}
 	if t.IsGenerics() && other.IsGenerics() {
 		if t.Name != other.Name {
 			return false
@@ -130,12 +134,10 @@ func SearchMethod(receiverClass *ast.ClassType, methods []*ast.Method, parameter
 			inputParam := convertGenericsType(receiverClass, parameters[i])
 			methodParam := convertGenericsType(receiverClass, p.Type)
 
-			// TODO: implement
-			// extend, implements, Object
 			if methodParam == ObjectType {
 				continue
 			}
-			if !Equals(inputParam, methodParam) {
+			if !Equals(inputParam, methodParam) && !isSubclassOf(inputParam, methodParam) {
 				match = false
 				break
 			}
@@ -147,6 +149,21 @@ func SearchMethod(receiverClass *ast.ClassType, methods []*ast.Method, parameter
 	return nil
 }
 
+// isSubclassOf returns whether the class extends the other class or implements the other interface
+func isSubclassOf(t, other *ast.ClassType) bool {
+	for c := t; c != nil; c = c.SuperClass {
+		if c.SuperClass == other {
+			return true
+		}
+		for _, impl := range c.ImplementClasses {
+			if impl == other {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func convertGenericsType(receiverClass *ast.ClassType, classType *ast.ClassType) *ast.ClassType {
 	generics := receiverClass.Generics
 	if classType == T1type {
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestCalloutMock(t *testing.T) {
+	report, err := runLandTest(t, "-d", "fixtures/callout", "--static-resources", "fixtures/callout/staticresources")
+	if err == nil {
+		t.Error("expected the error of the failed tests")
+	}
+
+	testCases := []struct {
+		Method string
+		Status string
+		Error  string
+	}{
+		{"respondsByMock", "passed", ""},
+		// the metadata file of the static resource is ignored
+		{"respondsByStaticResource", "passed", ""},
+		{"respondsByMultiStaticResource", "passed", ""},
+		{"failsWithoutMock", "error", "System.CalloutException: Methods defined as TestMethod do not support Web service callouts"},
+		{"failsWithoutStaticResource", "error", "System.CalloutException: No static resource is set for the endpoint http://example.com/paris in [http://example.com/tokyo]"},
+	}
+	methods := testMethodResults(report)
+	for _, testCase := range testCases {
+		method, ok := methods["CalloutTest."+testCase.Method]
+		if !ok {
+			t.Errorf("%s: not run", testCase.Method)
+			continue
+		}
+		if method.Status != testCase.Status {
+			t.Errorf("%s: expected %s, actual %s", testCase.Method, testCase.Status, method.Status)
+		}
+		if method.Error != testCase.Error {
+			t.Errorf("%s: expected error %q, actual %q", testCase.Method, testCase.Error, method.Error)
+		}
+	}
+}
@@ -3,7 +3,9 @@ package main
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"sort"
 	"time"
 
 	"strings"
@@ -12,8 +14,6 @@ import (
 
 	"regexp"
 
-	"bytes"
-
 	"path/filepath"
 
 	"github.com/Songmu/prompter"
@@ -72,6 +72,81 @@ var endpointFlag = cli.StringFlag{
 	Value:  "login.salesforce.com",
 }
 
+var nowFlag = cli.StringFlag{
+	Name:   "now",
+	Usage:  "freeze the current time (RFC3339) for Datetime.now(), Date.today() and date literals",
+	EnvVar: "LAND_NOW",
+}
+
+// setNow freezes the current time when the now flag is given
+func setNow(c *cli.Context) error {
+	now := c.String("now")
+	if now == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, now)
+	if err != nil {
+		return err
+	}
+	builtin.SetNow(t)
+	return nil
+}
+
+var databaseFlag = cli.StringFlag{
+	Name:   "database",
+	Usage:  "database of sobject records, the path of sqlite3 file, memory or postgres://...",
+	EnvVar: "LAND_DATABASE",
+	Value:  builtin.DefaultDatabaseSource,
+}
+
+// setDatabase switches the database driver, the tables of in-memory database are created from the metafile
+func setDatabase(c *cli.Context) error {
+	source := c.String("database")
+	driver, err := builtin.NewDatabaseDriver(source)
+	if err != nil {
+		return err
+	}
+	builtin.DatabaseDriver = driver
+	if source == builtin.MemoryDatabaseSource {
+		return builtin.CreateDatabase(c.String("metafile"))
+	}
+	return nil
+}
+
+var reporterFlag = cli.StringFlag{
+	Name:  "reporter",
+	Usage: "format of the test report, text, junit, json or tap",
+	Value: defaultReporter,
+}
+
+var outputFlag = cli.StringFlag{
+	Name:  "output, o",
+	Usage: "file to write the test report, the report is written to stdout when it is omitted",
+}
+
+var coverageFlag = cli.BoolFlag{
+	Name:  "coverage",
+	Usage: "print the line and branch coverage of each class, and write lcov.info and cobertura.xml",
+}
+
+var coverageDirectoryFlag = cli.StringFlag{
+	Name:  "coverage-dir",
+	Usage: "directory to write the coverage files",
+	Value: "coverage",
+}
+
+var parallelFlag = cli.IntFlag{
+	Name:  "parallel",
+	Usage: "number of test classes run in parallel, each of them runs on the copy of the database. postgresql database runs the classes one by one",
+	Value: 1,
+}
+
+var staticResourcesFlag = cli.StringFlag{
+	Name:  "static-resources",
+	Usage: "directory of the static resources for StaticResourceCalloutMock and MultiStaticResourceCalloutMock",
+	Value: builtin.StaticResourceDirectory,
+}
+
 var metaFileFlag = cli.StringFlag{
 	Name:   "metafile, m",
 	EnvVar: "SALESFORCE_METAFILE",
@@ -86,8 +161,12 @@ var dbSetupCommand = cli.Command{
 		passwordFlag,
 		endpointFlag,
 		metaFileFlag,
+		databaseFlag,
 	},
 	Action: func(c *cli.Context) error {
+		if err := setDatabase(c); err != nil {
+			return err
+		}
 		username := c.String("username")
 		password := c.String("password")
 		endpoint := c.String("endpoint")
@@ -128,8 +207,12 @@ var dbCreateCommand = cli.Command{
 	Usage: "",
 	Flags: []cli.Flag{
 		metaFileFlag,
+		databaseFlag,
 	},
 	Action: func(c *cli.Context) error {
+		if err := setDatabase(c); err != nil {
+			return err
+		}
 		metafile := c.String("metafile")
 		return builtin.CreateDatabase(metafile)
 	},
@@ -140,8 +223,12 @@ var dbSeedCommand = cli.Command{
 	Usage: "",
 	Flags: []cli.Flag{
 		metaFileFlag,
+		databaseFlag,
 	},
 	Action: func(c *cli.Context) error {
+		if err := setDatabase(c); err != nil {
+			return err
+		}
 		username := prompter.Prompt("Salesforce username", "")
 		password := prompter.Password("Salesforce password")
 		endpoint := prompter.Prompt("Login Endpoint", "login.salesforce.com")
@@ -175,6 +262,120 @@ var dbFetchCommand = cli.Command{
 	},
 }
 
+var fixtureDirectoryFlag = cli.StringFlag{
+	Name:  "directory, d",
+	Usage: "directory of the fixture files such as Account.csv, Contact.json or Opportunity.yaml",
+	Value: "fixtures",
+}
+
+var dbLoadCommand = cli.Command{
+	Name:  "db:load",
+	Usage: "",
+	Flags: []cli.Flag{
+		metaFileFlag,
+		databaseFlag,
+		fixtureDirectoryFlag,
+	},
+	Action: func(c *cli.Context) error {
+		builtin.LoadSObjectClass(c.String("metafile"))
+		if err := setDatabase(c); err != nil {
+			return err
+		}
+		counts, err := builtin.LoadFixtures(builtin.DatabaseDriver, c.String("directory"))
+		if err != nil {
+			return err
+		}
+		printRecordCounts("loaded", counts)
+		return nil
+	},
+}
+
+var dbDumpCommand = cli.Command{
+	Name:  "db:dump",
+	Usage: "",
+	Flags: []cli.Flag{
+		metaFileFlag,
+		databaseFlag,
+		fixtureDirectoryFlag,
+		cli.StringFlag{
+			Name:  "format",
+			Usage: "format of the fixture files, csv, json or yaml",
+			Value: "csv",
+		},
+		cli.StringSliceFlag{
+			Name:  "sobject, s",
+			Usage: "name of the sobject to dump, all sobjects are dumped when it is omitted",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		builtin.LoadSObjectClass(c.String("metafile"))
+		if err := setDatabase(c); err != nil {
+			return err
+		}
+		sObjectTypes := []string{}
+		for _, sobject := range c.StringSlice("sobject") {
+			sObjectTypes = append(sObjectTypes, splitList(sobject)...)
+		}
+		counts, err := builtin.DumpFixtures(builtin.DatabaseDriver, c.String("directory"), c.String("format"), sObjectTypes)
+		if err != nil {
+			return err
+		}
+		printRecordCounts("dumped", counts)
+		return nil
+	},
+}
+
+func printRecordCounts(action string, counts map[string]int) {
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Printf("%d %s records are %s\n", counts[name], name, action)
+	}
+}
+
+var genTestDataCommand = cli.Command{
+	Name:  "gen:testdata",
+	Usage: "",
+	Flags: []cli.Flag{
+		metaFileFlag,
+		databaseFlag,
+		cli.StringSliceFlag{
+			Name:  "sobject, s",
+			Usage: "name of the sobject to create records, which can be given more than once or separated by commas",
+		},
+		cli.IntFlag{
+			Name:  "count, n",
+			Usage: "number of records created for each sobject",
+			Value: 10,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		builtin.LoadSObjectClass(c.String("metafile"))
+		if err := setDatabase(c); err != nil {
+			return err
+		}
+		sObjectTypes := []string{}
+		for _, sobject := range c.StringSlice("sobject") {
+			sObjectTypes = append(sObjectTypes, splitList(sobject)...)
+		}
+		if len(sObjectTypes) == 0 {
+			return errors.New("sobject is required")
+		}
+		generator := builtin.NewTestDataGenerator(builtin.InsertByDriver(builtin.DatabaseDriver))
+		for _, sObjectType := range sObjectTypes {
+			records, err := generator.Create(sObjectType, c.Int("count"))
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%d %s records are created\n", len(records), sObjectType)
+		}
+		return nil
+	},
+}
+
 var testCommand = cli.Command{
 	Name:  "test",
 	Usage: "",
@@ -182,9 +383,29 @@ var testCommand = cli.Command{
 		fileFlag,
 		directoryFlag,
 		metaFileFlag,
+		databaseFlag,
+		nowFlag,
+		reporterFlag,
+		outputFlag,
+		coverageFlag,
+		coverageDirectoryFlag,
+		parallelFlag,
+		classSelectionFlag,
+		runSelectionFlag,
+		annotatedSelectionFlag,
+		failedSelectionFlag,
+		testResultsFileFlag,
+		staticResourcesFlag,
 	},
 	Action: func(c *cli.Context) error {
 		builtin.LoadSObjectClass(c.String("metafile"))
+		if err := setDatabase(c); err != nil {
+			return err
+		}
+		if err := setNow(c); err != nil {
+			return err
+		}
+		builtin.StaticResourceDirectory = c.String("static-resources")
 
 		files, err := parseFileOption(c)
 		if err != nil {
@@ -194,21 +415,71 @@ var testCommand = cli.Command{
 		if err != nil {
 			return err
 		}
-		classTypes, err := buildAllFile(trees)
+		classTypes, triggers, err := buildAllFile(trees)
 		if err != nil {
 			return err
 		}
-		var i = 1
-		for _, classType := range classTypes {
-			for _, methods := range classType.StaticMethods.All() {
-				for _, m := range methods {
-					if m.IsTestMethod() {
-						runTest(classTypes, classType, m, i)
-						i++
-					}
-				}
+		reporter, ok := testReporters[c.String("reporter")]
+		if !ok {
+			return fmt.Errorf("unknown reporter: %s", c.String("reporter"))
+		}
+		output := c.String("output")
+		var progress io.Writer = colorable.NewColorableStdout()
+		if c.String("reporter") != defaultReporter && output == "" {
+			// the report is written to stdout instead of the progress
+			progress = ioutil.Discard
+		}
+
+		options := []func(*interpreter.Interpreter){withTriggers(triggers)}
+		var coverage *interpreter.Coverage
+		if c.Bool("coverage") {
+			coverage = interpreter.NewCoverage()
+			options = append(options, func(i *interpreter.Interpreter) {
+				coverage.Subscribe(i.Events)
+			})
+		}
+
+		selection, err := newTestSelection(c)
+		if err != nil {
+			return err
+		}
+		parallel := c.Int("parallel")
+		if builtin.IsPostgresSource(c.String("database")) {
+			// the runners share the tables of postgresql, clearing the records of them deadlocks
+			parallel = 1
+		}
+		classResults, err := runTests(classTypes, selection, parallel, progress, options...)
+		if err != nil {
+			return err
+		}
+		tests := 0
+		results := []*TestClassResult{}
+		for _, result := range classResults {
+			if len(result.Tests) > 0 {
+				tests += len(result.Tests)
+				results = append(results, result)
 			}
 		}
+		if err := writeTestReport(reporter, output, results); err != nil {
+			return err
+		}
+		if err := saveFailedTests(c.String("results-file"), results); err != nil {
+			return err
+		}
+		if coverage != nil {
+			classes := coverageClasses(coverage, classTypes)
+			summary := progress
+			if summary == ioutil.Discard {
+				summary = os.Stderr
+			}
+			printCoverageSummary(summary, classes)
+			if err := writeCoverageFiles(c.String("coverage-dir"), classes); err != nil {
+				return err
+			}
+		}
+		if failed := countFailedTests(results); failed > 0 {
+			return fmt.Errorf("%d of %d tests failed", failed, tests)
+		}
 		return nil
 	},
 }
@@ -223,10 +494,25 @@ var watchCommand = cli.Command{
 			Value: "classes",
 		},
 		metaFileFlag,
+		databaseFlag,
+		nowFlag,
+		classSelectionFlag,
+		runSelectionFlag,
+		annotatedSelectionFlag,
+		failedSelectionFlag,
+		testResultsFileFlag,
+		staticResourcesFlag,
 	},
 	Action: func(c *cli.Context) error {
 		directory := c.String("directory")
 		builtin.LoadSObjectClass(c.String("metafile"))
+		if err := setDatabase(c); err != nil {
+			return err
+		}
+		if err := setNow(c); err != nil {
+			return err
+		}
+		builtin.StaticResourceDirectory = c.String("static-resources")
 
 		files, err := parseFileOption(c)
 		if err != nil {
@@ -236,11 +522,15 @@ var watchCommand = cli.Command{
 		if err != nil {
 			return err
 		}
-		classTypes, err := buildAllFile(trees)
+		classTypes, triggers, err := buildAllFile(trees)
+		if err != nil {
+			return err
+		}
+		selection, err := newTestSelection(c)
 		if err != nil {
 			return err
 		}
-		return watchAndRunTest(classTypes, directory)
+		return watchAndRunTest(classTypes, triggers, directory, selection)
 	},
 }
 
@@ -251,9 +541,13 @@ var serverCommand = cli.Command{
 		fileFlag,
 		directoryFlag,
 		metaFileFlag,
+		databaseFlag,
 	},
 	Action: func(c *cli.Context) error {
 		builtin.LoadSObjectClass(c.String("metafile"))
+		if err := setDatabase(c); err != nil {
+			return err
+		}
 
 		files, err := parseFileOption(c)
 		if err != nil {
@@ -263,7 +557,7 @@ var serverCommand = cli.Command{
 		if err != nil {
 			return err
 		}
-		classTypes, err := buildAllFile(trees)
+		classTypes, _, err := buildAllFile(trees)
 		if err != nil {
 			return err
 		}
@@ -323,12 +617,20 @@ var runCommand = cli.Command{
 		directoryFlag,
 		actionFlag,
 		metaFileFlag,
+		databaseFlag,
+		nowFlag,
 	},
 	Action: func(c *cli.Context) error {
 		if c.String("action") == "" {
 			return errors.New("-a CLASS#METHOD is required")
 		}
 		builtin.LoadSObjectClass(c.String("metafile"))
+		if err := setDatabase(c); err != nil {
+			return err
+		}
+		if err := setNow(c); err != nil {
+			return err
+		}
 
 		files, err := parseFileOption(c)
 		if err != nil {
@@ -338,17 +640,17 @@ var runCommand = cli.Command{
 		if err != nil {
 			return err
 		}
-		classTypes, err := buildAllFile(trees)
+		classTypes, triggers, err := buildAllFile(trees)
 		if err != nil {
 			return err
 		}
 		if c.Bool("interactive") {
-			err = interactiveRun(classTypes, files)
+			err = interactiveRun(classTypes, triggers, files)
 			if err != nil {
 				return err
 			}
 		} else {
-			err = run(c.String("action"), classTypes)
+			err = run(c.String("action"), classTypes, withTriggers(triggers))
 			if err != nil {
 				return err
 			}
@@ -376,7 +678,7 @@ var checkCommand = cli.Command{
 		if err != nil {
 			return err
 		}
-		classTypes, err := buildAllFile(trees)
+		classTypes, _, err := buildAllFile(trees)
 		if err != nil {
 			return err
 		}
@@ -409,11 +711,12 @@ var visualforceCommand = cli.Command{
 		if err != nil {
 			return err
 		}
-		classTypes, err := buildAllFile(trees)
+		classTypes, triggers, err := buildAllFile(trees)
 		if err != nil {
 			return err
 		}
 		i := interpreter.NewInterpreterWithBuiltin(classTypes)
+		withTriggers(triggers)(i)
 		i.LoadStaticField()
 		visualforce.Server(i)
 		return nil
@@ -453,7 +756,7 @@ func parseFileOption(c *cli.Context) ([]string, error) {
 				continue
 			}
 			ext := filepath.Ext(f.Name())
-			if ext != ".cls" && ext != ".apxc" {
+			if ext != ".cls" && ext != ".apxc" && ext != ".trigger" {
 				continue
 			}
 			files = append(files, fmt.Sprintf("%s/%s", dir, f.Name()))
@@ -479,7 +782,10 @@ func register(n ast.Node, reset bool) (*ast.ClassType, error) {
 	if err != nil {
 		return nil, err
 	}
-	classType := t.(*ast.ClassType)
+	classType, ok := t.(*ast.ClassType)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a class", n.GetType())
+	}
 	if _, ok := classMap.Get(classType.Name); ok && !reset {
 		return nil, fmt.Errorf("Class %s is already defined", classType.Name)
 	}
@@ -488,13 +794,28 @@ func register(n ast.Node, reset bool) (*ast.ClassType, error) {
 }
 
 func semanticAnalysis(t *ast.ClassType) error {
+	typeChecker := newTypeChecker()
+	_, err := typeChecker.VisitClassType(t)
+	return compileErrors(typeChecker, err)
+}
+
+func semanticAnalysisTrigger(t *ast.Trigger) error {
+	typeChecker := newTypeChecker()
+	_, err := t.Accept(typeChecker)
+	return compileErrors(typeChecker, err)
+}
+
+func newTypeChecker() *compiler.TypeChecker {
 	typeChecker := compiler.NewTypeChecker()
-	typeChecker.Context.ClassTypes = builtin.PrimitiveClassMap()
+	typeChecker.Context.ClassTypes = builtin.NewClassMapWithPrimivie([]*ast.ClassType{})
 	for _, class := range classMap.Data {
 		typeChecker.Context.ClassTypes.Set(class.Name, class)
 	}
 	typeChecker.Context.NameSpaces = builtin.GetNameSpaceStore()
-	_, err := typeChecker.VisitClassType(t)
+	return typeChecker
+}
+
+func compileErrors(typeChecker *compiler.TypeChecker, err error) error {
 	if len(typeChecker.Errors) != 0 {
 		for _, e := range typeChecker.Errors {
 			//pp.Println(e)
@@ -506,30 +827,72 @@ func semanticAnalysis(t *ast.ClassType) error {
 	return err
 }
 
+// withTriggers sets the triggers which run at the dml operations of the interpreter
+func withTriggers(triggers []*ast.Trigger) func(*interpreter.Interpreter) {
+	return func(i *interpreter.Interpreter) {
+		i.Context.Triggers = triggers
+	}
+}
+
 func run(action string, classTypes []*ast.ClassType, options ...func(*interpreter.Interpreter)) error {
+	builtin.DatabaseDriver.Begin()
+	defer builtin.DatabaseDriver.Rollback()
+	return invokeAction(action, classTypes, options...)
+}
+
+// invokeAction invokes the static method of `Class#method` in the current transaction
+func invokeAction(action string, classTypes []*ast.ClassType, options ...func(*interpreter.Interpreter)) (err error) {
 	method := "action"
 	args := strings.Split(action, "#")
 	if len(args) > 1 {
 		method = args[1]
 	}
-	interpreter := interpreter.NewInterpreterWithBuiltin(classTypes)
+	landInterpreter := interpreter.NewInterpreterWithBuiltin(classTypes)
 	invoke := &ast.MethodInvocation{
 		NameOrExpression: &ast.Name{
 			Value: []string{args[0], method},
 		},
 	}
 	for _, option := range options {
-		option(interpreter)
+		option(landInterpreter)
 	}
-	builtin.DatabaseDriver.Begin()
-	defer builtin.DatabaseDriver.Rollback()
+	defer func() {
+		// a failed assertion stops the method
+		if r := recover(); r != nil {
+			testError, ok := r.(*builtin.TestError)
+			if !ok {
+				panic(r)
+			}
+			err = testError
+		}
+	}()
 
-	interpreter.LoadStaticField()
-	_, err := invoke.Accept(interpreter)
-	return err
+	landInterpreter.LoadStaticField()
+	// the uncaught exception ends the action with the error
+	_, err = invoke.Accept(landInterpreter)
+	if err != nil {
+		return err
+	}
+	if runningTest, _ := landInterpreter.Extra["running_test"].(bool); runningTest {
+		return nil
+	}
+	// async jobs run after the transaction of the action
+	raise, err := landInterpreter.FlushAsyncJobs()
+	if err != nil {
+		return err
+	}
+	return interpreter.RaiseError(raise)
+}
+
+// errorString returns the message of the error, the uncaught exception is followed by the stack trace
+func errorString(err error) string {
+	if e, ok := err.(*interpreter.ExceptionError); ok && e.StackTrace() != "" {
+		return e.Error() + "\n" + e.StackTrace()
+	}
+	return err.Error()
 }
 
-func interactiveRun(classTypes []*ast.ClassType, files []string) error {
+func interactiveRun(classTypes []*ast.ClassType, triggers []*ast.Trigger, files []string) error {
 	lastReloadedAt := time.Now()
 	landInterpreter := interpreter.NewInterpreterWithBuiltin(classTypes)
 	l, _ := readline.NewEx(&readline.Config{
@@ -574,9 +937,6 @@ func interactiveRun(classTypes []*ast.ClassType, files []string) error {
 
 	// TODO: implement
 	env := interpreter.NewEnv(nil)
-	interpreter.Subscribe("method_end", func(ctx *interpreter.Context, n ast.Node) {
-		env = ctx.Env
-	})
 	for {
 		line, err := l.Readline()
 		if err != nil {
@@ -592,7 +952,7 @@ func interactiveRun(classTypes []*ast.ClassType, files []string) error {
 				continue
 			}
 			ch <- true
-			run(args[0], classTypes)
+			run(args[0], classTypes, withTriggers(triggers))
 			<-ch
 		case "reload":
 			ch <- true
@@ -632,13 +992,13 @@ func interactiveRun(classTypes []*ast.ClassType, files []string) error {
 					return err
 				}
 			}
-			run(args[0], classTypes)
+			run(args[0], classTypes, withTriggers(triggers))
 			<-ch
 		case "exit":
 			return nil
 		default:
 			code := createTempClass(line)
-			execFile(code, env)
+			env = execFile(code, env)
 		}
 	}
 	return nil
@@ -650,7 +1010,8 @@ public static void action() { %s; }
 }`, statement)
 }
 
-func watchAndRunTest(classTypes []*ast.ClassType, directory string) error {
+// watchAndRunTest runs the selected tests of the test classes which reference the changed class
+func watchAndRunTest(classTypes []*ast.ClassType, triggers []*ast.Trigger, directory string, selection *testSelection) error {
 	interpreter := interpreter.NewInterpreterWithBuiltin(classTypes)
 
 	watcher, err := fsnotify.NewWatcher()
@@ -673,16 +1034,12 @@ func watchAndRunTest(classTypes []*ast.ClassType, directory string) error {
 				classType, err := buildFile(interpreter, event.Name)
 				if err != nil {
 					fmt.Printf("Error: %s\n", err.Error())
-				} else {
-					i := 0
-					for _, methods := range classType.StaticMethods.Data {
-						for _, m := range methods {
-							if m.IsTestMethod() {
-								runTest(classTypes, classType, m, i)
-							}
-							i++
-						}
-					}
+					continue
+				}
+				classTypes = replaceClass(classTypes, classType)
+				testClasses := referencingTestClasses(classTypes, classType)
+				if _, err := runTests(testClasses, selection, 1, colorable.NewColorableStdout(), withTriggers(triggers)); err != nil {
+					fmt.Printf("Error: %s\n", err.Error())
 				}
 			}
 		case err, ok := <-watcher.Errors:
@@ -696,6 +1053,18 @@ func watchAndRunTest(classTypes []*ast.ClassType, directory string) error {
 	return err
 }
 
+// replaceClass replaces the class of the same name with the rebuilt class, or appends the new class
+func replaceClass(classTypes []*ast.ClassType, classType *ast.ClassType) []*ast.ClassType {
+	for i, t := range classTypes {
+		if strings.EqualFold(t.Name, classType.Name) {
+			replaced := append([]*ast.ClassType{}, classTypes...)
+			replaced[i] = classType
+			return replaced
+		}
+	}
+	return append(classTypes, classType)
+}
+
 func runAction(interpreter *interpreter.Interpreter, expression []string) error {
 	invoke := &ast.MethodInvocation{
 		NameOrExpression: &ast.Name{
@@ -713,7 +1082,7 @@ func buildFile(interpreter *interpreter.Interpreter, file string) (*ast.ClassTyp
 	if err != nil {
 		return nil, fmt.Errorf("Build Error: %s\n", err.Error())
 	}
-	tmpClassMap := builtin.PrimitiveClassMap()
+	tmpClassMap := builtin.NewClassMapWithPrimivie([]*ast.ClassType{})
 	for _, classType := range classMap.Data {
 		tmpClassMap.Set(classType.Name, classType)
 	}
@@ -729,16 +1098,23 @@ func buildFile(interpreter *interpreter.Interpreter, file string) (*ast.ClassTyp
 	return classType, nil
 }
 
-func buildAllFile(trees []ast.Node) ([]*ast.ClassType, error) {
-	classTypes := make([]*ast.ClassType, len(trees))
-	var err error
-	for i, t := range trees {
-		classTypes[i], err = register(t, false)
+// buildAllFile registers, resolves and checks the classes and the triggers of the files
+func buildAllFile(trees []ast.Node) ([]*ast.ClassType, []*ast.Trigger, error) {
+	classTypes := []*ast.ClassType{}
+	triggers := []*ast.Trigger{}
+	for _, t := range trees {
+		if trigger, ok := t.(*ast.Trigger); ok {
+			triggers = append(triggers, trigger)
+			continue
+		}
+		classType, err := register(t, false)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
+		classTypes = append(classTypes, classType)
 	}
-	tmpClassMap := builtin.PrimitiveClassMap()
+	var err error
+	tmpClassMap := builtin.NewClassMapWithPrimivie([]*ast.ClassType{})
 	for _, classType := range classMap.Data {
 		tmpClassMap.Set(classType.Name, classType)
 	}
@@ -746,23 +1122,33 @@ func buildAllFile(trees []ast.Node) ([]*ast.ClassType, error) {
 	for i, classType := range classTypes {
 		classTypes[i], err = convert(classType, tmpClassMap)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
 	for _, classType := range classTypes {
 		err := compiler.CheckClass(classType)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
 	for _, t := range classTypes {
 		if err := semanticAnalysis(t); err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+	}
+
+	for _, t := range triggers {
+		resolver := compiler.NewTypeRefResolver(tmpClassMap, builtin.GetNameSpaceStore())
+		if _, err := t.Accept(resolver); err != nil {
+			return nil, nil, err
+		}
+		if err := semanticAnalysisTrigger(t); err != nil {
+			return nil, nil, err
 		}
 	}
-	return classTypes, nil
+	return classTypes, triggers, nil
 }
 
 func execFile(code string, env *interpreter.Env) *interpreter.Env {
@@ -771,7 +1157,7 @@ func execFile(code string, env *interpreter.Env) *interpreter.Env {
 	if err != nil {
 		panic(err)
 	}
-	classTypes := builtin.PrimitiveClassMap()
+	classTypes := builtin.NewClassMapWithPrimivie([]*ast.ClassType{})
 	for _, classType := range classMap.Data {
 		classTypes.Set(classType.Name, classType)
 	}
@@ -783,15 +1169,19 @@ func execFile(code string, env *interpreter.Env) *interpreter.Env {
 	if err = semanticAnalysis(classType); err != nil {
 		panic(err)
 	}
-	interpreter := interpreter.NewInterpreterWithBuiltin([]*ast.ClassType{classType})
-	interpreter.Context.Env = env
+	landInterpreter := interpreter.NewInterpreterWithBuiltin([]*ast.ClassType{classType})
+	landInterpreter.Context.Env = env
+	// the variables declared by the statement remain in the env of the method
+	landInterpreter.Events.Subscribe("method_end", func(ctx *interpreter.Context, n ast.Node) {
+		env = ctx.Env
+	})
 	invoke := &ast.MethodInvocation{
 		NameOrExpression: &ast.Name{
 			Value: []string{"Temporary", "action"},
 		},
 	}
-	interpreter.LoadStaticField()
-	_, err = invoke.Accept(interpreter)
+	landInterpreter.LoadStaticField()
+	_, err = invoke.Accept(landInterpreter)
 	if err != nil {
 		panic(err)
 	}
@@ -815,7 +1205,7 @@ func reloadAll(interpreter *interpreter.Interpreter, files []string) error {
 		}
 	}
 
-	classMap := builtin.PrimitiveClassMap()
+	classMap := builtin.NewClassMapWithPrimivie([]*ast.ClassType{})
 	for _, classType := range classTypes {
 		classMap.Set(classType.Name, classType)
 	}
@@ -838,35 +1228,3 @@ func reloadAll(interpreter *interpreter.Interpreter, files []string) error {
 	}
 	return nil
 }
-
-func runTest(classTypes []*ast.ClassType, classType *ast.ClassType, m *ast.Method, i int) error {
-	action := fmt.Sprintf("%s#%s", classType.Name, m.Name)
-	fmt.Printf("(%d) %s: ", i, action)
-	var ret *interpreter.Interpreter
-	err := run(action, classTypes, func(i *interpreter.Interpreter) {
-		ret = i
-		i.Extra["stdout"] = new(bytes.Buffer)
-	})
-	if err != nil {
-		return err
-	}
-	stdout := colorable.NewColorableStdout()
-	errors := ret.Extra["errors"].([]*builtin.TestError)
-	if len(errors) > 0 {
-		fmt.Println("")
-		for _, error := range errors {
-			loc := error.Node.GetLocation()
-			str := fmt.Sprintf("  %s at %d:%d\n", loc.FileName, loc.Line, loc.Column)
-			fmt.Fprintf(stdout, builtin.NoticeColor, str)
-			str = fmt.Sprintf(`    Failure/Error: %s
-
-%s
-`, ast.ToString(error.Node), error.Message)
-			fmt.Fprintf(stdout, builtin.ErrorColor, str)
-		}
-	} else {
-		fmt.Fprintf(stdout, builtin.InfoColor, "pass\n")
-	}
-	fmt.Println("")
-	return nil
-}
@@ -12,6 +12,10 @@ import (
 )
 
 func CheckClass(t *ast.ClassType) error {
+	// the enum is created by the interpreter, which has no declaration to check
+	if builtin.IsEnum(t) {
+		return nil
+	}
 	if err := checkConstructorName(t); err != nil {
 		return err
 	}
@@ -203,6 +203,20 @@ func TestClassChecker(t *testing.T) {
 			},
 			errors.New("parameter name is duplicated: a"),
 		},
+		// the user defined exception extends Exception
+		{
+			&ast.ClassType{
+				Modifiers:       []*ast.Modifier{},
+				Annotations:     []*ast.Annotation{},
+				Name:            "FooException",
+				SuperClass:      builtin.ExceptionType,
+				InstanceFields:  ast.NewFieldMap(),
+				StaticFields:    ast.NewFieldMap(),
+				InstanceMethods: ast.NewMethodMap(),
+				StaticMethods:   ast.NewMethodMap(),
+			},
+			nil,
+		},
 	}
 	for i, testCase := range testCases {
 		err := CheckClass(testCase.Input)
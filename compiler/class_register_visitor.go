@@ -2,8 +2,10 @@ package compiler
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/tzmfreedom/goland/ast"
+	"github.com/tzmfreedom/goland/builtin"
 )
 
 type ClassRegisterVisitor struct{}
@@ -57,6 +59,23 @@ func (v *ClassRegisterVisitor) VisitInterfaceDeclaration(n *ast.InterfaceDeclara
 	return t, nil
 }
 
+// VisitEnumDeclaration creates the enum class, which has no apex code but the values
+func (v *ClassRegisterVisitor) VisitEnumDeclaration(n *ast.EnumDeclaration) (interface{}, error) {
+	names := map[string]bool{}
+	for _, value := range n.Values {
+		if names[strings.ToLower(value)] {
+			return nil, fmt.Errorf("Enum value %s is already defined", value)
+		}
+		names[strings.ToLower(value)] = true
+	}
+	t := builtin.CreateEnum(n.Name, n.Values)
+	t.Modifiers = n.Modifiers
+	t.Location = n.Location
+	t.Annotations = n.Annotations
+	t.Parent = n.Parent
+	return t, nil
+}
+
 func (v *ClassRegisterVisitor) VisitIntegerLiteral(n *ast.IntegerLiteral) (interface{}, error) {
 	return ast.VisitIntegerLiteral(v, n)
 }
@@ -361,7 +380,7 @@ func (v *ClassRegisterVisitor) setDeclaration(declarations []ast.Node, t *ast.Cl
 					)
 				}
 			}
-		case *ast.ClassDeclaration:
+		case *ast.ClassDeclaration, *ast.EnumDeclaration:
 			r, err := decl.Accept(v)
 			if err != nil {
 				return err
@@ -24,6 +24,10 @@ func NewTypeChecker() *TypeChecker {
 }
 
 func (v *TypeChecker) VisitClassType(n *ast.ClassType) (interface{}, error) {
+	// the enum has no apex code
+	if builtin.IsEnum(n) {
+		return nil, nil
+	}
 	v.Context.CurrentClass = n
 	if n.StaticFields != nil {
 		for _, f := range n.StaticFields.Data {
@@ -113,6 +117,11 @@ func (v *TypeChecker) VisitInterfaceDeclaration(n *ast.InterfaceDeclaration) (in
 	return ast.VisitInterfaceDeclaration(v, n)
 }
 
+func (v *TypeChecker) VisitEnumDeclaration(n *ast.EnumDeclaration) (interface{}, error) {
+	panic("Not pass")
+	return ast.VisitEnumDeclaration(v, n)
+}
+
 func (v *TypeChecker) VisitIntegerLiteral(n *ast.IntegerLiteral) (interface{}, error) {
 	return builtin.IntegerType, nil
 }
@@ -190,27 +199,46 @@ func (v *TypeChecker) VisitFieldDeclaration(n *ast.FieldDeclaration) (interface{
 	return ast.VisitFieldDeclaration(v, n)
 }
 
+// VisitTry returns the type of the return statement when the try block and all the catch blocks return,
+// or the finally block returns
 func (v *TypeChecker) VisitTry(n *ast.Try) (interface{}, error) {
-	n.Block.Accept(v)
+	r, err := n.Block.Accept(v)
+	if err != nil {
+		return nil, err
+	}
 	for _, c := range n.CatchClause {
-		c.Accept(v)
+		catchReturn, err := c.Accept(v)
+		if err != nil {
+			return nil, err
+		}
+		if catchReturn == nil {
+			r = nil
+		}
 	}
-	n.FinallyBlock.Accept(v)
-	return nil, nil
+	if n.FinallyBlock != nil {
+		finallyReturn, err := n.FinallyBlock.Accept(v)
+		if err != nil {
+			return nil, err
+		}
+		if finallyReturn != nil {
+			r = finallyReturn
+		}
+	}
+	return r, nil
 }
 
 func (v *TypeChecker) VisitCatch(n *ast.Catch) (interface{}, error) {
-	v.Context.Env.Set(n.Identifier, n.Type) // TODO: append scope
-	_, err := n.Block.Accept(v)
-	if err != nil {
-		return nil, err
+	if !builtin.IsException(n.Type) {
+		v.AddError(fmt.Sprintf("Catch type must be of type exception: %s", n.Type.String()), n)
 	}
-	return nil, nil
+	return v.NewEnv(func() (interface{}, error) {
+		v.Context.Env.Set(n.Identifier, n.Type)
+		return n.Block.Accept(v)
+	})
 }
 
 func (v *TypeChecker) VisitFinally(n *ast.Finally) (interface{}, error) {
-	n.Block.Accept(v)
-	return nil, nil
+	return n.Block.Accept(v)
 }
 
 func (v *TypeChecker) VisitFor(n *ast.For) (interface{}, error) {
@@ -264,8 +292,12 @@ func (v *TypeChecker) VisitEnhancedForControl(n *ast.EnhancedForControl) (interf
 	if err != nil {
 		return nil, err
 	}
-	expClassType := exp.(*ast.ClassType)
 	v.Context.Env.Set(n.VariableDeclaratorId, declClassType)
+	if exp == nil {
+		v.AddError("expression <void> must be List or Set expression", n)
+		return nil, nil
+	}
+	expClassType := exp.(*ast.ClassType)
 
 	if expClassType.Name != "List" && expClassType.Name != "Set" {
 		v.AddError(fmt.Sprintf("expression <%s> must be List or Set expression", expClassType.String()), n)
@@ -447,11 +479,11 @@ func (v *TypeChecker) VisitNew(n *ast.New) (interface{}, error) {
 		if n.Init != nil {
 			for _, record := range n.Init.Records {
 				r, err := record.Accept(v)
-				paramElemClass := r.(*ast.ClassType)
 				if err != nil {
 					return nil, err
 				}
-				if builtin.Equals(paramElemClass, elemClass) {
+				paramElemClass := r.(*ast.ClassType)
+				if !builtin.Equals(elemClass, paramElemClass) {
 					v.AddError(fmt.Sprintf("initialization is not match type %s != %s", elemClass.String(), paramElemClass.String()), n)
 				}
 			}
@@ -464,19 +496,19 @@ func (v *TypeChecker) VisitNew(n *ast.New) (interface{}, error) {
 		if n.Init != nil {
 			for key, value := range n.Init.Values {
 				r, err := key.Accept(v)
-				paramKeyClass := r.(*ast.ClassType)
 				if err != nil {
 					return nil, err
 				}
-				if builtin.Equals(paramKeyClass, keyClass) {
+				paramKeyClass := r.(*ast.ClassType)
+				if !builtin.Equals(keyClass, paramKeyClass) {
 					v.AddError(fmt.Sprintf("initialization is not match type %s != %s", keyClass.String(), paramKeyClass.String()), n)
 				}
 				r, err = value.Accept(v)
-				paramValueClass := r.(*ast.ClassType)
 				if err != nil {
 					return nil, err
 				}
-				if builtin.Equals(paramValueClass, valueClass) {
+				paramValueClass := r.(*ast.ClassType)
+				if !builtin.Equals(valueClass, paramValueClass) {
 					v.AddError(fmt.Sprintf("initialization is not match type %s != %s", valueClass.String(), paramValueClass.String()), n)
 				}
 			}
@@ -640,15 +672,8 @@ func (v *TypeChecker) VisitThrow(n *ast.Throw) (interface{}, error) {
 	}
 	// Check Subclass of Exception
 	baseClass := r.(*ast.ClassType)
-	super := baseClass.SuperClass
-	if super == nil {
+	if !builtin.IsException(baseClass) {
 		v.AddError(fmt.Sprintf("Throw expression must be of type exception: %s", baseClass.String()), n)
-	} else {
-		if err != nil {
-			v.AddError(err.Error(), n)
-		} else if super != builtin.ExceptionType {
-			v.AddError(fmt.Sprintf("Throw expression must be of type exception: %s", baseClass.String()), n)
-		}
 	}
 	return nil, nil
 }
@@ -659,14 +684,23 @@ func (v *TypeChecker) VisitSoql(n *ast.Soql) (interface{}, error) {
 	if err != nil {
 		return nil, v.compileError(err.Error(), n)
 	}
-	return &ast.ClassType{
-		Name:     "List",
-		Generics: []*ast.ClassType{t},
-	}, nil
+	if builtin.IsCountQuery(n) {
+		return builtin.IntegerType, nil
+	}
+	if builtin.IsAggregateQuery(n) {
+		return builtin.CreateListType(builtin.AggregateResultType), nil
+	}
+	return builtin.CreateListType(t), nil
 }
 
 func (v *TypeChecker) VisitSosl(n *ast.Sosl) (interface{}, error) {
-	return ast.VisitSosl(v, n)
+	resolver := NewTypeResolver(v.Context)
+	for _, returning := range n.ReturningObjects {
+		if _, err := resolver.ResolveType([]string{returning.Name}); err != nil {
+			return nil, v.compileError(err.Error(), n)
+		}
+	}
+	return builtin.CreateListType(builtin.CreateListType(builtin.SObjectType)), nil
 }
 
 func (v *TypeChecker) VisitStringLiteral(n *ast.StringLiteral) (interface{}, error) {
@@ -678,7 +712,16 @@ func (v *TypeChecker) VisitSwitch(n *ast.Switch) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	if classType, ok := exp.(*ast.ClassType); ok && builtin.IsEnum(classType) {
+		return nil, v.checkEnumWhen(classType, n.WhenStatements)
+	}
 	for _, w := range n.WhenStatements {
+		// the name is the enum value, which is allowed only in the switch on the enum
+		for _, c := range w.Condition {
+			if name, ok := c.(*ast.Name); ok {
+				return nil, v.compileError(fmt.Sprintf("Enum value %s is not allowed in the switch on %s", name.Value[0], exp.(*ast.ClassType).String()), c)
+			}
+		}
 		t, err := w.Accept(v)
 		if err != nil {
 			return nil, err
@@ -690,13 +733,45 @@ func (v *TypeChecker) VisitSwitch(n *ast.Switch) (interface{}, error) {
 	return nil, nil
 }
 
+// checkEnumWhen checks the when statements of the switch on the enum, whose conditions are the names of the values like `when RED, GREEN`
+func (v *TypeChecker) checkEnumWhen(classType *ast.ClassType, whenStatements []*ast.When) error {
+	for _, w := range whenStatements {
+		for _, c := range w.Condition {
+			name, ok := c.(*ast.Name)
+			if !ok {
+				// the literal does not match the enum value even if it is the name, only null is allowed
+				t, err := c.Accept(v)
+				if err != nil {
+					return err
+				}
+				if t != builtin.NullType {
+					v.AddError(fmt.Sprintf("When expression type %s does not match %s", t.(*ast.ClassType).String(), classType.Name), c)
+				}
+				continue
+			}
+			if builtin.EnumValue(classType, name.Value[0]) == nil {
+				v.AddError(fmt.Sprintf("Invalid enum value %s for %s", name.Value[0], classType.Name), c)
+			}
+		}
+		if _, err := w.Statements.Accept(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (v *TypeChecker) VisitTrigger(n *ast.Trigger) (interface{}, error) {
-	panic("Not pass")
-	return nil, nil
+	sObjectType, ok := v.Context.ClassTypes.Get(n.Object)
+	if !ok {
+		return nil, v.compileError(fmt.Sprintf("SObject <%s> does not exist", n.Object), n)
+	}
+	v.Context.Env = newTypeEnv(nil)
+	v.Context.Env.Set(builtin.TriggerContextName, builtin.CreateTriggerType(sObjectType))
+	_, err := n.Statements.Accept(v)
+	return nil, err
 }
 
 func (v *TypeChecker) VisitTriggerTiming(n *ast.TriggerTiming) (interface{}, error) {
-	panic("Not pass")
 	return nil, nil
 }
 
@@ -777,9 +852,13 @@ func (v *TypeChecker) VisitFieldAccess(n *ast.FieldAccess) (interface{}, error)
 	return f.Type, nil
 }
 
+// VisitType checks `Account.class` expression which returns System.Type
 func (v *TypeChecker) VisitType(n *ast.TypeRef) (interface{}, error) {
 	resolver := NewTypeResolver(v.Context)
-	return resolver.ConvertType(n)
+	if _, err := resolver.ConvertType(n); err != nil {
+		return nil, err
+	}
+	return builtin.TypeType, nil
 }
 
 func (v *TypeChecker) VisitBlock(n *ast.Block) (interface{}, error) {
@@ -793,7 +872,8 @@ func (v *TypeChecker) VisitBlock(n *ast.Block) (interface{}, error) {
 			}
 		}
 		if len(n.Statements) > 0 {
-			if _, ok := n.Statements[len(n.Statements)-1].(*ast.Return); ok {
+			switch n.Statements[len(n.Statements)-1].(type) {
+			case *ast.Return, *ast.Try:
 				return r, nil
 			}
 		}
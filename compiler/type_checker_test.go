@@ -47,6 +47,98 @@ func TestTypeChecker(t *testing.T) {
 				},
 			},
 		},
+		// the initializer of List and Map must match the element types
+		{
+			newTestClassTypeWithStatement([]ast.Node{
+				&ast.New{
+					Type: builtin.CreateListType(builtin.IntegerType),
+					Init: &ast.Init{
+						Records: []ast.Node{
+							&ast.IntegerLiteral{},
+							&ast.NullLiteral{},
+							&ast.StringLiteral{},
+						},
+					},
+				},
+				&ast.New{
+					Type: builtin.CreateMapType(builtin.StringType, builtin.IntegerType),
+					Init: &ast.Init{
+						Values: map[ast.Node]ast.Node{
+							&ast.StringLiteral{}:  &ast.IntegerLiteral{},
+							&ast.BooleanLiteral{}: &ast.DoubleLiteral{},
+						},
+					},
+				},
+			}),
+			[]*Error{
+				{
+					Message: "initialization is not match type Integer != Double",
+				},
+				{
+					Message: "initialization is not match type Integer != String",
+				},
+				{
+					Message: "initialization is not match type String != Boolean",
+				},
+			},
+		},
+		// the enum value is allowed only in the switch on the enum
+		{
+			newTestClassTypeWithStatement([]ast.Node{
+				&ast.Switch{
+					Expression: &ast.StringLiteral{},
+					WhenStatements: []*ast.When{
+						{
+							Condition: []ast.Node{
+								&ast.Name{Value: []string{"RED"}},
+							},
+							Statements: &ast.Block{},
+						},
+					},
+				},
+			}),
+			[]*Error{
+				{
+					Message: "Enum value RED is not allowed in the switch on String",
+				},
+			},
+		},
+		// the when of the switch on the enum must be the enum value or null
+		{
+			func() *ast.ClassType {
+				classType := newTestClassTypeWithStatement([]ast.Node{
+					&ast.Switch{
+						Expression: &ast.Name{Value: []string{"c"}},
+						WhenStatements: []*ast.When{
+							{
+								Condition: []ast.Node{
+									&ast.Name{Value: []string{"RED"}},
+									&ast.Name{Value: []string{"PURPLE"}},
+									&ast.StringLiteral{Value: "GREEN"},
+									&ast.NullLiteral{},
+								},
+								Statements: &ast.Block{},
+							},
+						},
+					},
+				})
+				classType.InstanceMethods.Data["foo"][0].Parameters = []*ast.Parameter{
+					{
+						Type: builtin.CreateEnum("Color", []string{"RED", "GREEN"}),
+						Name: "c",
+					},
+				}
+				return classType
+			}(),
+			[]*Error{
+				{
+					Message: "Invalid enum value PURPLE for Color",
+				},
+				{
+					Message: "When expression type String does not match Color",
+				},
+			},
+		},
 		// `if` condition type must be boolean
 		{
 			newTestClassTypeWithStatement([]ast.Node{
@@ -19,6 +19,10 @@ func NewTypeRefResolver(classTypes *ast.ClassMap, nameSpaceStore *builtin.NameSp
 }
 
 func (v *TypeRefResolver) Resolve(n *ast.ClassType) (*ast.ClassType, error) {
+	// the types of the enum are resolved on the creation
+	if builtin.IsEnum(n) {
+		return n, nil
+	}
 	if n.SuperClassRef != nil {
 		superClass, err := n.SuperClassRef.Accept(v)
 		if err != nil {
@@ -128,6 +132,10 @@ func (v *TypeRefResolver) VisitInterfaceDeclaration(n *ast.InterfaceDeclaration)
 	return ast.VisitInterfaceDeclaration(v, n)
 }
 
+func (v *TypeRefResolver) VisitEnumDeclaration(n *ast.EnumDeclaration) (interface{}, error) {
+	return ast.VisitEnumDeclaration(v, n)
+}
+
 func (v *TypeRefResolver) VisitIntegerLiteral(n *ast.IntegerLiteral) (interface{}, error) {
 	return ast.VisitIntegerLiteral(v, n)
 }
@@ -181,11 +189,13 @@ func (v *TypeRefResolver) VisitFieldDeclaration(n *ast.FieldDeclaration) (interf
 
 func (v *TypeRefResolver) VisitTry(n *ast.Try) (interface{}, error) {
 	n.Block.Accept(v)
-	n.FinallyBlock.Accept(v)
+	if n.FinallyBlock != nil {
+		n.FinallyBlock.Accept(v)
+	}
 	for _, c := range n.CatchClause {
 		c.Accept(v)
 	}
-	return ast.VisitTry(v, n)
+	return nil, nil
 }
 
 func (v *TypeRefResolver) VisitCatch(n *ast.Catch) (interface{}, error) {
@@ -290,7 +300,45 @@ func (v *TypeRefResolver) VisitThrow(n *ast.Throw) (interface{}, error) {
 }
 
 func (v *TypeRefResolver) VisitSoql(n *ast.Soql) (interface{}, error) {
-	return ast.VisitSoql(v, n)
+	for _, f := range n.SelectFields {
+		if subquery, ok := f.(*ast.Soql); ok {
+			if _, err := subquery.Accept(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	// bind variables may have type references such as `:new List<Object>{...}`
+	if err := v.resolveWhere(n.Where); err != nil {
+		return nil, err
+	}
+	if n.Group != nil {
+		if err := v.resolveWhere(n.Group.Having); err != nil {
+			return nil, err
+		}
+	}
+	for _, node := range []ast.Node{n.Limit, n.Offset} {
+		if node == nil {
+			continue
+		}
+		if _, err := node.Accept(v); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func (v *TypeRefResolver) resolveWhere(n ast.Node) error {
+	switch where := n.(type) {
+	case *ast.WhereCondition:
+		_, err := where.Expression.Accept(v)
+		return err
+	case *ast.WhereBinaryOperator:
+		if err := v.resolveWhere(where.Left); err != nil {
+			return err
+		}
+		return v.resolveWhere(where.Right)
+	}
+	return nil
 }
 
 func (v *TypeRefResolver) VisitSosl(n *ast.Sosl) (interface{}, error) {
@@ -334,7 +382,10 @@ func (v *TypeRefResolver) VisitVariableDeclaration(n *ast.VariableDeclaration) (
 }
 
 func (v *TypeRefResolver) VisitVariableDeclarator(n *ast.VariableDeclarator) (interface{}, error) {
-	n.Expression.Accept(v)
+	// the variable is declared without the initializer like `String s;`
+	if n.Expression != nil {
+		n.Expression.Accept(v)
+	}
 	return nil, nil
 }
 
@@ -57,7 +57,7 @@ func (r *TypeResolver) ResolveVariable(names []string, checkSetter bool) (*ast.C
 			var err error
 			for i, f := range names[1:] {
 				var allowedModifier int
-				if i == 0 && f == "this" {
+				if i == 0 && name == "this" {
 					allowedModifier = MODIFIER_ALL_OK
 				} else {
 					allowedModifier = MODIFIER_PUBLIC_ONLY
@@ -149,6 +149,27 @@ func (r *TypeResolver) ResolveVariable(names []string, checkSetter bool) (*ast.C
 				}
 			}
 		}
+		// the static field of the inner class like Outer.Inner.FIELD or Inner.FIELD in the outer class
+		if _, inner, rest, ok := FindInnerClass(r.Context.ClassTypes, r.Context.CurrentClass, names); ok && len(rest) > 0 {
+			field, err := FindStaticField(inner, rest[0], MODIFIER_PUBLIC_ONLY, checkSetter && len(rest) == 1)
+			if err != nil {
+				return nil, err
+			}
+			if field != nil {
+				fieldType := field.Type
+				for i, f := range rest[1:] {
+					instanceField, err := FindInstanceField(fieldType, f, MODIFIER_PUBLIC_ONLY, checkSetter && len(rest)-2 == i)
+					if err != nil {
+						return nil, err
+					}
+					if instanceField == nil {
+						return nil, fmt.Errorf("Field %s is not found", f)
+					}
+					fieldType = instanceField.Type
+				}
+				return fieldType, nil
+			}
+		}
 	}
 	return nil, fmt.Errorf("local variable %s is not found", names[0])
 }
@@ -159,6 +180,10 @@ func (r *TypeResolver) ResolveMethod(names []string, parameters []*ast.ClassType
 		if v, ok := r.Context.Env.Get("this"); ok {
 			classType, method, err := FindInstanceMethod(v, methodName, parameters, MODIFIER_ALL_OK)
 			if err != nil {
+				// the static method of the current class is called without the class name
+				if classType, method, staticErr := FindStaticMethod(v, methodName, parameters, MODIFIER_ALL_OK); staticErr == nil {
+					return classType, method, nil
+				}
 				return nil, nil, err
 			}
 			if method == nil {
@@ -199,7 +224,7 @@ func (r *TypeResolver) ResolveMethod(names []string, parameters []*ast.ClassType
 		}
 		if v, ok := r.Context.ClassTypes.Get(first); ok {
 			n, err := FindStaticField(v, names[1], MODIFIER_PUBLIC_ONLY, false)
-			if err == nil {
+			if err == nil && n != nil {
 				fieldType := n.Type
 				for _, f := range names[2 : len(names)-1] {
 					instanceField, err := FindInstanceField(fieldType, f, MODIFIER_PUBLIC_ONLY, false)
@@ -233,10 +258,62 @@ func (r *TypeResolver) ResolveMethod(names []string, parameters []*ast.ClassType
 				}
 			}
 		}
+
+		// inner_class.static_method() or inner_class.static_field.instance_field...instance_method()
+		if _, inner, rest, ok := FindInnerClass(r.Context.ClassTypes, r.Context.CurrentClass, names); ok {
+			if len(rest) == 1 {
+				return FindStaticMethod(inner, methodName, parameters, MODIFIER_PUBLIC_ONLY)
+			}
+			field, err := FindStaticField(inner, rest[0], MODIFIER_PUBLIC_ONLY, false)
+			if err != nil {
+				return nil, nil, err
+			}
+			if field != nil {
+				fieldType := field.Type
+				for _, f := range rest[1 : len(rest)-1] {
+					instanceField, err := FindInstanceField(fieldType, f, MODIFIER_PUBLIC_ONLY, false)
+					if err != nil {
+						return nil, nil, err
+					}
+					if instanceField == nil {
+						return nil, nil, fmt.Errorf("Field %s is not found", f)
+					}
+					fieldType = instanceField.Type
+				}
+				return FindInstanceMethod(fieldType, methodName, parameters, MODIFIER_PUBLIC_ONLY)
+			}
+		}
 	}
 	return nil, nil, errors.Errorf("%s is not found in this scope", strings.Join(names, "."))
 }
 
+// FindInnerClass returns the inner class at the head of the names, the outer class and the rest of the names.
+// The names are like Outer.Inner.FIELD, or Inner.FIELD in the outer class and its inner classes.
+// FindInnerClass finds the inner class of the names like Outer.Inner.X, or Inner.X in the outer class of the current class.
+// It returns the outer class, the inner class and the rest of the names.
+func FindInnerClass(classTypes *ast.ClassMap, currentClass *ast.ClassType, names []string) (*ast.ClassType, *ast.ClassType, []string, bool) {
+	if len(names) > 2 {
+		if outer, ok := classTypes.Get(names[0]); ok && outer.InnerClasses != nil {
+			if inner, ok := outer.InnerClasses.Get(names[1]); ok {
+				return outer, inner, names[2:], true
+			}
+		}
+	}
+	if currentClass == nil {
+		return nil, nil, nil, false
+	}
+	outerName := currentClass.Name
+	if decl, ok := currentClass.Parent.(*ast.ClassDeclaration); ok {
+		outerName = decl.Name
+	}
+	if outer, ok := classTypes.Get(outerName); ok && outer.InnerClasses != nil {
+		if inner, ok := outer.InnerClasses.Get(names[0]); ok {
+			return outer, inner, names[1:], true
+		}
+	}
+	return nil, nil, nil, false
+}
+
 func (r *TypeResolver) ResolveType(names []string) (*ast.ClassType, error) {
 	return r.resolver.ResolveType(names)
 }
@@ -804,6 +804,45 @@ func TestResolveMethod(t *testing.T) {
 			},
 			nil,
 		},
+		// the static method of the current class is called without the class name
+		{
+			[]string{"static"},
+			&Context{
+				Env: &TypeEnv{
+					Data: &TypeMap{
+						Data: map[string]*ast.ClassType{
+							"this": {
+								Name:            "class",
+								InstanceMethods: ast.NewMethodMap(),
+								StaticMethods: &ast.MethodMap{
+									Data: map[string][]*ast.Method{
+										"static": {
+											{
+												Name: "static",
+												Modifiers: []*ast.Modifier{
+													{
+														Name: "private",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			&ast.Method{
+				Name: "static",
+				Modifiers: []*ast.Modifier{
+					{
+						Name: "private",
+					},
+				},
+			},
+			nil,
+		},
 		{
 			[]string{"local", "instance"},
 			&Context{
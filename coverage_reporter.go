@@ -0,0 +1,221 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/tzmfreedom/goland/ast"
+	"github.com/tzmfreedom/goland/interpreter"
+)
+
+// coverageClasses returns the coverage of the classes under test, test classes are excluded
+func coverageClasses(coverage *interpreter.Coverage, classTypes []*ast.ClassType) []*interpreter.ClassCoverage {
+	classes := []*interpreter.ClassCoverage{}
+	for _, classType := range classTypes {
+		if classType.IsAnnotated("isTest") {
+			continue
+		}
+		classes = append(classes, coverage.ClassCoverage(classType))
+	}
+	return classes
+}
+
+func percentage(covered, total int) string {
+	if total == 0 {
+		return "100.00%"
+	}
+	return fmt.Sprintf("%.2f%%", float64(covered)*100/float64(total))
+}
+
+func printCoverageSummary(w io.Writer, classes []*interpreter.ClassCoverage) {
+	width := len("Total")
+	for _, class := range classes {
+		if len(class.Name) > width {
+			width = len(class.Name)
+		}
+	}
+	format := fmt.Sprintf("%%-%ds  %%8s  %%-9s  %%8s  %%s\n", width)
+	fmt.Fprintf(w, format, "Class", "Lines", "", "Branches", "")
+	lines, coveredLines, branches, coveredBranches := 0, 0, 0, 0
+	for _, class := range classes {
+		fmt.Fprintf(
+			w,
+			format,
+			class.Name,
+			percentage(class.CoveredLines(), len(class.Lines)),
+			fmt.Sprintf("(%d/%d)", class.CoveredLines(), len(class.Lines)),
+			percentage(class.CoveredBranches(), len(class.Branches)),
+			fmt.Sprintf("(%d/%d)", class.CoveredBranches(), len(class.Branches)),
+		)
+		lines += len(class.Lines)
+		coveredLines += class.CoveredLines()
+		branches += len(class.Branches)
+		coveredBranches += class.CoveredBranches()
+	}
+	fmt.Fprintf(
+		w,
+		format,
+		"Total",
+		percentage(coveredLines, lines),
+		fmt.Sprintf("(%d/%d)", coveredLines, lines),
+		percentage(coveredBranches, branches),
+		fmt.Sprintf("(%d/%d)", coveredBranches, branches),
+	)
+}
+
+// writeCoverageFiles writes lcov.info and cobertura.xml into the directory
+func writeCoverageFiles(directory string, classes []*interpreter.ClassCoverage) error {
+	if err := os.MkdirAll(directory, 0755); err != nil {
+		return err
+	}
+	writers := map[string]func(io.Writer, []*interpreter.ClassCoverage) error{
+		"lcov.info":     writeLcov,
+		"cobertura.xml": writeCobertura,
+	}
+	for name, write := range writers {
+		f, err := os.Create(filepath.Join(directory, name))
+		if err != nil {
+			return err
+		}
+		err = write(f, classes)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeLcov(w io.Writer, classes []*interpreter.ClassCoverage) error {
+	lines := []string{}
+	for _, class := range classes {
+		lines = append(lines, "TN:", "SF:"+class.FileName)
+		for _, line := range class.Lines {
+			lines = append(lines, fmt.Sprintf("DA:%d,%d", line.Line, line.Hits))
+		}
+		for _, branch := range class.Branches {
+			taken := "-"
+			if branch.Hits > 0 {
+				taken = fmt.Sprintf("%d", branch.Hits)
+			}
+			lines = append(lines, fmt.Sprintf("BRDA:%d,%d,%d,%s", branch.Line, branch.Block, branch.Branch, taken))
+		}
+		lines = append(
+			lines,
+			fmt.Sprintf("BRF:%d", len(class.Branches)),
+			fmt.Sprintf("BRH:%d", class.CoveredBranches()),
+			fmt.Sprintf("LF:%d", len(class.Lines)),
+			fmt.Sprintf("LH:%d", class.CoveredLines()),
+			"end_of_record",
+		)
+	}
+	_, err := io.WriteString(w, strings.Join(lines, "\n")+"\n")
+	return err
+}
+
+type coberturaCoverage struct {
+	XMLName         xml.Name            `xml:"coverage"`
+	LineRate        string              `xml:"line-rate,attr"`
+	BranchRate      string              `xml:"branch-rate,attr"`
+	LinesCovered    int                 `xml:"lines-covered,attr"`
+	LinesValid      int                 `xml:"lines-valid,attr"`
+	BranchesCovered int                 `xml:"branches-covered,attr"`
+	BranchesValid   int                 `xml:"branches-valid,attr"`
+	Version         string              `xml:"version,attr"`
+	Timestamp       int64               `xml:"timestamp,attr"`
+	Sources         []string            `xml:"sources>source"`
+	Packages        []*coberturaPackage `xml:"packages>package"`
+}
+
+type coberturaPackage struct {
+	Name       string            `xml:"name,attr"`
+	LineRate   string            `xml:"line-rate,attr"`
+	BranchRate string            `xml:"branch-rate,attr"`
+	Classes    []*coberturaClass `xml:"classes>class"`
+}
+
+type coberturaClass struct {
+	Name       string           `xml:"name,attr"`
+	FileName   string           `xml:"filename,attr"`
+	LineRate   string           `xml:"line-rate,attr"`
+	BranchRate string           `xml:"branch-rate,attr"`
+	Methods    struct{}         `xml:"methods"`
+	Lines      []*coberturaLine `xml:"lines>line"`
+}
+
+type coberturaLine struct {
+	Number            int    `xml:"number,attr"`
+	Hits              int    `xml:"hits,attr"`
+	Branch            bool   `xml:"branch,attr"`
+	ConditionCoverage string `xml:"condition-coverage,attr,omitempty"`
+}
+
+func rate(covered, total int) string {
+	if total == 0 {
+		return "1"
+	}
+	return fmt.Sprintf("%.4f", float64(covered)/float64(total))
+}
+
+func writeCobertura(w io.Writer, classes []*interpreter.ClassCoverage) error {
+	pkg := &coberturaPackage{Name: "apex"}
+	report := &coberturaCoverage{
+		Version:   Version,
+		Timestamp: time.Now().Unix(),
+		Sources:   []string{"."},
+		Packages:  []*coberturaPackage{pkg},
+	}
+	for _, class := range classes {
+		branches := map[int][]*interpreter.BranchCoverage{}
+		for _, branch := range class.Branches {
+			branches[branch.Line] = append(branches[branch.Line], branch)
+		}
+		c := &coberturaClass{
+			Name:       class.Name,
+			FileName:   class.FileName,
+			LineRate:   rate(class.CoveredLines(), len(class.Lines)),
+			BranchRate: rate(class.CoveredBranches(), len(class.Branches)),
+			Lines:      []*coberturaLine{},
+		}
+		for _, line := range class.Lines {
+			l := &coberturaLine{Number: line.Line, Hits: line.Hits}
+			if lineBranches, ok := branches[line.Line]; ok {
+				covered := 0
+				for _, branch := range lineBranches {
+					if branch.Hits > 0 {
+						covered++
+					}
+				}
+				l.Branch = true
+				l.ConditionCoverage = fmt.Sprintf("%s (%d/%d)", percentage(covered, len(lineBranches)), covered, len(lineBranches))
+			}
+			c.Lines = append(c.Lines, l)
+		}
+		pkg.Classes = append(pkg.Classes, c)
+
+		report.LinesValid += len(class.Lines)
+		report.LinesCovered += class.CoveredLines()
+		report.BranchesValid += len(class.Branches)
+		report.BranchesCovered += class.CoveredBranches()
+	}
+	report.LineRate = rate(report.LinesCovered, report.LinesValid)
+	report.BranchRate = rate(report.BranchesCovered, report.BranchesValid)
+	pkg.LineRate = report.LineRate
+	pkg.BranchRate = report.BranchRate
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadData(t *testing.T) {
+	report, err := runLandTest(t, "-d", "fixtures/load_data", "--static-resources", "fixtures/load_data/staticresources")
+	if err != nil {
+		t.Errorf("expected no error, actual %v", err)
+	}
+	expectPassed(t, report, "LoadDataTest", "loadsRecords", "referencesLoadedRecords")
+}
+
+func TestDbLoadAndDump(t *testing.T) {
+	directory, err := ioutil.TempDir("", "land_fixtures")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+	database := filepath.Join(directory, "land.db")
+	dumpDirectory := filepath.Join(directory, "dump")
+
+	commands := [][]string{
+		{"land", "db:create", "--database", database},
+		{"land", "db:load", "--database", database, "-d", "fixtures/load_data/records"},
+		{"land", "db:dump", "--database", database, "-d", dumpDirectory, "--format", "json", "-s", "Account"},
+	}
+	stdout := os.Stdout
+	os.Stdout, _ = os.Open(os.DevNull)
+	for _, args := range commands {
+		if err = newApp().Run(args); err != nil {
+			break
+		}
+	}
+	os.Stdout = stdout
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dumpDirectory, "Contact.json")); !os.IsNotExist(err) {
+		t.Errorf("expected only Account to be dumped, actual %v", err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dumpDirectory, "Account.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	records := []map[string]interface{}{}
+	if err := json.Unmarshal(data, &records); err != nil {
+		t.Fatal(err)
+	}
+	names := map[string]string{}
+	for _, record := range records {
+		names[record["Name"].(string)], _ = record["Website"].(string)
+	}
+	expected := map[string]string{"Acme": "https://acme.example.com", "Globex": ""}
+	if len(names) != len(expected) {
+		t.Errorf("expected %v, actual %v", expected, names)
+	}
+	for name, website := range expected {
+		if actual, ok := names[name]; !ok || actual != website {
+			t.Errorf("expected %s of %s, actual %s", website, name, actual)
+		}
+	}
+}
@@ -12,9 +12,11 @@ type Context struct {
 	StaticField *StaticFieldMap
 	ClassTypes  *ast.ClassMap           // loaded User Class
 	NameSpaces  *builtin.NameSpaceStore // NameSpaces and Related Classes
+	Triggers    []*ast.Trigger          // loaded Trigger
 
 	CurrentMethod *ast.MethodDeclaration
 	CurrentClass  *ast.ClassType
+	CallStack     []*Frame // running methods, the last is the current frame
 }
 
 func NewContext() *Context {
@@ -102,6 +104,12 @@ func (m *StaticFieldMap) Set(ns, k string, n *ast.ObjectMap) {
 	m.Data[ns][k] = n
 }
 
+func (m *StaticFieldMap) Delete(ns, k string) {
+	if objMap, ok := m.Data[strings.ToLower(ns)]; ok {
+		delete(objMap, strings.ToLower(k))
+	}
+}
+
 func (m *StaticFieldMap) Get(ns, k string) (*ast.ObjectMap, bool) {
 	ns = strings.ToLower(ns)
 	k = strings.ToLower(k)
@@ -0,0 +1,271 @@
+package interpreter
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/tzmfreedom/goland/ast"
+)
+
+// Coverage collects hits of the executed lines and branches through the events published by the interpreter
+type Coverage struct {
+	lines    map[string]map[int]int
+	branches map[coverageBranch]int
+	// mu guards the hits from the interpreters running in parallel
+	mu sync.Mutex
+}
+
+// coverageBranch is the true or false branch of the decision such as if, ternary or switch.
+// The when block of switch has only the true branch, and the false branch of switch is its else block.
+type coverageBranch struct {
+	node  ast.Node
+	taken bool
+}
+
+func NewCoverage() *Coverage {
+	return &Coverage{
+		lines:    map[string]map[int]int{},
+		branches: map[coverageBranch]int{},
+	}
+}
+
+// Subscribe starts collecting the coverage of the code run by the interpreter of the event bus
+func (c *Coverage) Subscribe(events *EventBus) {
+	events.Subscribe("line", func(ctx *Context, n ast.Node) {
+		loc := n.GetLocation()
+		if loc == nil {
+			return
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if _, ok := c.lines[loc.FileName]; !ok {
+			c.lines[loc.FileName] = map[int]int{}
+		}
+		c.lines[loc.FileName][loc.Line]++
+	})
+	events.Subscribe("branch_true", func(ctx *Context, n ast.Node) {
+		c.hitBranch(coverageBranch{n, true})
+	})
+	events.Subscribe("branch_false", func(ctx *Context, n ast.Node) {
+		c.hitBranch(coverageBranch{n, false})
+	})
+}
+
+func (c *Coverage) hitBranch(branch coverageBranch) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.branches[branch]++
+}
+
+type ClassCoverage struct {
+	Name     string
+	FileName string
+	Lines    []*LineCoverage
+	Branches []*BranchCoverage
+}
+
+type LineCoverage struct {
+	Line int
+	Hits int
+}
+
+// BranchCoverage is a branch of the decision, Block is the index of the decision in the class
+type BranchCoverage struct {
+	Line   int
+	Block  int
+	Branch int
+	Hits   int
+}
+
+func (c *ClassCoverage) CoveredLines() int {
+	n := 0
+	for _, line := range c.Lines {
+		if line.Hits > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+func (c *ClassCoverage) CoveredBranches() int {
+	n := 0
+	for _, branch := range c.Branches {
+		if branch.Hits > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// LineRate returns the ratio of covered lines, the class without executable lines is fully covered
+func (c *ClassCoverage) LineRate() float64 {
+	if len(c.Lines) == 0 {
+		return 1
+	}
+	return float64(c.CoveredLines()) / float64(len(c.Lines))
+}
+
+func (c *ClassCoverage) BranchRate() float64 {
+	if len(c.Branches) == 0 {
+		return 1
+	}
+	return float64(c.CoveredBranches()) / float64(len(c.Branches))
+}
+
+// ClassCoverage returns the coverage of the class, the lines of inner classes are included
+func (c *Coverage) ClassCoverage(classType *ast.ClassType) *ClassCoverage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	w := &coverageWalker{lines: map[int]bool{}}
+	w.walkClass(classType)
+
+	coverage := &ClassCoverage{
+		Name:     classType.Name,
+		Lines:    []*LineCoverage{},
+		Branches: []*BranchCoverage{},
+	}
+	if classType.Location != nil {
+		coverage.FileName = classType.Location.FileName
+	}
+	lines := []int{}
+	for line := range w.lines {
+		lines = append(lines, line)
+	}
+	sort.Ints(lines)
+	for _, line := range lines {
+		coverage.Lines = append(coverage.Lines, &LineCoverage{
+			Line: line,
+			Hits: c.lines[coverage.FileName][line],
+		})
+	}
+
+	sort.SliceStable(w.decisions, func(i, j int) bool {
+		return w.decisions[i].line < w.decisions[j].line
+	})
+	for i, decision := range w.decisions {
+		for j, branch := range decision.branches {
+			coverage.Branches = append(coverage.Branches, &BranchCoverage{
+				Line:   decision.line,
+				Block:  i,
+				Branch: j,
+				Hits:   c.branches[branch],
+			})
+		}
+	}
+	return coverage
+}
+
+type coverageDecision struct {
+	line     int
+	branches []coverageBranch
+}
+
+// coverageWalker collects the executable lines and the decisions of the class.
+// The executable lines are the statements in blocks, which publish the line event.
+type coverageWalker struct {
+	lines     map[int]bool
+	decisions []*coverageDecision
+}
+
+func (w *coverageWalker) walkClass(classType *ast.ClassType) {
+	methods := append([]*ast.Method{}, classType.Constructors...)
+	for _, methodMap := range []*ast.MethodMap{classType.InstanceMethods, classType.StaticMethods} {
+		if methodMap == nil {
+			continue
+		}
+		for _, m := range methodMap.All() {
+			methods = append(methods, m...)
+		}
+	}
+	for _, m := range methods {
+		if m.Statements != nil {
+			w.walk(m.Statements)
+		}
+	}
+	if classType.InnerClasses != nil {
+		for _, inner := range classType.InnerClasses.Data {
+			w.walkClass(inner)
+		}
+	}
+}
+
+func (w *coverageWalker) walk(n ast.Node) {
+	if isNilNode(n) {
+		return
+	}
+	switch n := n.(type) {
+	case *ast.Block:
+		for _, stmt := range n.Statements {
+			if loc := stmt.GetLocation(); loc != nil {
+				w.lines[loc.Line] = true
+			}
+			w.walk(stmt)
+		}
+	case *ast.If:
+		w.decision(n, coverageBranch{n, true}, coverageBranch{n, false})
+		w.walkExpression(n.Condition)
+		w.walk(n.IfStatement)
+		w.walk(n.ElseStatement)
+	case *ast.For:
+		w.walk(n.Statements)
+	case *ast.While:
+		w.walkExpression(n.Condition)
+		w.walk(n.Statements)
+	case *ast.Try:
+		w.walk(n.Block)
+		for _, c := range n.CatchClause {
+			w.walk(c.Block)
+		}
+		w.walk(n.FinallyBlock)
+	case *ast.Switch:
+		w.walkExpression(n.Expression)
+		branches := []coverageBranch{}
+		for _, when := range n.WhenStatements {
+			branches = append(branches, coverageBranch{when, true})
+		}
+		w.decision(n, append(branches, coverageBranch{n, false})...)
+		for _, when := range n.WhenStatements {
+			w.walk(when.Statements)
+		}
+		w.walk(n.ElseStatement)
+	default:
+		w.walkExpression(n)
+	}
+}
+
+// walkExpression finds ternary expressions in the children of the node
+func (w *coverageWalker) walkExpression(n ast.Node) {
+	if isNilNode(n) {
+		return
+	}
+	if ternary, ok := n.(*ast.TernalyExpression); ok {
+		w.decision(ternary, coverageBranch{ternary, true}, coverageBranch{ternary, false})
+	}
+	for _, child := range n.GetChildren() {
+		switch child := child.(type) {
+		case ast.Node:
+			w.walkExpression(child)
+		case []ast.Node:
+			for _, node := range child {
+				w.walkExpression(node)
+			}
+		}
+	}
+}
+
+func (w *coverageWalker) decision(n ast.Node, branches ...coverageBranch) {
+	line := 0
+	if loc := n.GetLocation(); loc != nil {
+		line = loc.Line
+	}
+	w.decisions = append(w.decisions, &coverageDecision{line: line, branches: branches})
+}
+
+func isNilNode(n ast.Node) bool {
+	if n == nil {
+		return true
+	}
+	v := reflect.ValueOf(n)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
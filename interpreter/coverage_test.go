@@ -0,0 +1,79 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tzmfreedom/goland/ast"
+)
+
+func TestClassCoverage(t *testing.T) {
+	loc := func(line int) *ast.Location {
+		return &ast.Location{FileName: "Foo.cls", Line: line}
+	}
+	call := &ast.MethodInvocation{Location: loc(3)}
+	ifStmt := &ast.If{
+		Condition: &ast.BooleanLiteral{Value: true, Location: loc(2)},
+		IfStatement: &ast.Block{
+			Statements: []ast.Node{call},
+			Location:   loc(2),
+		},
+		Location: loc(2),
+	}
+	ret := &ast.Return{
+		Expression: &ast.TernalyExpression{
+			Condition:       &ast.BooleanLiteral{Value: true, Location: loc(5)},
+			TrueExpression:  &ast.IntegerLiteral{Value: 1, Location: loc(5)},
+			FalseExpression: &ast.IntegerLiteral{Value: 2, Location: loc(5)},
+			Location:        loc(5),
+		},
+		Location: loc(5),
+	}
+	staticMethods := ast.NewMethodMap()
+	staticMethods.Set("action", []*ast.Method{
+		{
+			Name: "action",
+			Statements: &ast.Block{
+				Statements: []ast.Node{ifStmt, ret},
+			},
+		},
+	})
+	classType := &ast.ClassType{
+		Name:            "Foo",
+		Constructors:    []*ast.Method{},
+		InstanceMethods: ast.NewMethodMap(),
+		StaticMethods:   staticMethods,
+		Location:        loc(1),
+	}
+
+	events := NewEventBus()
+	coverage := NewCoverage()
+	coverage.Subscribe(events)
+	events.Publish("line", nil, ifStmt)
+	events.Publish("branch_true", nil, ifStmt)
+	events.Publish("line", nil, call)
+	events.Publish("line", nil, call)
+
+	expected := &ClassCoverage{
+		Name:     "Foo",
+		FileName: "Foo.cls",
+		Lines: []*LineCoverage{
+			{Line: 2, Hits: 1},
+			{Line: 3, Hits: 2},
+			{Line: 5, Hits: 0},
+		},
+		Branches: []*BranchCoverage{
+			{Line: 2, Block: 0, Branch: 0, Hits: 1},
+			{Line: 2, Block: 0, Branch: 1, Hits: 0},
+			{Line: 5, Block: 1, Branch: 0, Hits: 0},
+			{Line: 5, Block: 1, Branch: 1, Hits: 0},
+		},
+	}
+	actual := coverage.ClassCoverage(classType)
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("%s", diff)
+	}
+	if actual.CoveredLines() != 2 || actual.CoveredBranches() != 1 {
+		t.Errorf("covered lines %d, covered branches %d", actual.CoveredLines(), actual.CoveredBranches())
+	}
+}
@@ -15,8 +15,6 @@ import (
 	"github.com/tzmfreedom/goland/builtin"
 )
 
-var Debugger = &debugger{}
-
 type debugger struct {
 	Enabled bool
 	StepOut bool
@@ -62,6 +60,8 @@ func (d *debugger) Debug(ctx *Context, n ast.Node) {
 			return
 		case "current":
 			showCurrent(n)
+		case "bt", "backtrace":
+			showBacktrace(ctx, n)
 		case "exit":
 			d.Step = 0
 			d.Frame = 0
@@ -111,30 +111,40 @@ func showCurrent(n ast.Node) {
 	}
 }
 
-func init() {
-	Subscribe("method_start", func(ctx *Context, n ast.Node) {
-		if Debugger.Enabled {
-			Debugger.Frame++
+// showBacktrace shows the frames of the call stack with the files, the current frame is #0
+func showBacktrace(ctx *Context, n ast.Node) {
+	for i, frame := range ctx.Backtrace(n) {
+		fmt.Printf("#%d %s at %s:%d:%d\n", i, frame.Name, frame.Location.FileName, frame.Location.Line, frame.Location.Column)
+	}
+}
+
+// newDebugger returns the debugger which follows the frames and the lines through the events of the interpreter
+func newDebugger(events *EventBus) *debugger {
+	d := &debugger{}
+	events.Subscribe("method_start", func(ctx *Context, n ast.Node) {
+		if d.Enabled {
+			d.Frame++
 		}
 	})
-	Subscribe("method_end", func(ctx *Context, n ast.Node) {
-		if Debugger.Enabled {
-			if Debugger.Frame > 0 {
-				Debugger.Frame--
+	events.Subscribe("method_end", func(ctx *Context, n ast.Node) {
+		if d.Enabled {
+			if d.Frame > 0 {
+				d.Frame--
 			}
 		}
 	})
-	Subscribe("line", func(ctx *Context, n ast.Node) {
-		if Debugger.Enabled {
-			if Debugger.Step > 0 {
-				if Debugger.StepOut && Debugger.Frame != 0 {
+	events.Subscribe("line", func(ctx *Context, n ast.Node) {
+		if d.Enabled {
+			if d.Step > 0 {
+				if d.StepOut && d.Frame != 0 {
 					return
 				}
-				Debugger.Step--
+				d.Step--
 			}
-			if Debugger.Step == 0 {
-				Debugger.Debug(ctx, n)
+			if d.Step == 0 {
+				d.Debug(ctx, n)
 			}
 		}
 	})
+	return d
 }
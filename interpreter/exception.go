@@ -0,0 +1,143 @@
+package interpreter
+
+import (
+	"fmt"
+
+	"github.com/tzmfreedom/goland/ast"
+	"github.com/tzmfreedom/goland/builtin"
+)
+
+// ExceptionError is the apex exception which is thrown and not caught yet.
+// The exception propagates as the error of the visitor, so the evaluation stops until the try statement catches it.
+type ExceptionError struct {
+	Exception *ast.Object
+}
+
+func (e *ExceptionError) Error() string {
+	return builtin.ExceptionString(e.Exception)
+}
+
+// StackTrace returns the frames where the exception is created, which is empty for the exception not located
+func (e *ExceptionError) StackTrace() string {
+	stackTrace, _ := e.Exception.Extra["stack_trace"].(string)
+	return stackTrace
+}
+
+// nullPointerMessage is the message of NullPointerException thrown by the access to the null object
+const nullPointerMessage = "Attempt to de-reference a null object"
+
+// newExceptionError creates the error of the builtin exception, which is located at the node by the visitor
+func newExceptionError(classType *ast.ClassType, message string) *ExceptionError {
+	return &ExceptionError{Exception: builtin.NewException(classType, message)}
+}
+
+func nullPointerError() *ExceptionError {
+	return newExceptionError(builtin.NullPointerExceptionType, nullPointerMessage)
+}
+
+// raise returns the error of the builtin exception thrown at the node
+func (v *Interpreter) raise(n ast.Node, classType *ast.ClassType, format string, args ...interface{}) error {
+	return v.locate(newExceptionError(classType, fmt.Sprintf(format, args...)), n)
+}
+
+// locate locates the exception of the error at the node, such as NullPointerException from the type resolver
+func (v *Interpreter) locate(err error, n ast.Node) error {
+	if e, ok := err.(*ExceptionError); ok {
+		v.locateException(e.Exception, n)
+	}
+	return err
+}
+
+// RaiseError returns the error of the exception in the raise object, which is returned by the native methods.
+// It returns nil when the object is not the raise object.
+func RaiseError(r interface{}) error {
+	obj, ok := r.(*ast.Object)
+	if !ok || obj == nil || obj.ClassType != builtin.RaiseType {
+		return nil
+	}
+	return &ExceptionError{Exception: obj.Value().(*ast.Object)}
+}
+
+// fromRaise converts the result of the native method, the raise object becomes the error of the exception.
+// The exception created by the native method is located at the invocation.
+func (v *Interpreter) fromRaise(r interface{}, n ast.Node) (interface{}, error) {
+	if err := RaiseError(r); err != nil {
+		v.locateException(err.(*ExceptionError).Exception, n)
+		return nil, err
+	}
+	return r, nil
+}
+
+// locateException records where the exception is created for getLineNumber and getStackTraceString.
+// The location is kept when the exception is thrown again.
+func (v *Interpreter) locateException(exception *ast.Object, n ast.Node) {
+	if _, ok := exception.Extra["line_number"]; ok {
+		return
+	}
+	if n == nil {
+		return
+	}
+	loc := n.GetLocation()
+	if loc == nil {
+		return
+	}
+	exception.Extra["line_number"] = loc.Line
+	exception.Extra["stack_trace"] = v.Context.StackTrace(n)
+}
+
+// toRaise converts the error of the exception into the raise object for the builtin classes, such as the DML from Database methods.
+// The other errors are returned as they are.
+func toRaise(err error) (*ast.Object, error) {
+	if e, ok := err.(*ExceptionError); ok {
+		return builtin.CreateRaise(e.Exception), nil
+	}
+	return nil, err
+}
+
+// findCatch returns the first catch clause whose type is the class or the superclass of the exception
+func findCatch(catches []*ast.Catch, exception *ast.Object) *ast.Catch {
+	for _, catch := range catches {
+		for t := exception.ClassType; t != nil; t = t.SuperClass {
+			if t == catch.Type {
+				return catch
+			}
+		}
+	}
+	return nil
+}
+
+// checkArithmetic returns the exception of the arithmetic operation,
+// the operation of null is NullPointerException and the division by zero is MathException
+func (v *Interpreter) checkArithmetic(n ast.Node, op string, lObj, rObj *ast.Object) error {
+	if lObj == builtin.Null || rObj == builtin.Null {
+		return v.raise(n, builtin.NullPointerExceptionType, nullPointerMessage)
+	}
+	if !isNumber(lObj) || !isNumber(rObj) {
+		return v.raise(n, builtin.TypeExceptionType, "Arithmetic expressions must use numeric arguments: %s %s %s", lObj.ClassType.String(), op, rObj.ClassType.String())
+	}
+	if op == "/" {
+		if (rObj.ClassType == builtin.IntegerType && rObj.IntegerValue() == 0) ||
+			(rObj.ClassType == builtin.DoubleType && rObj.DoubleValue() == 0) {
+			return v.raise(n, builtin.MathExceptionType, "Divide by 0")
+		}
+	}
+	return nil
+}
+
+func isNumber(o *ast.Object) bool {
+	return o.ClassType == builtin.IntegerType || o.ClassType == builtin.DoubleType
+}
+
+func (v *Interpreter) operatorTypeError(n *ast.BinaryOperator, lType, rType *ast.ClassType) error {
+	return v.raise(n, builtin.TypeExceptionType, "Comparison arguments must be compatible types: %s, %s", lType.String(), rType.String())
+}
+
+func (v *Interpreter) checkListIndex(n ast.Node, key *ast.Object, records []*ast.Object) error {
+	if key == builtin.Null {
+		return v.raise(n, builtin.NullPointerExceptionType, nullPointerMessage)
+	}
+	if i := key.IntegerValue(); i < 0 || i >= len(records) {
+		return v.raise(n, builtin.ListExceptionType, "List index out of bounds: %d", i)
+	}
+	return nil
+}
@@ -7,7 +7,6 @@ import (
 
 	"strings"
 
-	"errors"
 	"fmt"
 
 	"github.com/k0kubun/pp"
@@ -17,26 +16,34 @@ import (
 )
 
 type Interpreter struct {
-	Context *Context
-	Extra   map[string]interface{}
+	Context   *Context
+	Extra     map[string]interface{}
+	Events    *EventBus
+	debugger  *debugger
+	asyncJobs []func() (interface{}, error)
 }
 
 func NewInterpreter(classTypeMap *ast.ClassMap) *Interpreter {
 	interpreter := &Interpreter{
 		Context: NewContext(),
 		Extra: map[string]interface{}{
-			"stdout": os.Stdout,
-			"stderr": os.Stderr,
-			"errors": []*builtin.TestError{},
+			"stdout":   os.Stdout,
+			"stderr":   os.Stderr,
+			"errors":   []*builtin.TestError{},
+			"limits":   builtin.NewLimits(),
+			"database": builtin.DatabaseDriver,
 		},
 	}
 	interpreter.Extra["interpreter"] = interpreter
+	interpreter.Events = NewEventBus()
+	interpreter.debugger = newDebugger(interpreter.Events)
 	interpreter.Context.ClassTypes = classTypeMap
 	return interpreter
 }
 
+// NewInterpreterWithBuiltin returns the interpreter which has its own class map of builtin classes and the classes
 func NewInterpreterWithBuiltin(classTypes []*ast.ClassType) *Interpreter {
-	interpreter := NewInterpreter(builtin.PrimitiveClassMap())
+	interpreter := NewInterpreter(builtin.NewClassMapWithPrimivie([]*ast.ClassType{}))
 	for _, classType := range classTypes {
 		interpreter.Context.ClassTypes.Set(classType.Name, classType)
 	}
@@ -44,6 +51,11 @@ func NewInterpreterWithBuiltin(classTypes []*ast.ClassType) *Interpreter {
 	return interpreter
 }
 
+// Database returns the database driver of the transaction which the interpreter runs in
+func (v *Interpreter) Database() builtin.Driver {
+	return v.Extra["database"].(builtin.Driver)
+}
+
 var binaryOperator = map[string]func(*ast.Object, *ast.Object) *ast.Object{
 	"=": func(lObj *ast.Object, rObj *ast.Object) *ast.Object {
 		return rObj
@@ -73,7 +85,7 @@ var binaryOperator = map[string]func(*ast.Object, *ast.Object) *ast.Object{
 			}
 		} else if lType == builtin.StringType {
 			l := lObj.StringValue()
-			r := rObj.StringValue()
+			r := builtin.String(rObj)
 			return builtin.NewString(l + r)
 		}
 		panic("not pass")
@@ -99,7 +111,7 @@ var binaryOperator = map[string]func(*ast.Object, *ast.Object) *ast.Object{
 			}
 			if rType == builtin.DoubleType {
 				r := rObj.DoubleValue()
-				return builtin.NewDouble(r - l)
+				return builtin.NewDouble(l - r)
 			}
 		}
 		panic("not pass")
@@ -151,7 +163,7 @@ var binaryOperator = map[string]func(*ast.Object, *ast.Object) *ast.Object{
 			}
 			if rType == builtin.DoubleType {
 				r := rObj.DoubleValue()
-				return builtin.NewDouble(r / l)
+				return builtin.NewDouble(l / r)
 			}
 		}
 		panic("not pass")
@@ -161,18 +173,38 @@ var binaryOperator = map[string]func(*ast.Object, *ast.Object) *ast.Object{
 func (v *Interpreter) LoadStaticField() {
 	v.Context.StaticField = NewStaticFieldMap()
 	for className, classType := range v.Context.ClassTypes.Data {
-		objectMap := ast.NewObjectMap()
-		if classType.StaticFields != nil {
-			for _, f := range classType.StaticFields.Data {
-				val, err := f.Expression.Accept(v)
-				if err != nil {
-					panic(err)
-				}
-				objectMap.Set(f.Name, val.(*ast.Object))
+		v.Context.StaticField.Set("_", className, v.loadStaticFields(classType))
+		if classType.InnerClasses == nil {
+			continue
+		}
+		// the values of the inner enum are held with the outer class like the namespace
+		for innerName, inner := range classType.InnerClasses.Data {
+			if builtin.IsEnum(inner) {
+				v.Context.StaticField.Set(className, innerName, v.loadStaticFields(inner))
+			}
+		}
+	}
+}
+
+// loadStaticFields evaluates the static fields of the class, the fields of the enum are its values
+func (v *Interpreter) loadStaticFields(classType *ast.ClassType) *ast.ObjectMap {
+	objectMap := ast.NewObjectMap()
+	if builtin.IsEnum(classType) {
+		for _, value := range builtin.EnumValues(classType) {
+			objectMap.Set(builtin.EnumName(value), value)
+		}
+		return objectMap
+	}
+	if classType.StaticFields != nil {
+		for _, f := range classType.StaticFields.Data {
+			val, err := f.Expression.Accept(v)
+			if err != nil {
+				panic(err)
 			}
+			objectMap.Set(f.Name, val.(*ast.Object))
 		}
-		v.Context.StaticField.Set("_", className, objectMap)
 	}
+	return objectMap
 }
 
 func (v *Interpreter) VisitClassDeclaration(n *ast.ClassDeclaration) (interface{}, error) {
@@ -191,6 +223,10 @@ func (v *Interpreter) VisitInterfaceDeclaration(n *ast.InterfaceDeclaration) (in
 	panic("not pass")
 }
 
+func (v *Interpreter) VisitEnumDeclaration(n *ast.EnumDeclaration) (interface{}, error) {
+	panic("not pass")
+}
+
 func (v *Interpreter) VisitIntegerLiteral(n *ast.IntegerLiteral) (interface{}, error) {
 	return builtin.NewInteger(n.Value), nil
 }
@@ -210,13 +246,23 @@ func (v *Interpreter) VisitArrayAccess(n *ast.ArrayAccess) (interface{}, error)
 	}
 	receiver := r.(*ast.Object)
 	key := k.(*ast.Object)
+	if receiver == builtin.Null {
+		return nil, v.raise(n, builtin.NullPointerExceptionType, nullPointerMessage)
+	}
 	if receiver.ClassType.Name == "List" {
 		records := receiver.Extra["records"].([]*ast.Object)
+		if err := v.checkListIndex(n, key, records); err != nil {
+			return nil, err
+		}
 		return records[key.IntegerValue()], nil
 	}
 
 	records := receiver.Extra["values"].(map[string]*ast.Object)
-	return records[key.StringValue()], nil
+	value, ok := records[key.StringValue()]
+	if !ok {
+		return builtin.Null, nil
+	}
+	return value, nil
 }
 
 func (v *Interpreter) VisitBooleanLiteral(n *ast.BooleanLiteral) (interface{}, error) {
@@ -238,14 +284,30 @@ func (v *Interpreter) VisitDml(n *ast.Dml) (interface{}, error) {
 	}
 	var records []*ast.Object
 	obj := o.(*ast.Object)
+	if obj == builtin.Null {
+		return nil, v.raise(n, builtin.NullPointerExceptionType, nullPointerMessage)
+	}
 	// TODO: check SObject class
 	if obj.ClassType.Name == "List" {
 		records = obj.Extra["records"].([]*ast.Object)
 	} else {
 		records = []*ast.Object{obj}
 	}
+	if len(records) == 0 {
+		return nil, nil
+	}
 	sObjectType := records[0].ClassType.Name
-	builtin.DatabaseDriver.Execute(n.Type, sObjectType, records, n.UpsertKey)
+	// the triggers are called at the dml statement
+	node := v.Extra["node"]
+	v.Extra["node"] = n
+	r, err := v.ExecuteDml(n.Type, sObjectType, records, n.UpsertKey)
+	v.Extra["node"] = node
+	if err != nil {
+		return nil, err
+	}
+	if _, err := v.fromRaise(r, n); err != nil {
+		return nil, err
+	}
 	return nil, nil
 }
 
@@ -257,36 +319,31 @@ func (v *Interpreter) VisitFieldDeclaration(n *ast.FieldDeclaration) (interface{
 	panic("not pass")
 }
 
+// VisitTry runs the first catch clause which matches the exception thrown by the try block.
+// The exception thrown by the catch clause propagates, and the finally block runs after the try block
+// returns, breaks or throws. The return, break and exception of the finally block override them.
 func (v *Interpreter) VisitTry(n *ast.Try) (interface{}, error) {
 	res, err := n.Block.Accept(v)
-	if err != nil {
-		return nil, err
-	}
-	if res != nil {
-		switch obj := res.(*ast.Object); obj.ClassType {
-		case builtin.ReturnType, builtin.BreakType, builtin.ContinueType:
-			return obj, nil
-		case builtin.RaiseType:
-			// TODO: implement
-			for _, catch := range n.CatchClause {
-				raiseValue := obj.Value().(*ast.Object)
-				if builtin.Equals(catch.Type, raiseValue.ClassType) {
-					v.Context.Env.Define(catch.Identifier, raiseValue)
-					_, err := catch.Accept(v)
-					if err != nil {
-						return nil, err
-					}
-					// TODO: return, raise impl
-				}
-			}
+	if e, ok := err.(*ExceptionError); ok {
+		if catch := findCatch(n.CatchClause, e.Exception); catch != nil {
+			res, err = v.NewEnv(func() (interface{}, error) {
+				v.Context.Env.Define(catch.Identifier, e.Exception)
+				return catch.Accept(v)
+			})
 		}
 	}
-	if n.FinallyBlock != nil {
-		if _, err = n.FinallyBlock.Accept(v); err != nil {
-			return nil, err
-		}
+	if n.FinallyBlock == nil {
+		return res, err
 	}
-	return nil, nil
+	// the error which is not an exception, such as the limit exceeded, stops the transaction
+	if _, ok := err.(*ExceptionError); err != nil && !ok {
+		return nil, err
+	}
+	finallyRes, finallyErr := n.FinallyBlock.Accept(v)
+	if finallyErr != nil || finallyRes != nil {
+		return finallyRes, finallyErr
+	}
+	return res, err
 }
 
 func (v *Interpreter) VisitCatch(n *ast.Catch) (interface{}, error) {
@@ -312,39 +369,33 @@ func (v *Interpreter) VisitFor(n *ast.For) (interface{}, error) {
 				if err != nil {
 					return nil, err
 				}
-				if res.(*ast.Object).BoolValue() {
-					res, err = n.Statements.Accept(v)
-					if res != nil {
-						switch obj := res.(*ast.Object); obj.ClassType {
-						case builtin.BreakType:
-							return nil, nil
-						case builtin.ContinueType:
-							for _, stmt := range control.ForUpdate {
-								stmt.Accept(v)
-							}
-							continue
-						case builtin.ReturnType, builtin.RaiseType:
-							return obj, nil
-						}
+				if !res.(*ast.Object).BoolValue() {
+					break
+				}
+				res, err = n.Statements.Accept(v)
+				if err != nil {
+					return nil, err
+				}
+				if res != nil {
+					switch obj := res.(*ast.Object); obj.ClassType {
+					case builtin.BreakType:
+						return nil, nil
+					case builtin.ReturnType:
+						return obj, nil
 					}
-					for _, stmt := range control.ForUpdate {
-						stmt.Accept(v)
+				}
+				for _, stmt := range control.ForUpdate {
+					if _, err := stmt.Accept(v); err != nil {
+						return nil, err
 					}
-				} else {
-					break
 				}
 			}
 		case *ast.EnhancedForControl:
-			_, err := control.Accept(v)
-			if err != nil {
-				return nil, err
-			}
-			iterator, err := control.Expression.Accept(v)
+			records, err := control.Accept(v)
 			if err != nil {
 				return nil, err
 			}
-			records := iterator.(*ast.Object).Extra["records"].([]*ast.Object)
-			for _, record := range records {
+			for _, record := range records.([]*ast.Object) {
 				v.Context.Env.Define(control.VariableDeclaratorId, record)
 				res, err := n.Statements.Accept(v)
 				if err != nil {
@@ -356,7 +407,7 @@ func (v *Interpreter) VisitFor(n *ast.For) (interface{}, error) {
 						return nil, nil
 					case builtin.ContinueType:
 						continue
-					case builtin.ReturnType, builtin.RaiseType:
+					case builtin.ReturnType:
 						return obj, nil
 					}
 				}
@@ -370,8 +421,23 @@ func (v *Interpreter) VisitForControl(n *ast.ForControl) (interface{}, error) {
 	panic("not pass")
 }
 
+// VisitEnhancedForControl returns the elements of the list or the set to iterate, the elements of the set are in the order of the insertion
 func (v *Interpreter) VisitEnhancedForControl(n *ast.EnhancedForControl) (interface{}, error) {
-	panic("not pass")
+	iterator, err := n.Expression.Accept(v)
+	if err != nil {
+		return nil, err
+	}
+	obj := iterator.(*ast.Object)
+	if obj == builtin.Null {
+		return nil, v.raise(n, builtin.NullPointerExceptionType, nullPointerMessage)
+	}
+	if records, ok := obj.Extra["records"].([]*ast.Object); ok {
+		return records, nil
+	}
+	if obj.ClassType.Name == "Set" {
+		return builtin.SetValues(obj), nil
+	}
+	return nil, fmt.Errorf("%s is not iterable", obj.ClassType.String())
 }
 
 func (v *Interpreter) VisitIf(n *ast.If) (interface{}, error) {
@@ -380,8 +446,11 @@ func (v *Interpreter) VisitIf(n *ast.If) (interface{}, error) {
 		return nil, err
 	}
 	if res.(*ast.Object).BoolValue() {
+		v.Events.Publish("branch_true", v.Context, n)
 		return n.IfStatement.Accept(v)
-	} else if n.ElseStatement != nil {
+	}
+	v.Events.Publish("branch_false", v.Context, n)
+	if n.ElseStatement != nil {
 		return n.ElseStatement.Accept(v)
 	}
 	return nil, nil
@@ -393,7 +462,7 @@ func (v *Interpreter) VisitMethodDeclaration(n *ast.MethodDeclaration) (interfac
 }
 
 func (v *Interpreter) VisitMethodInvocation(n *ast.MethodInvocation) (interface{}, error) {
-	Publish("method_start", v.Context, n)
+	v.Events.Publish("method_start", v.Context, n)
 	var receiver interface{}
 	var m *ast.Method
 	var err error
@@ -416,14 +485,14 @@ func (v *Interpreter) VisitMethodInvocation(n *ast.MethodInvocation) (interface{
 		// TODO: extend
 		_, m, err = FindInstanceMethod(receiver.(*ast.Object), exp.FieldName, evaluated, compiler.MODIFIER_ALL_OK)
 		if err != nil {
-			panic("not found")
+			return nil, v.locate(err, n)
 		}
 	case *ast.Name:
 		// TODO: implement
 		if exp.Value[0] == "_Debugger" {
 			switch exp.Value[1] {
 			case "run":
-				Debugger.Debug(v.Context, n)
+				v.debugger.Debug(v.Context, n)
 			case "debug":
 				for _, p := range n.Parameters {
 					pp.Println(p)
@@ -434,7 +503,17 @@ func (v *Interpreter) VisitMethodInvocation(n *ast.MethodInvocation) (interface{
 		resolver := NewTypeResolver(v.Context)
 		receiver, m, err = resolver.ResolveMethod(exp.Value, evaluated)
 		if err != nil {
-			return nil, err
+			return nil, v.locate(err, n)
+		}
+	}
+	if obj, ok := receiver.(*ast.Object); ok {
+		if provider, ok := builtin.StubProvider(obj); ok {
+			r, err := v.callStubProvider(provider, obj, m, evaluated)
+			v.Events.Publish("method_end", v.Context, n)
+			if err != nil {
+				return nil, err
+			}
+			return v.fromRaise(r, n)
 		}
 	}
 	prevClass := v.Context.CurrentClass
@@ -458,11 +537,65 @@ func (v *Interpreter) VisitMethodInvocation(n *ast.MethodInvocation) (interface{
 			r = m.NativeFunction(nil, evaluated, v.Extra)
 		}
 		v.Extra["node"] = nil
-		Publish("method_end", v.Context, n)
-		return r, nil
+		v.Events.Publish("method_end", v.Context, n)
+		return v.fromRaise(r, n)
 	}
+	if m.IsAnnotated("future") {
+		if err := builtin.UseLimit(v.Extra, builtin.LimitFutureCalls, 1); err != nil {
+			return nil, err
+		}
+		v.enqueueAsyncJob(n, receiver, m, evaluated)
+		v.Events.Publish("method_end", v.Context, n)
+		return nil, nil
+	}
+	return v.invokeMethod(n, receiver, m, evaluated)
+}
+
+// callStubProvider delegates the method call of the stub created by Test.createStub to handleMethodCall of the StubProvider
+func (v *Interpreter) callStubProvider(provider, stub *ast.Object, m *ast.Method, evaluated []*ast.Object) (interface{}, error) {
+	paramTypes := make([]*ast.Object, len(m.Parameters))
+	paramNames := make([]*ast.Object, len(m.Parameters))
+	for i, param := range m.Parameters {
+		paramTypes[i] = builtin.NewType(param.Type)
+		paramNames[i] = builtin.NewString(param.Name)
+	}
+	returnType := builtin.VoidType
+	if m.ReturnType != nil {
+		returnType = m.ReturnType
+	}
+	r, err := v.CallMethod(provider, "handleMethodCall", []*ast.Object{
+		stub,
+		builtin.NewString(m.Name),
+		builtin.NewType(returnType),
+		newListObject(builtin.TypeType, paramTypes),
+		newListObject(builtin.StringType, paramNames),
+		newListObject(builtin.ObjectType, evaluated),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.ClassType != builtin.RaiseType && m.ReturnType == nil {
+		return nil, nil
+	}
+	return r, nil
+}
+
+func newListObject(classType *ast.ClassType, records []*ast.Object) *ast.Object {
+	list := ast.CreateObject(builtin.CreateListType(classType))
+	list.Extra["records"] = records
+	return list
+}
+
+// invokeMethod runs the statements of the apex method.
+// The exception thrown by the method is returned as the error, and the env of the caller is restored.
+func (v *Interpreter) invokeMethod(n ast.Node, receiver interface{}, m *ast.Method, evaluated []*ast.Object) (interface{}, error) {
 	prev := v.Context.Env
 	v.Context.Env = NewEnv(nil)
+	popFrame := v.Context.pushFrame(methodFrameName(v.Context.CurrentClass, m), n)
+	defer func() {
+		v.Context.Env = prev
+		popFrame()
+	}()
 	for i, param := range m.Parameters {
 		v.Context.Env.Define(param.Name, evaluated[i])
 	}
@@ -471,19 +604,14 @@ func (v *Interpreter) VisitMethodInvocation(n *ast.MethodInvocation) (interface{
 		v.Context.Env.Define("this", obj)
 	}
 	r, err := m.Statements.Accept(v)
-	Publish("method_end", v.Context, n)
+	v.Events.Publish("method_end", v.Context, n)
 	if err != nil {
 		return nil, err
 	}
-	v.Context.Env = prev
 
 	if r != nil {
-		obj := r.(*ast.Object)
-		switch obj.ClassType {
-		case builtin.ReturnType:
+		if obj := r.(*ast.Object); obj.ClassType == builtin.ReturnType {
 			return obj.Value(), nil
-		case builtin.RaiseType:
-			return obj, nil
 		}
 	}
 	return nil, nil
@@ -507,6 +635,10 @@ func (v *Interpreter) VisitNew(n *ast.New) (interface{}, error) {
 			newObj.InstanceFields.Set(f.Name, r.(*ast.Object))
 		}
 	}
+	if builtin.IsException(classType) {
+		builtin.InitException(newObj)
+		v.locateException(newObj, n)
+	}
 	typeResolver := NewTypeResolver(v.Context)
 	if classType.HasConstructor() {
 		evaluated := make([]*ast.Object, len(n.Parameters))
@@ -522,11 +654,13 @@ func (v *Interpreter) VisitNew(n *ast.New) (interface{}, error) {
 			return nil, err
 		}
 		if constructor == nil {
-			panic("constructor is not found")
+			return nil, fmt.Errorf("Constructor is not found: %s", classType.Name)
 		}
 
 		if constructor.NativeFunction != nil {
-			constructor.NativeFunction(newObj, evaluated, v.Extra)
+			if _, err := v.fromRaise(constructor.NativeFunction(newObj, evaluated, v.Extra), n); err != nil {
+				return nil, err
+			}
 		} else {
 			prev := v.Context.Env
 			v.Context.Env = NewEnv(nil)
@@ -534,8 +668,13 @@ func (v *Interpreter) VisitNew(n *ast.New) (interface{}, error) {
 				v.Context.Env.Define(param.Name, evaluated[i])
 			}
 			v.Context.Env.Define("this", newObj)
-			constructor.Statements.Accept(v)
+			popFrame := v.Context.pushFrame(methodFrameName(classType, constructor), n)
+			_, err := constructor.Statements.Accept(v)
+			popFrame()
 			v.Context.Env = prev
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -591,6 +730,16 @@ func (v *Interpreter) VisitNew(n *ast.New) (interface{}, error) {
 			}
 		}
 	}
+	if classType.Name == "Set" && n.Init != nil {
+		builtin.InitSet(newObj)
+		for _, r := range n.Init.Records {
+			value, err := r.Accept(v)
+			if err != nil {
+				return nil, err
+			}
+			builtin.AddSetValue(newObj, value.(*ast.Object))
+		}
+	}
 	if classType.Name == "Map" {
 		if n.Init == nil {
 			newObj.Extra["values"] = map[string]*ast.Object{}
@@ -624,6 +773,9 @@ func (v *Interpreter) VisitUnaryOperator(n *ast.UnaryOperator) (interface{}, err
 		if err != nil {
 			return nil, err
 		}
+		if l.(*ast.Object) == builtin.Null {
+			return nil, v.raise(n, builtin.NullPointerExceptionType, nullPointerMessage)
+		}
 		exp := builtin.NewInteger(l.(*ast.Object).IntegerValue() + 1)
 		// TODO: implement
 		v.Context.Env.Update(name.Value[0], exp)
@@ -635,6 +787,9 @@ func (v *Interpreter) VisitUnaryOperator(n *ast.UnaryOperator) (interface{}, err
 		if err != nil {
 			return nil, err
 		}
+		if l.(*ast.Object) == builtin.Null {
+			return nil, v.raise(n, builtin.NullPointerExceptionType, nullPointerMessage)
+		}
 		exp := builtin.NewInteger(l.(*ast.Object).IntegerValue() - 1)
 		// TODO: implement
 		v.Context.Env.Update(name.Value[0], exp)
@@ -659,6 +814,32 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 	rObj := right.(*ast.Object)
 	rType := rObj.ClassType
 
+	switch n.Op {
+	case "+":
+		// the string concatenation accepts null and the values of any type
+		if lType == builtin.StringType || rType == builtin.StringType {
+			return builtin.NewString(builtin.String(lObj) + builtin.String(rObj)), nil
+		}
+		if err := v.checkArithmetic(n, n.Op, lObj, rObj); err != nil {
+			return nil, err
+		}
+	case "-", "*", "/":
+		if err := v.checkArithmetic(n, n.Op, lObj, rObj); err != nil {
+			return nil, err
+		}
+	case "+=", "-=", "*=", "/=":
+		if n.Op != "+=" || lType != builtin.StringType {
+			if err := v.checkArithmetic(n, strings.TrimSuffix(n.Op, "="), lObj, rObj); err != nil {
+				return nil, err
+			}
+		}
+	case "<", ">", "<=", ">=":
+		// the comparison with null is false
+		if lObj == builtin.Null || rObj == builtin.Null {
+			return builtin.NewBoolean(false), nil
+		}
+	}
+
 	switch n.Op {
 	case "+":
 		if lType == builtin.IntegerType {
@@ -681,12 +862,8 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 				r := rObj.DoubleValue()
 				return builtin.NewDouble(r + l), nil
 			}
-		} else if lType == builtin.StringType {
-			l := lObj.StringValue()
-			r := rObj.StringValue()
-			return builtin.NewString(l + r), nil
 		}
-		panic("type error")
+		return nil, v.operatorTypeError(n, lType, rType)
 	case "-":
 		if lType == builtin.IntegerType {
 			l := lObj.IntegerValue()
@@ -706,10 +883,10 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 			}
 			if rType == builtin.DoubleType {
 				r := rObj.DoubleValue()
-				return builtin.NewDouble(r - l), nil
+				return builtin.NewDouble(l - r), nil
 			}
 		}
-		panic("type error")
+		return nil, v.operatorTypeError(n, lType, rType)
 	case "*":
 		if lType == builtin.IntegerType {
 			l := lObj.IntegerValue()
@@ -732,7 +909,7 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 				return builtin.NewDouble(r * l), nil
 			}
 		}
-		panic("type error")
+		return nil, v.operatorTypeError(n, lType, rType)
 	case "/":
 		if lType == builtin.IntegerType {
 			l := lObj.IntegerValue()
@@ -752,10 +929,10 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 			}
 			if rType == builtin.DoubleType {
 				r := rObj.DoubleValue()
-				return builtin.NewDouble(r / l), nil
+				return builtin.NewDouble(l / r), nil
 			}
 		}
-		panic("type error")
+		return nil, v.operatorTypeError(n, lType, rType)
 	case "<":
 		if lType == builtin.IntegerType {
 			l := lObj.IntegerValue()
@@ -775,10 +952,10 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 			}
 			if rType == builtin.DoubleType {
 				r := rObj.DoubleValue()
-				return builtin.NewBoolean(r < l), nil
+				return builtin.NewBoolean(l < r), nil
 			}
 		}
-		panic("type error")
+		return nil, v.operatorTypeError(n, lType, rType)
 	case ">":
 		if lType == builtin.IntegerType {
 			l := lObj.IntegerValue()
@@ -798,10 +975,10 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 			}
 			if rType == builtin.DoubleType {
 				r := rObj.DoubleValue()
-				return builtin.NewBoolean(r > l), nil
+				return builtin.NewBoolean(l > r), nil
 			}
 		}
-		panic("type error")
+		return nil, v.operatorTypeError(n, lType, rType)
 	case "<=":
 		if lType == builtin.IntegerType {
 			l := lObj.IntegerValue()
@@ -821,10 +998,10 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 			}
 			if rType == builtin.DoubleType {
 				r := rObj.DoubleValue()
-				return builtin.NewBoolean(r <= l), nil
+				return builtin.NewBoolean(l <= r), nil
 			}
 		}
-		panic("type error")
+		return nil, v.operatorTypeError(n, lType, rType)
 	case ">=":
 		if lType == builtin.IntegerType {
 			l := lObj.IntegerValue()
@@ -844,10 +1021,10 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 			}
 			if rType == builtin.DoubleType {
 				r := rObj.DoubleValue()
-				return builtin.NewBoolean(r >= l), nil
+				return builtin.NewBoolean(l >= r), nil
 			}
 		}
-		panic("type error")
+		return nil, v.operatorTypeError(n, lType, rType)
 	case "==":
 		if lType == builtin.IntegerType {
 			l := lObj.IntegerValue()
@@ -869,7 +1046,7 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 				r := rObj.DoubleValue()
 				return builtin.NewBoolean(r == l), nil
 			}
-		} else if lType == builtin.StringType {
+		} else if lType == builtin.StringType && rType == builtin.StringType {
 			l := lObj.StringValue()
 			r := rObj.StringValue()
 			return builtin.NewBoolean(l == r), nil
@@ -898,7 +1075,7 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 				r := rObj.DoubleValue()
 				return builtin.NewBoolean(r != l), nil
 			}
-		} else if lType == builtin.StringType {
+		} else if lType == builtin.StringType && rType == builtin.StringType {
 			l := lObj.StringValue()
 			r := rObj.StringValue()
 			return builtin.NewBoolean(l != r), nil
@@ -915,12 +1092,17 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 		switch t := n.Left.(type) {
 		case *ast.Name:
 			resolver := NewTypeResolver(v.Context)
-			resolver.SetVariable(t.Value, value)
+			if err := resolver.SetVariable(t.Value, value); err != nil {
+				return nil, v.locate(err, n)
+			}
 		case *ast.FieldAccess:
 			exp, err := t.Expression.Accept(v)
 			if err != nil {
 				return nil, err
 			}
+			if exp.(*ast.Object) == builtin.Null {
+				return nil, v.raise(n, builtin.NullPointerExceptionType, nullPointerMessage)
+			}
 			exp.(*ast.Object).InstanceFields.Set(t.FieldName, value)
 		case *ast.ArrayAccess:
 			k, err := t.Key.Accept(v)
@@ -933,8 +1115,15 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 				return nil, err
 			}
 			receiver := r.(*ast.Object)
+			if receiver == builtin.Null {
+				return nil, v.raise(n, builtin.NullPointerExceptionType, nullPointerMessage)
+			}
 			if receiver.ClassType.Name == "List" {
-				receiver.Extra["records"].([]*ast.Object)[key.IntegerValue()] = value
+				records := receiver.Extra["records"].([]*ast.Object)
+				if err := v.checkListIndex(n, key, records); err != nil {
+					return nil, err
+				}
+				records[key.IntegerValue()] = value
 			}
 			if receiver.ClassType.Name == "Map" {
 				receiver.Extra["values"].(map[string]*ast.Object)[key.StringValue()] = value
@@ -962,30 +1151,108 @@ func (v *Interpreter) VisitThrow(n *ast.Throw) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	return builtin.CreateRaise(res.(*ast.Object)), nil
+	return nil, &ExceptionError{Exception: res.(*ast.Object)}
 }
 
 func (v *Interpreter) VisitSoql(n *ast.Soql) (interface{}, error) {
-	executor := &SoqlExecutor{}
+	if err := builtin.UseLimit(v.Extra, builtin.LimitQueries, 1); err != nil {
+		return nil, err
+	}
+	executor := &SoqlExecutor{Driver: v.Database()}
 	objects, err := executor.Execute(n, v)
+	if err != nil {
+		return nil, err
+	}
+	if records, ok := objects.Extra["records"].([]*ast.Object); ok {
+		if err := builtin.UseLimit(v.Extra, builtin.LimitQueryRows, len(records)); err != nil {
+			return nil, err
+		}
+	}
 	if n.ExactlyOne {
 		records := objects.Extra["records"].([]*ast.Object)
 		if len(records) == 0 {
-			return nil, errors.New("List has no rows for assignment to SObject")
+			return nil, v.raise(n, builtin.QueryExceptionType, "List has no rows for assignment to SObject")
 		}
 		if len(records) > 1 {
-			return nil, errors.New("List has more than 1 row for assignment to SObject")
+			return nil, v.raise(n, builtin.QueryExceptionType, "List has more than 1 row for assignment to SObject")
 		}
 	}
+	return objects, nil
+}
+
+// enqueueAsyncJob queues the method invocation which runs on FlushAsyncJobs
+func (v *Interpreter) enqueueAsyncJob(n ast.Node, receiver interface{}, m *ast.Method, evaluated []*ast.Object) {
+	currentClass := v.Context.CurrentClass
+	v.asyncJobs = append(v.asyncJobs, func() (interface{}, error) {
+		prevClass := v.Context.CurrentClass
+		v.Context.CurrentClass = currentClass
+		defer func() {
+			v.Context.CurrentClass = prevClass
+		}()
+		return v.invokeMethod(n, receiver, m, evaluated)
+	})
+}
+
+// EnqueueJob queues execute method of the queueable object
+func (v *Interpreter) EnqueueJob(queueable *ast.Object) error {
+	parameters := []*ast.Object{builtin.NewQueueableContext()}
+	_, m, err := FindInstanceMethod(queueable, "execute", parameters, compiler.MODIFIER_ALL_OK)
+	if err != nil {
+		return err
+	}
+	node, _ := v.Extra["node"].(ast.Node)
+	prevClass := v.Context.CurrentClass
+	v.Context.CurrentClass = queueable.ClassType
+	v.enqueueAsyncJob(node, queueable, m, parameters)
+	v.Context.CurrentClass = prevClass
+	return nil
+}
+
+// CallMethod invokes the instance method from the native method, such as respond of the mock set by Test.setMock.
+// The returned object is the raise object when the method throws an exception.
+func (v *Interpreter) CallMethod(receiver *ast.Object, name string, parameters []*ast.Object) (*ast.Object, error) {
+	_, m, err := FindInstanceMethod(receiver, name, parameters, compiler.MODIFIER_ALL_OK)
 	if err != nil {
 		return nil, err
 	}
-	return objects, nil
+	node, _ := v.Extra["node"].(ast.Node)
+	prevClass := v.Context.CurrentClass
+	v.Context.CurrentClass = receiver.ClassType
+	defer func() {
+		v.Context.CurrentClass = prevClass
+		v.Extra["node"] = node
+	}()
+	var r interface{}
+	if m.NativeFunction != nil {
+		r = m.NativeFunction(receiver, parameters, v.Extra)
+	} else {
+		r, err = v.invokeMethod(node, receiver, m, parameters)
+		if err != nil {
+			return toRaise(err)
+		}
+	}
+	if r == nil {
+		return builtin.Null, nil
+	}
+	return r.(*ast.Object), nil
+}
+
+// FlushAsyncJobs runs the queued jobs including the jobs queued by them.
+// It returns the exception raised by the job.
+func (v *Interpreter) FlushAsyncJobs() (*ast.Object, error) {
+	for len(v.asyncJobs) > 0 {
+		job := v.asyncJobs[0]
+		v.asyncJobs = v.asyncJobs[1:]
+		if _, err := job(); err != nil {
+			v.asyncJobs = nil
+			return toRaise(err)
+		}
+	}
+	return nil, nil
 }
 
 func (v *Interpreter) VisitSosl(n *ast.Sosl) (interface{}, error) {
-	// TODO: impl
-	return ast.VisitSosl(v, n)
+	return v.Database().Search(n, v), nil
 }
 
 func (v *Interpreter) VisitStringLiteral(n *ast.StringLiteral) (interface{}, error) {
@@ -1000,28 +1267,43 @@ func (v *Interpreter) VisitSwitch(n *ast.Switch) (interface{}, error) {
 	expObj := exp.(*ast.Object)
 	for _, when := range n.WhenStatements {
 		for _, cond := range when.Condition {
-			c, err := cond.Accept(v)
+			condObj, err := v.evaluateWhenCondition(expObj, cond)
 			if err != nil {
 				return nil, err
 			}
-			condObj := c.(*ast.Object)
 			if v.Equals(expObj, condObj) {
+				v.Events.Publish("branch_true", v.Context, when)
 				return when.Statements.Accept(v)
 			}
 		}
 	}
+	// no when matches, which is the branch of the else block even if it is omitted
+	v.Events.Publish("branch_false", v.Context, n)
 	if n.ElseStatement != nil {
 		return n.ElseStatement.Accept(v)
 	}
 	return nil, nil
 }
 
+// evaluateWhenCondition evaluates the condition of the when, the name of the condition is the value of the enum if the switch is on the enum
+func (v *Interpreter) evaluateWhenCondition(expObj *ast.Object, cond ast.Node) (*ast.Object, error) {
+	if name, ok := cond.(*ast.Name); ok && len(name.Value) == 1 && builtin.IsEnum(expObj.ClassType) {
+		if value := builtin.EnumValue(expObj.ClassType, name.Value[0]); value != nil {
+			return value, nil
+		}
+	}
+	c, err := cond.Accept(v)
+	if err != nil {
+		return nil, err
+	}
+	return c.(*ast.Object), nil
+}
+
 func (v *Interpreter) VisitTrigger(n *ast.Trigger) (interface{}, error) {
-	return ast.VisitTrigger(v, n)
+	return n.Statements.Accept(v)
 }
 
 func (v *Interpreter) VisitTriggerTiming(n *ast.TriggerTiming) (interface{}, error) {
-	panic("not pass")
 	return ast.VisitTriggerTiming(v, n)
 }
 
@@ -1030,7 +1312,7 @@ func (v *Interpreter) VisitVariableDeclaration(n *ast.VariableDeclaration) (inte
 		if declarator.Expression != nil {
 			val, err := declarator.Expression.Accept(v)
 			if err != nil {
-				panic(err)
+				return nil, err
 			}
 			v.Context.Env.Define(declarator.Name, val.(*ast.Object))
 		} else {
@@ -1063,20 +1345,18 @@ func (v *Interpreter) VisitWhile(n *ast.While) (interface{}, error) {
 			break
 		}
 		res, err := n.Statements.Accept(v)
+		if err != nil {
+			return nil, err
+		}
 		if res != nil {
 			obj := res.(*ast.Object)
 			switch obj.ClassType {
-			case builtin.ReturnType, builtin.RaiseType:
+			case builtin.ReturnType:
 				return obj, nil
 			case builtin.BreakType:
 				return nil, nil
-			case builtin.ContinueType:
-				continue
 			}
 		}
-		if err != nil {
-			return nil, err
-		}
 	}
 	return nil, nil
 }
@@ -1092,7 +1372,7 @@ func (v *Interpreter) VisitCastExpression(n *ast.CastExpression) (interface{}, e
 	}
 	expObj := exp.(*ast.Object)
 	if !builtin.Equals(n.CastType, expObj.ClassType) {
-		return nil, fmt.Errorf("Cast type is not match %s != %s", n.CastType.Name, expObj.ClassType.Name)
+		return nil, v.raise(n, builtin.TypeExceptionType, "Invalid conversion from runtime type %s to %s", expObj.ClassType.String(), n.CastType.String())
 	}
 	return expObj, nil
 }
@@ -1102,15 +1382,28 @@ func (v *Interpreter) VisitFieldAccess(n *ast.FieldAccess) (interface{}, error)
 	if err != nil {
 		return nil, err
 	}
-	f, ok := r.(*ast.Object).InstanceFields.Get(n.FieldName)
+	receiver := r.(*ast.Object)
+	if receiver == builtin.Null {
+		return nil, v.raise(n, builtin.NullPointerExceptionType, nullPointerMessage)
+	}
+	f, ok := receiver.InstanceFields.Get(n.FieldName)
 	if !ok {
-		panic("InstanceFields#Get failed")
+		if receiver.ClassType.SuperClass == builtin.SObjectType {
+			return nil, v.raise(n, builtin.SObjectExceptionType, "SObject row was retrieved via SOQL without querying the requested field: %s.%s", receiver.ClassType.Name, n.FieldName)
+		}
+		return nil, fmt.Errorf("Field %s does not exist in %s", n.FieldName, receiver.ClassType.Name)
 	}
 	return f, nil
 }
 
+// VisitType evaluates `Account.class` expression
 func (v *Interpreter) VisitType(n *ast.TypeRef) (interface{}, error) {
-	panic("not pass")
+	resolver := NewTypeResolver(v.Context)
+	classType, err := resolver.ConvertType(n)
+	if err != nil {
+		return nil, err
+	}
+	return builtin.NewType(classType), nil
 }
 
 func (v *Interpreter) VisitBlock(n *ast.Block) (interface{}, error) {
@@ -1120,7 +1413,7 @@ func (v *Interpreter) VisitBlock(n *ast.Block) (interface{}, error) {
 		v.Context.Env = prevEnv
 	}()
 	for _, stmt := range n.Statements {
-		Publish("line", v.Context, stmt)
+		v.Events.Publish("line", v.Context, stmt)
 		res, err := stmt.Accept(v)
 		if err != nil {
 			return nil, err
@@ -1128,7 +1421,7 @@ func (v *Interpreter) VisitBlock(n *ast.Block) (interface{}, error) {
 		if res != nil {
 			obj := res.(*ast.Object)
 			switch obj.ClassType {
-			case builtin.ReturnType, builtin.BreakType, builtin.ContinueType, builtin.RaiseType:
+			case builtin.ReturnType, builtin.BreakType, builtin.ContinueType:
 				return obj, nil
 			}
 		}
@@ -1162,8 +1455,10 @@ func (v *Interpreter) VisitTernalyExpression(n *ast.TernalyExpression) (interfac
 		return nil, err
 	}
 	if res.(*ast.Object).Extra["value"].(bool) {
+		v.Events.Publish("branch_true", v.Context, n)
 		return n.TrueExpression.Accept(v)
 	}
+	v.Events.Publish("branch_false", v.Context, n)
 	return n.FalseExpression.Accept(v)
 }
 
@@ -1177,7 +1472,11 @@ func (v *Interpreter) VisitSetCreator(n *ast.SetCreator) (interface{}, error) {
 
 func (v *Interpreter) VisitName(n *ast.Name) (interface{}, error) {
 	resolver := NewTypeResolver(v.Context)
-	return resolver.ResolveVariable(n.Value)
+	r, err := resolver.ResolveVariable(n.Value)
+	if err != nil {
+		return nil, v.locate(err, n)
+	}
+	return r, nil
 }
 
 func (v *Interpreter) VisitConstructorDeclaration(n *ast.ConstructorDeclaration) (interface{}, error) {
@@ -1196,6 +1495,10 @@ func (v *Interpreter) Equals(o, other *ast.Object) bool {
 	if o == builtin.Null {
 		return other == builtin.Null
 	}
+	// the values of the enum are singletons
+	if builtin.IsEnum(o.ClassType) {
+		return o == other
+	}
 	m, ok := o.ClassType.InstanceMethods.Get("equals")
 	if !ok {
 		return o.Value() == other.Value()
@@ -6,10 +6,12 @@ import (
 	"github.com/tzmfreedom/goland/builtin"
 )
 
-type SoqlExecutor struct{}
+type SoqlExecutor struct {
+	Driver builtin.Driver
+}
 
 func (e *SoqlExecutor) Execute(n *ast.Soql, visitor ast.Visitor) (*ast.Object, error) {
-	records := builtin.DatabaseDriver.Query(n, visitor)
+	records := e.Driver.Query(n, visitor)
 	return e.getListFromResponse(n, records)
 }
 
@@ -36,7 +38,15 @@ func getRecords(n *ast.Soql, records []*soapforce.SObject) []*ast.Object {
 }
 
 func (e *SoqlExecutor) getListFromResponse(n *ast.Soql, records []*ast.Object) (*ast.Object, error) {
-	// TODO: implement
+	if builtin.IsCountQuery(n) {
+		count, _ := records[0].InstanceFields.Get("expr0")
+		return count, nil
+	}
+	if builtin.IsAggregateQuery(n) {
+		list := ast.CreateObject(builtin.CreateListType(builtin.AggregateResultType))
+		list.Extra["records"] = records
+		return list, nil
+	}
 	classType, ok := builtin.PrimitiveClassMap().Get(n.FromObject)
 	if !ok {
 		panic(n.FromObject + "not found")
@@ -0,0 +1,93 @@
+package interpreter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tzmfreedom/goland/ast"
+)
+
+// Frame is the method running in the interpreter, such as the method, the constructor and the trigger.
+// Caller is the node which calls the frame, so the position of the caller frame is the location of it.
+type Frame struct {
+	Name   string
+	Caller ast.Node
+}
+
+// anonymousBlock is the frame of the code which is not in any class, such as the action of land run
+const anonymousBlock = "AnonymousBlock"
+
+// methodFrameName returns the name of the frame like Class.Foo.bar, the class is the one which declares the method.
+// The constructor is named <init>.
+func methodFrameName(classType *ast.ClassType, m *ast.Method) string {
+	if m.Parent != nil {
+		classType = m.Parent
+	}
+	name := m.Name
+	if m.IsConstructor {
+		name = "<init>"
+	}
+	return fmt.Sprintf("Class.%s.%s", frameClassName(classType), name)
+}
+
+func triggerFrameName(trigger *ast.Trigger) string {
+	return "Trigger." + trigger.Name
+}
+
+// frameClassName returns the name of the class, the inner class is prefixed with the outer class like Outer.Inner
+func frameClassName(classType *ast.ClassType) string {
+	if outer, ok := classType.Parent.(*ast.ClassDeclaration); ok {
+		return outer.Name + "." + classType.Name
+	}
+	return classType.Name
+}
+
+// pushFrame adds the frame called at the node, the returned function removes it
+func (ctx *Context) pushFrame(name string, caller ast.Node) func() {
+	ctx.CallStack = append(ctx.CallStack, &Frame{Name: name, Caller: caller})
+	depth := len(ctx.CallStack)
+	return func() {
+		ctx.CallStack = ctx.CallStack[:depth-1]
+	}
+}
+
+// FrameLocation is the frame and the location where the frame runs
+type FrameLocation struct {
+	Name     string
+	Location *ast.Location
+}
+
+func (f *FrameLocation) String() string {
+	return fmt.Sprintf("%s: line %d, column %d", f.Name, f.Location.Line, f.Location.Column)
+}
+
+// Backtrace returns the locations of the frames at the node, the current frame is the first.
+// The frames whose position is unknown, such as the method invoked by land run, are omitted.
+func (ctx *Context) Backtrace(n ast.Node) []*FrameLocation {
+	locations := []*FrameLocation{}
+	at := n
+	for i := len(ctx.CallStack) - 1; i >= -1; i-- {
+		name := anonymousBlock
+		if i >= 0 {
+			name = ctx.CallStack[i].Name
+		}
+		if at != nil {
+			if loc := at.GetLocation(); loc != nil {
+				locations = append(locations, &FrameLocation{Name: name, Location: loc})
+			}
+		}
+		if i >= 0 {
+			at = ctx.CallStack[i].Caller
+		}
+	}
+	return locations
+}
+
+// StackTrace returns the stack trace at the node like `Class.Foo.bar: line 12, column 1`, one line for each frame
+func (ctx *Context) StackTrace(n ast.Node) string {
+	lines := []string{}
+	for _, location := range ctx.Backtrace(n) {
+		lines = append(lines, location.String())
+	}
+	return strings.Join(lines, "\n")
+}
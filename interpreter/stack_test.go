@@ -0,0 +1,59 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/tzmfreedom/goland/ast"
+)
+
+func locatedNode(line, column int) ast.Node {
+	return &ast.MethodInvocation{
+		Location: &ast.Location{FileName: "foo.cls", Line: line, Column: column},
+	}
+}
+
+// newBacktraceContext returns the context which runs Foo.bar called by Foo.main, which is invoked by land run
+func newBacktraceContext() *Context {
+	ctx := NewContext()
+	ctx.pushFrame("Class.Foo.main", &ast.MethodInvocation{})
+	ctx.pushFrame("Class.Foo.bar", locatedNode(3, 8))
+	return ctx
+}
+
+func TestBacktrace(t *testing.T) {
+	ctx := newBacktraceContext()
+	expected := []string{
+		"Class.Foo.bar: line 10, column 4",
+		"Class.Foo.main: line 3, column 8",
+	}
+	locations := ctx.Backtrace(locatedNode(10, 4))
+	if len(locations) != len(expected) {
+		t.Fatalf("expected %d frames, actual %d", len(expected), len(locations))
+	}
+	for i, location := range locations {
+		if location.String() != expected[i] {
+			t.Errorf("expected %s, actual %s", expected[i], location.String())
+		}
+		if location.Location.FileName != "foo.cls" {
+			t.Errorf("expected foo.cls, actual %s", location.Location.FileName)
+		}
+	}
+
+	// the frame is removed when the method returns
+	popFrame := ctx.pushFrame("Class.Foo.<init>", locatedNode(10, 4))
+	if actual := len(ctx.Backtrace(locatedNode(2, 1))); actual != 3 {
+		t.Errorf("expected 3 frames, actual %d", actual)
+	}
+	popFrame()
+	if actual := len(ctx.Backtrace(locatedNode(10, 4))); actual != 2 {
+		t.Errorf("expected 2 frames, actual %d", actual)
+	}
+}
+
+// the backtrace command of the debugger
+func Example_showBacktrace() {
+	showBacktrace(newBacktraceContext(), locatedNode(10, 4))
+	// Output:
+	// #0 Class.Foo.bar at foo.cls:10:4
+	// #1 Class.Foo.main at foo.cls:3:8
+}
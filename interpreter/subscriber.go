@@ -6,20 +6,29 @@ import (
 
 type Subscriber func(ctx *Context, n ast.Node)
 
-var subscribers = map[string][]Subscriber{}
+// EventBus delivers the events published by an interpreter, such as line, method_start and method_end
+type EventBus struct {
+	subscribers map[string][]Subscriber
+}
+
+func NewEventBus() *EventBus {
+	return &EventBus{
+		subscribers: map[string][]Subscriber{},
+	}
+}
 
-func Publish(event string, ctx *Context, n ast.Node) {
-	if subs, ok := subscribers[event]; ok {
+func (b *EventBus) Publish(event string, ctx *Context, n ast.Node) {
+	if subs, ok := b.subscribers[event]; ok {
 		for _, s := range subs {
 			s(ctx, n)
 		}
 	}
 }
 
-func Subscribe(event string, subscriber Subscriber) {
-	if v, ok := subscribers[event]; ok {
-		subscribers[event] = append(v, subscriber)
+func (b *EventBus) Subscribe(event string, subscriber Subscriber) {
+	if v, ok := b.subscribers[event]; ok {
+		b.subscribers[event] = append(v, subscriber)
 	} else {
-		subscribers[event] = []Subscriber{subscriber}
+		b.subscribers[event] = []Subscriber{subscriber}
 	}
 }
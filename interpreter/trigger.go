@@ -0,0 +1,198 @@
+package interpreter
+
+import (
+	"strings"
+
+	"github.com/tzmfreedom/goland/ast"
+	"github.com/tzmfreedom/goland/builtin"
+)
+
+// ExecuteDml runs before triggers, the dml operation and after triggers.
+// When a trigger throws an exception, the raise object is returned instead of save results.
+func (v *Interpreter) ExecuteDml(dmlType, sObjectType string, records []*ast.Object, upsertKey string) (*ast.Object, error) {
+	if err := builtin.UseLimit(v.Extra, builtin.LimitDmlStatements, 1); err != nil {
+		return nil, err
+	}
+	if err := builtin.UseLimit(v.Extra, builtin.LimitDmlRows, len(records)); err != nil {
+		return nil, err
+	}
+	return v.executeDml(dmlType, sObjectType, records, upsertKey)
+}
+
+func (v *Interpreter) executeDml(dmlType, sObjectType string, records []*ast.Object, upsertKey string) (*ast.Object, error) {
+	dmlType = strings.ToLower(dmlType)
+	if dmlType == "upsert" {
+		return v.executeUpsert(sObjectType, records, upsertKey)
+	}
+	var newRecords, oldRecords []*ast.Object
+	switch dmlType {
+	case "insert", "undelete":
+		newRecords = records
+	case "update":
+		newRecords = records
+		oldRecords = v.queryOldRecords(sObjectType, records)
+	case "delete":
+		oldRecords = v.queryOldRecords(sObjectType, records)
+	}
+
+	if raise := builtin.ValidateDml(dmlType, records); raise != nil {
+		return raise, nil
+	}
+	r, err := v.fireTriggers("before", dmlType, sObjectType, newRecords, oldRecords)
+	if err != nil || r != nil {
+		return r, err
+	}
+	results := v.Database().Execute(dmlType, sObjectType, records, upsertKey)
+	r, err = v.fireTriggers("after", dmlType, sObjectType, newRecords, oldRecords)
+	if err != nil || r != nil {
+		return r, err
+	}
+	return results, nil
+}
+
+// executeUpsert fires insert triggers for new records and update triggers for existing records
+func (v *Interpreter) executeUpsert(sObjectType string, records []*ast.Object, upsertKey string) (*ast.Object, error) {
+	insertRecords := []*ast.Object{}
+	updateRecords := []*ast.Object{}
+	for _, record := range records {
+		id, err := v.Database().FindUpsertTarget(sObjectType, record, upsertKey)
+		if err != nil {
+			return nil, err
+		}
+		if id == "" {
+			insertRecords = append(insertRecords, record)
+		} else {
+			record.InstanceFields.Set("Id", builtin.NewId(id))
+			updateRecords = append(updateRecords, record)
+		}
+	}
+
+	results := map[*ast.Object]*ast.Object{}
+	for _, dmlType := range []string{"insert", "update"} {
+		targets := insertRecords
+		if dmlType == "update" {
+			targets = updateRecords
+		}
+		if len(targets) == 0 {
+			continue
+		}
+		r, err := v.executeDml(dmlType, sObjectType, targets, "")
+		if err != nil {
+			return nil, err
+		}
+		if r.ClassType == builtin.RaiseType {
+			return r, nil
+		}
+		for i, saveResult := range r.Extra["records"].([]*ast.Object) {
+			saveResult.Extra["isCreated"] = builtin.NewBoolean(dmlType == "insert")
+			results[targets[i]] = saveResult
+		}
+	}
+	saveResults := make([]*ast.Object, len(records))
+	for i, record := range records {
+		saveResults[i] = results[record]
+	}
+	return builtin.CreateListObject(nil, saveResults), nil
+}
+
+func (v *Interpreter) fireTriggers(timing, dmlType, sObjectType string, newRecords, oldRecords []*ast.Object) (*ast.Object, error) {
+	classType, ok := v.Context.ClassTypes.Get(sObjectType)
+	if !ok {
+		return nil, nil
+	}
+	for _, trigger := range v.Context.Triggers {
+		if !strings.EqualFold(trigger.Object, sObjectType) {
+			continue
+		}
+		if !matchTriggerTiming(trigger, timing, dmlType) {
+			continue
+		}
+		triggerContext := builtin.NewTriggerContext(classType, timing, dmlType, newRecords, oldRecords)
+		r, err := v.runTrigger(trigger, triggerContext)
+		if err != nil || r != nil {
+			return r, err
+		}
+	}
+	return nil, nil
+}
+
+func matchTriggerTiming(trigger *ast.Trigger, timing, dmlType string) bool {
+	for _, t := range trigger.TriggerTimings {
+		triggerTiming := t.(*ast.TriggerTiming)
+		if strings.EqualFold(triggerTiming.Timing, timing) && strings.EqualFold(triggerTiming.Dml, dmlType) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *Interpreter) runTrigger(trigger *ast.Trigger, triggerContext *ast.Object) (*ast.Object, error) {
+	prevEnv := v.Context.Env
+	prevClass := v.Context.CurrentClass
+	prevStaticField, hasStaticField := v.Context.StaticField.Get("_", builtin.TriggerContextName)
+	v.Context.Env = NewEnv(nil)
+	v.Context.Env.Define(builtin.TriggerContextName, triggerContext)
+	v.Context.CurrentClass = nil
+	v.Context.StaticField.Set("_", builtin.TriggerContextName, triggerContext.InstanceFields)
+	caller, _ := v.Extra["node"].(ast.Node)
+	popFrame := v.Context.pushFrame(triggerFrameName(trigger), caller)
+	defer func() {
+		popFrame()
+		v.Context.Env = prevEnv
+		v.Context.CurrentClass = prevClass
+		if hasStaticField {
+			v.Context.StaticField.Set("_", builtin.TriggerContextName, prevStaticField)
+		} else {
+			// the trigger context is not visible from the code out of the trigger
+			v.Context.StaticField.Delete("_", builtin.TriggerContextName)
+		}
+	}()
+
+	if _, err := trigger.Accept(v); err != nil {
+		return toRaise(err)
+	}
+	return nil, nil
+}
+
+func (v *Interpreter) queryOldRecords(sObjectType string, records []*ast.Object) []*ast.Object {
+	classType, ok := v.Context.ClassTypes.Get(sObjectType)
+	if !ok {
+		return []*ast.Object{}
+	}
+	selectFields := []ast.Node{}
+	for _, f := range classType.InstanceFields.Data {
+		if !builtin.IsColumn(classType.Name, f.Name) {
+			continue
+		}
+		selectFields = append(selectFields, &ast.SelectField{Value: []string{f.Name}})
+	}
+	ids := []string{}
+	for _, record := range records {
+		id, ok := record.InstanceFields.Get("Id")
+		if !ok || id == builtin.Null {
+			continue
+		}
+		ids = append(ids, id.StringValue())
+	}
+	oldRecords := []*ast.Object{}
+	if len(ids) == 0 {
+		return oldRecords
+	}
+	soql := &ast.Soql{
+		SelectFields: selectFields,
+		FromObject:   classType.Name,
+		Where:        builtin.NewInCondition("Id", ids),
+	}
+	// the old records are in the order of the records
+	found := map[string]*ast.Object{}
+	for _, record := range v.Database().Query(soql, v) {
+		id, _ := record.InstanceFields.Get("Id")
+		found[builtin.To18(id.StringValue())] = record
+	}
+	for _, id := range ids {
+		if record, ok := found[builtin.To18(id)]; ok {
+			oldRecords = append(oldRecords, record)
+		}
+	}
+	return oldRecords
+}
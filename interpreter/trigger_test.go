@@ -0,0 +1,39 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/tzmfreedom/goland/ast"
+	"github.com/tzmfreedom/goland/builtin"
+)
+
+func TestRunTriggerRestoresContext(t *testing.T) {
+	sObjectType := ast.CreateClass("Account", []*ast.Method{}, ast.NewMethodMap(), ast.NewMethodMap())
+	trigger := &ast.Trigger{
+		Name:       "AccountTrigger",
+		Object:     "Account",
+		Statements: &ast.Block{Statements: []ast.Node{}},
+	}
+	triggerContext := func() *ast.Object {
+		return builtin.NewTriggerContext(sObjectType, "before", "insert", []*ast.Object{}, []*ast.Object{})
+	}
+
+	v := NewInterpreterWithBuiltin([]*ast.ClassType{})
+	v.Context.StaticField = NewStaticFieldMap()
+	if _, err := v.runTrigger(trigger, triggerContext()); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := v.Context.StaticField.Get("_", builtin.TriggerContextName); ok {
+		t.Errorf("trigger context must be deleted after the trigger")
+	}
+
+	// the context of the outer trigger is restored after the nested trigger
+	outer := triggerContext()
+	v.Context.StaticField.Set("_", builtin.TriggerContextName, outer.InstanceFields)
+	if _, err := v.runTrigger(trigger, triggerContext()); err != nil {
+		t.Fatal(err)
+	}
+	if actual, ok := v.Context.StaticField.Get("_", builtin.TriggerContextName); !ok || actual != outer.InstanceFields {
+		t.Errorf("trigger context of the outer trigger must be restored")
+	}
+}
@@ -40,6 +40,9 @@ func (r *TypeResolver) SetVariable(names []string, setValue *ast.Object) error {
 		name := names[0]
 		if val, ok := r.Context.Env.Get(name); ok {
 			for _, f := range names[1 : len(names)-1] {
+				if val == builtin.Null {
+					return nullPointerError()
+				}
 				val, ok = val.InstanceFields.Get(f)
 				if !ok {
 					return errors.Errorf("%s is not found in this scope", f)
@@ -52,9 +55,12 @@ func (r *TypeResolver) SetVariable(names []string, setValue *ast.Object) error {
 			}
 			return setVariable(val, last, setValue)
 		}
-		// this
-		if val, ok := r.Context.Env.Get("this"); ok {
+		// this, the name which is not the field of this is the class like Class.STATIC_FIELD
+		if val, ok := r.Context.Env.Get("this"); ok && hasInstanceField(val, name) {
 			for _, f := range names[0 : len(names)-1] {
+				if val == builtin.Null {
+					return nullPointerError()
+				}
 				val, ok = val.InstanceFields.Get(f)
 				if !ok {
 					return errors.Errorf("%s is not found in this scope", f)
@@ -69,7 +75,18 @@ func (r *TypeResolver) SetVariable(names []string, setValue *ast.Object) error {
 		}
 		if v, ok := r.Context.StaticField.Get("_", name); ok {
 			if val, ok := v.Get(names[1]); ok {
+				// Class.STATIC_FIELD = value
+				if len(names) == 2 {
+					if val.Final {
+						return errors.New("Final variable has already been initialized")
+					}
+					v.Set(names[1], setValue)
+					return nil
+				}
 				for _, f := range names[2 : len(names)-1] {
+					if val == builtin.Null {
+						return nullPointerError()
+					}
 					val, ok = val.InstanceFields.Get(f)
 					if !ok {
 						return errors.Errorf("%s is not found in this scope", f)
@@ -103,10 +120,18 @@ func (r *TypeResolver) SetVariable(names []string, setValue *ast.Object) error {
 	return nil
 }
 
+func hasInstanceField(receiver *ast.Object, name string) bool {
+	_, ok := receiver.InstanceFields.Get(name)
+	return ok
+}
+
 func setVariable(receiver *ast.Object, name string, value *ast.Object) error {
+	if receiver == builtin.Null {
+		return nullPointerError()
+	}
 	v, ok := receiver.InstanceFields.Get(name)
 	if !ok {
-		panic("InstanceFields#Get failed")
+		return errors.Errorf("%s is not found in this scope", name)
 	}
 	if v.Final {
 		return errors.New("Final variable has already been initialized")
@@ -123,9 +148,6 @@ func (r *TypeResolver) ResolveVariable(names []string) (*ast.Object, error) {
 		// this
 		if val, ok := r.Context.Env.Get("this"); ok {
 			if val, ok = val.InstanceFields.Get(names[0]); ok {
-				if val == nil {
-					return nil, errors.Errorf("null pointer exception: %s", names[0])
-				}
 				return val, nil
 			}
 		}
@@ -135,7 +157,7 @@ func (r *TypeResolver) ResolveVariable(names []string) (*ast.Object, error) {
 		if val, ok := r.Context.Env.Get(name); ok {
 			for _, f := range names[1:] {
 				if val == builtin.Null {
-					return nil, errors.Errorf("null pointer exception: %s", f)
+					return nil, nullPointerError()
 				}
 				val, ok = val.InstanceFields.Get(f)
 				if !ok {
@@ -144,29 +166,29 @@ func (r *TypeResolver) ResolveVariable(names []string) (*ast.Object, error) {
 			}
 			return val, nil
 		}
-		// this
-		if val, ok := r.Context.Env.Get("this"); ok {
+		// this, the name which is not the field of this is the class like Class.STATIC_FIELD
+		if val, ok := r.Context.Env.Get("this"); ok && hasInstanceField(val, name) {
 			for _, f := range names[0:] {
+				if val == builtin.Null {
+					return nil, nullPointerError()
+				}
 				val, ok = val.InstanceFields.Get(f)
 				if !ok {
 					return nil, errors.Errorf("%s is not found in this scope", f)
 				}
-				if val == nil {
-					return nil, errors.Errorf("null pointer exception: %s", f)
-				}
 			}
 			return val, nil
 		}
 		if v, ok := r.Context.StaticField.Get("_", name); ok {
 			if val, ok := v.Get(names[1]); ok {
 				for _, f := range names[2:] {
+					if val == builtin.Null {
+						return nil, nullPointerError()
+					}
 					val, ok = val.InstanceFields.Get(f)
 					if !ok {
 						return nil, errors.Errorf("%s is not found in this scope", f)
 					}
-					if val == nil {
-						return nil, errors.Errorf("null pointer exception: %s", f)
-					}
 				}
 				return val, nil
 			}
@@ -174,17 +196,34 @@ func (r *TypeResolver) ResolveVariable(names []string) (*ast.Object, error) {
 		if objMap, ok := r.Context.StaticField.Get(name, names[1]); ok {
 			if val, ok := objMap.Get(names[2]); ok {
 				for _, f := range names[3:] {
+					if val == builtin.Null {
+						return nil, nullPointerError()
+					}
 					val, ok = val.InstanceFields.Get(f)
 					if !ok {
 						return nil, errors.Errorf("%s is not found in this scope", f)
 					}
-					if val == nil {
-						return nil, errors.Errorf("null pointer exception: %s", f)
-					}
 				}
 				return val, nil
 			}
 		}
+		// Inner.FIELD in the outer class, the static fields of the inner class are held like the namespace
+		if outer, inner, rest, ok := compiler.FindInnerClass(r.Context.ClassTypes, r.Context.CurrentClass, names); ok && len(rest) > 0 {
+			if objMap, ok := r.Context.StaticField.Get(outer.Name, inner.Name); ok {
+				if val, ok := objMap.Get(rest[0]); ok {
+					for _, f := range rest[1:] {
+						if val == builtin.Null {
+							return nil, nullPointerError()
+						}
+						val, ok = val.InstanceFields.Get(f)
+						if !ok {
+							return nil, errors.Errorf("%s is not found in this scope", f)
+						}
+					}
+					return val, nil
+				}
+			}
+		}
 	}
 	return nil, nil
 }
@@ -194,13 +233,19 @@ func (r *TypeResolver) ResolveMethod(names []string, parameters []*ast.Object) (
 		methodName := names[0]
 		if v, ok := r.Context.Env.Get("this"); ok {
 			_, method, err := FindInstanceMethod(v, methodName, parameters, compiler.MODIFIER_ALL_OK)
-			if err != nil {
-				return nil, nil, err
+			if err == nil {
+				if method == nil {
+					return nil, nil, errors.Errorf("%s is not found in this scope", methodName)
+				}
+				return v, method, nil
 			}
-			if method == nil {
-				return nil, nil, errors.Errorf("%s is not found in this scope", methodName)
+			if r.Context.CurrentClass == nil {
+				return nil, nil, err
 			}
-			return v, method, nil
+		}
+		// the static method of the current class is called without the class name
+		if r.Context.CurrentClass != nil {
+			return FindStaticMethod(r.Context.CurrentClass, methodName, parameters, compiler.MODIFIER_ALL_OK)
 		}
 	} else {
 		first := names[0]
@@ -208,6 +253,9 @@ func (r *TypeResolver) ResolveMethod(names []string, parameters []*ast.Object) (
 		fields := names[1 : len(names)-1]
 		if val, ok := r.Context.Env.Get(first); ok {
 			for _, f := range fields {
+				if val == builtin.Null {
+					return nil, nil, nullPointerError()
+				}
 				val, ok = val.InstanceFields.Get(f)
 				if !ok {
 					return nil, nil, errors.Errorf("%s is not found in this scope", f)
@@ -233,6 +281,9 @@ func (r *TypeResolver) ResolveMethod(names []string, parameters []*ast.Object) (
 			if v, ok := r.Context.StaticField.Get("_", first); ok {
 				if val, ok := v.Get(names[1]); ok {
 					for _, f := range names[2 : len(names)-1] {
+						if val == builtin.Null {
+							return nil, nil, nullPointerError()
+						}
 						val, ok = val.InstanceFields.Get(f)
 						if !ok {
 							return nil, nil, errors.Errorf("%s is not found in this scope", f)
@@ -247,6 +298,9 @@ func (r *TypeResolver) ResolveMethod(names []string, parameters []*ast.Object) (
 				if objMap, ok := r.Context.StaticField.Get(first, names[1]); ok {
 					if val, ok := objMap.Get(names[2]); ok {
 						for _, f := range names[3 : len(names)-1] {
+							if val == builtin.Null {
+								return nil, nil, nullPointerError()
+							}
 							val, ok = val.InstanceFields.Get(f)
 							if !ok {
 								return nil, nil, errors.Errorf("%s is not found in this scope", f)
@@ -257,6 +311,27 @@ func (r *TypeResolver) ResolveMethod(names []string, parameters []*ast.Object) (
 				}
 			}
 		}
+
+		// inner_class.static_method() or inner_class.static_field.instance_field...instance_method()
+		if outer, inner, rest, ok := compiler.FindInnerClass(r.Context.ClassTypes, r.Context.CurrentClass, names); ok {
+			if len(rest) == 1 {
+				return FindStaticMethod(inner, methodName, parameters, compiler.MODIFIER_ALL_OK)
+			}
+			if objMap, ok := r.Context.StaticField.Get(outer.Name, inner.Name); ok {
+				if val, ok := objMap.Get(rest[0]); ok {
+					for _, f := range rest[1 : len(rest)-1] {
+						if val == builtin.Null {
+							return nil, nil, nullPointerError()
+						}
+						val, ok = val.InstanceFields.Get(f)
+						if !ok {
+							return nil, nil, errors.Errorf("%s is not found in this scope", f)
+						}
+					}
+					return FindInstanceMethod(val, methodName, parameters, compiler.MODIFIER_ALL_OK)
+				}
+			}
+		}
 	}
 	return nil, nil, errors.Errorf("%s is not found in this scope", strings.Join(names, "."))
 }
@@ -266,6 +341,9 @@ func (r *TypeResolver) ResolveType(names []string) (*ast.ClassType, error) {
 }
 
 func FindInstanceMethod(object *ast.Object, methodName string, parameters []*ast.Object, allowedModifier int) (*ast.Object, *ast.Method, error) {
+	if object == builtin.Null {
+		return nil, nil, nullPointerError()
+	}
 	_, method, err := compiler.FindInstanceMethod(object.ClassType, methodName, convertClassTypes(parameters), allowedModifier)
 	return object, method, err
 }
@@ -13,6 +13,14 @@ var Version string
 func main() {
 	godotenv.Load()
 
+	err := newApp().Run(os.Args)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errorString(err))
+		os.Exit(1)
+	}
+}
+
+func newApp() *cli.App {
 	cli.VersionPrinter = func(c *cli.Context) {
 		fmt.Println(Version)
 	}
@@ -25,6 +33,9 @@ func main() {
 		dbCreateCommand,
 		dbSeedCommand,
 		dbFetchCommand,
+		dbLoadCommand,
+		dbDumpCommand,
+		genTestDataCommand,
 		testCommand,
 		watchCommand,
 		serverCommand,
@@ -34,9 +45,5 @@ func main() {
 		checkCommand,
 		visualforceCommand,
 	}
-	err := app.Run(os.Args)
-	if err != nil {
-		fmt.Fprintln(os.Stderr, err.Error())
-		os.Exit(1)
-	}
+	return app
 }
@@ -1,15 +1,87 @@
 package main
 
 import (
+	"encoding/json"
+	"io/ioutil"
 	"os"
+	"testing"
 
 	"github.com/tzmfreedom/goland/ast"
+	"github.com/tzmfreedom/goland/builtin"
 )
 
 func setup() {
 	classMap = ast.NewClassMap()
 }
 
+// runLandTest runs `land test` on the in-memory database and returns the json report and the error of the command.
+// The progress written to stdout is discarded.
+func runLandTest(t *testing.T, args ...string) (*jsonTestReport, error) {
+	setup()
+	f, err := ioutil.TempFile("", "land_report")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer devNull.Close()
+	stdout := os.Stdout
+	os.Stdout = devNull
+	defer func() { os.Stdout = stdout }()
+
+	args = append([]string{"land", "test", "--database", "memory", "--reporter", "json", "-o", f.Name()}, args...)
+	runErr := newApp().Run(args)
+
+	report := &jsonTestReport{}
+	b, err := ioutil.ReadFile(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) > 0 {
+		if err := json.Unmarshal(b, report); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return report, runErr
+}
+
+// expectPassed checks that all test methods of the class are run and passed
+func expectPassed(t *testing.T, report *jsonTestReport, class string, methods ...string) {
+	results := testMethodResults(report)
+	for _, name := range methods {
+		method, ok := results[class+"."+name]
+		if !ok {
+			t.Errorf("%s.%s: not run", class, name)
+			continue
+		}
+		if method.Status != "passed" {
+			t.Errorf("%s.%s: expected passed, actual %s", class, name, method.Status)
+		}
+		for _, failure := range method.Failures {
+			t.Errorf("%s.%s: %s at %d:%d", class, name, failure.Message, failure.Line, failure.Column)
+		}
+		if method.Error != "" {
+			t.Errorf("%s.%s: %s", class, name, method.Error)
+		}
+	}
+}
+
+// testMethodResults returns the test methods of the report by `Class.method`
+func testMethodResults(report *jsonTestReport) map[string]*jsonTestMethod {
+	methods := map[string]*jsonTestMethod{}
+	for _, class := range report.Classes {
+		for _, method := range class.Tests {
+			methods[class.Name+"."+method.Name] = method
+		}
+	}
+	return methods
+}
+
 // Arithmetic
 func ExampleRun1() {
 	setup()
@@ -79,3 +151,234 @@ func ExampleAbstract() {
 	// hello
 	// world
 }
+
+// Try, Catch, Finally, Throw
+func ExampleException() {
+	setup()
+	os.Args = []string{"land", "run", "--database", "memory", "-a", "Thrower#main", "-d", "fixtures/exception"}
+	main()
+	// Output:
+	// child: child
+	// exception: deep
+	// finally
+	// inner finally
+	// rethrown: inner
+	// finally after return
+	// returned
+	// 0
+	// 1
+}
+
+// Exception methods, standard exceptions, the cause is initialized only once
+func ExampleExceptionMethods() {
+	setup()
+	os.Args = []string{"land", "run", "--database", "memory", "-a", "Thrower#inspect", "-d", "fixtures/exception"}
+	main()
+	// Output:
+	// ChildException
+	// 47
+	// cause
+	// changed
+	// BaseException: cause
+	// System.DmlException
+	// 0
+	// first
+	// BaseException
+	// child changed
+	// ChildException
+}
+
+// runtime errors are the standard exceptions
+func ExampleRuntimeException() {
+	setup()
+	os.Args = []string{"land", "run", "--database", "memory", "-a", "RuntimeError#main", "-d", "fixtures/exception"}
+	main()
+	// Output:
+	// System.NullPointerException: Attempt to de-reference a null object
+	// System.ListException: List index out of bounds: 2
+	// Acme: Attempt to de-reference a null object
+	// System.MathException: Divide by 0
+	// System.TypeException: Invalid conversion from runtime type String to Integer
+}
+
+// stack trace of the frames, the current frame is the first
+func ExampleStackTrace() {
+	setup()
+	os.Args = []string{"land", "run", "--database", "memory", "-a", "StackTrace#main", "-d", "fixtures/exception"}
+	main()
+	// Output:
+	// 3
+	// Class.StackTrace.<init>: line 3, column 18
+	// Class.StackTrace.build: line 16, column 12
+	// Class.StackTrace.main: line 8, column 12
+}
+
+// Enum, values, valueOf, switch on enum
+func ExampleEnum() {
+	setup()
+	os.Args = []string{"land", "run", "--database", "memory", "-a", "Palette#main", "-d", "fixtures/enum"}
+	main()
+	// Output:
+	// RED
+	// 0
+	// GREEN
+	// 1
+	// BLUE
+	// 2
+	// BLUE
+	// true
+	// false
+	// cool
+	// warm
+	// 1
+	// 2
+	// No enum constant Color.PURPLE
+}
+
+// For-each loops over lists and sets, the elements of the set keep their types and the order of the insertion
+func ExampleForEach() {
+	setup()
+	os.Args = []string{"land", "run", "--database", "memory", "-a", "LoopRunner#main", "-d", "fixtures/loop"}
+	main()
+	// Output:
+	// 1
+	// 3
+	// b
+	// a
+	// 19
+	// 001D000000IqhSL
+}
+
+// Trigger context, before and after triggers of dml
+func ExampleTrigger() {
+	setup()
+	os.Args = []string{"land", "run", "--database", "memory", "-a", "TriggerRunner#main", "-d", "fixtures/trigger"}
+	main()
+	// Output:
+	// true
+	// 1
+	// foo (checked)
+	// foo (checked)
+	// Trigger.new
+}
+
+// ALL ROWS, upsert
+func ExampleDml() {
+	setup()
+	os.Args = []string{"land", "run", "--database", "memory", "-a", "DmlRunner#main", "-d", "fixtures/dml"}
+	main()
+	// Output:
+	// 0
+	// 1
+	// true
+	// true
+	// 15
+}
+
+// SOQL values are bound by the field types
+func ExampleSoqlTyped() {
+	setup()
+	os.Args = []string{"land", "run", "--database", "memory", "-a", "SoqlRunner#typed", "-d", "fixtures/soql"}
+	main()
+	// Output:
+	// O'Brien
+	// 1
+	// 100.000000
+	// true
+	// O'Brien
+}
+
+// 18 character Id with the key prefix
+func ExampleDmlIds() {
+	setup()
+	os.Args = []string{"land", "run", "--database", "memory", "-a", "DmlRunner#ids", "-d", "fixtures/dml"}
+	main()
+	// Output:
+	// 18
+	// 001
+	// 003
+	// 15
+	// System.StringException: Invalid id: foo
+}
+
+// ORDER BY, LIMIT, OFFSET, COUNT() and aggregate functions
+func ExampleSoqlAggregates() {
+	setup()
+	os.Args = []string{"land", "run", "--database", "memory", "-a", "SoqlRunner#aggregates", "-d", "fixtures/soql"}
+	main()
+	// Output:
+	// 4
+	// c
+	// b
+	// a
+	// Closed Won
+	// 2
+	// 150.000000
+	// 100.000000
+	// Prospecting
+	// 2
+	// 30.000000
+	// 30.000000
+}
+
+// parent relationship paths and child relationship subqueries
+func ExampleSoqlRelations() {
+	setup()
+	os.Args = []string{"land", "run", "--database", "memory", "-a", "SoqlRunner#relations", "-d", "fixtures/soql"}
+	main()
+	// Output:
+	// 2
+	// child
+	// parent
+	// parent
+	// 2
+	// b
+	// a
+	// 1
+	// a
+	// 0
+}
+
+// semi-join, anti-join, IN, NOT IN, LIKE, INCLUDES and EXCLUDES
+func ExampleSoqlConditions() {
+	setup()
+	os.Args = []string{"land", "run", "--database", "memory", "-a", "SoqlRunner#conditions", "-d", "fixtures/soql"}
+	main()
+	// Output:
+	// 2
+	// Empty
+	// Empty
+	// Other
+	// a
+	// b
+}
+
+// date literals and date functions are evaluated at the frozen current time
+func ExampleSoqlDates() {
+	setup()
+	defer builtin.ResetNow()
+	os.Args = []string{"land", "run", "--database", "memory", "-a", "SoqlRunner#dates", "-d", "fixtures/soql", "--now", "2019-05-15T10:00:00Z"}
+	main()
+	// Output:
+	// 2019
+	// 1
+	// 0
+	// 1
+	// 1
+	// 2019
+	// 1
+}
+
+// the search group limits the fields which the search term matches
+func ExampleSoqlSearch() {
+	setup()
+	os.Args = []string{"land", "run", "--database", "memory", "-a", "SoqlRunner#search", "-d", "fixtures/soql"}
+	main()
+	// Output:
+	// 2
+	// 1
+	// 1
+	// Acme
+	// 0
+	// Smith
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParallel(t *testing.T) {
+	f, err := ioutil.TempFile("", "land_parallel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+	if err := newApp().Run([]string{"land", "db:create", "--database", f.Name()}); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Database string
+		Parallel string
+	}{
+		{"memory", "1"},
+		{"memory", "4"},
+		{f.Name(), "1"},
+		{f.Name(), "4"},
+	}
+	for _, testCase := range testCases {
+		// each test method sees only its own records and static fields, even if the other classes run at the same time
+		report, err := runLandTest(t, "-d", "fixtures/parallel", "--database", testCase.Database, "--parallel", testCase.Parallel)
+		if err != nil {
+			t.Errorf("%s, parallel %s: expected no error, actual %v", testCase.Database, testCase.Parallel, err)
+		}
+		if report.Tests != 8 || report.Passed != 8 {
+			t.Errorf("%s, parallel %s: expected 8 passed tests, actual %d of %d", testCase.Database, testCase.Parallel, report.Passed, report.Tests)
+		}
+		// the classes are reported in the order of the files regardless of the order they finish
+		names := []string{}
+		for _, class := range report.Classes {
+			names = append(names, class.Name)
+		}
+		expected := []string{"ParallelATest", "ParallelBTest", "ParallelCTest", "ParallelDTest"}
+		if strings.Join(names, ",") != strings.Join(expected, ",") {
+			t.Errorf("%s, parallel %s: expected %v, actual %v", testCase.Database, testCase.Parallel, expected, names)
+		}
+	}
+}
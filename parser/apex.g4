@@ -405,10 +405,15 @@ whenStatement
     ;
 
 whenExpression
-    :   literal (',' literal)*
+    :   whenValue (',' whenValue)*
     |   apexType apexIdentifier
     ;
 
+whenValue
+    :   literal
+    |   apexIdentifier
+    ;
+
 forControl
     :   enhancedForControl
     |   forInit? ';' expression? ';' forUpdate?
@@ -507,6 +512,7 @@ primary
     |   soqlLiteral
     |   soslLiteral
     |   primitiveType
+    |   TRIGGER '.' (NEW | apexIdentifier)
     ;
 
 creator
@@ -605,6 +611,7 @@ query
       limitClause?
       offsetClause?
       viewClause?
+      allRowsClause?
     ;
 
 selectClause
@@ -616,7 +623,7 @@ fieldList
     ;
 
 selectField
-    : soqlField
+    : soqlField alias=apexIdentifier?
     | subquery
     | TYPEOF soqlField
       (WHEN apexIdentifier THEN fieldList)+
@@ -638,7 +645,7 @@ soqlField
     ;
 
 subquery
-    : query
+    : '(' query ')'
     ;
 
 whereClause
@@ -654,27 +661,36 @@ whereField
     :
        SOQL_NOT?
        soqlField
-       op=(
-         '='
-         | '<'
-         | '>'
-         | '<='
-         | '>='
-         | '!='
-         | '<>'
-         | LIKE
-         | IN
-       )
+       soqlOperator
        soqlValue
     |  '(' whereFields ')'
     ;
 
+soqlOperator
+    :  '='
+    |  '<'
+    |  '>'
+    |  '<='
+    |  '>='
+    |  '!='
+    |  '<>'
+    |  LIKE
+    |  IN
+    |  SOQL_NOT IN
+    |  INCLUDES
+    |  EXCLUDES
+    ;
+
 limitClause
     :  LIMIT (IntegerLiteral | bindVariable)
     ;
 
 orderClause
-    :  ORDER BY soqlField (',' soqlField)* asc_desc=(ASC | DESC)? (NULLS nulls=(LAST | FIRST))?
+    :  ORDER BY orderField (',' orderField)*
+    ;
+
+orderField
+    :  soqlField asc_desc=(ASC | DESC)? (NULLS nulls=(LAST | FIRST))?
     ;
 
 bindVariable
@@ -684,7 +700,14 @@ bindVariable
 soqlValue
     :  literal
     |  bindVariable
+    |  subquery
+    |  soqlValueList
     |  apexIdentifier COLON literal
+    |  apexIdentifier
+    ;
+
+soqlValueList
+    :  '(' literal (',' literal)* ')'
     ;
 
 withClause
@@ -711,6 +734,10 @@ viewClause
     : FOR (VIEW | REFERENCE) (UPDATE (TRACKING | VIEWSTAT))?
     ;
 
+allRowsClause
+    : ALL ROWS
+    ;
+
 // Apex - SOSL literal
 
 soslLiteral
@@ -718,7 +745,7 @@ soslLiteral
 	;
 
 soslQuery
-    : FIND literal IN ALL FIELDS RETURNING soslReturningObject (',' soslReturningObject)*
+    : FIND literal (IN searchGroup=(ALL | Identifier) FIELDS)? RETURNING soslReturningObject (',' soslReturningObject)*
     ;
 
 soslReturningObject
@@ -743,6 +770,9 @@ apexIdentifier
     |  FIND
     |  RETURNING
     |  ALL
+    |  ROWS
+    |  INCLUDES
+    |  EXCLUDES
     |  FIELDS
     |  RUNAS
     |  SYSTEM
@@ -763,6 +793,9 @@ typeIdentifier
     |  FIND
     |  RETURNING
     |  ALL
+    |  ROWS
+    |  INCLUDES
+    |  EXCLUDES
     |  FIELDS
     |  SYSTEM
     ;
@@ -856,6 +889,8 @@ SCOPE         : S C O P E;
 ROLLUP        : R O L L U P;
 CUBE          : C U B E;
 LIKE          : L I K E;
+INCLUDES      : I N C L U D E S;
+EXCLUDES      : E X C L U D E S;
 THEN          : T H E N;
 INSERT     : I N S E R T;
 UPSERT     : U P S E R T;
@@ -869,6 +904,7 @@ FIND       : F I N D;
 FIELDS     : F I E L D S;
 RETURNING  : R E T U R N I N G;
 ALL        : A L L;
+ROWS       : R O W S;
 TESTMETHOD   : T E S T M E T H O D;
 TRIGGER       : T R I G G E R;
 ON            : O N;
@@ -445,6 +445,12 @@ func (s *BaseapexListener) EnterWhenExpression(ctx *WhenExpressionContext) {}
 // ExitWhenExpression is called when production whenExpression is exited.
 func (s *BaseapexListener) ExitWhenExpression(ctx *WhenExpressionContext) {}
 
+// EnterWhenValue is called when production whenValue is entered.
+func (s *BaseapexListener) EnterWhenValue(ctx *WhenValueContext) {}
+
+// ExitWhenValue is called when production whenValue is exited.
+func (s *BaseapexListener) ExitWhenValue(ctx *WhenValueContext) {}
+
 // EnterForControl is called when production forControl is entered.
 func (s *BaseapexListener) EnterForControl(ctx *ForControlContext) {}
 
@@ -767,6 +773,12 @@ func (s *BaseapexListener) EnterWhereField(ctx *WhereFieldContext) {}
 // ExitWhereField is called when production whereField is exited.
 func (s *BaseapexListener) ExitWhereField(ctx *WhereFieldContext) {}
 
+// EnterSoqlOperator is called when production soqlOperator is entered.
+func (s *BaseapexListener) EnterSoqlOperator(ctx *SoqlOperatorContext) {}
+
+// ExitSoqlOperator is called when production soqlOperator is exited.
+func (s *BaseapexListener) ExitSoqlOperator(ctx *SoqlOperatorContext) {}
+
 // EnterLimitClause is called when production limitClause is entered.
 func (s *BaseapexListener) EnterLimitClause(ctx *LimitClauseContext) {}
 
@@ -779,6 +791,12 @@ func (s *BaseapexListener) EnterOrderClause(ctx *OrderClauseContext) {}
 // ExitOrderClause is called when production orderClause is exited.
 func (s *BaseapexListener) ExitOrderClause(ctx *OrderClauseContext) {}
 
+// EnterOrderField is called when production orderField is entered.
+func (s *BaseapexListener) EnterOrderField(ctx *OrderFieldContext) {}
+
+// ExitOrderField is called when production orderField is exited.
+func (s *BaseapexListener) ExitOrderField(ctx *OrderFieldContext) {}
+
 // EnterBindVariable is called when production bindVariable is entered.
 func (s *BaseapexListener) EnterBindVariable(ctx *BindVariableContext) {}
 
@@ -791,6 +809,12 @@ func (s *BaseapexListener) EnterSoqlValue(ctx *SoqlValueContext) {}
 // ExitSoqlValue is called when production soqlValue is exited.
 func (s *BaseapexListener) ExitSoqlValue(ctx *SoqlValueContext) {}
 
+// EnterSoqlValueList is called when production soqlValueList is entered.
+func (s *BaseapexListener) EnterSoqlValueList(ctx *SoqlValueListContext) {}
+
+// ExitSoqlValueList is called when production soqlValueList is exited.
+func (s *BaseapexListener) ExitSoqlValueList(ctx *SoqlValueListContext) {}
+
 // EnterWithClause is called when production withClause is entered.
 func (s *BaseapexListener) EnterWithClause(ctx *WithClauseContext) {}
 
@@ -827,6 +851,12 @@ func (s *BaseapexListener) EnterViewClause(ctx *ViewClauseContext) {}
 // ExitViewClause is called when production viewClause is exited.
 func (s *BaseapexListener) ExitViewClause(ctx *ViewClauseContext) {}
 
+// EnterAllRowsClause is called when production allRowsClause is entered.
+func (s *BaseapexListener) EnterAllRowsClause(ctx *AllRowsClauseContext) {}
+
+// ExitAllRowsClause is called when production allRowsClause is exited.
+func (s *BaseapexListener) ExitAllRowsClause(ctx *AllRowsClauseContext) {}
+
 // EnterSoslLiteral is called when production soslLiteral is entered.
 func (s *BaseapexListener) EnterSoslLiteral(ctx *SoslLiteralContext) {}
 
@@ -288,6 +288,10 @@ func (v *BaseapexVisitor) VisitWhenExpression(ctx *WhenExpressionContext) interf
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseapexVisitor) VisitWhenValue(ctx *WhenValueContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseapexVisitor) VisitForControl(ctx *ForControlContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -500,6 +504,10 @@ func (v *BaseapexVisitor) VisitWhereField(ctx *WhereFieldContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseapexVisitor) VisitSoqlOperator(ctx *SoqlOperatorContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseapexVisitor) VisitLimitClause(ctx *LimitClauseContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -508,6 +516,10 @@ func (v *BaseapexVisitor) VisitOrderClause(ctx *OrderClauseContext) interface{}
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseapexVisitor) VisitOrderField(ctx *OrderFieldContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseapexVisitor) VisitBindVariable(ctx *BindVariableContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -516,6 +528,10 @@ func (v *BaseapexVisitor) VisitSoqlValue(ctx *SoqlValueContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseapexVisitor) VisitSoqlValueList(ctx *SoqlValueListContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseapexVisitor) VisitWithClause(ctx *WithClauseContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -540,6 +556,10 @@ func (v *BaseapexVisitor) VisitViewClause(ctx *ViewClauseContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseapexVisitor) VisitAllRowsClause(ctx *AllRowsClauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseapexVisitor) VisitSoslLiteral(ctx *SoslLiteralContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -14,7 +14,7 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 166, 1655,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 169, 1684,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
 	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
@@ -63,725 +63,738 @@ var serializedLexerAtn = []uint16{
 	4, 219, 9, 219, 4, 220, 9, 220, 4, 221, 9, 221, 4, 222, 9, 222, 4, 223,
 	9, 223, 4, 224, 9, 224, 4, 225, 9, 225, 4, 226, 9, 226, 4, 227, 9, 227,
 	4, 228, 9, 228, 4, 229, 9, 229, 4, 230, 9, 230, 4, 231, 9, 231, 4, 232,
-	9, 232, 4, 233, 9, 233, 3, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4,
-	3, 5, 3, 5, 3, 5, 3, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 7,
-	3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8,
-	3, 8, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3,
-	10, 3, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12,
-	3, 12, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3,
-	13, 3, 13, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 15,
-	3, 15, 3, 15, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3,
-	17, 3, 17, 3, 17, 3, 17, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 19, 3, 19,
-	3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 20, 3,
-	20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 22,
-	3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 23, 3, 23, 3, 23, 3, 23, 3, 24, 3,
-	24, 3, 24, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 26, 3, 26, 3, 26, 3, 26,
-	3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 27, 3, 27, 3, 27, 3,
-	27, 3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28,
-	3, 28, 3, 28, 3, 28, 3, 28, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3,
-	29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 31, 3, 31,
-	3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3,
-	32, 3, 32, 3, 32, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 34,
-	3, 34, 3, 34, 3, 34, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3,
-	35, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 37, 3, 37,
-	3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 38, 3, 38, 3,
-	38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39,
-	3, 39, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 41, 3, 41, 3,
-	41, 3, 41, 3, 41, 3, 41, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42,
-	3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 43, 3, 43, 3, 43, 3, 43, 3,
-	43, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 45, 3, 45, 3, 45, 3, 45,
-	3, 45, 3, 45, 3, 45, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3,
-	46, 3, 46, 3, 46, 3, 47, 3, 47, 3, 47, 3, 47, 3, 48, 3, 48, 3, 48, 3, 48,
-	3, 48, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3,
-	50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51,
-	3, 51, 3, 51, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 53, 3, 53, 3, 53, 3,
-	53, 3, 53, 3, 53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54,
-	3, 54, 3, 54, 3, 54, 3, 54, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3,
-	55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 56, 3, 56, 3, 56, 3, 56,
-	3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3,
-	56, 3, 56, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 58, 3, 58,
-	3, 58, 3, 58, 3, 58, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 60, 3,
-	60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61,
-	3, 62, 3, 62, 3, 62, 3, 63, 3, 63, 3, 63, 3, 63, 3, 64, 3, 64, 3, 64, 3,
-	64, 3, 64, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 66, 3, 66, 3, 66, 3, 66,
-	3, 66, 3, 66, 3, 66, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3,
-	67, 3, 67, 3, 67, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 69, 3, 69, 3, 69,
-	3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 70, 3, 70, 3, 70, 3, 70, 3,
-	70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71,
-	3, 71, 3, 72, 3, 72, 3, 72, 3, 73, 3, 73, 3, 73, 3, 73, 3, 74, 3, 74, 3,
-	74, 3, 74, 3, 74, 3, 74, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 76, 3, 76,
-	3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 77, 3, 77, 3, 77, 3,
-	77, 3, 77, 3, 77, 3, 78, 3, 78, 3, 78, 3, 78, 3, 78, 3, 78, 3, 78, 3, 79,
-	3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3,
-	80, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82,
-	3, 82, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 84, 3, 84, 3,
-	84, 3, 84, 3, 84, 3, 85, 3, 85, 3, 85, 3, 85, 3, 85, 3, 86, 3, 86, 3, 86,
-	3, 86, 3, 86, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 88, 3,
-	88, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89,
-	3, 89, 3, 89, 3, 90, 3, 90, 3, 90, 3, 90, 3, 90, 3, 90, 3, 90, 3, 91, 3,
-	91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 92, 3, 92, 3, 92,
-	3, 92, 3, 93, 3, 93, 3, 93, 3, 94, 3, 94, 3, 94, 3, 94, 3, 95, 3, 95, 3,
-	95, 3, 95, 3, 95, 3, 96, 3, 96, 3, 96, 3, 96, 3, 96, 3, 96, 3, 96, 3, 97,
-	3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 98, 3,
-	98, 3, 98, 3, 98, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99,
-	3, 99, 3, 99, 3, 99, 3, 100, 3, 100, 3, 100, 3, 100, 3, 100, 3, 100, 3,
-	100, 3, 100, 3, 101, 3, 101, 3, 101, 3, 102, 3, 102, 3, 102, 3, 102, 3,
-	102, 3, 102, 3, 102, 3, 103, 3, 103, 3, 103, 3, 103, 3, 103, 3, 103, 3,
-	104, 3, 104, 3, 104, 3, 104, 3, 104, 3, 104, 3, 105, 3, 105, 3, 105, 3,
-	105, 3, 105, 3, 105, 3, 105, 3, 106, 3, 106, 3, 106, 3, 106, 5, 106, 1166,
-	10, 106, 3, 107, 3, 107, 5, 107, 1170, 10, 107, 3, 108, 3, 108, 5, 108,
-	1174, 10, 108, 3, 109, 3, 109, 5, 109, 1178, 10, 109, 3, 110, 3, 110, 5,
-	110, 1182, 10, 110, 3, 111, 3, 111, 3, 112, 3, 112, 3, 113, 3, 113, 7,
-	113, 1190, 10, 113, 12, 113, 14, 113, 1193, 11, 113, 3, 113, 5, 113, 1196,
-	10, 113, 3, 114, 3, 114, 5, 114, 1200, 10, 114, 3, 115, 3, 115, 3, 116,
-	3, 116, 5, 116, 1206, 10, 116, 3, 117, 6, 117, 1209, 10, 117, 13, 117,
-	14, 117, 1210, 3, 118, 3, 118, 3, 118, 3, 118, 3, 119, 3, 119, 7, 119,
-	1219, 10, 119, 12, 119, 14, 119, 1222, 11, 119, 3, 119, 5, 119, 1225, 10,
-	119, 3, 120, 3, 120, 3, 121, 3, 121, 5, 121, 1231, 10, 121, 3, 122, 3,
-	122, 5, 122, 1235, 10, 122, 3, 122, 3, 122, 3, 123, 3, 123, 7, 123, 1241,
-	10, 123, 12, 123, 14, 123, 1244, 11, 123, 3, 123, 5, 123, 1247, 10, 123,
-	3, 124, 3, 124, 3, 125, 3, 125, 5, 125, 1253, 10, 125, 3, 126, 3, 126,
-	3, 126, 3, 126, 3, 127, 3, 127, 7, 127, 1261, 10, 127, 12, 127, 14, 127,
-	1264, 11, 127, 3, 127, 5, 127, 1267, 10, 127, 3, 128, 3, 128, 3, 129, 3,
-	129, 5, 129, 1273, 10, 129, 3, 130, 3, 130, 5, 130, 1277, 10, 130, 3, 131,
-	3, 131, 3, 131, 5, 131, 1282, 10, 131, 3, 131, 5, 131, 1285, 10, 131, 3,
-	131, 5, 131, 1288, 10, 131, 3, 131, 3, 131, 3, 131, 5, 131, 1293, 10, 131,
-	3, 131, 5, 131, 1296, 10, 131, 3, 131, 3, 131, 3, 131, 5, 131, 1301, 10,
-	131, 3, 131, 3, 131, 3, 131, 5, 131, 1306, 10, 131, 3, 132, 3, 132, 3,
-	132, 3, 133, 3, 133, 3, 134, 5, 134, 1314, 10, 134, 3, 134, 3, 134, 3,
-	135, 3, 135, 3, 136, 3, 136, 3, 137, 3, 137, 3, 137, 5, 137, 1325, 10,
-	137, 3, 138, 3, 138, 5, 138, 1329, 10, 138, 3, 138, 3, 138, 3, 138, 5,
-	138, 1334, 10, 138, 3, 138, 3, 138, 5, 138, 1338, 10, 138, 3, 139, 3, 139,
-	3, 139, 3, 140, 3, 140, 3, 141, 3, 141, 3, 141, 3, 141, 3, 141, 3, 141,
-	3, 141, 3, 141, 3, 141, 3, 141, 3, 141, 5, 141, 1356, 10, 141, 3, 142,
-	3, 142, 5, 142, 1360, 10, 142, 3, 142, 3, 142, 3, 143, 6, 143, 1365, 10,
-	143, 13, 143, 14, 143, 1366, 3, 144, 3, 144, 5, 144, 1371, 10, 144, 3,
-	145, 3, 145, 3, 145, 3, 145, 5, 145, 1377, 10, 145, 3, 146, 3, 146, 3,
-	146, 3, 146, 3, 146, 3, 146, 3, 146, 3, 146, 3, 146, 3, 146, 3, 146, 5,
-	146, 1390, 10, 146, 3, 147, 3, 147, 3, 147, 3, 147, 3, 147, 3, 147, 3,
-	147, 3, 148, 3, 148, 3, 149, 3, 149, 3, 149, 3, 149, 3, 149, 3, 150, 3,
-	150, 3, 151, 3, 151, 3, 152, 3, 152, 3, 153, 3, 153, 3, 154, 3, 154, 3,
-	155, 3, 155, 3, 156, 3, 156, 3, 157, 3, 157, 3, 158, 3, 158, 3, 159, 3,
-	159, 3, 160, 3, 160, 3, 161, 3, 161, 3, 162, 3, 162, 3, 163, 3, 163, 3,
-	164, 3, 164, 3, 165, 3, 165, 3, 166, 3, 166, 3, 166, 3, 167, 3, 167, 3,
-	167, 3, 167, 3, 168, 3, 168, 3, 168, 3, 169, 3, 169, 3, 169, 3, 170, 3,
-	170, 3, 170, 3, 171, 3, 171, 3, 171, 3, 172, 3, 172, 3, 172, 3, 173, 3,
-	173, 3, 173, 3, 174, 3, 174, 3, 174, 3, 175, 3, 175, 3, 176, 3, 176, 3,
-	177, 3, 177, 3, 178, 3, 178, 3, 179, 3, 179, 3, 180, 3, 180, 3, 181, 3,
-	181, 3, 182, 3, 182, 3, 183, 3, 183, 3, 183, 3, 184, 3, 184, 3, 184, 3,
-	185, 3, 185, 3, 185, 3, 186, 3, 186, 3, 186, 3, 187, 3, 187, 3, 187, 3,
-	188, 3, 188, 3, 188, 3, 189, 3, 189, 3, 189, 3, 190, 3, 190, 3, 190, 3,
-	191, 3, 191, 3, 191, 3, 191, 3, 192, 3, 192, 3, 192, 3, 192, 3, 193, 3,
-	193, 3, 193, 3, 193, 3, 193, 3, 194, 3, 194, 3, 194, 3, 195, 3, 195, 7,
-	195, 1524, 10, 195, 12, 195, 14, 195, 1527, 11, 195, 3, 196, 3, 196, 3,
-	197, 3, 197, 3, 198, 3, 198, 3, 199, 3, 199, 3, 199, 3, 199, 3, 200, 6,
-	200, 1540, 10, 200, 13, 200, 14, 200, 1541, 3, 200, 3, 200, 3, 201, 3,
-	201, 3, 201, 3, 201, 3, 201, 3, 201, 7, 201, 1552, 10, 201, 12, 201, 14,
-	201, 1555, 11, 201, 3, 201, 3, 201, 3, 201, 3, 201, 3, 201, 3, 202, 3,
-	202, 3, 202, 3, 202, 3, 202, 3, 202, 3, 203, 3, 203, 3, 203, 3, 203, 7,
-	203, 1572, 10, 203, 12, 203, 14, 203, 1575, 11, 203, 3, 203, 3, 203, 3,
-	203, 3, 203, 3, 203, 3, 204, 3, 204, 3, 204, 3, 204, 3, 204, 3, 205, 3,
-	205, 3, 205, 3, 205, 7, 205, 1591, 10, 205, 12, 205, 14, 205, 1594, 11,
-	205, 3, 205, 3, 205, 3, 206, 3, 206, 3, 206, 3, 206, 3, 207, 3, 207, 3,
-	208, 3, 208, 3, 209, 3, 209, 3, 210, 3, 210, 3, 211, 3, 211, 3, 212, 3,
-	212, 3, 213, 3, 213, 3, 214, 3, 214, 3, 215, 3, 215, 3, 216, 3, 216, 3,
-	217, 3, 217, 3, 218, 3, 218, 3, 219, 3, 219, 3, 220, 3, 220, 3, 221, 3,
-	221, 3, 222, 3, 222, 3, 223, 3, 223, 3, 224, 3, 224, 3, 225, 3, 225, 3,
-	226, 3, 226, 3, 227, 3, 227, 3, 228, 3, 228, 3, 229, 3, 229, 3, 230, 3,
-	230, 3, 231, 3, 231, 3, 232, 3, 232, 3, 233, 3, 233, 4, 1553, 1573, 2,
-	234, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12,
-	23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21,
-	41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55, 29, 57, 30,
-	59, 31, 61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71, 37, 73, 38, 75, 39,
-	77, 40, 79, 41, 81, 42, 83, 43, 85, 44, 87, 45, 89, 46, 91, 47, 93, 48,
-	95, 49, 97, 50, 99, 51, 101, 52, 103, 53, 105, 54, 107, 55, 109, 56, 111,
-	57, 113, 58, 115, 59, 117, 60, 119, 61, 121, 62, 123, 63, 125, 64, 127,
-	65, 129, 66, 131, 67, 133, 68, 135, 69, 137, 70, 139, 71, 141, 72, 143,
-	73, 145, 74, 147, 75, 149, 76, 151, 77, 153, 78, 155, 79, 157, 80, 159,
-	81, 161, 82, 163, 83, 165, 84, 167, 85, 169, 86, 171, 87, 173, 88, 175,
-	89, 177, 90, 179, 91, 181, 92, 183, 93, 185, 94, 187, 95, 189, 96, 191,
-	97, 193, 98, 195, 99, 197, 100, 199, 101, 201, 102, 203, 103, 205, 104,
-	207, 105, 209, 106, 211, 107, 213, 2, 215, 2, 217, 2, 219, 2, 221, 2, 223,
-	2, 225, 2, 227, 2, 229, 2, 231, 2, 233, 2, 235, 2, 237, 2, 239, 2, 241,
-	2, 243, 2, 245, 2, 247, 2, 249, 2, 251, 2, 253, 2, 255, 2, 257, 2, 259,
-	108, 261, 2, 263, 2, 265, 2, 267, 2, 269, 2, 271, 2, 273, 2, 275, 2, 277,
-	2, 279, 2, 281, 109, 283, 110, 285, 2, 287, 2, 289, 2, 291, 2, 293, 2,
-	295, 2, 297, 111, 299, 112, 301, 113, 303, 114, 305, 115, 307, 116, 309,
-	117, 311, 118, 313, 119, 315, 120, 317, 121, 319, 122, 321, 123, 323, 124,
-	325, 125, 327, 126, 329, 127, 331, 128, 333, 129, 335, 130, 337, 131, 339,
-	132, 341, 133, 343, 134, 345, 135, 347, 136, 349, 137, 351, 138, 353, 139,
-	355, 140, 357, 141, 359, 142, 361, 143, 363, 144, 365, 145, 367, 146, 369,
-	147, 371, 148, 373, 149, 375, 150, 377, 151, 379, 152, 381, 153, 383, 154,
-	385, 155, 387, 156, 389, 157, 391, 2, 393, 2, 395, 158, 397, 159, 399,
-	160, 401, 161, 403, 162, 405, 163, 407, 164, 409, 165, 411, 166, 413, 2,
-	415, 2, 417, 2, 419, 2, 421, 2, 423, 2, 425, 2, 427, 2, 429, 2, 431, 2,
-	433, 2, 435, 2, 437, 2, 439, 2, 441, 2, 443, 2, 445, 2, 447, 2, 449, 2,
-	451, 2, 453, 2, 455, 2, 457, 2, 459, 2, 461, 2, 463, 2, 465, 2, 3, 2, 41,
-	4, 2, 78, 78, 110, 110, 3, 2, 51, 59, 4, 2, 90, 90, 122, 122, 5, 2, 50,
-	59, 67, 72, 99, 104, 3, 2, 50, 57, 4, 2, 68, 68, 100, 100, 3, 2, 50, 51,
-	4, 2, 71, 71, 103, 103, 4, 2, 45, 45, 47, 47, 6, 2, 70, 70, 72, 72, 102,
-	102, 104, 104, 4, 2, 82, 82, 114, 114, 4, 2, 41, 41, 94, 94, 10, 2, 36,
-	36, 41, 41, 94, 94, 100, 100, 104, 104, 112, 112, 116, 116, 118, 118, 3,
-	2, 50, 53, 6, 2, 38, 38, 67, 92, 97, 97, 99, 124, 7, 2, 38, 38, 50, 59,
-	67, 92, 97, 97, 99, 124, 5, 2, 11, 12, 14, 15, 34, 34, 4, 2, 12, 12, 15,
-	15, 4, 2, 67, 67, 99, 99, 4, 2, 69, 69, 101, 101, 4, 2, 70, 70, 102, 102,
-	4, 2, 72, 72, 104, 104, 4, 2, 73, 73, 105, 105, 4, 2, 74, 74, 106, 106,
-	4, 2, 75, 75, 107, 107, 4, 2, 76, 76, 108, 108, 4, 2, 77, 77, 109, 109,
-	4, 2, 79, 79, 111, 111, 4, 2, 80, 80, 112, 112, 4, 2, 81, 81, 113, 113,
-	4, 2, 83, 83, 115, 115, 4, 2, 84, 84, 116, 116, 4, 2, 85, 85, 117, 117,
-	4, 2, 86, 86, 118, 118, 4, 2, 87, 87, 119, 119, 4, 2, 88, 88, 120, 120,
-	4, 2, 89, 89, 121, 121, 4, 2, 91, 91, 123, 123, 4, 2, 92, 92, 124, 124,
-	2, 1636, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3,
-	2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17,
-	3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2,
-	25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2,
-	2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2,
-	2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2,
-	2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3,
-	2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63,
-	3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2,
-	71, 3, 2, 2, 2, 2, 73, 3, 2, 2, 2, 2, 75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2,
-	2, 79, 3, 2, 2, 2, 2, 81, 3, 2, 2, 2, 2, 83, 3, 2, 2, 2, 2, 85, 3, 2, 2,
-	2, 2, 87, 3, 2, 2, 2, 2, 89, 3, 2, 2, 2, 2, 91, 3, 2, 2, 2, 2, 93, 3, 2,
-	2, 2, 2, 95, 3, 2, 2, 2, 2, 97, 3, 2, 2, 2, 2, 99, 3, 2, 2, 2, 2, 101,
-	3, 2, 2, 2, 2, 103, 3, 2, 2, 2, 2, 105, 3, 2, 2, 2, 2, 107, 3, 2, 2, 2,
-	2, 109, 3, 2, 2, 2, 2, 111, 3, 2, 2, 2, 2, 113, 3, 2, 2, 2, 2, 115, 3,
-	2, 2, 2, 2, 117, 3, 2, 2, 2, 2, 119, 3, 2, 2, 2, 2, 121, 3, 2, 2, 2, 2,
-	123, 3, 2, 2, 2, 2, 125, 3, 2, 2, 2, 2, 127, 3, 2, 2, 2, 2, 129, 3, 2,
-	2, 2, 2, 131, 3, 2, 2, 2, 2, 133, 3, 2, 2, 2, 2, 135, 3, 2, 2, 2, 2, 137,
-	3, 2, 2, 2, 2, 139, 3, 2, 2, 2, 2, 141, 3, 2, 2, 2, 2, 143, 3, 2, 2, 2,
-	2, 145, 3, 2, 2, 2, 2, 147, 3, 2, 2, 2, 2, 149, 3, 2, 2, 2, 2, 151, 3,
-	2, 2, 2, 2, 153, 3, 2, 2, 2, 2, 155, 3, 2, 2, 2, 2, 157, 3, 2, 2, 2, 2,
-	159, 3, 2, 2, 2, 2, 161, 3, 2, 2, 2, 2, 163, 3, 2, 2, 2, 2, 165, 3, 2,
-	2, 2, 2, 167, 3, 2, 2, 2, 2, 169, 3, 2, 2, 2, 2, 171, 3, 2, 2, 2, 2, 173,
-	3, 2, 2, 2, 2, 175, 3, 2, 2, 2, 2, 177, 3, 2, 2, 2, 2, 179, 3, 2, 2, 2,
-	2, 181, 3, 2, 2, 2, 2, 183, 3, 2, 2, 2, 2, 185, 3, 2, 2, 2, 2, 187, 3,
-	2, 2, 2, 2, 189, 3, 2, 2, 2, 2, 191, 3, 2, 2, 2, 2, 193, 3, 2, 2, 2, 2,
-	195, 3, 2, 2, 2, 2, 197, 3, 2, 2, 2, 2, 199, 3, 2, 2, 2, 2, 201, 3, 2,
-	2, 2, 2, 203, 3, 2, 2, 2, 2, 205, 3, 2, 2, 2, 2, 207, 3, 2, 2, 2, 2, 209,
-	3, 2, 2, 2, 2, 211, 3, 2, 2, 2, 2, 259, 3, 2, 2, 2, 2, 281, 3, 2, 2, 2,
-	2, 283, 3, 2, 2, 2, 2, 297, 3, 2, 2, 2, 2, 299, 3, 2, 2, 2, 2, 301, 3,
-	2, 2, 2, 2, 303, 3, 2, 2, 2, 2, 305, 3, 2, 2, 2, 2, 307, 3, 2, 2, 2, 2,
-	309, 3, 2, 2, 2, 2, 311, 3, 2, 2, 2, 2, 313, 3, 2, 2, 2, 2, 315, 3, 2,
-	2, 2, 2, 317, 3, 2, 2, 2, 2, 319, 3, 2, 2, 2, 2, 321, 3, 2, 2, 2, 2, 323,
-	3, 2, 2, 2, 2, 325, 3, 2, 2, 2, 2, 327, 3, 2, 2, 2, 2, 329, 3, 2, 2, 2,
-	2, 331, 3, 2, 2, 2, 2, 333, 3, 2, 2, 2, 2, 335, 3, 2, 2, 2, 2, 337, 3,
-	2, 2, 2, 2, 339, 3, 2, 2, 2, 2, 341, 3, 2, 2, 2, 2, 343, 3, 2, 2, 2, 2,
-	345, 3, 2, 2, 2, 2, 347, 3, 2, 2, 2, 2, 349, 3, 2, 2, 2, 2, 351, 3, 2,
-	2, 2, 2, 353, 3, 2, 2, 2, 2, 355, 3, 2, 2, 2, 2, 357, 3, 2, 2, 2, 2, 359,
-	3, 2, 2, 2, 2, 361, 3, 2, 2, 2, 2, 363, 3, 2, 2, 2, 2, 365, 3, 2, 2, 2,
-	2, 367, 3, 2, 2, 2, 2, 369, 3, 2, 2, 2, 2, 371, 3, 2, 2, 2, 2, 373, 3,
-	2, 2, 2, 2, 375, 3, 2, 2, 2, 2, 377, 3, 2, 2, 2, 2, 379, 3, 2, 2, 2, 2,
-	381, 3, 2, 2, 2, 2, 383, 3, 2, 2, 2, 2, 385, 3, 2, 2, 2, 2, 387, 3, 2,
-	2, 2, 2, 389, 3, 2, 2, 2, 2, 395, 3, 2, 2, 2, 2, 397, 3, 2, 2, 2, 2, 399,
-	3, 2, 2, 2, 2, 401, 3, 2, 2, 2, 2, 403, 3, 2, 2, 2, 2, 405, 3, 2, 2, 2,
-	2, 407, 3, 2, 2, 2, 2, 409, 3, 2, 2, 2, 2, 411, 3, 2, 2, 2, 3, 467, 3,
-	2, 2, 2, 5, 470, 3, 2, 2, 2, 7, 479, 3, 2, 2, 2, 9, 487, 3, 2, 2, 2, 11,
-	491, 3, 2, 2, 2, 13, 495, 3, 2, 2, 2, 15, 504, 3, 2, 2, 2, 17, 512, 3,
-	2, 2, 2, 19, 518, 3, 2, 2, 2, 21, 524, 3, 2, 2, 2, 23, 530, 3, 2, 2, 2,
-	25, 536, 3, 2, 2, 2, 27, 545, 3, 2, 2, 2, 29, 553, 3, 2, 2, 2, 31, 556,
-	3, 2, 2, 2, 33, 563, 3, 2, 2, 2, 35, 568, 3, 2, 2, 2, 37, 573, 3, 2, 2,
-	2, 39, 581, 3, 2, 2, 2, 41, 587, 3, 2, 2, 2, 43, 595, 3, 2, 2, 2, 45, 601,
-	3, 2, 2, 2, 47, 605, 3, 2, 2, 2, 49, 608, 3, 2, 2, 2, 51, 613, 3, 2, 2,
-	2, 53, 624, 3, 2, 2, 2, 55, 631, 3, 2, 2, 2, 57, 642, 3, 2, 2, 2, 59, 650,
-	3, 2, 2, 2, 61, 657, 3, 2, 2, 2, 63, 667, 3, 2, 2, 2, 65, 672, 3, 2, 2,
-	2, 67, 679, 3, 2, 2, 2, 69, 683, 3, 2, 2, 2, 71, 691, 3, 2, 2, 2, 73, 699,
-	3, 2, 2, 2, 75, 709, 3, 2, 2, 2, 77, 716, 3, 2, 2, 2, 79, 723, 3, 2, 2,
-	2, 81, 730, 3, 2, 2, 2, 83, 736, 3, 2, 2, 2, 85, 749, 3, 2, 2, 2, 87, 754,
-	3, 2, 2, 2, 89, 760, 3, 2, 2, 2, 91, 767, 3, 2, 2, 2, 93, 777, 3, 2, 2,
-	2, 95, 781, 3, 2, 2, 2, 97, 786, 3, 2, 2, 2, 99, 795, 3, 2, 2, 2, 101,
-	801, 3, 2, 2, 2, 103, 808, 3, 2, 2, 2, 105, 813, 3, 2, 2, 2, 107, 820,
-	3, 2, 2, 2, 109, 831, 3, 2, 2, 2, 111, 844, 3, 2, 2, 2, 113, 860, 3, 2,
-	2, 2, 115, 867, 3, 2, 2, 2, 117, 872, 3, 2, 2, 2, 119, 878, 3, 2, 2, 2,
-	121, 884, 3, 2, 2, 2, 123, 890, 3, 2, 2, 2, 125, 893, 3, 2, 2, 2, 127,
-	897, 3, 2, 2, 2, 129, 902, 3, 2, 2, 2, 131, 907, 3, 2, 2, 2, 133, 914,
-	3, 2, 2, 2, 135, 924, 3, 2, 2, 2, 137, 929, 3, 2, 2, 2, 139, 938, 3, 2,
-	2, 2, 141, 947, 3, 2, 2, 2, 143, 954, 3, 2, 2, 2, 145, 957, 3, 2, 2, 2,
-	147, 961, 3, 2, 2, 2, 149, 967, 3, 2, 2, 2, 151, 972, 3, 2, 2, 2, 153,
-	981, 3, 2, 2, 2, 155, 987, 3, 2, 2, 2, 157, 994, 3, 2, 2, 2, 159, 1000,
-	3, 2, 2, 2, 161, 1006, 3, 2, 2, 2, 163, 1011, 3, 2, 2, 2, 165, 1017, 3,
-	2, 2, 2, 167, 1024, 3, 2, 2, 2, 169, 1029, 3, 2, 2, 2, 171, 1034, 3, 2,
-	2, 2, 173, 1039, 3, 2, 2, 2, 175, 1046, 3, 2, 2, 2, 177, 1053, 3, 2, 2,
-	2, 179, 1060, 3, 2, 2, 2, 181, 1067, 3, 2, 2, 2, 183, 1076, 3, 2, 2, 2,
-	185, 1080, 3, 2, 2, 2, 187, 1083, 3, 2, 2, 2, 189, 1087, 3, 2, 2, 2, 191,
-	1092, 3, 2, 2, 2, 193, 1099, 3, 2, 2, 2, 195, 1109, 3, 2, 2, 2, 197, 1113,
-	3, 2, 2, 2, 199, 1124, 3, 2, 2, 2, 201, 1132, 3, 2, 2, 2, 203, 1135, 3,
-	2, 2, 2, 205, 1142, 3, 2, 2, 2, 207, 1148, 3, 2, 2, 2, 209, 1154, 3, 2,
-	2, 2, 211, 1165, 3, 2, 2, 2, 213, 1167, 3, 2, 2, 2, 215, 1171, 3, 2, 2,
-	2, 217, 1175, 3, 2, 2, 2, 219, 1179, 3, 2, 2, 2, 221, 1183, 3, 2, 2, 2,
-	223, 1185, 3, 2, 2, 2, 225, 1187, 3, 2, 2, 2, 227, 1199, 3, 2, 2, 2, 229,
-	1201, 3, 2, 2, 2, 231, 1205, 3, 2, 2, 2, 233, 1208, 3, 2, 2, 2, 235, 1212,
-	3, 2, 2, 2, 237, 1216, 3, 2, 2, 2, 239, 1226, 3, 2, 2, 2, 241, 1230, 3,
-	2, 2, 2, 243, 1232, 3, 2, 2, 2, 245, 1238, 3, 2, 2, 2, 247, 1248, 3, 2,
-	2, 2, 249, 1252, 3, 2, 2, 2, 251, 1254, 3, 2, 2, 2, 253, 1258, 3, 2, 2,
-	2, 255, 1268, 3, 2, 2, 2, 257, 1272, 3, 2, 2, 2, 259, 1276, 3, 2, 2, 2,
-	261, 1305, 3, 2, 2, 2, 263, 1307, 3, 2, 2, 2, 265, 1310, 3, 2, 2, 2, 267,
-	1313, 3, 2, 2, 2, 269, 1317, 3, 2, 2, 2, 271, 1319, 3, 2, 2, 2, 273, 1321,
-	3, 2, 2, 2, 275, 1337, 3, 2, 2, 2, 277, 1339, 3, 2, 2, 2, 279, 1342, 3,
-	2, 2, 2, 281, 1355, 3, 2, 2, 2, 283, 1357, 3, 2, 2, 2, 285, 1364, 3, 2,
-	2, 2, 287, 1370, 3, 2, 2, 2, 289, 1376, 3, 2, 2, 2, 291, 1389, 3, 2, 2,
-	2, 293, 1391, 3, 2, 2, 2, 295, 1398, 3, 2, 2, 2, 297, 1400, 3, 2, 2, 2,
-	299, 1405, 3, 2, 2, 2, 301, 1407, 3, 2, 2, 2, 303, 1409, 3, 2, 2, 2, 305,
-	1411, 3, 2, 2, 2, 307, 1413, 3, 2, 2, 2, 309, 1415, 3, 2, 2, 2, 311, 1417,
-	3, 2, 2, 2, 313, 1419, 3, 2, 2, 2, 315, 1421, 3, 2, 2, 2, 317, 1423, 3,
-	2, 2, 2, 319, 1425, 3, 2, 2, 2, 321, 1427, 3, 2, 2, 2, 323, 1429, 3, 2,
-	2, 2, 325, 1431, 3, 2, 2, 2, 327, 1433, 3, 2, 2, 2, 329, 1435, 3, 2, 2,
-	2, 331, 1437, 3, 2, 2, 2, 333, 1440, 3, 2, 2, 2, 335, 1444, 3, 2, 2, 2,
-	337, 1447, 3, 2, 2, 2, 339, 1450, 3, 2, 2, 2, 341, 1453, 3, 2, 2, 2, 343,
-	1456, 3, 2, 2, 2, 345, 1459, 3, 2, 2, 2, 347, 1462, 3, 2, 2, 2, 349, 1465,
-	3, 2, 2, 2, 351, 1467, 3, 2, 2, 2, 353, 1469, 3, 2, 2, 2, 355, 1471, 3,
-	2, 2, 2, 357, 1473, 3, 2, 2, 2, 359, 1475, 3, 2, 2, 2, 361, 1477, 3, 2,
-	2, 2, 363, 1479, 3, 2, 2, 2, 365, 1481, 3, 2, 2, 2, 367, 1484, 3, 2, 2,
-	2, 369, 1487, 3, 2, 2, 2, 371, 1490, 3, 2, 2, 2, 373, 1493, 3, 2, 2, 2,
-	375, 1496, 3, 2, 2, 2, 377, 1499, 3, 2, 2, 2, 379, 1502, 3, 2, 2, 2, 381,
-	1505, 3, 2, 2, 2, 383, 1509, 3, 2, 2, 2, 385, 1513, 3, 2, 2, 2, 387, 1518,
-	3, 2, 2, 2, 389, 1521, 3, 2, 2, 2, 391, 1528, 3, 2, 2, 2, 393, 1530, 3,
-	2, 2, 2, 395, 1532, 3, 2, 2, 2, 397, 1534, 3, 2, 2, 2, 399, 1539, 3, 2,
-	2, 2, 401, 1545, 3, 2, 2, 2, 403, 1561, 3, 2, 2, 2, 405, 1567, 3, 2, 2,
-	2, 407, 1581, 3, 2, 2, 2, 409, 1586, 3, 2, 2, 2, 411, 1597, 3, 2, 2, 2,
-	413, 1601, 3, 2, 2, 2, 415, 1603, 3, 2, 2, 2, 417, 1605, 3, 2, 2, 2, 419,
-	1607, 3, 2, 2, 2, 421, 1609, 3, 2, 2, 2, 423, 1611, 3, 2, 2, 2, 425, 1613,
-	3, 2, 2, 2, 427, 1615, 3, 2, 2, 2, 429, 1617, 3, 2, 2, 2, 431, 1619, 3,
-	2, 2, 2, 433, 1621, 3, 2, 2, 2, 435, 1623, 3, 2, 2, 2, 437, 1625, 3, 2,
-	2, 2, 439, 1627, 3, 2, 2, 2, 441, 1629, 3, 2, 2, 2, 443, 1631, 3, 2, 2,
-	2, 445, 1633, 3, 2, 2, 2, 447, 1635, 3, 2, 2, 2, 449, 1637, 3, 2, 2, 2,
-	451, 1639, 3, 2, 2, 2, 453, 1641, 3, 2, 2, 2, 455, 1643, 3, 2, 2, 2, 457,
-	1645, 3, 2, 2, 2, 459, 1647, 3, 2, 2, 2, 461, 1649, 3, 2, 2, 2, 463, 1651,
-	3, 2, 2, 2, 465, 1653, 3, 2, 2, 2, 467, 468, 7, 62, 2, 2, 468, 469, 7,
-	64, 2, 2, 469, 4, 3, 2, 2, 2, 470, 471, 5, 441, 221, 2, 471, 472, 5, 455,
-	228, 2, 472, 473, 5, 421, 211, 2, 473, 474, 5, 447, 224, 2, 474, 475, 5,
-	447, 224, 2, 475, 476, 5, 429, 215, 2, 476, 477, 5, 419, 210, 2, 477, 478,
-	5, 421, 211, 2, 478, 6, 3, 2, 2, 2, 479, 480, 5, 455, 228, 2, 480, 481,
-	5, 429, 215, 2, 481, 482, 5, 447, 224, 2, 482, 483, 5, 451, 226, 2, 483,
-	484, 5, 453, 227, 2, 484, 485, 5, 413, 207, 2, 485, 486, 5, 435, 218, 2,
-	486, 8, 3, 2, 2, 2, 487, 488, 5, 449, 225, 2, 488, 489, 5, 421, 211, 2,
-	489, 490, 5, 451, 226, 2, 490, 10, 3, 2, 2, 2, 491, 492, 5, 425, 213, 2,
-	492, 493, 5, 421, 211, 2, 493, 494, 5, 451, 226, 2, 494, 12, 3, 2, 2, 2,
-	495, 496, 5, 413, 207, 2, 496, 497, 5, 415, 208, 2, 497, 498, 5, 449, 225,
-	2, 498, 499, 5, 451, 226, 2, 499, 500, 5, 447, 224, 2, 500, 501, 5, 413,
-	207, 2, 501, 502, 5, 417, 209, 2, 502, 503, 5, 451, 226, 2, 503, 14, 3,
-	2, 2, 2, 504, 505, 5, 415, 208, 2, 505, 506, 5, 441, 221, 2, 506, 507,
-	5, 441, 221, 2, 507, 508, 5, 435, 218, 2, 508, 509, 5, 421, 211, 2, 509,
-	510, 5, 413, 207, 2, 510, 511, 5, 439, 220, 2, 511, 16, 3, 2, 2, 2, 512,
-	513, 5, 415, 208, 2, 513, 514, 5, 447, 224, 2, 514, 515, 5, 421, 211, 2,
-	515, 516, 5, 413, 207, 2, 516, 517, 5, 433, 217, 2, 517, 18, 3, 2, 2, 2,
-	518, 519, 5, 417, 209, 2, 519, 520, 5, 413, 207, 2, 520, 521, 5, 451, 226,
-	2, 521, 522, 5, 417, 209, 2, 522, 523, 5, 427, 214, 2, 523, 20, 3, 2, 2,
-	2, 524, 525, 5, 417, 209, 2, 525, 526, 5, 435, 218, 2, 526, 527, 5, 413,
-	207, 2, 527, 528, 5, 449, 225, 2, 528, 529, 5, 449, 225, 2, 529, 22, 3,
-	2, 2, 2, 530, 531, 5, 417, 209, 2, 531, 532, 5, 441, 221, 2, 532, 533,
-	5, 439, 220, 2, 533, 534, 5, 449, 225, 2, 534, 535, 5, 451, 226, 2, 535,
-	24, 3, 2, 2, 2, 536, 537, 5, 417, 209, 2, 537, 538, 5, 441, 221, 2, 538,
-	539, 5, 439, 220, 2, 539, 540, 5, 451, 226, 2, 540, 541, 5, 429, 215, 2,
-	541, 542, 5, 439, 220, 2, 542, 543, 5, 453, 227, 2, 543, 544, 5, 421, 211,
-	2, 544, 26, 3, 2, 2, 2, 545, 546, 5, 419, 210, 2, 546, 547, 5, 421, 211,
-	2, 547, 548, 5, 423, 212, 2, 548, 549, 5, 413, 207, 2, 549, 550, 5, 453,
-	227, 2, 550, 551, 5, 435, 218, 2, 551, 552, 5, 451, 226, 2, 552, 28, 3,
-	2, 2, 2, 553, 554, 5, 419, 210, 2, 554, 555, 5, 441, 221, 2, 555, 30, 3,
-	2, 2, 2, 556, 557, 5, 419, 210, 2, 557, 558, 5, 441, 221, 2, 558, 559,
-	5, 453, 227, 2, 559, 560, 5, 415, 208, 2, 560, 561, 5, 435, 218, 2, 561,
-	562, 5, 421, 211, 2, 562, 32, 3, 2, 2, 2, 563, 564, 5, 421, 211, 2, 564,
-	565, 5, 435, 218, 2, 565, 566, 5, 449, 225, 2, 566, 567, 5, 421, 211, 2,
-	567, 34, 3, 2, 2, 2, 568, 569, 5, 421, 211, 2, 569, 570, 5, 439, 220, 2,
-	570, 571, 5, 453, 227, 2, 571, 572, 5, 437, 219, 2, 572, 36, 3, 2, 2, 2,
-	573, 574, 5, 421, 211, 2, 574, 575, 5, 459, 230, 2, 575, 576, 5, 451, 226,
-	2, 576, 577, 5, 421, 211, 2, 577, 578, 5, 439, 220, 2, 578, 579, 5, 419,
-	210, 2, 579, 580, 5, 449, 225, 2, 580, 38, 3, 2, 2, 2, 581, 582, 5, 423,
-	212, 2, 582, 583, 5, 429, 215, 2, 583, 584, 5, 439, 220, 2, 584, 585, 5,
-	413, 207, 2, 585, 586, 5, 435, 218, 2, 586, 40, 3, 2, 2, 2, 587, 588, 5,
-	423, 212, 2, 588, 589, 5, 429, 215, 2, 589, 590, 5, 439, 220, 2, 590, 591,
-	5, 413, 207, 2, 591, 592, 5, 435, 218, 2, 592, 593, 5, 435, 218, 2, 593,
-	594, 5, 461, 231, 2, 594, 42, 3, 2, 2, 2, 595, 596, 5, 423, 212, 2, 596,
-	597, 5, 435, 218, 2, 597, 598, 5, 441, 221, 2, 598, 599, 5, 413, 207, 2,
-	599, 600, 5, 451, 226, 2, 600, 44, 3, 2, 2, 2, 601, 602, 5, 423, 212, 2,
-	602, 603, 5, 441, 221, 2, 603, 604, 5, 447, 224, 2, 604, 46, 3, 2, 2, 2,
-	605, 606, 5, 429, 215, 2, 606, 607, 5, 423, 212, 2, 607, 48, 3, 2, 2, 2,
-	608, 609, 5, 425, 213, 2, 609, 610, 5, 441, 221, 2, 610, 611, 5, 451, 226,
-	2, 611, 612, 5, 441, 221, 2, 612, 50, 3, 2, 2, 2, 613, 614, 5, 429, 215,
-	2, 614, 615, 5, 437, 219, 2, 615, 616, 5, 443, 222, 2, 616, 617, 5, 435,
-	218, 2, 617, 618, 5, 421, 211, 2, 618, 619, 5, 437, 219, 2, 619, 620, 5,
-	421, 211, 2, 620, 621, 5, 439, 220, 2, 621, 622, 5, 451, 226, 2, 622, 623,
-	5, 449, 225, 2, 623, 52, 3, 2, 2, 2, 624, 625, 5, 429, 215, 2, 625, 626,
-	5, 437, 219, 2, 626, 627, 5, 443, 222, 2, 627, 628, 5, 441, 221, 2, 628,
-	629, 5, 447, 224, 2, 629, 630, 5, 451, 226, 2, 630, 54, 3, 2, 2, 2, 631,
-	632, 5, 429, 215, 2, 632, 633, 5, 439, 220, 2, 633, 634, 5, 449, 225, 2,
-	634, 635, 5, 451, 226, 2, 635, 636, 5, 413, 207, 2, 636, 637, 5, 439, 220,
-	2, 637, 638, 5, 417, 209, 2, 638, 639, 5, 421, 211, 2, 639, 640, 5, 441,
-	221, 2, 640, 641, 5, 423, 212, 2, 641, 56, 3, 2, 2, 2, 642, 643, 5, 429,
-	215, 2, 643, 644, 5, 439, 220, 2, 644, 645, 5, 451, 226, 2, 645, 646, 5,
-	421, 211, 2, 646, 647, 5, 425, 213, 2, 647, 648, 5, 421, 211, 2, 648, 649,
-	5, 447, 224, 2, 649, 58, 3, 2, 2, 2, 650, 651, 5, 449, 225, 2, 651, 652,
-	5, 451, 226, 2, 652, 653, 5, 447, 224, 2, 653, 654, 5, 429, 215, 2, 654,
-	655, 5, 439, 220, 2, 655, 656, 5, 425, 213, 2, 656, 60, 3, 2, 2, 2, 657,
-	658, 5, 429, 215, 2, 658, 659, 5, 439, 220, 2, 659, 660, 5, 451, 226, 2,
-	660, 661, 5, 421, 211, 2, 661, 662, 5, 447, 224, 2, 662, 663, 5, 423, 212,
-	2, 663, 664, 5, 413, 207, 2, 664, 665, 5, 417, 209, 2, 665, 666, 5, 421,
-	211, 2, 666, 62, 3, 2, 2, 2, 667, 668, 5, 435, 218, 2, 668, 669, 5, 441,
-	221, 2, 669, 670, 5, 439, 220, 2, 670, 671, 5, 425, 213, 2, 671, 64, 3,
-	2, 2, 2, 672, 673, 5, 439, 220, 2, 673, 674, 5, 413, 207, 2, 674, 675,
-	5, 451, 226, 2, 675, 676, 5, 429, 215, 2, 676, 677, 5, 455, 228, 2, 677,
-	678, 5, 421, 211, 2, 678, 66, 3, 2, 2, 2, 679, 680, 5, 439, 220, 2, 680,
-	681, 5, 421, 211, 2, 681, 682, 5, 457, 229, 2, 682, 68, 3, 2, 2, 2, 683,
-	684, 5, 443, 222, 2, 684, 685, 5, 413, 207, 2, 685, 686, 5, 417, 209, 2,
-	686, 687, 5, 433, 217, 2, 687, 688, 5, 413, 207, 2, 688, 689, 5, 425, 213,
-	2, 689, 690, 5, 421, 211, 2, 690, 70, 3, 2, 2, 2, 691, 692, 5, 443, 222,
-	2, 692, 693, 5, 447, 224, 2, 693, 694, 5, 429, 215, 2, 694, 695, 5, 455,
-	228, 2, 695, 696, 5, 413, 207, 2, 696, 697, 5, 451, 226, 2, 697, 698, 5,
-	421, 211, 2, 698, 72, 3, 2, 2, 2, 699, 700, 5, 443, 222, 2, 700, 701, 5,
-	447, 224, 2, 701, 702, 5, 441, 221, 2, 702, 703, 5, 451, 226, 2, 703, 704,
-	5, 421, 211, 2, 704, 705, 5, 417, 209, 2, 705, 706, 5, 451, 226, 2, 706,
-	707, 5, 421, 211, 2, 707, 708, 5, 419, 210, 2, 708, 74, 3, 2, 2, 2, 709,
-	710, 5, 443, 222, 2, 710, 711, 5, 453, 227, 2, 711, 712, 5, 415, 208, 2,
-	712, 713, 5, 435, 218, 2, 713, 714, 5, 429, 215, 2, 714, 715, 5, 417, 209,
-	2, 715, 76, 3, 2, 2, 2, 716, 717, 5, 447, 224, 2, 717, 718, 5, 421, 211,
-	2, 718, 719, 5, 451, 226, 2, 719, 720, 5, 453, 227, 2, 720, 721, 5, 447,
-	224, 2, 721, 722, 5, 439, 220, 2, 722, 78, 3, 2, 2, 2, 723, 724, 5, 449,
-	225, 2, 724, 725, 5, 451, 226, 2, 725, 726, 5, 413, 207, 2, 726, 727, 5,
-	451, 226, 2, 727, 728, 5, 429, 215, 2, 728, 729, 5, 417, 209, 2, 729, 80,
-	3, 2, 2, 2, 730, 731, 5, 449, 225, 2, 731, 732, 5, 453, 227, 2, 732, 733,
-	5, 443, 222, 2, 733, 734, 5, 421, 211, 2, 734, 735, 5, 447, 224, 2, 735,
-	82, 3, 2, 2, 2, 736, 737, 5, 449, 225, 2, 737, 738, 5, 461, 231, 2, 738,
-	739, 5, 439, 220, 2, 739, 740, 5, 417, 209, 2, 740, 741, 5, 427, 214, 2,
-	741, 742, 5, 447, 224, 2, 742, 743, 5, 441, 221, 2, 743, 744, 5, 439, 220,
-	2, 744, 745, 5, 429, 215, 2, 745, 746, 5, 463, 232, 2, 746, 747, 5, 421,
-	211, 2, 747, 748, 5, 419, 210, 2, 748, 84, 3, 2, 2, 2, 749, 750, 5, 451,
-	226, 2, 750, 751, 5, 427, 214, 2, 751, 752, 5, 429, 215, 2, 752, 753, 5,
-	449, 225, 2, 753, 86, 3, 2, 2, 2, 754, 755, 5, 451, 226, 2, 755, 756, 5,
-	427, 214, 2, 756, 757, 5, 447, 224, 2, 757, 758, 5, 441, 221, 2, 758, 759,
-	5, 457, 229, 2, 759, 88, 3, 2, 2, 2, 760, 761, 5, 451, 226, 2, 761, 762,
-	5, 427, 214, 2, 762, 763, 5, 447, 224, 2, 763, 764, 5, 441, 221, 2, 764,
-	765, 5, 457, 229, 2, 765, 766, 5, 449, 225, 2, 766, 90, 3, 2, 2, 2, 767,
-	768, 5, 451, 226, 2, 768, 769, 5, 447, 224, 2, 769, 770, 5, 413, 207, 2,
-	770, 771, 5, 439, 220, 2, 771, 772, 5, 449, 225, 2, 772, 773, 5, 429, 215,
-	2, 773, 774, 5, 421, 211, 2, 774, 775, 5, 439, 220, 2, 775, 776, 5, 451,
-	226, 2, 776, 92, 3, 2, 2, 2, 777, 778, 5, 451, 226, 2, 778, 779, 5, 447,
-	224, 2, 779, 780, 5, 461, 231, 2, 780, 94, 3, 2, 2, 2, 781, 782, 5, 455,
-	228, 2, 782, 783, 5, 441, 221, 2, 783, 784, 5, 429, 215, 2, 784, 785, 5,
-	419, 210, 2, 785, 96, 3, 2, 2, 2, 786, 787, 5, 455, 228, 2, 787, 788, 5,
-	441, 221, 2, 788, 789, 5, 435, 218, 2, 789, 790, 5, 413, 207, 2, 790, 791,
-	5, 451, 226, 2, 791, 792, 5, 429, 215, 2, 792, 793, 5, 435, 218, 2, 793,
-	794, 5, 421, 211, 2, 794, 98, 3, 2, 2, 2, 795, 796, 5, 457, 229, 2, 796,
-	797, 5, 427, 214, 2, 797, 798, 5, 429, 215, 2, 798, 799, 5, 435, 218, 2,
-	799, 800, 5, 421, 211, 2, 800, 100, 3, 2, 2, 2, 801, 802, 5, 449, 225,
-	2, 802, 803, 5, 457, 229, 2, 803, 804, 5, 429, 215, 2, 804, 805, 5, 451,
-	226, 2, 805, 806, 5, 417, 209, 2, 806, 807, 5, 427, 214, 2, 807, 102, 3,
-	2, 2, 2, 808, 809, 5, 457, 229, 2, 809, 810, 5, 427, 214, 2, 810, 811,
-	5, 421, 211, 2, 811, 812, 5, 439, 220, 2, 812, 104, 3, 2, 2, 2, 813, 814,
-	5, 425, 213, 2, 814, 815, 5, 435, 218, 2, 815, 816, 5, 441, 221, 2, 816,
-	817, 5, 415, 208, 2, 817, 818, 5, 413, 207, 2, 818, 819, 5, 435, 218, 2,
-	819, 106, 3, 2, 2, 2, 820, 821, 5, 457, 229, 2, 821, 822, 5, 421, 211,
-	2, 822, 823, 5, 415, 208, 2, 823, 824, 5, 449, 225, 2, 824, 825, 5, 421,
-	211, 2, 825, 826, 5, 447, 224, 2, 826, 827, 5, 455, 228, 2, 827, 828, 5,
-	429, 215, 2, 828, 829, 5, 417, 209, 2, 829, 830, 5, 421, 211, 2, 830, 108,
-	3, 2, 2, 2, 831, 832, 5, 457, 229, 2, 832, 833, 5, 429, 215, 2, 833, 834,
-	5, 451, 226, 2, 834, 835, 5, 427, 214, 2, 835, 836, 5, 465, 233, 2, 836,
-	837, 5, 449, 225, 2, 837, 838, 5, 427, 214, 2, 838, 839, 5, 413, 207, 2,
-	839, 840, 5, 447, 224, 2, 840, 841, 5, 429, 215, 2, 841, 842, 5, 439, 220,
-	2, 842, 843, 5, 425, 213, 2, 843, 110, 3, 2, 2, 2, 844, 845, 5, 457, 229,
-	2, 845, 846, 5, 429, 215, 2, 846, 847, 5, 451, 226, 2, 847, 848, 5, 427,
-	214, 2, 848, 849, 5, 441, 221, 2, 849, 850, 5, 453, 227, 2, 850, 851, 5,
-	451, 226, 2, 851, 852, 5, 465, 233, 2, 852, 853, 5, 449, 225, 2, 853, 854,
-	5, 427, 214, 2, 854, 855, 5, 413, 207, 2, 855, 856, 5, 447, 224, 2, 856,
-	857, 5, 429, 215, 2, 857, 858, 5, 439, 220, 2, 858, 859, 5, 425, 213, 2,
-	859, 112, 3, 2, 2, 2, 860, 861, 5, 449, 225, 2, 861, 862, 5, 421, 211,
-	2, 862, 863, 5, 435, 218, 2, 863, 864, 5, 421, 211, 2, 864, 865, 5, 417,
-	209, 2, 865, 866, 5, 451, 226, 2, 866, 114, 3, 2, 2, 2, 867, 868, 5, 423,
-	212, 2, 868, 869, 5, 447, 224, 2, 869, 870, 5, 441, 221, 2, 870, 871, 5,
-	437, 219, 2, 871, 116, 3, 2, 2, 2, 872, 873, 5, 457, 229, 2, 873, 874,
-	5, 427, 214, 2, 874, 875, 5, 421, 211, 2, 875, 876, 5, 447, 224, 2, 876,
-	877, 5, 421, 211, 2, 877, 118, 3, 2, 2, 2, 878, 879, 5, 435, 218, 2, 879,
-	880, 5, 429, 215, 2, 880, 881, 5, 437, 219, 2, 881, 882, 5, 429, 215, 2,
-	882, 883, 5, 451, 226, 2, 883, 120, 3, 2, 2, 2, 884, 885, 5, 441, 221,
-	2, 885, 886, 5, 447, 224, 2, 886, 887, 5, 419, 210, 2, 887, 888, 5, 421,
-	211, 2, 888, 889, 5, 447, 224, 2, 889, 122, 3, 2, 2, 2, 890, 891, 5, 415,
-	208, 2, 891, 892, 5, 461, 231, 2, 892, 124, 3, 2, 2, 2, 893, 894, 5, 413,
-	207, 2, 894, 895, 5, 449, 225, 2, 895, 896, 5, 417, 209, 2, 896, 126, 3,
-	2, 2, 2, 897, 898, 5, 419, 210, 2, 898, 899, 5, 421, 211, 2, 899, 900,
-	5, 449, 225, 2, 900, 901, 5, 417, 209, 2, 901, 128, 3, 2, 2, 2, 902, 903,
-	5, 457, 229, 2, 903, 904, 5, 429, 215, 2, 904, 905, 5, 451, 226, 2, 905,
-	906, 5, 427, 214, 2, 906, 130, 3, 2, 2, 2, 907, 908, 5, 451, 226, 2, 908,
-	909, 5, 461, 231, 2, 909, 910, 5, 443, 222, 2, 910, 911, 5, 421, 211, 2,
-	911, 912, 5, 441, 221, 2, 912, 913, 5, 423, 212, 2, 913, 132, 3, 2, 2,
-	2, 914, 915, 5, 447, 224, 2, 915, 916, 5, 421, 211, 2, 916, 917, 5, 423,
-	212, 2, 917, 918, 5, 421, 211, 2, 918, 919, 5, 447, 224, 2, 919, 920, 5,
-	421, 211, 2, 920, 921, 5, 439, 220, 2, 921, 922, 5, 417, 209, 2, 922, 923,
-	5, 421, 211, 2, 923, 134, 3, 2, 2, 2, 924, 925, 5, 455, 228, 2, 925, 926,
-	5, 429, 215, 2, 926, 927, 5, 421, 211, 2, 927, 928, 5, 457, 229, 2, 928,
-	136, 3, 2, 2, 2, 929, 930, 5, 455, 228, 2, 930, 931, 5, 429, 215, 2, 931,
-	932, 5, 421, 211, 2, 932, 933, 5, 457, 229, 2, 933, 934, 5, 449, 225, 2,
-	934, 935, 5, 451, 226, 2, 935, 936, 5, 413, 207, 2, 936, 937, 5, 451, 226,
-	2, 937, 138, 3, 2, 2, 2, 938, 939, 5, 451, 226, 2, 939, 940, 5, 447, 224,
-	2, 940, 941, 5, 413, 207, 2, 941, 942, 5, 417, 209, 2, 942, 943, 5, 433,
-	217, 2, 943, 944, 5, 429, 215, 2, 944, 945, 5, 439, 220, 2, 945, 946, 5,
-	425, 213, 2, 946, 140, 3, 2, 2, 2, 947, 948, 5, 441, 221, 2, 948, 949,
-	5, 423, 212, 2, 949, 950, 5, 423, 212, 2, 950, 951, 5, 449, 225, 2, 951,
-	952, 5, 421, 211, 2, 952, 953, 5, 451, 226, 2, 953, 142, 3, 2, 2, 2, 954,
-	955, 5, 429, 215, 2, 955, 956, 5, 439, 220, 2, 956, 144, 3, 2, 2, 2, 957,
-	958, 5, 421, 211, 2, 958, 959, 5, 439, 220, 2, 959, 960, 5, 419, 210, 2,
-	960, 146, 3, 2, 2, 2, 961, 962, 5, 453, 227, 2, 962, 963, 5, 449, 225,
-	2, 963, 964, 5, 429, 215, 2, 964, 965, 5, 439, 220, 2, 965, 966, 5, 425,
-	213, 2, 966, 148, 3, 2, 2, 2, 967, 968, 5, 419, 210, 2, 968, 969, 5, 413,
-	207, 2, 969, 970, 5, 451, 226, 2, 970, 971, 5, 413, 207, 2, 971, 150, 3,
-	2, 2, 2, 972, 973, 5, 417, 209, 2, 973, 974, 5, 413, 207, 2, 974, 975,
-	5, 451, 226, 2, 975, 976, 5, 421, 211, 2, 976, 977, 5, 425, 213, 2, 977,
-	978, 5, 441, 221, 2, 978, 979, 5, 447, 224, 2, 979, 980, 5, 461, 231, 2,
-	980, 152, 3, 2, 2, 2, 981, 982, 5, 425, 213, 2, 982, 983, 5, 447, 224,
-	2, 983, 984, 5, 441, 221, 2, 984, 985, 5, 453, 227, 2, 985, 986, 5, 443,
-	222, 2, 986, 154, 3, 2, 2, 2, 987, 988, 5, 427, 214, 2, 988, 989, 5, 413,
-	207, 2, 989, 990, 5, 455, 228, 2, 990, 991, 5, 429, 215, 2, 991, 992, 5,
-	439, 220, 2, 992, 993, 5, 425, 213, 2, 993, 156, 3, 2, 2, 2, 994, 995,
-	5, 439, 220, 2, 995, 996, 5, 453, 227, 2, 996, 997, 5, 435, 218, 2, 997,
-	998, 5, 435, 218, 2, 998, 999, 5, 449, 225, 2, 999, 158, 3, 2, 2, 2, 1000,
-	1001, 5, 423, 212, 2, 1001, 1002, 5, 429, 215, 2, 1002, 1003, 5, 447, 224,
-	2, 1003, 1004, 5, 449, 225, 2, 1004, 1005, 5, 451, 226, 2, 1005, 160, 3,
-	2, 2, 2, 1006, 1007, 5, 435, 218, 2, 1007, 1008, 5, 413, 207, 2, 1008,
-	1009, 5, 449, 225, 2, 1009, 1010, 5, 451, 226, 2, 1010, 162, 3, 2, 2, 2,
-	1011, 1012, 5, 449, 225, 2, 1012, 1013, 5, 417, 209, 2, 1013, 1014, 5,
-	441, 221, 2, 1014, 1015, 5, 443, 222, 2, 1015, 1016, 5, 421, 211, 2, 1016,
-	164, 3, 2, 2, 2, 1017, 1018, 5, 447, 224, 2, 1018, 1019, 5, 441, 221, 2,
-	1019, 1020, 5, 435, 218, 2, 1020, 1021, 5, 435, 218, 2, 1021, 1022, 5,
-	453, 227, 2, 1022, 1023, 5, 443, 222, 2, 1023, 166, 3, 2, 2, 2, 1024, 1025,
-	5, 417, 209, 2, 1025, 1026, 5, 453, 227, 2, 1026, 1027, 5, 415, 208, 2,
-	1027, 1028, 5, 421, 211, 2, 1028, 168, 3, 2, 2, 2, 1029, 1030, 5, 435,
-	218, 2, 1030, 1031, 5, 429, 215, 2, 1031, 1032, 5, 433, 217, 2, 1032, 1033,
-	5, 421, 211, 2, 1033, 170, 3, 2, 2, 2, 1034, 1035, 5, 451, 226, 2, 1035,
-	1036, 5, 427, 214, 2, 1036, 1037, 5, 421, 211, 2, 1037, 1038, 5, 439, 220,
-	2, 1038, 172, 3, 2, 2, 2, 1039, 1040, 5, 429, 215, 2, 1040, 1041, 5, 439,
-	220, 2, 1041, 1042, 5, 449, 225, 2, 1042, 1043, 5, 421, 211, 2, 1043, 1044,
-	5, 447, 224, 2, 1044, 1045, 5, 451, 226, 2, 1045, 174, 3, 2, 2, 2, 1046,
-	1047, 5, 453, 227, 2, 1047, 1048, 5, 443, 222, 2, 1048, 1049, 5, 449, 225,
-	2, 1049, 1050, 5, 421, 211, 2, 1050, 1051, 5, 447, 224, 2, 1051, 1052,
-	5, 451, 226, 2, 1052, 176, 3, 2, 2, 2, 1053, 1054, 5, 453, 227, 2, 1054,
-	1055, 5, 443, 222, 2, 1055, 1056, 5, 419, 210, 2, 1056, 1057, 5, 413, 207,
-	2, 1057, 1058, 5, 451, 226, 2, 1058, 1059, 5, 421, 211, 2, 1059, 178, 3,
-	2, 2, 2, 1060, 1061, 5, 419, 210, 2, 1061, 1062, 5, 421, 211, 2, 1062,
-	1063, 5, 435, 218, 2, 1063, 1064, 5, 421, 211, 2, 1064, 1065, 5, 451, 226,
-	2, 1065, 1066, 5, 421, 211, 2, 1066, 180, 3, 2, 2, 2, 1067, 1068, 5, 453,
-	227, 2, 1068, 1069, 5, 439, 220, 2, 1069, 1070, 5, 419, 210, 2, 1070, 1071,
-	5, 421, 211, 2, 1071, 1072, 5, 435, 218, 2, 1072, 1073, 5, 421, 211, 2,
-	1073, 1074, 5, 451, 226, 2, 1074, 1075, 5, 421, 211, 2, 1075, 182, 3, 2,
-	2, 2, 1076, 1077, 5, 413, 207, 2, 1077, 1078, 5, 439, 220, 2, 1078, 1079,
-	5, 419, 210, 2, 1079, 184, 3, 2, 2, 2, 1080, 1081, 5, 441, 221, 2, 1081,
-	1082, 5, 447, 224, 2, 1082, 186, 3, 2, 2, 2, 1083, 1084, 5, 439, 220, 2,
-	1084, 1085, 5, 441, 221, 2, 1085, 1086, 5, 451, 226, 2, 1086, 188, 3, 2,
-	2, 2, 1087, 1088, 5, 423, 212, 2, 1088, 1089, 5, 429, 215, 2, 1089, 1090,
-	5, 439, 220, 2, 1090, 1091, 5, 419, 210, 2, 1091, 190, 3, 2, 2, 2, 1092,
-	1093, 5, 423, 212, 2, 1093, 1094, 5, 429, 215, 2, 1094, 1095, 5, 421, 211,
-	2, 1095, 1096, 5, 435, 218, 2, 1096, 1097, 5, 419, 210, 2, 1097, 1098,
-	5, 449, 225, 2, 1098, 192, 3, 2, 2, 2, 1099, 1100, 5, 447, 224, 2, 1100,
-	1101, 5, 421, 211, 2, 1101, 1102, 5, 451, 226, 2, 1102, 1103, 5, 453, 227,
-	2, 1103, 1104, 5, 447, 224, 2, 1104, 1105, 5, 439, 220, 2, 1105, 1106,
-	5, 429, 215, 2, 1106, 1107, 5, 439, 220, 2, 1107, 1108, 5, 425, 213, 2,
-	1108, 194, 3, 2, 2, 2, 1109, 1110, 5, 413, 207, 2, 1110, 1111, 5, 435,
-	218, 2, 1111, 1112, 5, 435, 218, 2, 1112, 196, 3, 2, 2, 2, 1113, 1114,
-	5, 451, 226, 2, 1114, 1115, 5, 421, 211, 2, 1115, 1116, 5, 449, 225, 2,
-	1116, 1117, 5, 451, 226, 2, 1117, 1118, 5, 437, 219, 2, 1118, 1119, 5,
-	421, 211, 2, 1119, 1120, 5, 451, 226, 2, 1120, 1121, 5, 427, 214, 2, 1121,
-	1122, 5, 441, 221, 2, 1122, 1123, 5, 419, 210, 2, 1123, 198, 3, 2, 2, 2,
-	1124, 1125, 5, 451, 226, 2, 1125, 1126, 5, 447, 224, 2, 1126, 1127, 5,
-	429, 215, 2, 1127, 1128, 5, 425, 213, 2, 1128, 1129, 5, 425, 213, 2, 1129,
-	1130, 5, 421, 211, 2, 1130, 1131, 5, 447, 224, 2, 1131, 200, 3, 2, 2, 2,
-	1132, 1133, 5, 441, 221, 2, 1133, 1134, 5, 439, 220, 2, 1134, 202, 3, 2,
-	2, 2, 1135, 1136, 5, 415, 208, 2, 1136, 1137, 5, 421, 211, 2, 1137, 1138,
-	5, 423, 212, 2, 1138, 1139, 5, 441, 221, 2, 1139, 1140, 5, 447, 224, 2,
-	1140, 1141, 5, 421, 211, 2, 1141, 204, 3, 2, 2, 2, 1142, 1143, 5, 413,
-	207, 2, 1143, 1144, 5, 423, 212, 2, 1144, 1145, 5, 451, 226, 2, 1145, 1146,
-	5, 421, 211, 2, 1146, 1147, 5, 447, 224, 2, 1147, 206, 3, 2, 2, 2, 1148,
-	1149, 5, 447, 224, 2, 1149, 1150, 5, 453, 227, 2, 1150, 1151, 5, 439, 220,
-	2, 1151, 1152, 5, 413, 207, 2, 1152, 1153, 5, 449, 225, 2, 1153, 208, 3,
-	2, 2, 2, 1154, 1155, 5, 449, 225, 2, 1155, 1156, 5, 461, 231, 2, 1156,
-	1157, 5, 449, 225, 2, 1157, 1158, 5, 451, 226, 2, 1158, 1159, 5, 421, 211,
-	2, 1159, 1160, 5, 437, 219, 2, 1160, 210, 3, 2, 2, 2, 1161, 1166, 5, 213,
-	107, 2, 1162, 1166, 5, 215, 108, 2, 1163, 1166, 5, 217, 109, 2, 1164, 1166,
-	5, 219, 110, 2, 1165, 1161, 3, 2, 2, 2, 1165, 1162, 3, 2, 2, 2, 1165, 1163,
-	3, 2, 2, 2, 1165, 1164, 3, 2, 2, 2, 1166, 212, 3, 2, 2, 2, 1167, 1169,
-	5, 223, 112, 2, 1168, 1170, 5, 221, 111, 2, 1169, 1168, 3, 2, 2, 2, 1169,
-	1170, 3, 2, 2, 2, 1170, 214, 3, 2, 2, 2, 1171, 1173, 5, 235, 118, 2, 1172,
-	1174, 5, 221, 111, 2, 1173, 1172, 3, 2, 2, 2, 1173, 1174, 3, 2, 2, 2, 1174,
-	216, 3, 2, 2, 2, 1175, 1177, 5, 243, 122, 2, 1176, 1178, 5, 221, 111, 2,
-	1177, 1176, 3, 2, 2, 2, 1177, 1178, 3, 2, 2, 2, 1178, 218, 3, 2, 2, 2,
-	1179, 1181, 5, 251, 126, 2, 1180, 1182, 5, 221, 111, 2, 1181, 1180, 3,
-	2, 2, 2, 1181, 1182, 3, 2, 2, 2, 1182, 220, 3, 2, 2, 2, 1183, 1184, 9,
-	2, 2, 2, 1184, 222, 3, 2, 2, 2, 1185, 1186, 5, 225, 113, 2, 1186, 224,
-	3, 2, 2, 2, 1187, 1195, 5, 227, 114, 2, 1188, 1190, 5, 231, 116, 2, 1189,
-	1188, 3, 2, 2, 2, 1190, 1193, 3, 2, 2, 2, 1191, 1189, 3, 2, 2, 2, 1191,
-	1192, 3, 2, 2, 2, 1192, 1194, 3, 2, 2, 2, 1193, 1191, 3, 2, 2, 2, 1194,
-	1196, 5, 227, 114, 2, 1195, 1191, 3, 2, 2, 2, 1195, 1196, 3, 2, 2, 2, 1196,
-	226, 3, 2, 2, 2, 1197, 1200, 7, 50, 2, 2, 1198, 1200, 5, 229, 115, 2, 1199,
-	1197, 3, 2, 2, 2, 1199, 1198, 3, 2, 2, 2, 1200, 228, 3, 2, 2, 2, 1201,
-	1202, 9, 3, 2, 2, 1202, 230, 3, 2, 2, 2, 1203, 1206, 5, 227, 114, 2, 1204,
-	1206, 7, 97, 2, 2, 1205, 1203, 3, 2, 2, 2, 1205, 1204, 3, 2, 2, 2, 1206,
-	232, 3, 2, 2, 2, 1207, 1209, 7, 97, 2, 2, 1208, 1207, 3, 2, 2, 2, 1209,
-	1210, 3, 2, 2, 2, 1210, 1208, 3, 2, 2, 2, 1210, 1211, 3, 2, 2, 2, 1211,
-	234, 3, 2, 2, 2, 1212, 1213, 7, 50, 2, 2, 1213, 1214, 9, 4, 2, 2, 1214,
-	1215, 5, 237, 119, 2, 1215, 236, 3, 2, 2, 2, 1216, 1224, 5, 239, 120, 2,
-	1217, 1219, 5, 241, 121, 2, 1218, 1217, 3, 2, 2, 2, 1219, 1222, 3, 2, 2,
-	2, 1220, 1218, 3, 2, 2, 2, 1220, 1221, 3, 2, 2, 2, 1221, 1223, 3, 2, 2,
-	2, 1222, 1220, 3, 2, 2, 2, 1223, 1225, 5, 239, 120, 2, 1224, 1220, 3, 2,
-	2, 2, 1224, 1225, 3, 2, 2, 2, 1225, 238, 3, 2, 2, 2, 1226, 1227, 9, 5,
-	2, 2, 1227, 240, 3, 2, 2, 2, 1228, 1231, 5, 239, 120, 2, 1229, 1231, 7,
-	97, 2, 2, 1230, 1228, 3, 2, 2, 2, 1230, 1229, 3, 2, 2, 2, 1231, 242, 3,
-	2, 2, 2, 1232, 1234, 7, 50, 2, 2, 1233, 1235, 5, 233, 117, 2, 1234, 1233,
-	3, 2, 2, 2, 1234, 1235, 3, 2, 2, 2, 1235, 1236, 3, 2, 2, 2, 1236, 1237,
-	5, 245, 123, 2, 1237, 244, 3, 2, 2, 2, 1238, 1246, 5, 247, 124, 2, 1239,
-	1241, 5, 249, 125, 2, 1240, 1239, 3, 2, 2, 2, 1241, 1244, 3, 2, 2, 2, 1242,
-	1240, 3, 2, 2, 2, 1242, 1243, 3, 2, 2, 2, 1243, 1245, 3, 2, 2, 2, 1244,
-	1242, 3, 2, 2, 2, 1245, 1247, 5, 247, 124, 2, 1246, 1242, 3, 2, 2, 2, 1246,
-	1247, 3, 2, 2, 2, 1247, 246, 3, 2, 2, 2, 1248, 1249, 9, 6, 2, 2, 1249,
-	248, 3, 2, 2, 2, 1250, 1253, 5, 247, 124, 2, 1251, 1253, 7, 97, 2, 2, 1252,
-	1250, 3, 2, 2, 2, 1252, 1251, 3, 2, 2, 2, 1253, 250, 3, 2, 2, 2, 1254,
-	1255, 7, 50, 2, 2, 1255, 1256, 9, 7, 2, 2, 1256, 1257, 5, 253, 127, 2,
-	1257, 252, 3, 2, 2, 2, 1258, 1266, 5, 255, 128, 2, 1259, 1261, 5, 257,
-	129, 2, 1260, 1259, 3, 2, 2, 2, 1261, 1264, 3, 2, 2, 2, 1262, 1260, 3,
-	2, 2, 2, 1262, 1263, 3, 2, 2, 2, 1263, 1265, 3, 2, 2, 2, 1264, 1262, 3,
-	2, 2, 2, 1265, 1267, 5, 255, 128, 2, 1266, 1262, 3, 2, 2, 2, 1266, 1267,
-	3, 2, 2, 2, 1267, 254, 3, 2, 2, 2, 1268, 1269, 9, 8, 2, 2, 1269, 256, 3,
-	2, 2, 2, 1270, 1273, 5, 255, 128, 2, 1271, 1273, 7, 97, 2, 2, 1272, 1270,
-	3, 2, 2, 2, 1272, 1271, 3, 2, 2, 2, 1273, 258, 3, 2, 2, 2, 1274, 1277,
-	5, 261, 131, 2, 1275, 1277, 5, 273, 137, 2, 1276, 1274, 3, 2, 2, 2, 1276,
-	1275, 3, 2, 2, 2, 1277, 260, 3, 2, 2, 2, 1278, 1279, 5, 225, 113, 2, 1279,
-	1281, 7, 48, 2, 2, 1280, 1282, 5, 225, 113, 2, 1281, 1280, 3, 2, 2, 2,
-	1281, 1282, 3, 2, 2, 2, 1282, 1284, 3, 2, 2, 2, 1283, 1285, 5, 263, 132,
-	2, 1284, 1283, 3, 2, 2, 2, 1284, 1285, 3, 2, 2, 2, 1285, 1287, 3, 2, 2,
-	2, 1286, 1288, 5, 271, 136, 2, 1287, 1286, 3, 2, 2, 2, 1287, 1288, 3, 2,
-	2, 2, 1288, 1306, 3, 2, 2, 2, 1289, 1290, 7, 48, 2, 2, 1290, 1292, 5, 225,
-	113, 2, 1291, 1293, 5, 263, 132, 2, 1292, 1291, 3, 2, 2, 2, 1292, 1293,
-	3, 2, 2, 2, 1293, 1295, 3, 2, 2, 2, 1294, 1296, 5, 271, 136, 2, 1295, 1294,
-	3, 2, 2, 2, 1295, 1296, 3, 2, 2, 2, 1296, 1306, 3, 2, 2, 2, 1297, 1298,
-	5, 225, 113, 2, 1298, 1300, 5, 263, 132, 2, 1299, 1301, 5, 271, 136, 2,
-	1300, 1299, 3, 2, 2, 2, 1300, 1301, 3, 2, 2, 2, 1301, 1306, 3, 2, 2, 2,
-	1302, 1303, 5, 225, 113, 2, 1303, 1304, 5, 271, 136, 2, 1304, 1306, 3,
-	2, 2, 2, 1305, 1278, 3, 2, 2, 2, 1305, 1289, 3, 2, 2, 2, 1305, 1297, 3,
-	2, 2, 2, 1305, 1302, 3, 2, 2, 2, 1306, 262, 3, 2, 2, 2, 1307, 1308, 5,
-	265, 133, 2, 1308, 1309, 5, 267, 134, 2, 1309, 264, 3, 2, 2, 2, 1310, 1311,
-	9, 9, 2, 2, 1311, 266, 3, 2, 2, 2, 1312, 1314, 5, 269, 135, 2, 1313, 1312,
-	3, 2, 2, 2, 1313, 1314, 3, 2, 2, 2, 1314, 1315, 3, 2, 2, 2, 1315, 1316,
-	5, 225, 113, 2, 1316, 268, 3, 2, 2, 2, 1317, 1318, 9, 10, 2, 2, 1318, 270,
-	3, 2, 2, 2, 1319, 1320, 9, 11, 2, 2, 1320, 272, 3, 2, 2, 2, 1321, 1322,
-	5, 275, 138, 2, 1322, 1324, 5, 277, 139, 2, 1323, 1325, 5, 271, 136, 2,
-	1324, 1323, 3, 2, 2, 2, 1324, 1325, 3, 2, 2, 2, 1325, 274, 3, 2, 2, 2,
-	1326, 1328, 5, 235, 118, 2, 1327, 1329, 7, 48, 2, 2, 1328, 1327, 3, 2,
-	2, 2, 1328, 1329, 3, 2, 2, 2, 1329, 1338, 3, 2, 2, 2, 1330, 1331, 7, 50,
-	2, 2, 1331, 1333, 9, 4, 2, 2, 1332, 1334, 5, 237, 119, 2, 1333, 1332, 3,
-	2, 2, 2, 1333, 1334, 3, 2, 2, 2, 1334, 1335, 3, 2, 2, 2, 1335, 1336, 7,
-	48, 2, 2, 1336, 1338, 5, 237, 119, 2, 1337, 1326, 3, 2, 2, 2, 1337, 1330,
-	3, 2, 2, 2, 1338, 276, 3, 2, 2, 2, 1339, 1340, 5, 279, 140, 2, 1340, 1341,
-	5, 267, 134, 2, 1341, 278, 3, 2, 2, 2, 1342, 1343, 9, 12, 2, 2, 1343, 280,
-	3, 2, 2, 2, 1344, 1345, 5, 451, 226, 2, 1345, 1346, 5, 447, 224, 2, 1346,
-	1347, 5, 453, 227, 2, 1347, 1348, 5, 421, 211, 2, 1348, 1356, 3, 2, 2,
-	2, 1349, 1350, 5, 423, 212, 2, 1350, 1351, 5, 413, 207, 2, 1351, 1352,
-	5, 435, 218, 2, 1352, 1353, 5, 449, 225, 2, 1353, 1354, 5, 421, 211, 2,
-	1354, 1356, 3, 2, 2, 2, 1355, 1344, 3, 2, 2, 2, 1355, 1349, 3, 2, 2, 2,
-	1356, 282, 3, 2, 2, 2, 1357, 1359, 5, 411, 206, 2, 1358, 1360, 5, 285,
-	143, 2, 1359, 1358, 3, 2, 2, 2, 1359, 1360, 3, 2, 2, 2, 1360, 1361, 3,
-	2, 2, 2, 1361, 1362, 5, 411, 206, 2, 1362, 284, 3, 2, 2, 2, 1363, 1365,
-	5, 287, 144, 2, 1364, 1363, 3, 2, 2, 2, 1365, 1366, 3, 2, 2, 2, 1366, 1364,
-	3, 2, 2, 2, 1366, 1367, 3, 2, 2, 2, 1367, 286, 3, 2, 2, 2, 1368, 1371,
-	10, 13, 2, 2, 1369, 1371, 5, 289, 145, 2, 1370, 1368, 3, 2, 2, 2, 1370,
-	1369, 3, 2, 2, 2, 1371, 288, 3, 2, 2, 2, 1372, 1373, 7, 94, 2, 2, 1373,
-	1377, 9, 14, 2, 2, 1374, 1377, 5, 291, 146, 2, 1375, 1377, 5, 293, 147,
-	2, 1376, 1372, 3, 2, 2, 2, 1376, 1374, 3, 2, 2, 2, 1376, 1375, 3, 2, 2,
-	2, 1377, 290, 3, 2, 2, 2, 1378, 1379, 7, 94, 2, 2, 1379, 1390, 5, 247,
-	124, 2, 1380, 1381, 7, 94, 2, 2, 1381, 1382, 5, 247, 124, 2, 1382, 1383,
-	5, 247, 124, 2, 1383, 1390, 3, 2, 2, 2, 1384, 1385, 7, 94, 2, 2, 1385,
-	1386, 5, 295, 148, 2, 1386, 1387, 5, 247, 124, 2, 1387, 1388, 5, 247, 124,
-	2, 1388, 1390, 3, 2, 2, 2, 1389, 1378, 3, 2, 2, 2, 1389, 1380, 3, 2, 2,
-	2, 1389, 1384, 3, 2, 2, 2, 1390, 292, 3, 2, 2, 2, 1391, 1392, 7, 94, 2,
-	2, 1392, 1393, 7, 119, 2, 2, 1393, 1394, 5, 239, 120, 2, 1394, 1395, 5,
-	239, 120, 2, 1395, 1396, 5, 239, 120, 2, 1396, 1397, 5, 239, 120, 2, 1397,
-	294, 3, 2, 2, 2, 1398, 1399, 9, 15, 2, 2, 1399, 296, 3, 2, 2, 2, 1400,
-	1401, 5, 439, 220, 2, 1401, 1402, 5, 453, 227, 2, 1402, 1403, 5, 435, 218,
-	2, 1403, 1404, 5, 435, 218, 2, 1404, 298, 3, 2, 2, 2, 1405, 1406, 7, 42,
-	2, 2, 1406, 300, 3, 2, 2, 2, 1407, 1408, 7, 43, 2, 2, 1408, 302, 3, 2,
-	2, 2, 1409, 1410, 7, 125, 2, 2, 1410, 304, 3, 2, 2, 2, 1411, 1412, 7, 127,
-	2, 2, 1412, 306, 3, 2, 2, 2, 1413, 1414, 7, 93, 2, 2, 1414, 308, 3, 2,
-	2, 2, 1415, 1416, 7, 95, 2, 2, 1416, 310, 3, 2, 2, 2, 1417, 1418, 7, 61,
-	2, 2, 1418, 312, 3, 2, 2, 2, 1419, 1420, 7, 46, 2, 2, 1420, 314, 3, 2,
-	2, 2, 1421, 1422, 7, 48, 2, 2, 1422, 316, 3, 2, 2, 2, 1423, 1424, 7, 63,
-	2, 2, 1424, 318, 3, 2, 2, 2, 1425, 1426, 7, 64, 2, 2, 1426, 320, 3, 2,
-	2, 2, 1427, 1428, 7, 62, 2, 2, 1428, 322, 3, 2, 2, 2, 1429, 1430, 7, 35,
-	2, 2, 1430, 324, 3, 2, 2, 2, 1431, 1432, 7, 128, 2, 2, 1432, 326, 3, 2,
-	2, 2, 1433, 1434, 7, 65, 2, 2, 1434, 328, 3, 2, 2, 2, 1435, 1436, 7, 60,
-	2, 2, 1436, 330, 3, 2, 2, 2, 1437, 1438, 7, 63, 2, 2, 1438, 1439, 7, 63,
-	2, 2, 1439, 332, 3, 2, 2, 2, 1440, 1441, 7, 63, 2, 2, 1441, 1442, 7, 63,
-	2, 2, 1442, 1443, 7, 63, 2, 2, 1443, 334, 3, 2, 2, 2, 1444, 1445, 7, 62,
-	2, 2, 1445, 1446, 7, 63, 2, 2, 1446, 336, 3, 2, 2, 2, 1447, 1448, 7, 64,
-	2, 2, 1448, 1449, 7, 63, 2, 2, 1449, 338, 3, 2, 2, 2, 1450, 1451, 7, 35,
-	2, 2, 1451, 1452, 7, 63, 2, 2, 1452, 340, 3, 2, 2, 2, 1453, 1454, 7, 40,
-	2, 2, 1454, 1455, 7, 40, 2, 2, 1455, 342, 3, 2, 2, 2, 1456, 1457, 7, 126,
-	2, 2, 1457, 1458, 7, 126, 2, 2, 1458, 344, 3, 2, 2, 2, 1459, 1460, 7, 45,
-	2, 2, 1460, 1461, 7, 45, 2, 2, 1461, 346, 3, 2, 2, 2, 1462, 1463, 7, 47,
-	2, 2, 1463, 1464, 7, 47, 2, 2, 1464, 348, 3, 2, 2, 2, 1465, 1466, 7, 45,
-	2, 2, 1466, 350, 3, 2, 2, 2, 1467, 1468, 7, 47, 2, 2, 1468, 352, 3, 2,
-	2, 2, 1469, 1470, 7, 44, 2, 2, 1470, 354, 3, 2, 2, 2, 1471, 1472, 7, 49,
-	2, 2, 1472, 356, 3, 2, 2, 2, 1473, 1474, 7, 40, 2, 2, 1474, 358, 3, 2,
-	2, 2, 1475, 1476, 7, 126, 2, 2, 1476, 360, 3, 2, 2, 2, 1477, 1478, 7, 96,
-	2, 2, 1478, 362, 3, 2, 2, 2, 1479, 1480, 7, 39, 2, 2, 1480, 364, 3, 2,
-	2, 2, 1481, 1482, 7, 45, 2, 2, 1482, 1483, 7, 63, 2, 2, 1483, 366, 3, 2,
-	2, 2, 1484, 1485, 7, 47, 2, 2, 1485, 1486, 7, 63, 2, 2, 1486, 368, 3, 2,
-	2, 2, 1487, 1488, 7, 44, 2, 2, 1488, 1489, 7, 63, 2, 2, 1489, 370, 3, 2,
-	2, 2, 1490, 1491, 7, 49, 2, 2, 1491, 1492, 7, 63, 2, 2, 1492, 372, 3, 2,
-	2, 2, 1493, 1494, 7, 40, 2, 2, 1494, 1495, 7, 63, 2, 2, 1495, 374, 3, 2,
-	2, 2, 1496, 1497, 7, 126, 2, 2, 1497, 1498, 7, 63, 2, 2, 1498, 376, 3,
-	2, 2, 2, 1499, 1500, 7, 96, 2, 2, 1500, 1501, 7, 63, 2, 2, 1501, 378, 3,
-	2, 2, 2, 1502, 1503, 7, 39, 2, 2, 1503, 1504, 7, 63, 2, 2, 1504, 380, 3,
-	2, 2, 2, 1505, 1506, 7, 62, 2, 2, 1506, 1507, 7, 62, 2, 2, 1507, 1508,
-	7, 63, 2, 2, 1508, 382, 3, 2, 2, 2, 1509, 1510, 7, 64, 2, 2, 1510, 1511,
-	7, 64, 2, 2, 1511, 1512, 7, 63, 2, 2, 1512, 384, 3, 2, 2, 2, 1513, 1514,
-	7, 64, 2, 2, 1514, 1515, 7, 64, 2, 2, 1515, 1516, 7, 64, 2, 2, 1516, 1517,
-	7, 63, 2, 2, 1517, 386, 3, 2, 2, 2, 1518, 1519, 7, 63, 2, 2, 1519, 1520,
-	7, 64, 2, 2, 1520, 388, 3, 2, 2, 2, 1521, 1525, 5, 391, 196, 2, 1522, 1524,
-	5, 393, 197, 2, 1523, 1522, 3, 2, 2, 2, 1524, 1527, 3, 2, 2, 2, 1525, 1523,
-	3, 2, 2, 2, 1525, 1526, 3, 2, 2, 2, 1526, 390, 3, 2, 2, 2, 1527, 1525,
-	3, 2, 2, 2, 1528, 1529, 9, 16, 2, 2, 1529, 392, 3, 2, 2, 2, 1530, 1531,
-	9, 17, 2, 2, 1531, 394, 3, 2, 2, 2, 1532, 1533, 7, 66, 2, 2, 1533, 396,
-	3, 2, 2, 2, 1534, 1535, 7, 48, 2, 2, 1535, 1536, 7, 48, 2, 2, 1536, 1537,
-	7, 48, 2, 2, 1537, 398, 3, 2, 2, 2, 1538, 1540, 9, 18, 2, 2, 1539, 1538,
-	3, 2, 2, 2, 1540, 1541, 3, 2, 2, 2, 1541, 1539, 3, 2, 2, 2, 1541, 1542,
-	3, 2, 2, 2, 1542, 1543, 3, 2, 2, 2, 1543, 1544, 8, 200, 2, 2, 1544, 400,
-	3, 2, 2, 2, 1545, 1546, 7, 49, 2, 2, 1546, 1547, 7, 44, 2, 2, 1547, 1548,
-	7, 44, 2, 2, 1548, 1549, 3, 2, 2, 2, 1549, 1553, 9, 19, 2, 2, 1550, 1552,
-	11, 2, 2, 2, 1551, 1550, 3, 2, 2, 2, 1552, 1555, 3, 2, 2, 2, 1553, 1554,
-	3, 2, 2, 2, 1553, 1551, 3, 2, 2, 2, 1554, 1556, 3, 2, 2, 2, 1555, 1553,
-	3, 2, 2, 2, 1556, 1557, 7, 44, 2, 2, 1557, 1558, 7, 49, 2, 2, 1558, 1559,
-	3, 2, 2, 2, 1559, 1560, 8, 201, 2, 2, 1560, 402, 3, 2, 2, 2, 1561, 1562,
-	7, 49, 2, 2, 1562, 1563, 7, 44, 2, 2, 1563, 1564, 7, 44, 2, 2, 1564, 1565,
-	3, 2, 2, 2, 1565, 1566, 8, 202, 2, 2, 1566, 404, 3, 2, 2, 2, 1567, 1568,
-	7, 49, 2, 2, 1568, 1569, 7, 44, 2, 2, 1569, 1573, 3, 2, 2, 2, 1570, 1572,
-	11, 2, 2, 2, 1571, 1570, 3, 2, 2, 2, 1572, 1575, 3, 2, 2, 2, 1573, 1574,
-	3, 2, 2, 2, 1573, 1571, 3, 2, 2, 2, 1574, 1576, 3, 2, 2, 2, 1575, 1573,
-	3, 2, 2, 2, 1576, 1577, 7, 44, 2, 2, 1577, 1578, 7, 49, 2, 2, 1578, 1579,
-	3, 2, 2, 2, 1579, 1580, 8, 203, 2, 2, 1580, 406, 3, 2, 2, 2, 1581, 1582,
-	7, 49, 2, 2, 1582, 1583, 7, 44, 2, 2, 1583, 1584, 3, 2, 2, 2, 1584, 1585,
-	8, 204, 2, 2, 1585, 408, 3, 2, 2, 2, 1586, 1587, 7, 49, 2, 2, 1587, 1588,
-	7, 49, 2, 2, 1588, 1592, 3, 2, 2, 2, 1589, 1591, 10, 19, 2, 2, 1590, 1589,
-	3, 2, 2, 2, 1591, 1594, 3, 2, 2, 2, 1592, 1590, 3, 2, 2, 2, 1592, 1593,
-	3, 2, 2, 2, 1593, 1595, 3, 2, 2, 2, 1594, 1592, 3, 2, 2, 2, 1595, 1596,
-	8, 205, 2, 2, 1596, 410, 3, 2, 2, 2, 1597, 1598, 7, 41, 2, 2, 1598, 1599,
-	3, 2, 2, 2, 1599, 1600, 8, 206, 2, 2, 1600, 412, 3, 2, 2, 2, 1601, 1602,
-	9, 20, 2, 2, 1602, 414, 3, 2, 2, 2, 1603, 1604, 9, 7, 2, 2, 1604, 416,
-	3, 2, 2, 2, 1605, 1606, 9, 21, 2, 2, 1606, 418, 3, 2, 2, 2, 1607, 1608,
-	9, 22, 2, 2, 1608, 420, 3, 2, 2, 2, 1609, 1610, 9, 9, 2, 2, 1610, 422,
-	3, 2, 2, 2, 1611, 1612, 9, 23, 2, 2, 1612, 424, 3, 2, 2, 2, 1613, 1614,
-	9, 24, 2, 2, 1614, 426, 3, 2, 2, 2, 1615, 1616, 9, 25, 2, 2, 1616, 428,
-	3, 2, 2, 2, 1617, 1618, 9, 26, 2, 2, 1618, 430, 3, 2, 2, 2, 1619, 1620,
-	9, 27, 2, 2, 1620, 432, 3, 2, 2, 2, 1621, 1622, 9, 28, 2, 2, 1622, 434,
-	3, 2, 2, 2, 1623, 1624, 9, 2, 2, 2, 1624, 436, 3, 2, 2, 2, 1625, 1626,
-	9, 29, 2, 2, 1626, 438, 3, 2, 2, 2, 1627, 1628, 9, 30, 2, 2, 1628, 440,
-	3, 2, 2, 2, 1629, 1630, 9, 31, 2, 2, 1630, 442, 3, 2, 2, 2, 1631, 1632,
-	9, 12, 2, 2, 1632, 444, 3, 2, 2, 2, 1633, 1634, 9, 32, 2, 2, 1634, 446,
-	3, 2, 2, 2, 1635, 1636, 9, 33, 2, 2, 1636, 448, 3, 2, 2, 2, 1637, 1638,
-	9, 34, 2, 2, 1638, 450, 3, 2, 2, 2, 1639, 1640, 9, 35, 2, 2, 1640, 452,
-	3, 2, 2, 2, 1641, 1642, 9, 36, 2, 2, 1642, 454, 3, 2, 2, 2, 1643, 1644,
-	9, 37, 2, 2, 1644, 456, 3, 2, 2, 2, 1645, 1646, 9, 38, 2, 2, 1646, 458,
-	3, 2, 2, 2, 1647, 1648, 9, 4, 2, 2, 1648, 460, 3, 2, 2, 2, 1649, 1650,
-	9, 39, 2, 2, 1650, 462, 3, 2, 2, 2, 1651, 1652, 9, 40, 2, 2, 1652, 464,
-	3, 2, 2, 2, 1653, 1654, 7, 34, 2, 2, 1654, 466, 3, 2, 2, 2, 47, 2, 1165,
-	1169, 1173, 1177, 1181, 1191, 1195, 1199, 1205, 1210, 1220, 1224, 1230,
-	1234, 1242, 1246, 1252, 1262, 1266, 1272, 1276, 1281, 1284, 1287, 1292,
-	1295, 1300, 1305, 1313, 1324, 1328, 1333, 1337, 1355, 1359, 1366, 1370,
-	1376, 1389, 1525, 1541, 1553, 1573, 1592, 3, 8, 2, 2,
+	9, 232, 4, 233, 9, 233, 4, 234, 9, 234, 4, 235, 9, 235, 4, 236, 9, 236,
+	3, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 3, 5,
+	3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7,
+	3, 7, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9,
+	3, 9, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11,
+	3, 11, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3,
+	13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14,
+	3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 15, 3, 15, 3, 15, 3, 16, 3,
+	16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17,
+	3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3,
+	19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 21, 3, 21,
+	3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 3, 22, 3,
+	22, 3, 22, 3, 23, 3, 23, 3, 23, 3, 23, 3, 24, 3, 24, 3, 24, 3, 25, 3, 25,
+	3, 25, 3, 25, 3, 25, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3,
+	26, 3, 26, 3, 26, 3, 26, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27,
+	3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3,
+	28, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 30, 3, 30,
+	3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3,
+	31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 33,
+	3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 34, 3, 34, 3, 34, 3, 34, 3,
+	35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 36, 3, 36, 3, 36,
+	3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3,
+	37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38,
+	3, 38, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 40, 3, 40, 3,
+	40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41,
+	3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3,
+	42, 3, 42, 3, 42, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 44, 3, 44, 3, 44,
+	3, 44, 3, 44, 3, 44, 3, 45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 45, 3,
+	46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 47,
+	3, 47, 3, 47, 3, 47, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 49, 3, 49, 3,
+	49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 50, 3, 50, 3, 50, 3, 50,
+	3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 52, 3,
+	52, 3, 52, 3, 52, 3, 52, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53,
+	3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3,
+	54, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55,
+	3, 55, 3, 55, 3, 55, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3,
+	56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 57, 3, 57,
+	3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3,
+	59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60,
+	3, 60, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 62, 3, 62, 3, 62, 3,
+	63, 3, 63, 3, 63, 3, 63, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 65, 3, 65,
+	3, 65, 3, 65, 3, 65, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3,
+	67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 68,
+	3, 68, 3, 68, 3, 68, 3, 68, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3,
+	69, 3, 69, 3, 69, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70,
+	3, 70, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 72, 3, 72, 3,
+	72, 3, 73, 3, 73, 3, 73, 3, 73, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74,
+	3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3,
+	76, 3, 76, 3, 76, 3, 76, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 78,
+	3, 78, 3, 78, 3, 78, 3, 78, 3, 78, 3, 78, 3, 79, 3, 79, 3, 79, 3, 79, 3,
+	79, 3, 79, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 81, 3, 81, 3, 81,
+	3, 81, 3, 81, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 83, 3, 83, 3,
+	83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 85,
+	3, 85, 3, 85, 3, 85, 3, 85, 3, 86, 3, 86, 3, 86, 3, 86, 3, 86, 3, 86, 3,
+	86, 3, 86, 3, 86, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87,
+	3, 87, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 3, 89, 3, 89, 3, 89, 3, 89, 3,
+	89, 3, 89, 3, 89, 3, 90, 3, 90, 3, 90, 3, 90, 3, 90, 3, 90, 3, 90, 3, 91,
+	3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 92, 3, 92, 3, 92, 3, 92, 3,
+	92, 3, 92, 3, 92, 3, 93, 3, 93, 3, 93, 3, 93, 3, 93, 3, 93, 3, 93, 3, 93,
+	3, 93, 3, 94, 3, 94, 3, 94, 3, 94, 3, 95, 3, 95, 3, 95, 3, 96, 3, 96, 3,
+	96, 3, 96, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 98, 3, 98, 3, 98, 3, 98,
+	3, 98, 3, 98, 3, 98, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3, 99, 3,
+	99, 3, 99, 3, 99, 3, 100, 3, 100, 3, 100, 3, 100, 3, 101, 3, 101, 3, 101,
+	3, 101, 3, 101, 3, 102, 3, 102, 3, 102, 3, 102, 3, 102, 3, 102, 3, 102,
+	3, 102, 3, 102, 3, 102, 3, 102, 3, 103, 3, 103, 3, 103, 3, 103, 3, 103,
+	3, 103, 3, 103, 3, 103, 3, 104, 3, 104, 3, 104, 3, 105, 3, 105, 3, 105,
+	3, 105, 3, 105, 3, 105, 3, 105, 3, 106, 3, 106, 3, 106, 3, 106, 3, 106,
+	3, 106, 3, 107, 3, 107, 3, 107, 3, 107, 3, 107, 3, 107, 3, 108, 3, 108,
+	3, 108, 3, 108, 3, 108, 3, 108, 3, 108, 3, 109, 3, 109, 3, 109, 3, 109,
+	5, 109, 1195, 10, 109, 3, 110, 3, 110, 5, 110, 1199, 10, 110, 3, 111, 3,
+	111, 5, 111, 1203, 10, 111, 3, 112, 3, 112, 5, 112, 1207, 10, 112, 3, 113,
+	3, 113, 5, 113, 1211, 10, 113, 3, 114, 3, 114, 3, 115, 3, 115, 3, 116,
+	3, 116, 7, 116, 1219, 10, 116, 12, 116, 14, 116, 1222, 11, 116, 3, 116,
+	5, 116, 1225, 10, 116, 3, 117, 3, 117, 5, 117, 1229, 10, 117, 3, 118, 3,
+	118, 3, 119, 3, 119, 5, 119, 1235, 10, 119, 3, 120, 6, 120, 1238, 10, 120,
+	13, 120, 14, 120, 1239, 3, 121, 3, 121, 3, 121, 3, 121, 3, 122, 3, 122,
+	7, 122, 1248, 10, 122, 12, 122, 14, 122, 1251, 11, 122, 3, 122, 5, 122,
+	1254, 10, 122, 3, 123, 3, 123, 3, 124, 3, 124, 5, 124, 1260, 10, 124, 3,
+	125, 3, 125, 5, 125, 1264, 10, 125, 3, 125, 3, 125, 3, 126, 3, 126, 7,
+	126, 1270, 10, 126, 12, 126, 14, 126, 1273, 11, 126, 3, 126, 5, 126, 1276,
+	10, 126, 3, 127, 3, 127, 3, 128, 3, 128, 5, 128, 1282, 10, 128, 3, 129,
+	3, 129, 3, 129, 3, 129, 3, 130, 3, 130, 7, 130, 1290, 10, 130, 12, 130,
+	14, 130, 1293, 11, 130, 3, 130, 5, 130, 1296, 10, 130, 3, 131, 3, 131,
+	3, 132, 3, 132, 5, 132, 1302, 10, 132, 3, 133, 3, 133, 5, 133, 1306, 10,
+	133, 3, 134, 3, 134, 3, 134, 5, 134, 1311, 10, 134, 3, 134, 5, 134, 1314,
+	10, 134, 3, 134, 5, 134, 1317, 10, 134, 3, 134, 3, 134, 3, 134, 5, 134,
+	1322, 10, 134, 3, 134, 5, 134, 1325, 10, 134, 3, 134, 3, 134, 3, 134, 5,
+	134, 1330, 10, 134, 3, 134, 3, 134, 3, 134, 5, 134, 1335, 10, 134, 3, 135,
+	3, 135, 3, 135, 3, 136, 3, 136, 3, 137, 5, 137, 1343, 10, 137, 3, 137,
+	3, 137, 3, 138, 3, 138, 3, 139, 3, 139, 3, 140, 3, 140, 3, 140, 5, 140,
+	1354, 10, 140, 3, 141, 3, 141, 5, 141, 1358, 10, 141, 3, 141, 3, 141, 3,
+	141, 5, 141, 1363, 10, 141, 3, 141, 3, 141, 5, 141, 1367, 10, 141, 3, 142,
+	3, 142, 3, 142, 3, 143, 3, 143, 3, 144, 3, 144, 3, 144, 3, 144, 3, 144,
+	3, 144, 3, 144, 3, 144, 3, 144, 3, 144, 3, 144, 5, 144, 1385, 10, 144,
+	3, 145, 3, 145, 5, 145, 1389, 10, 145, 3, 145, 3, 145, 3, 146, 6, 146,
+	1394, 10, 146, 13, 146, 14, 146, 1395, 3, 147, 3, 147, 5, 147, 1400, 10,
+	147, 3, 148, 3, 148, 3, 148, 3, 148, 5, 148, 1406, 10, 148, 3, 149, 3,
+	149, 3, 149, 3, 149, 3, 149, 3, 149, 3, 149, 3, 149, 3, 149, 3, 149, 3,
+	149, 5, 149, 1419, 10, 149, 3, 150, 3, 150, 3, 150, 3, 150, 3, 150, 3,
+	150, 3, 150, 3, 151, 3, 151, 3, 152, 3, 152, 3, 152, 3, 152, 3, 152, 3,
+	153, 3, 153, 3, 154, 3, 154, 3, 155, 3, 155, 3, 156, 3, 156, 3, 157, 3,
+	157, 3, 158, 3, 158, 3, 159, 3, 159, 3, 160, 3, 160, 3, 161, 3, 161, 3,
+	162, 3, 162, 3, 163, 3, 163, 3, 164, 3, 164, 3, 165, 3, 165, 3, 166, 3,
+	166, 3, 167, 3, 167, 3, 168, 3, 168, 3, 169, 3, 169, 3, 169, 3, 170, 3,
+	170, 3, 170, 3, 170, 3, 171, 3, 171, 3, 171, 3, 172, 3, 172, 3, 172, 3,
+	173, 3, 173, 3, 173, 3, 174, 3, 174, 3, 174, 3, 175, 3, 175, 3, 175, 3,
+	176, 3, 176, 3, 176, 3, 177, 3, 177, 3, 177, 3, 178, 3, 178, 3, 179, 3,
+	179, 3, 180, 3, 180, 3, 181, 3, 181, 3, 182, 3, 182, 3, 183, 3, 183, 3,
+	184, 3, 184, 3, 185, 3, 185, 3, 186, 3, 186, 3, 186, 3, 187, 3, 187, 3,
+	187, 3, 188, 3, 188, 3, 188, 3, 189, 3, 189, 3, 189, 3, 190, 3, 190, 3,
+	190, 3, 191, 3, 191, 3, 191, 3, 192, 3, 192, 3, 192, 3, 193, 3, 193, 3,
+	193, 3, 194, 3, 194, 3, 194, 3, 194, 3, 195, 3, 195, 3, 195, 3, 195, 3,
+	196, 3, 196, 3, 196, 3, 196, 3, 196, 3, 197, 3, 197, 3, 197, 3, 198, 3,
+	198, 7, 198, 1553, 10, 198, 12, 198, 14, 198, 1556, 11, 198, 3, 199, 3,
+	199, 3, 200, 3, 200, 3, 201, 3, 201, 3, 202, 3, 202, 3, 202, 3, 202, 3,
+	203, 6, 203, 1569, 10, 203, 13, 203, 14, 203, 1570, 3, 203, 3, 203, 3,
+	204, 3, 204, 3, 204, 3, 204, 3, 204, 3, 204, 7, 204, 1581, 10, 204, 12,
+	204, 14, 204, 1584, 11, 204, 3, 204, 3, 204, 3, 204, 3, 204, 3, 204, 3,
+	205, 3, 205, 3, 205, 3, 205, 3, 205, 3, 205, 3, 206, 3, 206, 3, 206, 3,
+	206, 7, 206, 1601, 10, 206, 12, 206, 14, 206, 1604, 11, 206, 3, 206, 3,
+	206, 3, 206, 3, 206, 3, 206, 3, 207, 3, 207, 3, 207, 3, 207, 3, 207, 3,
+	208, 3, 208, 3, 208, 3, 208, 7, 208, 1620, 10, 208, 12, 208, 14, 208, 1623,
+	11, 208, 3, 208, 3, 208, 3, 209, 3, 209, 3, 209, 3, 209, 3, 210, 3, 210,
+	3, 211, 3, 211, 3, 212, 3, 212, 3, 213, 3, 213, 3, 214, 3, 214, 3, 215,
+	3, 215, 3, 216, 3, 216, 3, 217, 3, 217, 3, 218, 3, 218, 3, 219, 3, 219,
+	3, 220, 3, 220, 3, 221, 3, 221, 3, 222, 3, 222, 3, 223, 3, 223, 3, 224,
+	3, 224, 3, 225, 3, 225, 3, 226, 3, 226, 3, 227, 3, 227, 3, 228, 3, 228,
+	3, 229, 3, 229, 3, 230, 3, 230, 3, 231, 3, 231, 3, 232, 3, 232, 3, 233,
+	3, 233, 3, 234, 3, 234, 3, 235, 3, 235, 3, 236, 3, 236, 4, 1582, 1602,
+	2, 237, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21,
+	12, 23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37, 20, 39,
+	21, 41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55, 29, 57,
+	30, 59, 31, 61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71, 37, 73, 38, 75,
+	39, 77, 40, 79, 41, 81, 42, 83, 43, 85, 44, 87, 45, 89, 46, 91, 47, 93,
+	48, 95, 49, 97, 50, 99, 51, 101, 52, 103, 53, 105, 54, 107, 55, 109, 56,
+	111, 57, 113, 58, 115, 59, 117, 60, 119, 61, 121, 62, 123, 63, 125, 64,
+	127, 65, 129, 66, 131, 67, 133, 68, 135, 69, 137, 70, 139, 71, 141, 72,
+	143, 73, 145, 74, 147, 75, 149, 76, 151, 77, 153, 78, 155, 79, 157, 80,
+	159, 81, 161, 82, 163, 83, 165, 84, 167, 85, 169, 86, 171, 87, 173, 88,
+	175, 89, 177, 90, 179, 91, 181, 92, 183, 93, 185, 94, 187, 95, 189, 96,
+	191, 97, 193, 98, 195, 99, 197, 100, 199, 101, 201, 102, 203, 103, 205,
+	104, 207, 105, 209, 106, 211, 107, 213, 108, 215, 109, 217, 110, 219, 2,
+	221, 2, 223, 2, 225, 2, 227, 2, 229, 2, 231, 2, 233, 2, 235, 2, 237, 2,
+	239, 2, 241, 2, 243, 2, 245, 2, 247, 2, 249, 2, 251, 2, 253, 2, 255, 2,
+	257, 2, 259, 2, 261, 2, 263, 2, 265, 111, 267, 2, 269, 2, 271, 2, 273,
+	2, 275, 2, 277, 2, 279, 2, 281, 2, 283, 2, 285, 2, 287, 112, 289, 113,
+	291, 2, 293, 2, 295, 2, 297, 2, 299, 2, 301, 2, 303, 114, 305, 115, 307,
+	116, 309, 117, 311, 118, 313, 119, 315, 120, 317, 121, 319, 122, 321, 123,
+	323, 124, 325, 125, 327, 126, 329, 127, 331, 128, 333, 129, 335, 130, 337,
+	131, 339, 132, 341, 133, 343, 134, 345, 135, 347, 136, 349, 137, 351, 138,
+	353, 139, 355, 140, 357, 141, 359, 142, 361, 143, 363, 144, 365, 145, 367,
+	146, 369, 147, 371, 148, 373, 149, 375, 150, 377, 151, 379, 152, 381, 153,
+	383, 154, 385, 155, 387, 156, 389, 157, 391, 158, 393, 159, 395, 160, 397,
+	2, 399, 2, 401, 161, 403, 162, 405, 163, 407, 164, 409, 165, 411, 166,
+	413, 167, 415, 168, 417, 169, 419, 2, 421, 2, 423, 2, 425, 2, 427, 2, 429,
+	2, 431, 2, 433, 2, 435, 2, 437, 2, 439, 2, 441, 2, 443, 2, 445, 2, 447,
+	2, 449, 2, 451, 2, 453, 2, 455, 2, 457, 2, 459, 2, 461, 2, 463, 2, 465,
+	2, 467, 2, 469, 2, 471, 2, 3, 2, 41, 4, 2, 78, 78, 110, 110, 3, 2, 51,
+	59, 4, 2, 90, 90, 122, 122, 5, 2, 50, 59, 67, 72, 99, 104, 3, 2, 50, 57,
+	4, 2, 68, 68, 100, 100, 3, 2, 50, 51, 4, 2, 71, 71, 103, 103, 4, 2, 45,
+	45, 47, 47, 6, 2, 70, 70, 72, 72, 102, 102, 104, 104, 4, 2, 82, 82, 114,
+	114, 4, 2, 41, 41, 94, 94, 10, 2, 36, 36, 41, 41, 94, 94, 100, 100, 104,
+	104, 112, 112, 116, 116, 118, 118, 3, 2, 50, 53, 6, 2, 38, 38, 67, 92,
+	97, 97, 99, 124, 7, 2, 38, 38, 50, 59, 67, 92, 97, 97, 99, 124, 5, 2, 11,
+	12, 14, 15, 34, 34, 4, 2, 12, 12, 15, 15, 4, 2, 67, 67, 99, 99, 4, 2, 69,
+	69, 101, 101, 4, 2, 70, 70, 102, 102, 4, 2, 72, 72, 104, 104, 4, 2, 73,
+	73, 105, 105, 4, 2, 74, 74, 106, 106, 4, 2, 75, 75, 107, 107, 4, 2, 76,
+	76, 108, 108, 4, 2, 77, 77, 109, 109, 4, 2, 79, 79, 111, 111, 4, 2, 80,
+	80, 112, 112, 4, 2, 81, 81, 113, 113, 4, 2, 83, 83, 115, 115, 4, 2, 84,
+	84, 116, 116, 4, 2, 85, 85, 117, 117, 4, 2, 86, 86, 118, 118, 4, 2, 87,
+	87, 119, 119, 4, 2, 88, 88, 120, 120, 4, 2, 89, 89, 121, 121, 4, 2, 91,
+	91, 123, 123, 4, 2, 92, 92, 124, 124, 2, 1665, 2, 3, 3, 2, 2, 2, 2, 5,
+	3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13,
+	3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2,
+	21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2,
+	2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2,
+	2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2,
+	2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3,
+	2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59,
+	3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2,
+	67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 73, 3, 2, 2, 2,
+	2, 75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 2, 79, 3, 2, 2, 2, 2, 81, 3, 2, 2,
+	2, 2, 83, 3, 2, 2, 2, 2, 85, 3, 2, 2, 2, 2, 87, 3, 2, 2, 2, 2, 89, 3, 2,
+	2, 2, 2, 91, 3, 2, 2, 2, 2, 93, 3, 2, 2, 2, 2, 95, 3, 2, 2, 2, 2, 97, 3,
+	2, 2, 2, 2, 99, 3, 2, 2, 2, 2, 101, 3, 2, 2, 2, 2, 103, 3, 2, 2, 2, 2,
+	105, 3, 2, 2, 2, 2, 107, 3, 2, 2, 2, 2, 109, 3, 2, 2, 2, 2, 111, 3, 2,
+	2, 2, 2, 113, 3, 2, 2, 2, 2, 115, 3, 2, 2, 2, 2, 117, 3, 2, 2, 2, 2, 119,
+	3, 2, 2, 2, 2, 121, 3, 2, 2, 2, 2, 123, 3, 2, 2, 2, 2, 125, 3, 2, 2, 2,
+	2, 127, 3, 2, 2, 2, 2, 129, 3, 2, 2, 2, 2, 131, 3, 2, 2, 2, 2, 133, 3,
+	2, 2, 2, 2, 135, 3, 2, 2, 2, 2, 137, 3, 2, 2, 2, 2, 139, 3, 2, 2, 2, 2,
+	141, 3, 2, 2, 2, 2, 143, 3, 2, 2, 2, 2, 145, 3, 2, 2, 2, 2, 147, 3, 2,
+	2, 2, 2, 149, 3, 2, 2, 2, 2, 151, 3, 2, 2, 2, 2, 153, 3, 2, 2, 2, 2, 155,
+	3, 2, 2, 2, 2, 157, 3, 2, 2, 2, 2, 159, 3, 2, 2, 2, 2, 161, 3, 2, 2, 2,
+	2, 163, 3, 2, 2, 2, 2, 165, 3, 2, 2, 2, 2, 167, 3, 2, 2, 2, 2, 169, 3,
+	2, 2, 2, 2, 171, 3, 2, 2, 2, 2, 173, 3, 2, 2, 2, 2, 175, 3, 2, 2, 2, 2,
+	177, 3, 2, 2, 2, 2, 179, 3, 2, 2, 2, 2, 181, 3, 2, 2, 2, 2, 183, 3, 2,
+	2, 2, 2, 185, 3, 2, 2, 2, 2, 187, 3, 2, 2, 2, 2, 189, 3, 2, 2, 2, 2, 191,
+	3, 2, 2, 2, 2, 193, 3, 2, 2, 2, 2, 195, 3, 2, 2, 2, 2, 197, 3, 2, 2, 2,
+	2, 199, 3, 2, 2, 2, 2, 201, 3, 2, 2, 2, 2, 203, 3, 2, 2, 2, 2, 205, 3,
+	2, 2, 2, 2, 207, 3, 2, 2, 2, 2, 209, 3, 2, 2, 2, 2, 211, 3, 2, 2, 2, 2,
+	213, 3, 2, 2, 2, 2, 215, 3, 2, 2, 2, 2, 217, 3, 2, 2, 2, 2, 265, 3, 2,
+	2, 2, 2, 287, 3, 2, 2, 2, 2, 289, 3, 2, 2, 2, 2, 303, 3, 2, 2, 2, 2, 305,
+	3, 2, 2, 2, 2, 307, 3, 2, 2, 2, 2, 309, 3, 2, 2, 2, 2, 311, 3, 2, 2, 2,
+	2, 313, 3, 2, 2, 2, 2, 315, 3, 2, 2, 2, 2, 317, 3, 2, 2, 2, 2, 319, 3,
+	2, 2, 2, 2, 321, 3, 2, 2, 2, 2, 323, 3, 2, 2, 2, 2, 325, 3, 2, 2, 2, 2,
+	327, 3, 2, 2, 2, 2, 329, 3, 2, 2, 2, 2, 331, 3, 2, 2, 2, 2, 333, 3, 2,
+	2, 2, 2, 335, 3, 2, 2, 2, 2, 337, 3, 2, 2, 2, 2, 339, 3, 2, 2, 2, 2, 341,
+	3, 2, 2, 2, 2, 343, 3, 2, 2, 2, 2, 345, 3, 2, 2, 2, 2, 347, 3, 2, 2, 2,
+	2, 349, 3, 2, 2, 2, 2, 351, 3, 2, 2, 2, 2, 353, 3, 2, 2, 2, 2, 355, 3,
+	2, 2, 2, 2, 357, 3, 2, 2, 2, 2, 359, 3, 2, 2, 2, 2, 361, 3, 2, 2, 2, 2,
+	363, 3, 2, 2, 2, 2, 365, 3, 2, 2, 2, 2, 367, 3, 2, 2, 2, 2, 369, 3, 2,
+	2, 2, 2, 371, 3, 2, 2, 2, 2, 373, 3, 2, 2, 2, 2, 375, 3, 2, 2, 2, 2, 377,
+	3, 2, 2, 2, 2, 379, 3, 2, 2, 2, 2, 381, 3, 2, 2, 2, 2, 383, 3, 2, 2, 2,
+	2, 385, 3, 2, 2, 2, 2, 387, 3, 2, 2, 2, 2, 389, 3, 2, 2, 2, 2, 391, 3,
+	2, 2, 2, 2, 393, 3, 2, 2, 2, 2, 395, 3, 2, 2, 2, 2, 401, 3, 2, 2, 2, 2,
+	403, 3, 2, 2, 2, 2, 405, 3, 2, 2, 2, 2, 407, 3, 2, 2, 2, 2, 409, 3, 2,
+	2, 2, 2, 411, 3, 2, 2, 2, 2, 413, 3, 2, 2, 2, 2, 415, 3, 2, 2, 2, 2, 417,
+	3, 2, 2, 2, 3, 473, 3, 2, 2, 2, 5, 476, 3, 2, 2, 2, 7, 485, 3, 2, 2, 2,
+	9, 493, 3, 2, 2, 2, 11, 497, 3, 2, 2, 2, 13, 501, 3, 2, 2, 2, 15, 510,
+	3, 2, 2, 2, 17, 518, 3, 2, 2, 2, 19, 524, 3, 2, 2, 2, 21, 530, 3, 2, 2,
+	2, 23, 536, 3, 2, 2, 2, 25, 542, 3, 2, 2, 2, 27, 551, 3, 2, 2, 2, 29, 559,
+	3, 2, 2, 2, 31, 562, 3, 2, 2, 2, 33, 569, 3, 2, 2, 2, 35, 574, 3, 2, 2,
+	2, 37, 579, 3, 2, 2, 2, 39, 587, 3, 2, 2, 2, 41, 593, 3, 2, 2, 2, 43, 601,
+	3, 2, 2, 2, 45, 607, 3, 2, 2, 2, 47, 611, 3, 2, 2, 2, 49, 614, 3, 2, 2,
+	2, 51, 619, 3, 2, 2, 2, 53, 630, 3, 2, 2, 2, 55, 637, 3, 2, 2, 2, 57, 648,
+	3, 2, 2, 2, 59, 656, 3, 2, 2, 2, 61, 663, 3, 2, 2, 2, 63, 673, 3, 2, 2,
+	2, 65, 678, 3, 2, 2, 2, 67, 685, 3, 2, 2, 2, 69, 689, 3, 2, 2, 2, 71, 697,
+	3, 2, 2, 2, 73, 705, 3, 2, 2, 2, 75, 715, 3, 2, 2, 2, 77, 722, 3, 2, 2,
+	2, 79, 729, 3, 2, 2, 2, 81, 736, 3, 2, 2, 2, 83, 742, 3, 2, 2, 2, 85, 755,
+	3, 2, 2, 2, 87, 760, 3, 2, 2, 2, 89, 766, 3, 2, 2, 2, 91, 773, 3, 2, 2,
+	2, 93, 783, 3, 2, 2, 2, 95, 787, 3, 2, 2, 2, 97, 792, 3, 2, 2, 2, 99, 801,
+	3, 2, 2, 2, 101, 807, 3, 2, 2, 2, 103, 814, 3, 2, 2, 2, 105, 819, 3, 2,
+	2, 2, 107, 826, 3, 2, 2, 2, 109, 837, 3, 2, 2, 2, 111, 850, 3, 2, 2, 2,
+	113, 866, 3, 2, 2, 2, 115, 873, 3, 2, 2, 2, 117, 878, 3, 2, 2, 2, 119,
+	884, 3, 2, 2, 2, 121, 890, 3, 2, 2, 2, 123, 896, 3, 2, 2, 2, 125, 899,
+	3, 2, 2, 2, 127, 903, 3, 2, 2, 2, 129, 908, 3, 2, 2, 2, 131, 913, 3, 2,
+	2, 2, 133, 920, 3, 2, 2, 2, 135, 930, 3, 2, 2, 2, 137, 935, 3, 2, 2, 2,
+	139, 944, 3, 2, 2, 2, 141, 953, 3, 2, 2, 2, 143, 960, 3, 2, 2, 2, 145,
+	963, 3, 2, 2, 2, 147, 967, 3, 2, 2, 2, 149, 973, 3, 2, 2, 2, 151, 978,
+	3, 2, 2, 2, 153, 987, 3, 2, 2, 2, 155, 993, 3, 2, 2, 2, 157, 1000, 3, 2,
+	2, 2, 159, 1006, 3, 2, 2, 2, 161, 1012, 3, 2, 2, 2, 163, 1017, 3, 2, 2,
+	2, 165, 1023, 3, 2, 2, 2, 167, 1030, 3, 2, 2, 2, 169, 1035, 3, 2, 2, 2,
+	171, 1040, 3, 2, 2, 2, 173, 1049, 3, 2, 2, 2, 175, 1058, 3, 2, 2, 2, 177,
+	1063, 3, 2, 2, 2, 179, 1070, 3, 2, 2, 2, 181, 1077, 3, 2, 2, 2, 183, 1084,
+	3, 2, 2, 2, 185, 1091, 3, 2, 2, 2, 187, 1100, 3, 2, 2, 2, 189, 1104, 3,
+	2, 2, 2, 191, 1107, 3, 2, 2, 2, 193, 1111, 3, 2, 2, 2, 195, 1116, 3, 2,
+	2, 2, 197, 1123, 3, 2, 2, 2, 199, 1133, 3, 2, 2, 2, 201, 1137, 3, 2, 2,
+	2, 203, 1142, 3, 2, 2, 2, 205, 1153, 3, 2, 2, 2, 207, 1161, 3, 2, 2, 2,
+	209, 1164, 3, 2, 2, 2, 211, 1171, 3, 2, 2, 2, 213, 1177, 3, 2, 2, 2, 215,
+	1183, 3, 2, 2, 2, 217, 1194, 3, 2, 2, 2, 219, 1196, 3, 2, 2, 2, 221, 1200,
+	3, 2, 2, 2, 223, 1204, 3, 2, 2, 2, 225, 1208, 3, 2, 2, 2, 227, 1212, 3,
+	2, 2, 2, 229, 1214, 3, 2, 2, 2, 231, 1216, 3, 2, 2, 2, 233, 1228, 3, 2,
+	2, 2, 235, 1230, 3, 2, 2, 2, 237, 1234, 3, 2, 2, 2, 239, 1237, 3, 2, 2,
+	2, 241, 1241, 3, 2, 2, 2, 243, 1245, 3, 2, 2, 2, 245, 1255, 3, 2, 2, 2,
+	247, 1259, 3, 2, 2, 2, 249, 1261, 3, 2, 2, 2, 251, 1267, 3, 2, 2, 2, 253,
+	1277, 3, 2, 2, 2, 255, 1281, 3, 2, 2, 2, 257, 1283, 3, 2, 2, 2, 259, 1287,
+	3, 2, 2, 2, 261, 1297, 3, 2, 2, 2, 263, 1301, 3, 2, 2, 2, 265, 1305, 3,
+	2, 2, 2, 267, 1334, 3, 2, 2, 2, 269, 1336, 3, 2, 2, 2, 271, 1339, 3, 2,
+	2, 2, 273, 1342, 3, 2, 2, 2, 275, 1346, 3, 2, 2, 2, 277, 1348, 3, 2, 2,
+	2, 279, 1350, 3, 2, 2, 2, 281, 1366, 3, 2, 2, 2, 283, 1368, 3, 2, 2, 2,
+	285, 1371, 3, 2, 2, 2, 287, 1384, 3, 2, 2, 2, 289, 1386, 3, 2, 2, 2, 291,
+	1393, 3, 2, 2, 2, 293, 1399, 3, 2, 2, 2, 295, 1405, 3, 2, 2, 2, 297, 1418,
+	3, 2, 2, 2, 299, 1420, 3, 2, 2, 2, 301, 1427, 3, 2, 2, 2, 303, 1429, 3,
+	2, 2, 2, 305, 1434, 3, 2, 2, 2, 307, 1436, 3, 2, 2, 2, 309, 1438, 3, 2,
+	2, 2, 311, 1440, 3, 2, 2, 2, 313, 1442, 3, 2, 2, 2, 315, 1444, 3, 2, 2,
+	2, 317, 1446, 3, 2, 2, 2, 319, 1448, 3, 2, 2, 2, 321, 1450, 3, 2, 2, 2,
+	323, 1452, 3, 2, 2, 2, 325, 1454, 3, 2, 2, 2, 327, 1456, 3, 2, 2, 2, 329,
+	1458, 3, 2, 2, 2, 331, 1460, 3, 2, 2, 2, 333, 1462, 3, 2, 2, 2, 335, 1464,
+	3, 2, 2, 2, 337, 1466, 3, 2, 2, 2, 339, 1469, 3, 2, 2, 2, 341, 1473, 3,
+	2, 2, 2, 343, 1476, 3, 2, 2, 2, 345, 1479, 3, 2, 2, 2, 347, 1482, 3, 2,
+	2, 2, 349, 1485, 3, 2, 2, 2, 351, 1488, 3, 2, 2, 2, 353, 1491, 3, 2, 2,
+	2, 355, 1494, 3, 2, 2, 2, 357, 1496, 3, 2, 2, 2, 359, 1498, 3, 2, 2, 2,
+	361, 1500, 3, 2, 2, 2, 363, 1502, 3, 2, 2, 2, 365, 1504, 3, 2, 2, 2, 367,
+	1506, 3, 2, 2, 2, 369, 1508, 3, 2, 2, 2, 371, 1510, 3, 2, 2, 2, 373, 1513,
+	3, 2, 2, 2, 375, 1516, 3, 2, 2, 2, 377, 1519, 3, 2, 2, 2, 379, 1522, 3,
+	2, 2, 2, 381, 1525, 3, 2, 2, 2, 383, 1528, 3, 2, 2, 2, 385, 1531, 3, 2,
+	2, 2, 387, 1534, 3, 2, 2, 2, 389, 1538, 3, 2, 2, 2, 391, 1542, 3, 2, 2,
+	2, 393, 1547, 3, 2, 2, 2, 395, 1550, 3, 2, 2, 2, 397, 1557, 3, 2, 2, 2,
+	399, 1559, 3, 2, 2, 2, 401, 1561, 3, 2, 2, 2, 403, 1563, 3, 2, 2, 2, 405,
+	1568, 3, 2, 2, 2, 407, 1574, 3, 2, 2, 2, 409, 1590, 3, 2, 2, 2, 411, 1596,
+	3, 2, 2, 2, 413, 1610, 3, 2, 2, 2, 415, 1615, 3, 2, 2, 2, 417, 1626, 3,
+	2, 2, 2, 419, 1630, 3, 2, 2, 2, 421, 1632, 3, 2, 2, 2, 423, 1634, 3, 2,
+	2, 2, 425, 1636, 3, 2, 2, 2, 427, 1638, 3, 2, 2, 2, 429, 1640, 3, 2, 2,
+	2, 431, 1642, 3, 2, 2, 2, 433, 1644, 3, 2, 2, 2, 435, 1646, 3, 2, 2, 2,
+	437, 1648, 3, 2, 2, 2, 439, 1650, 3, 2, 2, 2, 441, 1652, 3, 2, 2, 2, 443,
+	1654, 3, 2, 2, 2, 445, 1656, 3, 2, 2, 2, 447, 1658, 3, 2, 2, 2, 449, 1660,
+	3, 2, 2, 2, 451, 1662, 3, 2, 2, 2, 453, 1664, 3, 2, 2, 2, 455, 1666, 3,
+	2, 2, 2, 457, 1668, 3, 2, 2, 2, 459, 1670, 3, 2, 2, 2, 461, 1672, 3, 2,
+	2, 2, 463, 1674, 3, 2, 2, 2, 465, 1676, 3, 2, 2, 2, 467, 1678, 3, 2, 2,
+	2, 469, 1680, 3, 2, 2, 2, 471, 1682, 3, 2, 2, 2, 473, 474, 7, 62, 2, 2,
+	474, 475, 7, 64, 2, 2, 475, 4, 3, 2, 2, 2, 476, 477, 5, 447, 224, 2, 477,
+	478, 5, 461, 231, 2, 478, 479, 5, 427, 214, 2, 479, 480, 5, 453, 227, 2,
+	480, 481, 5, 453, 227, 2, 481, 482, 5, 435, 218, 2, 482, 483, 5, 425, 213,
+	2, 483, 484, 5, 427, 214, 2, 484, 6, 3, 2, 2, 2, 485, 486, 5, 461, 231,
+	2, 486, 487, 5, 435, 218, 2, 487, 488, 5, 453, 227, 2, 488, 489, 5, 457,
+	229, 2, 489, 490, 5, 459, 230, 2, 490, 491, 5, 419, 210, 2, 491, 492, 5,
+	441, 221, 2, 492, 8, 3, 2, 2, 2, 493, 494, 5, 455, 228, 2, 494, 495, 5,
+	427, 214, 2, 495, 496, 5, 457, 229, 2, 496, 10, 3, 2, 2, 2, 497, 498, 5,
+	431, 216, 2, 498, 499, 5, 427, 214, 2, 499, 500, 5, 457, 229, 2, 500, 12,
+	3, 2, 2, 2, 501, 502, 5, 419, 210, 2, 502, 503, 5, 421, 211, 2, 503, 504,
+	5, 455, 228, 2, 504, 505, 5, 457, 229, 2, 505, 506, 5, 453, 227, 2, 506,
+	507, 5, 419, 210, 2, 507, 508, 5, 423, 212, 2, 508, 509, 5, 457, 229, 2,
+	509, 14, 3, 2, 2, 2, 510, 511, 5, 421, 211, 2, 511, 512, 5, 447, 224, 2,
+	512, 513, 5, 447, 224, 2, 513, 514, 5, 441, 221, 2, 514, 515, 5, 427, 214,
+	2, 515, 516, 5, 419, 210, 2, 516, 517, 5, 445, 223, 2, 517, 16, 3, 2, 2,
+	2, 518, 519, 5, 421, 211, 2, 519, 520, 5, 453, 227, 2, 520, 521, 5, 427,
+	214, 2, 521, 522, 5, 419, 210, 2, 522, 523, 5, 439, 220, 2, 523, 18, 3,
+	2, 2, 2, 524, 525, 5, 423, 212, 2, 525, 526, 5, 419, 210, 2, 526, 527,
+	5, 457, 229, 2, 527, 528, 5, 423, 212, 2, 528, 529, 5, 433, 217, 2, 529,
+	20, 3, 2, 2, 2, 530, 531, 5, 423, 212, 2, 531, 532, 5, 441, 221, 2, 532,
+	533, 5, 419, 210, 2, 533, 534, 5, 455, 228, 2, 534, 535, 5, 455, 228, 2,
+	535, 22, 3, 2, 2, 2, 536, 537, 5, 423, 212, 2, 537, 538, 5, 447, 224, 2,
+	538, 539, 5, 445, 223, 2, 539, 540, 5, 455, 228, 2, 540, 541, 5, 457, 229,
+	2, 541, 24, 3, 2, 2, 2, 542, 543, 5, 423, 212, 2, 543, 544, 5, 447, 224,
+	2, 544, 545, 5, 445, 223, 2, 545, 546, 5, 457, 229, 2, 546, 547, 5, 435,
+	218, 2, 547, 548, 5, 445, 223, 2, 548, 549, 5, 459, 230, 2, 549, 550, 5,
+	427, 214, 2, 550, 26, 3, 2, 2, 2, 551, 552, 5, 425, 213, 2, 552, 553, 5,
+	427, 214, 2, 553, 554, 5, 429, 215, 2, 554, 555, 5, 419, 210, 2, 555, 556,
+	5, 459, 230, 2, 556, 557, 5, 441, 221, 2, 557, 558, 5, 457, 229, 2, 558,
+	28, 3, 2, 2, 2, 559, 560, 5, 425, 213, 2, 560, 561, 5, 447, 224, 2, 561,
+	30, 3, 2, 2, 2, 562, 563, 5, 425, 213, 2, 563, 564, 5, 447, 224, 2, 564,
+	565, 5, 459, 230, 2, 565, 566, 5, 421, 211, 2, 566, 567, 5, 441, 221, 2,
+	567, 568, 5, 427, 214, 2, 568, 32, 3, 2, 2, 2, 569, 570, 5, 427, 214, 2,
+	570, 571, 5, 441, 221, 2, 571, 572, 5, 455, 228, 2, 572, 573, 5, 427, 214,
+	2, 573, 34, 3, 2, 2, 2, 574, 575, 5, 427, 214, 2, 575, 576, 5, 445, 223,
+	2, 576, 577, 5, 459, 230, 2, 577, 578, 5, 443, 222, 2, 578, 36, 3, 2, 2,
+	2, 579, 580, 5, 427, 214, 2, 580, 581, 5, 465, 233, 2, 581, 582, 5, 457,
+	229, 2, 582, 583, 5, 427, 214, 2, 583, 584, 5, 445, 223, 2, 584, 585, 5,
+	425, 213, 2, 585, 586, 5, 455, 228, 2, 586, 38, 3, 2, 2, 2, 587, 588, 5,
+	429, 215, 2, 588, 589, 5, 435, 218, 2, 589, 590, 5, 445, 223, 2, 590, 591,
+	5, 419, 210, 2, 591, 592, 5, 441, 221, 2, 592, 40, 3, 2, 2, 2, 593, 594,
+	5, 429, 215, 2, 594, 595, 5, 435, 218, 2, 595, 596, 5, 445, 223, 2, 596,
+	597, 5, 419, 210, 2, 597, 598, 5, 441, 221, 2, 598, 599, 5, 441, 221, 2,
+	599, 600, 5, 467, 234, 2, 600, 42, 3, 2, 2, 2, 601, 602, 5, 429, 215, 2,
+	602, 603, 5, 441, 221, 2, 603, 604, 5, 447, 224, 2, 604, 605, 5, 419, 210,
+	2, 605, 606, 5, 457, 229, 2, 606, 44, 3, 2, 2, 2, 607, 608, 5, 429, 215,
+	2, 608, 609, 5, 447, 224, 2, 609, 610, 5, 453, 227, 2, 610, 46, 3, 2, 2,
+	2, 611, 612, 5, 435, 218, 2, 612, 613, 5, 429, 215, 2, 613, 48, 3, 2, 2,
+	2, 614, 615, 5, 431, 216, 2, 615, 616, 5, 447, 224, 2, 616, 617, 5, 457,
+	229, 2, 617, 618, 5, 447, 224, 2, 618, 50, 3, 2, 2, 2, 619, 620, 5, 435,
+	218, 2, 620, 621, 5, 443, 222, 2, 621, 622, 5, 449, 225, 2, 622, 623, 5,
+	441, 221, 2, 623, 624, 5, 427, 214, 2, 624, 625, 5, 443, 222, 2, 625, 626,
+	5, 427, 214, 2, 626, 627, 5, 445, 223, 2, 627, 628, 5, 457, 229, 2, 628,
+	629, 5, 455, 228, 2, 629, 52, 3, 2, 2, 2, 630, 631, 5, 435, 218, 2, 631,
+	632, 5, 443, 222, 2, 632, 633, 5, 449, 225, 2, 633, 634, 5, 447, 224, 2,
+	634, 635, 5, 453, 227, 2, 635, 636, 5, 457, 229, 2, 636, 54, 3, 2, 2, 2,
+	637, 638, 5, 435, 218, 2, 638, 639, 5, 445, 223, 2, 639, 640, 5, 455, 228,
+	2, 640, 641, 5, 457, 229, 2, 641, 642, 5, 419, 210, 2, 642, 643, 5, 445,
+	223, 2, 643, 644, 5, 423, 212, 2, 644, 645, 5, 427, 214, 2, 645, 646, 5,
+	447, 224, 2, 646, 647, 5, 429, 215, 2, 647, 56, 3, 2, 2, 2, 648, 649, 5,
+	435, 218, 2, 649, 650, 5, 445, 223, 2, 650, 651, 5, 457, 229, 2, 651, 652,
+	5, 427, 214, 2, 652, 653, 5, 431, 216, 2, 653, 654, 5, 427, 214, 2, 654,
+	655, 5, 453, 227, 2, 655, 58, 3, 2, 2, 2, 656, 657, 5, 455, 228, 2, 657,
+	658, 5, 457, 229, 2, 658, 659, 5, 453, 227, 2, 659, 660, 5, 435, 218, 2,
+	660, 661, 5, 445, 223, 2, 661, 662, 5, 431, 216, 2, 662, 60, 3, 2, 2, 2,
+	663, 664, 5, 435, 218, 2, 664, 665, 5, 445, 223, 2, 665, 666, 5, 457, 229,
+	2, 666, 667, 5, 427, 214, 2, 667, 668, 5, 453, 227, 2, 668, 669, 5, 429,
+	215, 2, 669, 670, 5, 419, 210, 2, 670, 671, 5, 423, 212, 2, 671, 672, 5,
+	427, 214, 2, 672, 62, 3, 2, 2, 2, 673, 674, 5, 441, 221, 2, 674, 675, 5,
+	447, 224, 2, 675, 676, 5, 445, 223, 2, 676, 677, 5, 431, 216, 2, 677, 64,
+	3, 2, 2, 2, 678, 679, 5, 445, 223, 2, 679, 680, 5, 419, 210, 2, 680, 681,
+	5, 457, 229, 2, 681, 682, 5, 435, 218, 2, 682, 683, 5, 461, 231, 2, 683,
+	684, 5, 427, 214, 2, 684, 66, 3, 2, 2, 2, 685, 686, 5, 445, 223, 2, 686,
+	687, 5, 427, 214, 2, 687, 688, 5, 463, 232, 2, 688, 68, 3, 2, 2, 2, 689,
+	690, 5, 449, 225, 2, 690, 691, 5, 419, 210, 2, 691, 692, 5, 423, 212, 2,
+	692, 693, 5, 439, 220, 2, 693, 694, 5, 419, 210, 2, 694, 695, 5, 431, 216,
+	2, 695, 696, 5, 427, 214, 2, 696, 70, 3, 2, 2, 2, 697, 698, 5, 449, 225,
+	2, 698, 699, 5, 453, 227, 2, 699, 700, 5, 435, 218, 2, 700, 701, 5, 461,
+	231, 2, 701, 702, 5, 419, 210, 2, 702, 703, 5, 457, 229, 2, 703, 704, 5,
+	427, 214, 2, 704, 72, 3, 2, 2, 2, 705, 706, 5, 449, 225, 2, 706, 707, 5,
+	453, 227, 2, 707, 708, 5, 447, 224, 2, 708, 709, 5, 457, 229, 2, 709, 710,
+	5, 427, 214, 2, 710, 711, 5, 423, 212, 2, 711, 712, 5, 457, 229, 2, 712,
+	713, 5, 427, 214, 2, 713, 714, 5, 425, 213, 2, 714, 74, 3, 2, 2, 2, 715,
+	716, 5, 449, 225, 2, 716, 717, 5, 459, 230, 2, 717, 718, 5, 421, 211, 2,
+	718, 719, 5, 441, 221, 2, 719, 720, 5, 435, 218, 2, 720, 721, 5, 423, 212,
+	2, 721, 76, 3, 2, 2, 2, 722, 723, 5, 453, 227, 2, 723, 724, 5, 427, 214,
+	2, 724, 725, 5, 457, 229, 2, 725, 726, 5, 459, 230, 2, 726, 727, 5, 453,
+	227, 2, 727, 728, 5, 445, 223, 2, 728, 78, 3, 2, 2, 2, 729, 730, 5, 455,
+	228, 2, 730, 731, 5, 457, 229, 2, 731, 732, 5, 419, 210, 2, 732, 733, 5,
+	457, 229, 2, 733, 734, 5, 435, 218, 2, 734, 735, 5, 423, 212, 2, 735, 80,
+	3, 2, 2, 2, 736, 737, 5, 455, 228, 2, 737, 738, 5, 459, 230, 2, 738, 739,
+	5, 449, 225, 2, 739, 740, 5, 427, 214, 2, 740, 741, 5, 453, 227, 2, 741,
+	82, 3, 2, 2, 2, 742, 743, 5, 455, 228, 2, 743, 744, 5, 467, 234, 2, 744,
+	745, 5, 445, 223, 2, 745, 746, 5, 423, 212, 2, 746, 747, 5, 433, 217, 2,
+	747, 748, 5, 453, 227, 2, 748, 749, 5, 447, 224, 2, 749, 750, 5, 445, 223,
+	2, 750, 751, 5, 435, 218, 2, 751, 752, 5, 469, 235, 2, 752, 753, 5, 427,
+	214, 2, 753, 754, 5, 425, 213, 2, 754, 84, 3, 2, 2, 2, 755, 756, 5, 457,
+	229, 2, 756, 757, 5, 433, 217, 2, 757, 758, 5, 435, 218, 2, 758, 759, 5,
+	455, 228, 2, 759, 86, 3, 2, 2, 2, 760, 761, 5, 457, 229, 2, 761, 762, 5,
+	433, 217, 2, 762, 763, 5, 453, 227, 2, 763, 764, 5, 447, 224, 2, 764, 765,
+	5, 463, 232, 2, 765, 88, 3, 2, 2, 2, 766, 767, 5, 457, 229, 2, 767, 768,
+	5, 433, 217, 2, 768, 769, 5, 453, 227, 2, 769, 770, 5, 447, 224, 2, 770,
+	771, 5, 463, 232, 2, 771, 772, 5, 455, 228, 2, 772, 90, 3, 2, 2, 2, 773,
+	774, 5, 457, 229, 2, 774, 775, 5, 453, 227, 2, 775, 776, 5, 419, 210, 2,
+	776, 777, 5, 445, 223, 2, 777, 778, 5, 455, 228, 2, 778, 779, 5, 435, 218,
+	2, 779, 780, 5, 427, 214, 2, 780, 781, 5, 445, 223, 2, 781, 782, 5, 457,
+	229, 2, 782, 92, 3, 2, 2, 2, 783, 784, 5, 457, 229, 2, 784, 785, 5, 453,
+	227, 2, 785, 786, 5, 467, 234, 2, 786, 94, 3, 2, 2, 2, 787, 788, 5, 461,
+	231, 2, 788, 789, 5, 447, 224, 2, 789, 790, 5, 435, 218, 2, 790, 791, 5,
+	425, 213, 2, 791, 96, 3, 2, 2, 2, 792, 793, 5, 461, 231, 2, 793, 794, 5,
+	447, 224, 2, 794, 795, 5, 441, 221, 2, 795, 796, 5, 419, 210, 2, 796, 797,
+	5, 457, 229, 2, 797, 798, 5, 435, 218, 2, 798, 799, 5, 441, 221, 2, 799,
+	800, 5, 427, 214, 2, 800, 98, 3, 2, 2, 2, 801, 802, 5, 463, 232, 2, 802,
+	803, 5, 433, 217, 2, 803, 804, 5, 435, 218, 2, 804, 805, 5, 441, 221, 2,
+	805, 806, 5, 427, 214, 2, 806, 100, 3, 2, 2, 2, 807, 808, 5, 455, 228,
+	2, 808, 809, 5, 463, 232, 2, 809, 810, 5, 435, 218, 2, 810, 811, 5, 457,
+	229, 2, 811, 812, 5, 423, 212, 2, 812, 813, 5, 433, 217, 2, 813, 102, 3,
+	2, 2, 2, 814, 815, 5, 463, 232, 2, 815, 816, 5, 433, 217, 2, 816, 817,
+	5, 427, 214, 2, 817, 818, 5, 445, 223, 2, 818, 104, 3, 2, 2, 2, 819, 820,
+	5, 431, 216, 2, 820, 821, 5, 441, 221, 2, 821, 822, 5, 447, 224, 2, 822,
+	823, 5, 421, 211, 2, 823, 824, 5, 419, 210, 2, 824, 825, 5, 441, 221, 2,
+	825, 106, 3, 2, 2, 2, 826, 827, 5, 463, 232, 2, 827, 828, 5, 427, 214,
+	2, 828, 829, 5, 421, 211, 2, 829, 830, 5, 455, 228, 2, 830, 831, 5, 427,
+	214, 2, 831, 832, 5, 453, 227, 2, 832, 833, 5, 461, 231, 2, 833, 834, 5,
+	435, 218, 2, 834, 835, 5, 423, 212, 2, 835, 836, 5, 427, 214, 2, 836, 108,
+	3, 2, 2, 2, 837, 838, 5, 463, 232, 2, 838, 839, 5, 435, 218, 2, 839, 840,
+	5, 457, 229, 2, 840, 841, 5, 433, 217, 2, 841, 842, 5, 471, 236, 2, 842,
+	843, 5, 455, 228, 2, 843, 844, 5, 433, 217, 2, 844, 845, 5, 419, 210, 2,
+	845, 846, 5, 453, 227, 2, 846, 847, 5, 435, 218, 2, 847, 848, 5, 445, 223,
+	2, 848, 849, 5, 431, 216, 2, 849, 110, 3, 2, 2, 2, 850, 851, 5, 463, 232,
+	2, 851, 852, 5, 435, 218, 2, 852, 853, 5, 457, 229, 2, 853, 854, 5, 433,
+	217, 2, 854, 855, 5, 447, 224, 2, 855, 856, 5, 459, 230, 2, 856, 857, 5,
+	457, 229, 2, 857, 858, 5, 471, 236, 2, 858, 859, 5, 455, 228, 2, 859, 860,
+	5, 433, 217, 2, 860, 861, 5, 419, 210, 2, 861, 862, 5, 453, 227, 2, 862,
+	863, 5, 435, 218, 2, 863, 864, 5, 445, 223, 2, 864, 865, 5, 431, 216, 2,
+	865, 112, 3, 2, 2, 2, 866, 867, 5, 455, 228, 2, 867, 868, 5, 427, 214,
+	2, 868, 869, 5, 441, 221, 2, 869, 870, 5, 427, 214, 2, 870, 871, 5, 423,
+	212, 2, 871, 872, 5, 457, 229, 2, 872, 114, 3, 2, 2, 2, 873, 874, 5, 429,
+	215, 2, 874, 875, 5, 453, 227, 2, 875, 876, 5, 447, 224, 2, 876, 877, 5,
+	443, 222, 2, 877, 116, 3, 2, 2, 2, 878, 879, 5, 463, 232, 2, 879, 880,
+	5, 433, 217, 2, 880, 881, 5, 427, 214, 2, 881, 882, 5, 453, 227, 2, 882,
+	883, 5, 427, 214, 2, 883, 118, 3, 2, 2, 2, 884, 885, 5, 441, 221, 2, 885,
+	886, 5, 435, 218, 2, 886, 887, 5, 443, 222, 2, 887, 888, 5, 435, 218, 2,
+	888, 889, 5, 457, 229, 2, 889, 120, 3, 2, 2, 2, 890, 891, 5, 447, 224,
+	2, 891, 892, 5, 453, 227, 2, 892, 893, 5, 425, 213, 2, 893, 894, 5, 427,
+	214, 2, 894, 895, 5, 453, 227, 2, 895, 122, 3, 2, 2, 2, 896, 897, 5, 421,
+	211, 2, 897, 898, 5, 467, 234, 2, 898, 124, 3, 2, 2, 2, 899, 900, 5, 419,
+	210, 2, 900, 901, 5, 455, 228, 2, 901, 902, 5, 423, 212, 2, 902, 126, 3,
+	2, 2, 2, 903, 904, 5, 425, 213, 2, 904, 905, 5, 427, 214, 2, 905, 906,
+	5, 455, 228, 2, 906, 907, 5, 423, 212, 2, 907, 128, 3, 2, 2, 2, 908, 909,
+	5, 463, 232, 2, 909, 910, 5, 435, 218, 2, 910, 911, 5, 457, 229, 2, 911,
+	912, 5, 433, 217, 2, 912, 130, 3, 2, 2, 2, 913, 914, 5, 457, 229, 2, 914,
+	915, 5, 467, 234, 2, 915, 916, 5, 449, 225, 2, 916, 917, 5, 427, 214, 2,
+	917, 918, 5, 447, 224, 2, 918, 919, 5, 429, 215, 2, 919, 132, 3, 2, 2,
+	2, 920, 921, 5, 453, 227, 2, 921, 922, 5, 427, 214, 2, 922, 923, 5, 429,
+	215, 2, 923, 924, 5, 427, 214, 2, 924, 925, 5, 453, 227, 2, 925, 926, 5,
+	427, 214, 2, 926, 927, 5, 445, 223, 2, 927, 928, 5, 423, 212, 2, 928, 929,
+	5, 427, 214, 2, 929, 134, 3, 2, 2, 2, 930, 931, 5, 461, 231, 2, 931, 932,
+	5, 435, 218, 2, 932, 933, 5, 427, 214, 2, 933, 934, 5, 463, 232, 2, 934,
+	136, 3, 2, 2, 2, 935, 936, 5, 461, 231, 2, 936, 937, 5, 435, 218, 2, 937,
+	938, 5, 427, 214, 2, 938, 939, 5, 463, 232, 2, 939, 940, 5, 455, 228, 2,
+	940, 941, 5, 457, 229, 2, 941, 942, 5, 419, 210, 2, 942, 943, 5, 457, 229,
+	2, 943, 138, 3, 2, 2, 2, 944, 945, 5, 457, 229, 2, 945, 946, 5, 453, 227,
+	2, 946, 947, 5, 419, 210, 2, 947, 948, 5, 423, 212, 2, 948, 949, 5, 439,
+	220, 2, 949, 950, 5, 435, 218, 2, 950, 951, 5, 445, 223, 2, 951, 952, 5,
+	431, 216, 2, 952, 140, 3, 2, 2, 2, 953, 954, 5, 447, 224, 2, 954, 955,
+	5, 429, 215, 2, 955, 956, 5, 429, 215, 2, 956, 957, 5, 455, 228, 2, 957,
+	958, 5, 427, 214, 2, 958, 959, 5, 457, 229, 2, 959, 142, 3, 2, 2, 2, 960,
+	961, 5, 435, 218, 2, 961, 962, 5, 445, 223, 2, 962, 144, 3, 2, 2, 2, 963,
+	964, 5, 427, 214, 2, 964, 965, 5, 445, 223, 2, 965, 966, 5, 425, 213, 2,
+	966, 146, 3, 2, 2, 2, 967, 968, 5, 459, 230, 2, 968, 969, 5, 455, 228,
+	2, 969, 970, 5, 435, 218, 2, 970, 971, 5, 445, 223, 2, 971, 972, 5, 431,
+	216, 2, 972, 148, 3, 2, 2, 2, 973, 974, 5, 425, 213, 2, 974, 975, 5, 419,
+	210, 2, 975, 976, 5, 457, 229, 2, 976, 977, 5, 419, 210, 2, 977, 150, 3,
+	2, 2, 2, 978, 979, 5, 423, 212, 2, 979, 980, 5, 419, 210, 2, 980, 981,
+	5, 457, 229, 2, 981, 982, 5, 427, 214, 2, 982, 983, 5, 431, 216, 2, 983,
+	984, 5, 447, 224, 2, 984, 985, 5, 453, 227, 2, 985, 986, 5, 467, 234, 2,
+	986, 152, 3, 2, 2, 2, 987, 988, 5, 431, 216, 2, 988, 989, 5, 453, 227,
+	2, 989, 990, 5, 447, 224, 2, 990, 991, 5, 459, 230, 2, 991, 992, 5, 449,
+	225, 2, 992, 154, 3, 2, 2, 2, 993, 994, 5, 433, 217, 2, 994, 995, 5, 419,
+	210, 2, 995, 996, 5, 461, 231, 2, 996, 997, 5, 435, 218, 2, 997, 998, 5,
+	445, 223, 2, 998, 999, 5, 431, 216, 2, 999, 156, 3, 2, 2, 2, 1000, 1001,
+	5, 445, 223, 2, 1001, 1002, 5, 459, 230, 2, 1002, 1003, 5, 441, 221, 2,
+	1003, 1004, 5, 441, 221, 2, 1004, 1005, 5, 455, 228, 2, 1005, 158, 3, 2,
+	2, 2, 1006, 1007, 5, 429, 215, 2, 1007, 1008, 5, 435, 218, 2, 1008, 1009,
+	5, 453, 227, 2, 1009, 1010, 5, 455, 228, 2, 1010, 1011, 5, 457, 229, 2,
+	1011, 160, 3, 2, 2, 2, 1012, 1013, 5, 441, 221, 2, 1013, 1014, 5, 419,
+	210, 2, 1014, 1015, 5, 455, 228, 2, 1015, 1016, 5, 457, 229, 2, 1016, 162,
+	3, 2, 2, 2, 1017, 1018, 5, 455, 228, 2, 1018, 1019, 5, 423, 212, 2, 1019,
+	1020, 5, 447, 224, 2, 1020, 1021, 5, 449, 225, 2, 1021, 1022, 5, 427, 214,
+	2, 1022, 164, 3, 2, 2, 2, 1023, 1024, 5, 453, 227, 2, 1024, 1025, 5, 447,
+	224, 2, 1025, 1026, 5, 441, 221, 2, 1026, 1027, 5, 441, 221, 2, 1027, 1028,
+	5, 459, 230, 2, 1028, 1029, 5, 449, 225, 2, 1029, 166, 3, 2, 2, 2, 1030,
+	1031, 5, 423, 212, 2, 1031, 1032, 5, 459, 230, 2, 1032, 1033, 5, 421, 211,
+	2, 1033, 1034, 5, 427, 214, 2, 1034, 168, 3, 2, 2, 2, 1035, 1036, 5, 441,
+	221, 2, 1036, 1037, 5, 435, 218, 2, 1037, 1038, 5, 439, 220, 2, 1038, 1039,
+	5, 427, 214, 2, 1039, 170, 3, 2, 2, 2, 1040, 1041, 5, 435, 218, 2, 1041,
+	1042, 5, 445, 223, 2, 1042, 1043, 5, 423, 212, 2, 1043, 1044, 5, 441, 221,
+	2, 1044, 1045, 5, 459, 230, 2, 1045, 1046, 5, 425, 213, 2, 1046, 1047,
+	5, 427, 214, 2, 1047, 1048, 5, 455, 228, 2, 1048, 172, 3, 2, 2, 2, 1049,
+	1050, 5, 427, 214, 2, 1050, 1051, 5, 465, 233, 2, 1051, 1052, 5, 423, 212,
+	2, 1052, 1053, 5, 441, 221, 2, 1053, 1054, 5, 459, 230, 2, 1054, 1055,
+	5, 425, 213, 2, 1055, 1056, 5, 427, 214, 2, 1056, 1057, 5, 455, 228, 2,
+	1057, 174, 3, 2, 2, 2, 1058, 1059, 5, 457, 229, 2, 1059, 1060, 5, 433,
+	217, 2, 1060, 1061, 5, 427, 214, 2, 1061, 1062, 5, 445, 223, 2, 1062, 176,
+	3, 2, 2, 2, 1063, 1064, 5, 435, 218, 2, 1064, 1065, 5, 445, 223, 2, 1065,
+	1066, 5, 455, 228, 2, 1066, 1067, 5, 427, 214, 2, 1067, 1068, 5, 453, 227,
+	2, 1068, 1069, 5, 457, 229, 2, 1069, 178, 3, 2, 2, 2, 1070, 1071, 5, 459,
+	230, 2, 1071, 1072, 5, 449, 225, 2, 1072, 1073, 5, 455, 228, 2, 1073, 1074,
+	5, 427, 214, 2, 1074, 1075, 5, 453, 227, 2, 1075, 1076, 5, 457, 229, 2,
+	1076, 180, 3, 2, 2, 2, 1077, 1078, 5, 459, 230, 2, 1078, 1079, 5, 449,
+	225, 2, 1079, 1080, 5, 425, 213, 2, 1080, 1081, 5, 419, 210, 2, 1081, 1082,
+	5, 457, 229, 2, 1082, 1083, 5, 427, 214, 2, 1083, 182, 3, 2, 2, 2, 1084,
+	1085, 5, 425, 213, 2, 1085, 1086, 5, 427, 214, 2, 1086, 1087, 5, 441, 221,
+	2, 1087, 1088, 5, 427, 214, 2, 1088, 1089, 5, 457, 229, 2, 1089, 1090,
+	5, 427, 214, 2, 1090, 184, 3, 2, 2, 2, 1091, 1092, 5, 459, 230, 2, 1092,
+	1093, 5, 445, 223, 2, 1093, 1094, 5, 425, 213, 2, 1094, 1095, 5, 427, 214,
+	2, 1095, 1096, 5, 441, 221, 2, 1096, 1097, 5, 427, 214, 2, 1097, 1098,
+	5, 457, 229, 2, 1098, 1099, 5, 427, 214, 2, 1099, 186, 3, 2, 2, 2, 1100,
+	1101, 5, 419, 210, 2, 1101, 1102, 5, 445, 223, 2, 1102, 1103, 5, 425, 213,
+	2, 1103, 188, 3, 2, 2, 2, 1104, 1105, 5, 447, 224, 2, 1105, 1106, 5, 453,
+	227, 2, 1106, 190, 3, 2, 2, 2, 1107, 1108, 5, 445, 223, 2, 1108, 1109,
+	5, 447, 224, 2, 1109, 1110, 5, 457, 229, 2, 1110, 192, 3, 2, 2, 2, 1111,
+	1112, 5, 429, 215, 2, 1112, 1113, 5, 435, 218, 2, 1113, 1114, 5, 445, 223,
+	2, 1114, 1115, 5, 425, 213, 2, 1115, 194, 3, 2, 2, 2, 1116, 1117, 5, 429,
+	215, 2, 1117, 1118, 5, 435, 218, 2, 1118, 1119, 5, 427, 214, 2, 1119, 1120,
+	5, 441, 221, 2, 1120, 1121, 5, 425, 213, 2, 1121, 1122, 5, 455, 228, 2,
+	1122, 196, 3, 2, 2, 2, 1123, 1124, 5, 453, 227, 2, 1124, 1125, 5, 427,
+	214, 2, 1125, 1126, 5, 457, 229, 2, 1126, 1127, 5, 459, 230, 2, 1127, 1128,
+	5, 453, 227, 2, 1128, 1129, 5, 445, 223, 2, 1129, 1130, 5, 435, 218, 2,
+	1130, 1131, 5, 445, 223, 2, 1131, 1132, 5, 431, 216, 2, 1132, 198, 3, 2,
+	2, 2, 1133, 1134, 5, 419, 210, 2, 1134, 1135, 5, 441, 221, 2, 1135, 1136,
+	5, 441, 221, 2, 1136, 200, 3, 2, 2, 2, 1137, 1138, 5, 453, 227, 2, 1138,
+	1139, 5, 447, 224, 2, 1139, 1140, 5, 463, 232, 2, 1140, 1141, 5, 455, 228,
+	2, 1141, 202, 3, 2, 2, 2, 1142, 1143, 5, 457, 229, 2, 1143, 1144, 5, 427,
+	214, 2, 1144, 1145, 5, 455, 228, 2, 1145, 1146, 5, 457, 229, 2, 1146, 1147,
+	5, 443, 222, 2, 1147, 1148, 5, 427, 214, 2, 1148, 1149, 5, 457, 229, 2,
+	1149, 1150, 5, 433, 217, 2, 1150, 1151, 5, 447, 224, 2, 1151, 1152, 5,
+	425, 213, 2, 1152, 204, 3, 2, 2, 2, 1153, 1154, 5, 457, 229, 2, 1154, 1155,
+	5, 453, 227, 2, 1155, 1156, 5, 435, 218, 2, 1156, 1157, 5, 431, 216, 2,
+	1157, 1158, 5, 431, 216, 2, 1158, 1159, 5, 427, 214, 2, 1159, 1160, 5,
+	453, 227, 2, 1160, 206, 3, 2, 2, 2, 1161, 1162, 5, 447, 224, 2, 1162, 1163,
+	5, 445, 223, 2, 1163, 208, 3, 2, 2, 2, 1164, 1165, 5, 421, 211, 2, 1165,
+	1166, 5, 427, 214, 2, 1166, 1167, 5, 429, 215, 2, 1167, 1168, 5, 447, 224,
+	2, 1168, 1169, 5, 453, 227, 2, 1169, 1170, 5, 427, 214, 2, 1170, 210, 3,
+	2, 2, 2, 1171, 1172, 5, 419, 210, 2, 1172, 1173, 5, 429, 215, 2, 1173,
+	1174, 5, 457, 229, 2, 1174, 1175, 5, 427, 214, 2, 1175, 1176, 5, 453, 227,
+	2, 1176, 212, 3, 2, 2, 2, 1177, 1178, 5, 453, 227, 2, 1178, 1179, 5, 459,
+	230, 2, 1179, 1180, 5, 445, 223, 2, 1180, 1181, 5, 419, 210, 2, 1181, 1182,
+	5, 455, 228, 2, 1182, 214, 3, 2, 2, 2, 1183, 1184, 5, 455, 228, 2, 1184,
+	1185, 5, 467, 234, 2, 1185, 1186, 5, 455, 228, 2, 1186, 1187, 5, 457, 229,
+	2, 1187, 1188, 5, 427, 214, 2, 1188, 1189, 5, 443, 222, 2, 1189, 216, 3,
+	2, 2, 2, 1190, 1195, 5, 219, 110, 2, 1191, 1195, 5, 221, 111, 2, 1192,
+	1195, 5, 223, 112, 2, 1193, 1195, 5, 225, 113, 2, 1194, 1190, 3, 2, 2,
+	2, 1194, 1191, 3, 2, 2, 2, 1194, 1192, 3, 2, 2, 2, 1194, 1193, 3, 2, 2,
+	2, 1195, 218, 3, 2, 2, 2, 1196, 1198, 5, 229, 115, 2, 1197, 1199, 5, 227,
+	114, 2, 1198, 1197, 3, 2, 2, 2, 1198, 1199, 3, 2, 2, 2, 1199, 220, 3, 2,
+	2, 2, 1200, 1202, 5, 241, 121, 2, 1201, 1203, 5, 227, 114, 2, 1202, 1201,
+	3, 2, 2, 2, 1202, 1203, 3, 2, 2, 2, 1203, 222, 3, 2, 2, 2, 1204, 1206,
+	5, 249, 125, 2, 1205, 1207, 5, 227, 114, 2, 1206, 1205, 3, 2, 2, 2, 1206,
+	1207, 3, 2, 2, 2, 1207, 224, 3, 2, 2, 2, 1208, 1210, 5, 257, 129, 2, 1209,
+	1211, 5, 227, 114, 2, 1210, 1209, 3, 2, 2, 2, 1210, 1211, 3, 2, 2, 2, 1211,
+	226, 3, 2, 2, 2, 1212, 1213, 9, 2, 2, 2, 1213, 228, 3, 2, 2, 2, 1214, 1215,
+	5, 231, 116, 2, 1215, 230, 3, 2, 2, 2, 1216, 1224, 5, 233, 117, 2, 1217,
+	1219, 5, 237, 119, 2, 1218, 1217, 3, 2, 2, 2, 1219, 1222, 3, 2, 2, 2, 1220,
+	1218, 3, 2, 2, 2, 1220, 1221, 3, 2, 2, 2, 1221, 1223, 3, 2, 2, 2, 1222,
+	1220, 3, 2, 2, 2, 1223, 1225, 5, 233, 117, 2, 1224, 1220, 3, 2, 2, 2, 1224,
+	1225, 3, 2, 2, 2, 1225, 232, 3, 2, 2, 2, 1226, 1229, 7, 50, 2, 2, 1227,
+	1229, 5, 235, 118, 2, 1228, 1226, 3, 2, 2, 2, 1228, 1227, 3, 2, 2, 2, 1229,
+	234, 3, 2, 2, 2, 1230, 1231, 9, 3, 2, 2, 1231, 236, 3, 2, 2, 2, 1232, 1235,
+	5, 233, 117, 2, 1233, 1235, 7, 97, 2, 2, 1234, 1232, 3, 2, 2, 2, 1234,
+	1233, 3, 2, 2, 2, 1235, 238, 3, 2, 2, 2, 1236, 1238, 7, 97, 2, 2, 1237,
+	1236, 3, 2, 2, 2, 1238, 1239, 3, 2, 2, 2, 1239, 1237, 3, 2, 2, 2, 1239,
+	1240, 3, 2, 2, 2, 1240, 240, 3, 2, 2, 2, 1241, 1242, 7, 50, 2, 2, 1242,
+	1243, 9, 4, 2, 2, 1243, 1244, 5, 243, 122, 2, 1244, 242, 3, 2, 2, 2, 1245,
+	1253, 5, 245, 123, 2, 1246, 1248, 5, 247, 124, 2, 1247, 1246, 3, 2, 2,
+	2, 1248, 1251, 3, 2, 2, 2, 1249, 1247, 3, 2, 2, 2, 1249, 1250, 3, 2, 2,
+	2, 1250, 1252, 3, 2, 2, 2, 1251, 1249, 3, 2, 2, 2, 1252, 1254, 5, 245,
+	123, 2, 1253, 1249, 3, 2, 2, 2, 1253, 1254, 3, 2, 2, 2, 1254, 244, 3, 2,
+	2, 2, 1255, 1256, 9, 5, 2, 2, 1256, 246, 3, 2, 2, 2, 1257, 1260, 5, 245,
+	123, 2, 1258, 1260, 7, 97, 2, 2, 1259, 1257, 3, 2, 2, 2, 1259, 1258, 3,
+	2, 2, 2, 1260, 248, 3, 2, 2, 2, 1261, 1263, 7, 50, 2, 2, 1262, 1264, 5,
+	239, 120, 2, 1263, 1262, 3, 2, 2, 2, 1263, 1264, 3, 2, 2, 2, 1264, 1265,
+	3, 2, 2, 2, 1265, 1266, 5, 251, 126, 2, 1266, 250, 3, 2, 2, 2, 1267, 1275,
+	5, 253, 127, 2, 1268, 1270, 5, 255, 128, 2, 1269, 1268, 3, 2, 2, 2, 1270,
+	1273, 3, 2, 2, 2, 1271, 1269, 3, 2, 2, 2, 1271, 1272, 3, 2, 2, 2, 1272,
+	1274, 3, 2, 2, 2, 1273, 1271, 3, 2, 2, 2, 1274, 1276, 5, 253, 127, 2, 1275,
+	1271, 3, 2, 2, 2, 1275, 1276, 3, 2, 2, 2, 1276, 252, 3, 2, 2, 2, 1277,
+	1278, 9, 6, 2, 2, 1278, 254, 3, 2, 2, 2, 1279, 1282, 5, 253, 127, 2, 1280,
+	1282, 7, 97, 2, 2, 1281, 1279, 3, 2, 2, 2, 1281, 1280, 3, 2, 2, 2, 1282,
+	256, 3, 2, 2, 2, 1283, 1284, 7, 50, 2, 2, 1284, 1285, 9, 7, 2, 2, 1285,
+	1286, 5, 259, 130, 2, 1286, 258, 3, 2, 2, 2, 1287, 1295, 5, 261, 131, 2,
+	1288, 1290, 5, 263, 132, 2, 1289, 1288, 3, 2, 2, 2, 1290, 1293, 3, 2, 2,
+	2, 1291, 1289, 3, 2, 2, 2, 1291, 1292, 3, 2, 2, 2, 1292, 1294, 3, 2, 2,
+	2, 1293, 1291, 3, 2, 2, 2, 1294, 1296, 5, 261, 131, 2, 1295, 1291, 3, 2,
+	2, 2, 1295, 1296, 3, 2, 2, 2, 1296, 260, 3, 2, 2, 2, 1297, 1298, 9, 8,
+	2, 2, 1298, 262, 3, 2, 2, 2, 1299, 1302, 5, 261, 131, 2, 1300, 1302, 7,
+	97, 2, 2, 1301, 1299, 3, 2, 2, 2, 1301, 1300, 3, 2, 2, 2, 1302, 264, 3,
+	2, 2, 2, 1303, 1306, 5, 267, 134, 2, 1304, 1306, 5, 279, 140, 2, 1305,
+	1303, 3, 2, 2, 2, 1305, 1304, 3, 2, 2, 2, 1306, 266, 3, 2, 2, 2, 1307,
+	1308, 5, 231, 116, 2, 1308, 1310, 7, 48, 2, 2, 1309, 1311, 5, 231, 116,
+	2, 1310, 1309, 3, 2, 2, 2, 1310, 1311, 3, 2, 2, 2, 1311, 1313, 3, 2, 2,
+	2, 1312, 1314, 5, 269, 135, 2, 1313, 1312, 3, 2, 2, 2, 1313, 1314, 3, 2,
+	2, 2, 1314, 1316, 3, 2, 2, 2, 1315, 1317, 5, 277, 139, 2, 1316, 1315, 3,
+	2, 2, 2, 1316, 1317, 3, 2, 2, 2, 1317, 1335, 3, 2, 2, 2, 1318, 1319, 7,
+	48, 2, 2, 1319, 1321, 5, 231, 116, 2, 1320, 1322, 5, 269, 135, 2, 1321,
+	1320, 3, 2, 2, 2, 1321, 1322, 3, 2, 2, 2, 1322, 1324, 3, 2, 2, 2, 1323,
+	1325, 5, 277, 139, 2, 1324, 1323, 3, 2, 2, 2, 1324, 1325, 3, 2, 2, 2, 1325,
+	1335, 3, 2, 2, 2, 1326, 1327, 5, 231, 116, 2, 1327, 1329, 5, 269, 135,
+	2, 1328, 1330, 5, 277, 139, 2, 1329, 1328, 3, 2, 2, 2, 1329, 1330, 3, 2,
+	2, 2, 1330, 1335, 3, 2, 2, 2, 1331, 1332, 5, 231, 116, 2, 1332, 1333, 5,
+	277, 139, 2, 1333, 1335, 3, 2, 2, 2, 1334, 1307, 3, 2, 2, 2, 1334, 1318,
+	3, 2, 2, 2, 1334, 1326, 3, 2, 2, 2, 1334, 1331, 3, 2, 2, 2, 1335, 268,
+	3, 2, 2, 2, 1336, 1337, 5, 271, 136, 2, 1337, 1338, 5, 273, 137, 2, 1338,
+	270, 3, 2, 2, 2, 1339, 1340, 9, 9, 2, 2, 1340, 272, 3, 2, 2, 2, 1341, 1343,
+	5, 275, 138, 2, 1342, 1341, 3, 2, 2, 2, 1342, 1343, 3, 2, 2, 2, 1343, 1344,
+	3, 2, 2, 2, 1344, 1345, 5, 231, 116, 2, 1345, 274, 3, 2, 2, 2, 1346, 1347,
+	9, 10, 2, 2, 1347, 276, 3, 2, 2, 2, 1348, 1349, 9, 11, 2, 2, 1349, 278,
+	3, 2, 2, 2, 1350, 1351, 5, 281, 141, 2, 1351, 1353, 5, 283, 142, 2, 1352,
+	1354, 5, 277, 139, 2, 1353, 1352, 3, 2, 2, 2, 1353, 1354, 3, 2, 2, 2, 1354,
+	280, 3, 2, 2, 2, 1355, 1357, 5, 241, 121, 2, 1356, 1358, 7, 48, 2, 2, 1357,
+	1356, 3, 2, 2, 2, 1357, 1358, 3, 2, 2, 2, 1358, 1367, 3, 2, 2, 2, 1359,
+	1360, 7, 50, 2, 2, 1360, 1362, 9, 4, 2, 2, 1361, 1363, 5, 243, 122, 2,
+	1362, 1361, 3, 2, 2, 2, 1362, 1363, 3, 2, 2, 2, 1363, 1364, 3, 2, 2, 2,
+	1364, 1365, 7, 48, 2, 2, 1365, 1367, 5, 243, 122, 2, 1366, 1355, 3, 2,
+	2, 2, 1366, 1359, 3, 2, 2, 2, 1367, 282, 3, 2, 2, 2, 1368, 1369, 5, 285,
+	143, 2, 1369, 1370, 5, 273, 137, 2, 1370, 284, 3, 2, 2, 2, 1371, 1372,
+	9, 12, 2, 2, 1372, 286, 3, 2, 2, 2, 1373, 1374, 5, 457, 229, 2, 1374, 1375,
+	5, 453, 227, 2, 1375, 1376, 5, 459, 230, 2, 1376, 1377, 5, 427, 214, 2,
+	1377, 1385, 3, 2, 2, 2, 1378, 1379, 5, 429, 215, 2, 1379, 1380, 5, 419,
+	210, 2, 1380, 1381, 5, 441, 221, 2, 1381, 1382, 5, 455, 228, 2, 1382, 1383,
+	5, 427, 214, 2, 1383, 1385, 3, 2, 2, 2, 1384, 1373, 3, 2, 2, 2, 1384, 1378,
+	3, 2, 2, 2, 1385, 288, 3, 2, 2, 2, 1386, 1388, 5, 417, 209, 2, 1387, 1389,
+	5, 291, 146, 2, 1388, 1387, 3, 2, 2, 2, 1388, 1389, 3, 2, 2, 2, 1389, 1390,
+	3, 2, 2, 2, 1390, 1391, 5, 417, 209, 2, 1391, 290, 3, 2, 2, 2, 1392, 1394,
+	5, 293, 147, 2, 1393, 1392, 3, 2, 2, 2, 1394, 1395, 3, 2, 2, 2, 1395, 1393,
+	3, 2, 2, 2, 1395, 1396, 3, 2, 2, 2, 1396, 292, 3, 2, 2, 2, 1397, 1400,
+	10, 13, 2, 2, 1398, 1400, 5, 295, 148, 2, 1399, 1397, 3, 2, 2, 2, 1399,
+	1398, 3, 2, 2, 2, 1400, 294, 3, 2, 2, 2, 1401, 1402, 7, 94, 2, 2, 1402,
+	1406, 9, 14, 2, 2, 1403, 1406, 5, 297, 149, 2, 1404, 1406, 5, 299, 150,
+	2, 1405, 1401, 3, 2, 2, 2, 1405, 1403, 3, 2, 2, 2, 1405, 1404, 3, 2, 2,
+	2, 1406, 296, 3, 2, 2, 2, 1407, 1408, 7, 94, 2, 2, 1408, 1419, 5, 253,
+	127, 2, 1409, 1410, 7, 94, 2, 2, 1410, 1411, 5, 253, 127, 2, 1411, 1412,
+	5, 253, 127, 2, 1412, 1419, 3, 2, 2, 2, 1413, 1414, 7, 94, 2, 2, 1414,
+	1415, 5, 301, 151, 2, 1415, 1416, 5, 253, 127, 2, 1416, 1417, 5, 253, 127,
+	2, 1417, 1419, 3, 2, 2, 2, 1418, 1407, 3, 2, 2, 2, 1418, 1409, 3, 2, 2,
+	2, 1418, 1413, 3, 2, 2, 2, 1419, 298, 3, 2, 2, 2, 1420, 1421, 7, 94, 2,
+	2, 1421, 1422, 7, 119, 2, 2, 1422, 1423, 5, 245, 123, 2, 1423, 1424, 5,
+	245, 123, 2, 1424, 1425, 5, 245, 123, 2, 1425, 1426, 5, 245, 123, 2, 1426,
+	300, 3, 2, 2, 2, 1427, 1428, 9, 15, 2, 2, 1428, 302, 3, 2, 2, 2, 1429,
+	1430, 5, 445, 223, 2, 1430, 1431, 5, 459, 230, 2, 1431, 1432, 5, 441, 221,
+	2, 1432, 1433, 5, 441, 221, 2, 1433, 304, 3, 2, 2, 2, 1434, 1435, 7, 42,
+	2, 2, 1435, 306, 3, 2, 2, 2, 1436, 1437, 7, 43, 2, 2, 1437, 308, 3, 2,
+	2, 2, 1438, 1439, 7, 125, 2, 2, 1439, 310, 3, 2, 2, 2, 1440, 1441, 7, 127,
+	2, 2, 1441, 312, 3, 2, 2, 2, 1442, 1443, 7, 93, 2, 2, 1443, 314, 3, 2,
+	2, 2, 1444, 1445, 7, 95, 2, 2, 1445, 316, 3, 2, 2, 2, 1446, 1447, 7, 61,
+	2, 2, 1447, 318, 3, 2, 2, 2, 1448, 1449, 7, 46, 2, 2, 1449, 320, 3, 2,
+	2, 2, 1450, 1451, 7, 48, 2, 2, 1451, 322, 3, 2, 2, 2, 1452, 1453, 7, 63,
+	2, 2, 1453, 324, 3, 2, 2, 2, 1454, 1455, 7, 64, 2, 2, 1455, 326, 3, 2,
+	2, 2, 1456, 1457, 7, 62, 2, 2, 1457, 328, 3, 2, 2, 2, 1458, 1459, 7, 35,
+	2, 2, 1459, 330, 3, 2, 2, 2, 1460, 1461, 7, 128, 2, 2, 1461, 332, 3, 2,
+	2, 2, 1462, 1463, 7, 65, 2, 2, 1463, 334, 3, 2, 2, 2, 1464, 1465, 7, 60,
+	2, 2, 1465, 336, 3, 2, 2, 2, 1466, 1467, 7, 63, 2, 2, 1467, 1468, 7, 63,
+	2, 2, 1468, 338, 3, 2, 2, 2, 1469, 1470, 7, 63, 2, 2, 1470, 1471, 7, 63,
+	2, 2, 1471, 1472, 7, 63, 2, 2, 1472, 340, 3, 2, 2, 2, 1473, 1474, 7, 62,
+	2, 2, 1474, 1475, 7, 63, 2, 2, 1475, 342, 3, 2, 2, 2, 1476, 1477, 7, 64,
+	2, 2, 1477, 1478, 7, 63, 2, 2, 1478, 344, 3, 2, 2, 2, 1479, 1480, 7, 35,
+	2, 2, 1480, 1481, 7, 63, 2, 2, 1481, 346, 3, 2, 2, 2, 1482, 1483, 7, 40,
+	2, 2, 1483, 1484, 7, 40, 2, 2, 1484, 348, 3, 2, 2, 2, 1485, 1486, 7, 126,
+	2, 2, 1486, 1487, 7, 126, 2, 2, 1487, 350, 3, 2, 2, 2, 1488, 1489, 7, 45,
+	2, 2, 1489, 1490, 7, 45, 2, 2, 1490, 352, 3, 2, 2, 2, 1491, 1492, 7, 47,
+	2, 2, 1492, 1493, 7, 47, 2, 2, 1493, 354, 3, 2, 2, 2, 1494, 1495, 7, 45,
+	2, 2, 1495, 356, 3, 2, 2, 2, 1496, 1497, 7, 47, 2, 2, 1497, 358, 3, 2,
+	2, 2, 1498, 1499, 7, 44, 2, 2, 1499, 360, 3, 2, 2, 2, 1500, 1501, 7, 49,
+	2, 2, 1501, 362, 3, 2, 2, 2, 1502, 1503, 7, 40, 2, 2, 1503, 364, 3, 2,
+	2, 2, 1504, 1505, 7, 126, 2, 2, 1505, 366, 3, 2, 2, 2, 1506, 1507, 7, 96,
+	2, 2, 1507, 368, 3, 2, 2, 2, 1508, 1509, 7, 39, 2, 2, 1509, 370, 3, 2,
+	2, 2, 1510, 1511, 7, 45, 2, 2, 1511, 1512, 7, 63, 2, 2, 1512, 372, 3, 2,
+	2, 2, 1513, 1514, 7, 47, 2, 2, 1514, 1515, 7, 63, 2, 2, 1515, 374, 3, 2,
+	2, 2, 1516, 1517, 7, 44, 2, 2, 1517, 1518, 7, 63, 2, 2, 1518, 376, 3, 2,
+	2, 2, 1519, 1520, 7, 49, 2, 2, 1520, 1521, 7, 63, 2, 2, 1521, 378, 3, 2,
+	2, 2, 1522, 1523, 7, 40, 2, 2, 1523, 1524, 7, 63, 2, 2, 1524, 380, 3, 2,
+	2, 2, 1525, 1526, 7, 126, 2, 2, 1526, 1527, 7, 63, 2, 2, 1527, 382, 3,
+	2, 2, 2, 1528, 1529, 7, 96, 2, 2, 1529, 1530, 7, 63, 2, 2, 1530, 384, 3,
+	2, 2, 2, 1531, 1532, 7, 39, 2, 2, 1532, 1533, 7, 63, 2, 2, 1533, 386, 3,
+	2, 2, 2, 1534, 1535, 7, 62, 2, 2, 1535, 1536, 7, 62, 2, 2, 1536, 1537,
+	7, 63, 2, 2, 1537, 388, 3, 2, 2, 2, 1538, 1539, 7, 64, 2, 2, 1539, 1540,
+	7, 64, 2, 2, 1540, 1541, 7, 63, 2, 2, 1541, 390, 3, 2, 2, 2, 1542, 1543,
+	7, 64, 2, 2, 1543, 1544, 7, 64, 2, 2, 1544, 1545, 7, 64, 2, 2, 1545, 1546,
+	7, 63, 2, 2, 1546, 392, 3, 2, 2, 2, 1547, 1548, 7, 63, 2, 2, 1548, 1549,
+	7, 64, 2, 2, 1549, 394, 3, 2, 2, 2, 1550, 1554, 5, 397, 199, 2, 1551, 1553,
+	5, 399, 200, 2, 1552, 1551, 3, 2, 2, 2, 1553, 1556, 3, 2, 2, 2, 1554, 1552,
+	3, 2, 2, 2, 1554, 1555, 3, 2, 2, 2, 1555, 396, 3, 2, 2, 2, 1556, 1554,
+	3, 2, 2, 2, 1557, 1558, 9, 16, 2, 2, 1558, 398, 3, 2, 2, 2, 1559, 1560,
+	9, 17, 2, 2, 1560, 400, 3, 2, 2, 2, 1561, 1562, 7, 66, 2, 2, 1562, 402,
+	3, 2, 2, 2, 1563, 1564, 7, 48, 2, 2, 1564, 1565, 7, 48, 2, 2, 1565, 1566,
+	7, 48, 2, 2, 1566, 404, 3, 2, 2, 2, 1567, 1569, 9, 18, 2, 2, 1568, 1567,
+	3, 2, 2, 2, 1569, 1570, 3, 2, 2, 2, 1570, 1568, 3, 2, 2, 2, 1570, 1571,
+	3, 2, 2, 2, 1571, 1572, 3, 2, 2, 2, 1572, 1573, 8, 203, 2, 2, 1573, 406,
+	3, 2, 2, 2, 1574, 1575, 7, 49, 2, 2, 1575, 1576, 7, 44, 2, 2, 1576, 1577,
+	7, 44, 2, 2, 1577, 1578, 3, 2, 2, 2, 1578, 1582, 9, 19, 2, 2, 1579, 1581,
+	11, 2, 2, 2, 1580, 1579, 3, 2, 2, 2, 1581, 1584, 3, 2, 2, 2, 1582, 1583,
+	3, 2, 2, 2, 1582, 1580, 3, 2, 2, 2, 1583, 1585, 3, 2, 2, 2, 1584, 1582,
+	3, 2, 2, 2, 1585, 1586, 7, 44, 2, 2, 1586, 1587, 7, 49, 2, 2, 1587, 1588,
+	3, 2, 2, 2, 1588, 1589, 8, 204, 2, 2, 1589, 408, 3, 2, 2, 2, 1590, 1591,
+	7, 49, 2, 2, 1591, 1592, 7, 44, 2, 2, 1592, 1593, 7, 44, 2, 2, 1593, 1594,
+	3, 2, 2, 2, 1594, 1595, 8, 205, 2, 2, 1595, 410, 3, 2, 2, 2, 1596, 1597,
+	7, 49, 2, 2, 1597, 1598, 7, 44, 2, 2, 1598, 1602, 3, 2, 2, 2, 1599, 1601,
+	11, 2, 2, 2, 1600, 1599, 3, 2, 2, 2, 1601, 1604, 3, 2, 2, 2, 1602, 1603,
+	3, 2, 2, 2, 1602, 1600, 3, 2, 2, 2, 1603, 1605, 3, 2, 2, 2, 1604, 1602,
+	3, 2, 2, 2, 1605, 1606, 7, 44, 2, 2, 1606, 1607, 7, 49, 2, 2, 1607, 1608,
+	3, 2, 2, 2, 1608, 1609, 8, 206, 2, 2, 1609, 412, 3, 2, 2, 2, 1610, 1611,
+	7, 49, 2, 2, 1611, 1612, 7, 44, 2, 2, 1612, 1613, 3, 2, 2, 2, 1613, 1614,
+	8, 207, 2, 2, 1614, 414, 3, 2, 2, 2, 1615, 1616, 7, 49, 2, 2, 1616, 1617,
+	7, 49, 2, 2, 1617, 1621, 3, 2, 2, 2, 1618, 1620, 10, 19, 2, 2, 1619, 1618,
+	3, 2, 2, 2, 1620, 1623, 3, 2, 2, 2, 1621, 1619, 3, 2, 2, 2, 1621, 1622,
+	3, 2, 2, 2, 1622, 1624, 3, 2, 2, 2, 1623, 1621, 3, 2, 2, 2, 1624, 1625,
+	8, 208, 2, 2, 1625, 416, 3, 2, 2, 2, 1626, 1627, 7, 41, 2, 2, 1627, 1628,
+	3, 2, 2, 2, 1628, 1629, 8, 209, 2, 2, 1629, 418, 3, 2, 2, 2, 1630, 1631,
+	9, 20, 2, 2, 1631, 420, 3, 2, 2, 2, 1632, 1633, 9, 7, 2, 2, 1633, 422,
+	3, 2, 2, 2, 1634, 1635, 9, 21, 2, 2, 1635, 424, 3, 2, 2, 2, 1636, 1637,
+	9, 22, 2, 2, 1637, 426, 3, 2, 2, 2, 1638, 1639, 9, 9, 2, 2, 1639, 428,
+	3, 2, 2, 2, 1640, 1641, 9, 23, 2, 2, 1641, 430, 3, 2, 2, 2, 1642, 1643,
+	9, 24, 2, 2, 1643, 432, 3, 2, 2, 2, 1644, 1645, 9, 25, 2, 2, 1645, 434,
+	3, 2, 2, 2, 1646, 1647, 9, 26, 2, 2, 1647, 436, 3, 2, 2, 2, 1648, 1649,
+	9, 27, 2, 2, 1649, 438, 3, 2, 2, 2, 1650, 1651, 9, 28, 2, 2, 1651, 440,
+	3, 2, 2, 2, 1652, 1653, 9, 2, 2, 2, 1653, 442, 3, 2, 2, 2, 1654, 1655,
+	9, 29, 2, 2, 1655, 444, 3, 2, 2, 2, 1656, 1657, 9, 30, 2, 2, 1657, 446,
+	3, 2, 2, 2, 1658, 1659, 9, 31, 2, 2, 1659, 448, 3, 2, 2, 2, 1660, 1661,
+	9, 12, 2, 2, 1661, 450, 3, 2, 2, 2, 1662, 1663, 9, 32, 2, 2, 1663, 452,
+	3, 2, 2, 2, 1664, 1665, 9, 33, 2, 2, 1665, 454, 3, 2, 2, 2, 1666, 1667,
+	9, 34, 2, 2, 1667, 456, 3, 2, 2, 2, 1668, 1669, 9, 35, 2, 2, 1669, 458,
+	3, 2, 2, 2, 1670, 1671, 9, 36, 2, 2, 1671, 460, 3, 2, 2, 2, 1672, 1673,
+	9, 37, 2, 2, 1673, 462, 3, 2, 2, 2, 1674, 1675, 9, 38, 2, 2, 1675, 464,
+	3, 2, 2, 2, 1676, 1677, 9, 4, 2, 2, 1677, 466, 3, 2, 2, 2, 1678, 1679,
+	9, 39, 2, 2, 1679, 468, 3, 2, 2, 2, 1680, 1681, 9, 40, 2, 2, 1681, 470,
+	3, 2, 2, 2, 1682, 1683, 7, 34, 2, 2, 1683, 472, 3, 2, 2, 2, 47, 2, 1194,
+	1198, 1202, 1206, 1210, 1220, 1224, 1228, 1234, 1239, 1249, 1253, 1259,
+	1263, 1271, 1275, 1281, 1291, 1295, 1301, 1305, 1310, 1313, 1316, 1321,
+	1324, 1329, 1334, 1342, 1353, 1357, 1362, 1366, 1384, 1388, 1395, 1399,
+	1405, 1418, 1554, 1570, 1582, 1602, 1621, 3, 8, 2, 2,
 }
 
 var lexerDeserializer = antlr.NewATNDeserializer(nil)
@@ -802,12 +815,12 @@ var lexerLiteralNames = []string{
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"", "", "", "'('", "')'", "'{'", "'}'", "'['", "']'", "';'", "','", "'.'",
-	"'='", "'>'", "'<'", "'!'", "'~'", "'?'", "':'", "'=='", "'==='", "'<='",
-	"'>='", "'!='", "'&&'", "'||'", "'++'", "'--'", "'+'", "'-'", "'*'", "'/'",
-	"'&'", "'|'", "'^'", "'%'", "'+='", "'-='", "'*='", "'/='", "'&='", "'|='",
-	"'^='", "'%='", "'<<='", "'>>='", "'>>>='", "'=>'", "", "'@'", "'...'",
-	"", "", "'/**'", "", "'/*'", "", "'''",
+	"", "", "", "", "", "", "'('", "')'", "'{'", "'}'", "'['", "']'", "';'",
+	"','", "'.'", "'='", "'>'", "'<'", "'!'", "'~'", "'?'", "':'", "'=='",
+	"'==='", "'<='", "'>='", "'!='", "'&&'", "'||'", "'++'", "'--'", "'+'",
+	"'-'", "'*'", "'/'", "'&'", "'|'", "'^'", "'%'", "'+='", "'-='", "'*='",
+	"'/='", "'&='", "'|='", "'^='", "'%='", "'<<='", "'>>='", "'>>>='", "'=>'",
+	"", "'@'", "'...'", "", "", "'/**'", "", "'/*'", "", "'''",
 }
 
 var lexerSymbolicNames = []string{
@@ -822,18 +835,18 @@ var lexerSymbolicNames = []string{
 	"LIMIT", "ORDER", "BY", "ASC", "DESC", "WITH", "TYPEOF", "REFERENCE", "VIEW",
 	"VIEWSTAT", "TRACKING", "OFFSET", "IN", "END", "USING", "DATA", "CATEGORY",
 	"GROUP", "HAVING", "NULLS", "FIRST", "LAST", "SCOPE", "ROLLUP", "CUBE",
-	"LIKE", "THEN", "INSERT", "UPSERT", "UPDATE", "DELETE", "UNDELETE", "SOQL_AND",
-	"SOQL_OR", "SOQL_NOT", "FIND", "FIELDS", "RETURNING", "ALL", "TESTMETHOD",
-	"TRIGGER", "ON", "BEFORE", "AFTER", "RUNAS", "SYSTEM", "IntegerLiteral",
-	"FloatingPointLiteral", "BooleanLiteral", "StringLiteral", "NullLiteral",
-	"LPAREN", "RPAREN", "LBRACE", "RBRACE", "LBRACK", "RBRACK", "SEMI", "COMMA",
-	"DOT", "ASSIGN", "GT", "LT", "BANG", "TILDE", "QUESTION", "COLON", "EQUAL",
-	"T_EQUAL", "LE", "GE", "NOTEQUAL", "AND", "OR", "INC", "DEC", "ADD", "SUB",
-	"MUL", "DIV", "BITAND", "BITOR", "CARET", "MOD", "ADD_ASSIGN", "SUB_ASSIGN",
-	"MUL_ASSIGN", "DIV_ASSIGN", "AND_ASSIGN", "OR_ASSIGN", "XOR_ASSIGN", "MOD_ASSIGN",
-	"LSHIFT_ASSIGN", "RSHIFT_ASSIGN", "URSHIFT_ASSIGN", "LAMBDA_LIKE", "Identifier",
-	"AT", "ELLIPSIS", "WS", "APEXDOC_COMMENT", "APEXDOC_COMMENT_START", "COMMENT",
-	"COMMENT_START", "LINE_COMMENT", "QUOTE",
+	"LIKE", "INCLUDES", "EXCLUDES", "THEN", "INSERT", "UPSERT", "UPDATE", "DELETE",
+	"UNDELETE", "SOQL_AND", "SOQL_OR", "SOQL_NOT", "FIND", "FIELDS", "RETURNING",
+	"ALL", "ROWS", "TESTMETHOD", "TRIGGER", "ON", "BEFORE", "AFTER", "RUNAS",
+	"SYSTEM", "IntegerLiteral", "FloatingPointLiteral", "BooleanLiteral", "StringLiteral",
+	"NullLiteral", "LPAREN", "RPAREN", "LBRACE", "RBRACE", "LBRACK", "RBRACK",
+	"SEMI", "COMMA", "DOT", "ASSIGN", "GT", "LT", "BANG", "TILDE", "QUESTION",
+	"COLON", "EQUAL", "T_EQUAL", "LE", "GE", "NOTEQUAL", "AND", "OR", "INC",
+	"DEC", "ADD", "SUB", "MUL", "DIV", "BITAND", "BITOR", "CARET", "MOD", "ADD_ASSIGN",
+	"SUB_ASSIGN", "MUL_ASSIGN", "DIV_ASSIGN", "AND_ASSIGN", "OR_ASSIGN", "XOR_ASSIGN",
+	"MOD_ASSIGN", "LSHIFT_ASSIGN", "RSHIFT_ASSIGN", "URSHIFT_ASSIGN", "LAMBDA_LIKE",
+	"Identifier", "AT", "ELLIPSIS", "WS", "APEXDOC_COMMENT", "APEXDOC_COMMENT_START",
+	"COMMENT", "COMMENT_START", "LINE_COMMENT", "QUOTE",
 }
 
 var lexerRuleNames = []string{
@@ -848,24 +861,25 @@ var lexerRuleNames = []string{
 	"LIMIT", "ORDER", "BY", "ASC", "DESC", "WITH", "TYPEOF", "REFERENCE", "VIEW",
 	"VIEWSTAT", "TRACKING", "OFFSET", "IN", "END", "USING", "DATA", "CATEGORY",
 	"GROUP", "HAVING", "NULLS", "FIRST", "LAST", "SCOPE", "ROLLUP", "CUBE",
-	"LIKE", "THEN", "INSERT", "UPSERT", "UPDATE", "DELETE", "UNDELETE", "SOQL_AND",
-	"SOQL_OR", "SOQL_NOT", "FIND", "FIELDS", "RETURNING", "ALL", "TESTMETHOD",
-	"TRIGGER", "ON", "BEFORE", "AFTER", "RUNAS", "SYSTEM", "IntegerLiteral",
-	"DecimalIntegerLiteral", "HexIntegerLiteral", "OctalIntegerLiteral", "BinaryIntegerLiteral",
-	"IntegerTypeSuffix", "DecimalNumeral", "Digits", "Digit", "NonZeroDigit",
-	"DigitOrUnderscore", "Underscores", "HexNumeral", "HexDigits", "HexDigit",
-	"HexDigitOrUnderscore", "OctalNumeral", "OctalDigits", "OctalDigit", "OctalDigitOrUnderscore",
-	"BinaryNumeral", "BinaryDigits", "BinaryDigit", "BinaryDigitOrUnderscore",
-	"FloatingPointLiteral", "DecimalFloatingPointLiteral", "ExponentPart",
-	"ExponentIndicator", "SignedInteger", "Sign", "FloatTypeSuffix", "HexadecimalFloatingPointLiteral",
-	"HexSignificand", "BinaryExponent", "BinaryExponentIndicator", "BooleanLiteral",
-	"StringLiteral", "StringCharacters", "StringCharacter", "EscapeSequence",
-	"OctalEscape", "UnicodeEscape", "ZeroToThree", "NullLiteral", "LPAREN",
-	"RPAREN", "LBRACE", "RBRACE", "LBRACK", "RBRACK", "SEMI", "COMMA", "DOT",
-	"ASSIGN", "GT", "LT", "BANG", "TILDE", "QUESTION", "COLON", "EQUAL", "T_EQUAL",
-	"LE", "GE", "NOTEQUAL", "AND", "OR", "INC", "DEC", "ADD", "SUB", "MUL",
-	"DIV", "BITAND", "BITOR", "CARET", "MOD", "ADD_ASSIGN", "SUB_ASSIGN", "MUL_ASSIGN",
-	"DIV_ASSIGN", "AND_ASSIGN", "OR_ASSIGN", "XOR_ASSIGN", "MOD_ASSIGN", "LSHIFT_ASSIGN",
+	"LIKE", "INCLUDES", "EXCLUDES", "THEN", "INSERT", "UPSERT", "UPDATE", "DELETE",
+	"UNDELETE", "SOQL_AND", "SOQL_OR", "SOQL_NOT", "FIND", "FIELDS", "RETURNING",
+	"ALL", "ROWS", "TESTMETHOD", "TRIGGER", "ON", "BEFORE", "AFTER", "RUNAS",
+	"SYSTEM", "IntegerLiteral", "DecimalIntegerLiteral", "HexIntegerLiteral",
+	"OctalIntegerLiteral", "BinaryIntegerLiteral", "IntegerTypeSuffix", "DecimalNumeral",
+	"Digits", "Digit", "NonZeroDigit", "DigitOrUnderscore", "Underscores",
+	"HexNumeral", "HexDigits", "HexDigit", "HexDigitOrUnderscore", "OctalNumeral",
+	"OctalDigits", "OctalDigit", "OctalDigitOrUnderscore", "BinaryNumeral",
+	"BinaryDigits", "BinaryDigit", "BinaryDigitOrUnderscore", "FloatingPointLiteral",
+	"DecimalFloatingPointLiteral", "ExponentPart", "ExponentIndicator", "SignedInteger",
+	"Sign", "FloatTypeSuffix", "HexadecimalFloatingPointLiteral", "HexSignificand",
+	"BinaryExponent", "BinaryExponentIndicator", "BooleanLiteral", "StringLiteral",
+	"StringCharacters", "StringCharacter", "EscapeSequence", "OctalEscape",
+	"UnicodeEscape", "ZeroToThree", "NullLiteral", "LPAREN", "RPAREN", "LBRACE",
+	"RBRACE", "LBRACK", "RBRACK", "SEMI", "COMMA", "DOT", "ASSIGN", "GT", "LT",
+	"BANG", "TILDE", "QUESTION", "COLON", "EQUAL", "T_EQUAL", "LE", "GE", "NOTEQUAL",
+	"AND", "OR", "INC", "DEC", "ADD", "SUB", "MUL", "DIV", "BITAND", "BITOR",
+	"CARET", "MOD", "ADD_ASSIGN", "SUB_ASSIGN", "MUL_ASSIGN", "DIV_ASSIGN",
+	"AND_ASSIGN", "OR_ASSIGN", "XOR_ASSIGN", "MOD_ASSIGN", "LSHIFT_ASSIGN",
 	"RSHIFT_ASSIGN", "URSHIFT_ASSIGN", "LAMBDA_LIKE", "Identifier", "JavaLetter",
 	"JavaLetterOrDigit", "AT", "ELLIPSIS", "WS", "APEXDOC_COMMENT", "APEXDOC_COMMENT_START",
 	"COMMENT", "COMMENT_START", "LINE_COMMENT", "QUOTE", "A", "B", "C", "D",
@@ -992,84 +1006,87 @@ const (
 	apexLexerROLLUP                = 82
 	apexLexerCUBE                  = 83
 	apexLexerLIKE                  = 84
-	apexLexerTHEN                  = 85
-	apexLexerINSERT                = 86
-	apexLexerUPSERT                = 87
-	apexLexerUPDATE                = 88
-	apexLexerDELETE                = 89
-	apexLexerUNDELETE              = 90
-	apexLexerSOQL_AND              = 91
-	apexLexerSOQL_OR               = 92
-	apexLexerSOQL_NOT              = 93
-	apexLexerFIND                  = 94
-	apexLexerFIELDS                = 95
-	apexLexerRETURNING             = 96
-	apexLexerALL                   = 97
-	apexLexerTESTMETHOD            = 98
-	apexLexerTRIGGER               = 99
-	apexLexerON                    = 100
-	apexLexerBEFORE                = 101
-	apexLexerAFTER                 = 102
-	apexLexerRUNAS                 = 103
-	apexLexerSYSTEM                = 104
-	apexLexerIntegerLiteral        = 105
-	apexLexerFloatingPointLiteral  = 106
-	apexLexerBooleanLiteral        = 107
-	apexLexerStringLiteral         = 108
-	apexLexerNullLiteral           = 109
-	apexLexerLPAREN                = 110
-	apexLexerRPAREN                = 111
-	apexLexerLBRACE                = 112
-	apexLexerRBRACE                = 113
-	apexLexerLBRACK                = 114
-	apexLexerRBRACK                = 115
-	apexLexerSEMI                  = 116
-	apexLexerCOMMA                 = 117
-	apexLexerDOT                   = 118
-	apexLexerASSIGN                = 119
-	apexLexerGT                    = 120
-	apexLexerLT                    = 121
-	apexLexerBANG                  = 122
-	apexLexerTILDE                 = 123
-	apexLexerQUESTION              = 124
-	apexLexerCOLON                 = 125
-	apexLexerEQUAL                 = 126
-	apexLexerT_EQUAL               = 127
-	apexLexerLE                    = 128
-	apexLexerGE                    = 129
-	apexLexerNOTEQUAL              = 130
-	apexLexerAND                   = 131
-	apexLexerOR                    = 132
-	apexLexerINC                   = 133
-	apexLexerDEC                   = 134
-	apexLexerADD                   = 135
-	apexLexerSUB                   = 136
-	apexLexerMUL                   = 137
-	apexLexerDIV                   = 138
-	apexLexerBITAND                = 139
-	apexLexerBITOR                 = 140
-	apexLexerCARET                 = 141
-	apexLexerMOD                   = 142
-	apexLexerADD_ASSIGN            = 143
-	apexLexerSUB_ASSIGN            = 144
-	apexLexerMUL_ASSIGN            = 145
-	apexLexerDIV_ASSIGN            = 146
-	apexLexerAND_ASSIGN            = 147
-	apexLexerOR_ASSIGN             = 148
-	apexLexerXOR_ASSIGN            = 149
-	apexLexerMOD_ASSIGN            = 150
-	apexLexerLSHIFT_ASSIGN         = 151
-	apexLexerRSHIFT_ASSIGN         = 152
-	apexLexerURSHIFT_ASSIGN        = 153
-	apexLexerLAMBDA_LIKE           = 154
-	apexLexerIdentifier            = 155
-	apexLexerAT                    = 156
-	apexLexerELLIPSIS              = 157
-	apexLexerWS                    = 158
-	apexLexerAPEXDOC_COMMENT       = 159
-	apexLexerAPEXDOC_COMMENT_START = 160
-	apexLexerCOMMENT               = 161
-	apexLexerCOMMENT_START         = 162
-	apexLexerLINE_COMMENT          = 163
-	apexLexerQUOTE                 = 164
+	apexLexerINCLUDES              = 85
+	apexLexerEXCLUDES              = 86
+	apexLexerTHEN                  = 87
+	apexLexerINSERT                = 88
+	apexLexerUPSERT                = 89
+	apexLexerUPDATE                = 90
+	apexLexerDELETE                = 91
+	apexLexerUNDELETE              = 92
+	apexLexerSOQL_AND              = 93
+	apexLexerSOQL_OR               = 94
+	apexLexerSOQL_NOT              = 95
+	apexLexerFIND                  = 96
+	apexLexerFIELDS                = 97
+	apexLexerRETURNING             = 98
+	apexLexerALL                   = 99
+	apexLexerROWS                  = 100
+	apexLexerTESTMETHOD            = 101
+	apexLexerTRIGGER               = 102
+	apexLexerON                    = 103
+	apexLexerBEFORE                = 104
+	apexLexerAFTER                 = 105
+	apexLexerRUNAS                 = 106
+	apexLexerSYSTEM                = 107
+	apexLexerIntegerLiteral        = 108
+	apexLexerFloatingPointLiteral  = 109
+	apexLexerBooleanLiteral        = 110
+	apexLexerStringLiteral         = 111
+	apexLexerNullLiteral           = 112
+	apexLexerLPAREN                = 113
+	apexLexerRPAREN                = 114
+	apexLexerLBRACE                = 115
+	apexLexerRBRACE                = 116
+	apexLexerLBRACK                = 117
+	apexLexerRBRACK                = 118
+	apexLexerSEMI                  = 119
+	apexLexerCOMMA                 = 120
+	apexLexerDOT                   = 121
+	apexLexerASSIGN                = 122
+	apexLexerGT                    = 123
+	apexLexerLT                    = 124
+	apexLexerBANG                  = 125
+	apexLexerTILDE                 = 126
+	apexLexerQUESTION              = 127
+	apexLexerCOLON                 = 128
+	apexLexerEQUAL                 = 129
+	apexLexerT_EQUAL               = 130
+	apexLexerLE                    = 131
+	apexLexerGE                    = 132
+	apexLexerNOTEQUAL              = 133
+	apexLexerAND                   = 134
+	apexLexerOR                    = 135
+	apexLexerINC                   = 136
+	apexLexerDEC                   = 137
+	apexLexerADD                   = 138
+	apexLexerSUB                   = 139
+	apexLexerMUL                   = 140
+	apexLexerDIV                   = 141
+	apexLexerBITAND                = 142
+	apexLexerBITOR                 = 143
+	apexLexerCARET                 = 144
+	apexLexerMOD                   = 145
+	apexLexerADD_ASSIGN            = 146
+	apexLexerSUB_ASSIGN            = 147
+	apexLexerMUL_ASSIGN            = 148
+	apexLexerDIV_ASSIGN            = 149
+	apexLexerAND_ASSIGN            = 150
+	apexLexerOR_ASSIGN             = 151
+	apexLexerXOR_ASSIGN            = 152
+	apexLexerMOD_ASSIGN            = 153
+	apexLexerLSHIFT_ASSIGN         = 154
+	apexLexerRSHIFT_ASSIGN         = 155
+	apexLexerURSHIFT_ASSIGN        = 156
+	apexLexerLAMBDA_LIKE           = 157
+	apexLexerIdentifier            = 158
+	apexLexerAT                    = 159
+	apexLexerELLIPSIS              = 160
+	apexLexerWS                    = 161
+	apexLexerAPEXDOC_COMMENT       = 162
+	apexLexerAPEXDOC_COMMENT_START = 163
+	apexLexerCOMMENT               = 164
+	apexLexerCOMMENT_START         = 165
+	apexLexerLINE_COMMENT          = 166
+	apexLexerQUOTE                 = 167
 )
@@ -218,6 +218,9 @@ type apexListener interface {
 	// EnterWhenExpression is called when entering the whenExpression production.
 	EnterWhenExpression(c *WhenExpressionContext)
 
+	// EnterWhenValue is called when entering the whenValue production.
+	EnterWhenValue(c *WhenValueContext)
+
 	// EnterForControl is called when entering the forControl production.
 	EnterForControl(c *ForControlContext)
 
@@ -377,18 +380,27 @@ type apexListener interface {
 	// EnterWhereField is called when entering the whereField production.
 	EnterWhereField(c *WhereFieldContext)
 
+	// EnterSoqlOperator is called when entering the soqlOperator production.
+	EnterSoqlOperator(c *SoqlOperatorContext)
+
 	// EnterLimitClause is called when entering the limitClause production.
 	EnterLimitClause(c *LimitClauseContext)
 
 	// EnterOrderClause is called when entering the orderClause production.
 	EnterOrderClause(c *OrderClauseContext)
 
+	// EnterOrderField is called when entering the orderField production.
+	EnterOrderField(c *OrderFieldContext)
+
 	// EnterBindVariable is called when entering the bindVariable production.
 	EnterBindVariable(c *BindVariableContext)
 
 	// EnterSoqlValue is called when entering the soqlValue production.
 	EnterSoqlValue(c *SoqlValueContext)
 
+	// EnterSoqlValueList is called when entering the soqlValueList production.
+	EnterSoqlValueList(c *SoqlValueListContext)
+
 	// EnterWithClause is called when entering the withClause production.
 	EnterWithClause(c *WithClauseContext)
 
@@ -407,6 +419,9 @@ type apexListener interface {
 	// EnterViewClause is called when entering the viewClause production.
 	EnterViewClause(c *ViewClauseContext)
 
+	// EnterAllRowsClause is called when entering the allRowsClause production.
+	EnterAllRowsClause(c *AllRowsClauseContext)
+
 	// EnterSoslLiteral is called when entering the soslLiteral production.
 	EnterSoslLiteral(c *SoslLiteralContext)
 
@@ -632,6 +647,9 @@ type apexListener interface {
 	// ExitWhenExpression is called when exiting the whenExpression production.
 	ExitWhenExpression(c *WhenExpressionContext)
 
+	// ExitWhenValue is called when exiting the whenValue production.
+	ExitWhenValue(c *WhenValueContext)
+
 	// ExitForControl is called when exiting the forControl production.
 	ExitForControl(c *ForControlContext)
 
@@ -791,18 +809,27 @@ type apexListener interface {
 	// ExitWhereField is called when exiting the whereField production.
 	ExitWhereField(c *WhereFieldContext)
 
+	// ExitSoqlOperator is called when exiting the soqlOperator production.
+	ExitSoqlOperator(c *SoqlOperatorContext)
+
 	// ExitLimitClause is called when exiting the limitClause production.
 	ExitLimitClause(c *LimitClauseContext)
 
 	// ExitOrderClause is called when exiting the orderClause production.
 	ExitOrderClause(c *OrderClauseContext)
 
+	// ExitOrderField is called when exiting the orderField production.
+	ExitOrderField(c *OrderFieldContext)
+
 	// ExitBindVariable is called when exiting the bindVariable production.
 	ExitBindVariable(c *BindVariableContext)
 
 	// ExitSoqlValue is called when exiting the soqlValue production.
 	ExitSoqlValue(c *SoqlValueContext)
 
+	// ExitSoqlValueList is called when exiting the soqlValueList production.
+	ExitSoqlValueList(c *SoqlValueListContext)
+
 	// ExitWithClause is called when exiting the withClause production.
 	ExitWithClause(c *WithClauseContext)
 
@@ -821,6 +848,9 @@ type apexListener interface {
 	// ExitViewClause is called when exiting the viewClause production.
 	ExitViewClause(c *ViewClauseContext)
 
+	// ExitAllRowsClause is called when exiting the allRowsClause production.
+	ExitAllRowsClause(c *AllRowsClauseContext)
+
 	// ExitSoslLiteral is called when exiting the soslLiteral production.
 	ExitSoslLiteral(c *SoslLiteralContext)
 
@@ -16,7 +16,7 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 166, 1484,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 169, 1550,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
@@ -41,697 +41,735 @@ var parserATN = []uint16{
 	9, 111, 4, 112, 9, 112, 4, 113, 9, 113, 4, 114, 9, 114, 4, 115, 9, 115,
 	4, 116, 9, 116, 4, 117, 9, 117, 4, 118, 9, 118, 4, 119, 9, 119, 4, 120,
 	9, 120, 4, 121, 9, 121, 4, 122, 9, 122, 4, 123, 9, 123, 4, 124, 9, 124,
-	4, 125, 9, 125, 4, 126, 9, 126, 4, 127, 9, 127, 3, 2, 3, 2, 3, 2, 3, 3,
-	7, 3, 259, 10, 3, 12, 3, 14, 3, 262, 11, 3, 3, 3, 3, 3, 7, 3, 266, 10,
-	3, 12, 3, 14, 3, 269, 11, 3, 3, 3, 3, 3, 7, 3, 273, 10, 3, 12, 3, 14, 3,
-	276, 11, 3, 3, 3, 3, 3, 3, 3, 5, 3, 281, 10, 3, 3, 4, 3, 4, 3, 4, 3, 4,
-	3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 7, 5, 295, 10, 5, 12, 5,
-	14, 5, 298, 11, 5, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 5, 7, 305, 10, 7, 3, 8,
-	3, 8, 5, 8, 309, 10, 8, 3, 9, 3, 9, 5, 9, 313, 10, 9, 3, 10, 3, 10, 3,
-	10, 3, 10, 5, 10, 319, 10, 10, 3, 10, 3, 10, 5, 10, 323, 10, 10, 3, 10,
-	3, 10, 3, 11, 3, 11, 3, 11, 3, 11, 5, 11, 331, 10, 11, 3, 11, 3, 11, 5,
-	11, 335, 10, 11, 3, 11, 5, 11, 338, 10, 11, 3, 11, 5, 11, 341, 10, 11,
-	3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 7, 12, 348, 10, 12, 12, 12, 14, 12,
-	351, 11, 12, 3, 13, 7, 13, 354, 10, 13, 12, 13, 14, 13, 357, 11, 13, 3,
-	13, 3, 13, 5, 13, 361, 10, 13, 3, 13, 5, 13, 364, 10, 13, 3, 14, 3, 14,
-	7, 14, 368, 10, 14, 12, 14, 14, 14, 371, 11, 14, 3, 15, 3, 15, 3, 15, 3,
-	15, 3, 16, 3, 16, 3, 16, 7, 16, 380, 10, 16, 12, 16, 14, 16, 383, 11, 16,
-	3, 17, 3, 17, 7, 17, 387, 10, 17, 12, 17, 14, 17, 390, 11, 17, 3, 17, 3,
-	17, 3, 18, 3, 18, 7, 18, 396, 10, 18, 12, 18, 14, 18, 399, 11, 18, 3, 18,
-	3, 18, 3, 19, 3, 19, 5, 19, 405, 10, 19, 3, 19, 3, 19, 7, 19, 409, 10,
-	19, 12, 19, 14, 19, 412, 11, 19, 3, 19, 5, 19, 415, 10, 19, 3, 20, 3, 20,
-	3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 5, 20, 424, 10, 20, 3, 21, 5, 21, 427,
-	10, 21, 3, 21, 3, 21, 5, 21, 431, 10, 21, 3, 21, 3, 21, 3, 21, 3, 21, 7,
-	21, 437, 10, 21, 12, 21, 14, 21, 440, 11, 21, 3, 21, 3, 21, 5, 21, 444,
-	10, 21, 3, 21, 3, 21, 5, 21, 448, 10, 21, 3, 22, 3, 22, 3, 22, 3, 22, 5,
-	22, 454, 10, 22, 3, 22, 3, 22, 3, 23, 3, 23, 3, 23, 3, 23, 3, 24, 3, 24,
-	3, 24, 3, 24, 3, 25, 3, 25, 3, 25, 5, 25, 469, 10, 25, 3, 25, 3, 25, 3,
-	26, 7, 26, 474, 10, 26, 12, 26, 14, 26, 477, 11, 26, 3, 26, 3, 26, 5, 26,
-	481, 10, 26, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 5, 27, 488, 10, 27, 3,
-	28, 3, 28, 3, 28, 3, 28, 7, 28, 494, 10, 28, 12, 28, 14, 28, 497, 11, 28,
-	3, 28, 3, 28, 3, 29, 3, 29, 3, 29, 7, 29, 504, 10, 29, 12, 29, 14, 29,
-	507, 11, 29, 3, 29, 3, 29, 3, 29, 3, 30, 3, 30, 5, 30, 514, 10, 30, 3,
-	30, 3, 30, 3, 30, 3, 30, 7, 30, 520, 10, 30, 12, 30, 14, 30, 523, 11, 30,
-	3, 30, 3, 30, 5, 30, 527, 10, 30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 7,
-	31, 534, 10, 31, 12, 31, 14, 31, 537, 11, 31, 3, 32, 3, 32, 3, 32, 5, 32,
-	542, 10, 32, 3, 33, 3, 33, 3, 33, 7, 33, 547, 10, 33, 12, 33, 14, 33, 550,
-	11, 33, 3, 34, 3, 34, 5, 34, 554, 10, 34, 3, 35, 3, 35, 3, 35, 3, 35, 7,
-	35, 560, 10, 35, 12, 35, 14, 35, 563, 11, 35, 3, 35, 5, 35, 566, 10, 35,
-	5, 35, 568, 10, 35, 3, 35, 3, 35, 3, 36, 3, 36, 3, 37, 3, 37, 7, 37, 576,
-	10, 37, 12, 37, 14, 37, 579, 11, 37, 3, 37, 3, 37, 7, 37, 583, 10, 37,
-	12, 37, 14, 37, 586, 11, 37, 5, 37, 588, 10, 37, 3, 38, 3, 38, 3, 38, 3,
-	39, 3, 39, 5, 39, 595, 10, 39, 3, 39, 3, 39, 3, 39, 5, 39, 600, 10, 39,
-	7, 39, 602, 10, 39, 12, 39, 14, 39, 605, 11, 39, 3, 39, 3, 39, 5, 39, 609,
-	10, 39, 3, 40, 3, 40, 3, 41, 3, 41, 3, 41, 3, 41, 7, 41, 617, 10, 41, 12,
-	41, 14, 41, 620, 11, 41, 3, 41, 3, 41, 3, 42, 3, 42, 3, 42, 3, 42, 5, 42,
-	628, 10, 42, 5, 42, 630, 10, 42, 3, 43, 3, 43, 3, 43, 7, 43, 635, 10, 43,
-	12, 43, 14, 43, 638, 11, 43, 3, 44, 3, 44, 5, 44, 642, 10, 44, 3, 44, 3,
-	44, 3, 45, 3, 45, 3, 45, 7, 45, 649, 10, 45, 12, 45, 14, 45, 652, 11, 45,
-	3, 45, 3, 45, 5, 45, 656, 10, 45, 3, 45, 5, 45, 659, 10, 45, 3, 46, 7,
-	46, 662, 10, 46, 12, 46, 14, 46, 665, 11, 46, 3, 46, 3, 46, 3, 46, 3, 47,
-	7, 47, 671, 10, 47, 12, 47, 14, 47, 674, 11, 47, 3, 47, 3, 47, 3, 47, 3,
-	47, 3, 48, 3, 48, 3, 49, 3, 49, 3, 50, 3, 50, 3, 50, 7, 50, 687, 10, 50,
-	12, 50, 14, 50, 690, 11, 50, 3, 51, 3, 51, 3, 52, 3, 52, 3, 52, 3, 52,
-	3, 52, 5, 52, 699, 10, 52, 3, 52, 5, 52, 702, 10, 52, 3, 53, 3, 53, 3,
-	54, 3, 54, 7, 54, 708, 10, 54, 12, 54, 14, 54, 711, 11, 54, 3, 55, 3, 55,
-	3, 55, 3, 55, 3, 56, 3, 56, 3, 56, 5, 56, 720, 10, 56, 3, 57, 3, 57, 3,
-	57, 3, 57, 7, 57, 726, 10, 57, 12, 57, 14, 57, 729, 11, 57, 5, 57, 731,
-	10, 57, 3, 57, 5, 57, 734, 10, 57, 3, 57, 3, 57, 3, 58, 3, 58, 7, 58, 740,
-	10, 58, 12, 58, 14, 58, 743, 11, 58, 3, 58, 3, 58, 3, 59, 3, 59, 3, 59,
-	5, 59, 750, 10, 59, 3, 60, 3, 60, 3, 60, 3, 61, 7, 61, 756, 10, 61, 12,
-	61, 14, 61, 759, 11, 61, 3, 61, 3, 61, 3, 61, 3, 62, 3, 62, 3, 62, 3, 62,
-	3, 62, 3, 62, 5, 62, 770, 10, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3,
-	62, 3, 62, 3, 62, 5, 62, 780, 10, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62,
+	4, 125, 9, 125, 4, 126, 9, 126, 4, 127, 9, 127, 4, 128, 9, 128, 4, 129,
+	9, 129, 4, 130, 9, 130, 4, 131, 9, 131, 4, 132, 9, 132, 3, 2, 3, 2, 3,
+	2, 3, 3, 7, 3, 269, 10, 3, 12, 3, 14, 3, 272, 11, 3, 3, 3, 3, 3, 7, 3,
+	276, 10, 3, 12, 3, 14, 3, 279, 11, 3, 3, 3, 3, 3, 7, 3, 283, 10, 3, 12,
+	3, 14, 3, 286, 11, 3, 3, 3, 3, 3, 3, 3, 5, 3, 291, 10, 3, 3, 4, 3, 4, 3,
+	4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 7, 5, 305, 10,
+	5, 12, 5, 14, 5, 308, 11, 5, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 5, 7, 315, 10,
+	7, 3, 8, 3, 8, 5, 8, 319, 10, 8, 3, 9, 3, 9, 5, 9, 323, 10, 9, 3, 10, 3,
+	10, 3, 10, 3, 10, 5, 10, 329, 10, 10, 3, 10, 3, 10, 5, 10, 333, 10, 10,
+	3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3, 11, 5, 11, 341, 10, 11, 3, 11, 3,
+	11, 5, 11, 345, 10, 11, 3, 11, 5, 11, 348, 10, 11, 3, 11, 5, 11, 351, 10,
+	11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 7, 12, 358, 10, 12, 12, 12, 14,
+	12, 361, 11, 12, 3, 13, 7, 13, 364, 10, 13, 12, 13, 14, 13, 367, 11, 13,
+	3, 13, 3, 13, 5, 13, 371, 10, 13, 3, 13, 5, 13, 374, 10, 13, 3, 14, 3,
+	14, 7, 14, 378, 10, 14, 12, 14, 14, 14, 381, 11, 14, 3, 15, 3, 15, 3, 15,
+	3, 15, 3, 16, 3, 16, 3, 16, 7, 16, 390, 10, 16, 12, 16, 14, 16, 393, 11,
+	16, 3, 17, 3, 17, 7, 17, 397, 10, 17, 12, 17, 14, 17, 400, 11, 17, 3, 17,
+	3, 17, 3, 18, 3, 18, 7, 18, 406, 10, 18, 12, 18, 14, 18, 409, 11, 18, 3,
+	18, 3, 18, 3, 19, 3, 19, 5, 19, 415, 10, 19, 3, 19, 3, 19, 7, 19, 419,
+	10, 19, 12, 19, 14, 19, 422, 11, 19, 3, 19, 5, 19, 425, 10, 19, 3, 20,
+	3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 5, 20, 434, 10, 20, 3, 21, 5,
+	21, 437, 10, 21, 3, 21, 3, 21, 5, 21, 441, 10, 21, 3, 21, 3, 21, 3, 21,
+	3, 21, 7, 21, 447, 10, 21, 12, 21, 14, 21, 450, 11, 21, 3, 21, 3, 21, 5,
+	21, 454, 10, 21, 3, 21, 3, 21, 5, 21, 458, 10, 21, 3, 22, 3, 22, 3, 22,
+	3, 22, 5, 22, 464, 10, 22, 3, 22, 3, 22, 3, 23, 3, 23, 3, 23, 3, 23, 3,
+	24, 3, 24, 3, 24, 3, 24, 3, 25, 3, 25, 3, 25, 5, 25, 479, 10, 25, 3, 25,
+	3, 25, 3, 26, 7, 26, 484, 10, 26, 12, 26, 14, 26, 487, 11, 26, 3, 26, 3,
+	26, 5, 26, 491, 10, 26, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 5, 27, 498,
+	10, 27, 3, 28, 3, 28, 3, 28, 3, 28, 7, 28, 504, 10, 28, 12, 28, 14, 28,
+	507, 11, 28, 3, 28, 3, 28, 3, 29, 3, 29, 3, 29, 7, 29, 514, 10, 29, 12,
+	29, 14, 29, 517, 11, 29, 3, 29, 3, 29, 3, 29, 3, 30, 3, 30, 5, 30, 524,
+	10, 30, 3, 30, 3, 30, 3, 30, 3, 30, 7, 30, 530, 10, 30, 12, 30, 14, 30,
+	533, 11, 30, 3, 30, 3, 30, 5, 30, 537, 10, 30, 3, 30, 3, 30, 3, 31, 3,
+	31, 3, 31, 7, 31, 544, 10, 31, 12, 31, 14, 31, 547, 11, 31, 3, 32, 3, 32,
+	3, 32, 5, 32, 552, 10, 32, 3, 33, 3, 33, 3, 33, 7, 33, 557, 10, 33, 12,
+	33, 14, 33, 560, 11, 33, 3, 34, 3, 34, 5, 34, 564, 10, 34, 3, 35, 3, 35,
+	3, 35, 3, 35, 7, 35, 570, 10, 35, 12, 35, 14, 35, 573, 11, 35, 3, 35, 5,
+	35, 576, 10, 35, 5, 35, 578, 10, 35, 3, 35, 3, 35, 3, 36, 3, 36, 3, 37,
+	3, 37, 7, 37, 586, 10, 37, 12, 37, 14, 37, 589, 11, 37, 3, 37, 3, 37, 7,
+	37, 593, 10, 37, 12, 37, 14, 37, 596, 11, 37, 5, 37, 598, 10, 37, 3, 38,
+	3, 38, 3, 38, 3, 39, 3, 39, 5, 39, 605, 10, 39, 3, 39, 3, 39, 3, 39, 5,
+	39, 610, 10, 39, 7, 39, 612, 10, 39, 12, 39, 14, 39, 615, 11, 39, 3, 39,
+	3, 39, 5, 39, 619, 10, 39, 3, 40, 3, 40, 3, 41, 3, 41, 3, 41, 3, 41, 7,
+	41, 627, 10, 41, 12, 41, 14, 41, 630, 11, 41, 3, 41, 3, 41, 3, 42, 3, 42,
+	3, 42, 3, 42, 5, 42, 638, 10, 42, 5, 42, 640, 10, 42, 3, 43, 3, 43, 3,
+	43, 7, 43, 645, 10, 43, 12, 43, 14, 43, 648, 11, 43, 3, 44, 3, 44, 5, 44,
+	652, 10, 44, 3, 44, 3, 44, 3, 45, 3, 45, 3, 45, 7, 45, 659, 10, 45, 12,
+	45, 14, 45, 662, 11, 45, 3, 45, 3, 45, 5, 45, 666, 10, 45, 3, 45, 5, 45,
+	669, 10, 45, 3, 46, 7, 46, 672, 10, 46, 12, 46, 14, 46, 675, 11, 46, 3,
+	46, 3, 46, 3, 46, 3, 47, 7, 47, 681, 10, 47, 12, 47, 14, 47, 684, 11, 47,
+	3, 47, 3, 47, 3, 47, 3, 47, 3, 48, 3, 48, 3, 49, 3, 49, 3, 50, 3, 50, 3,
+	50, 7, 50, 697, 10, 50, 12, 50, 14, 50, 700, 11, 50, 3, 51, 3, 51, 3, 52,
+	3, 52, 3, 52, 3, 52, 3, 52, 5, 52, 709, 10, 52, 3, 52, 5, 52, 712, 10,
+	52, 3, 53, 3, 53, 3, 54, 3, 54, 7, 54, 718, 10, 54, 12, 54, 14, 54, 721,
+	11, 54, 3, 55, 3, 55, 3, 55, 3, 55, 3, 56, 3, 56, 3, 56, 5, 56, 730, 10,
+	56, 3, 57, 3, 57, 3, 57, 3, 57, 7, 57, 736, 10, 57, 12, 57, 14, 57, 739,
+	11, 57, 5, 57, 741, 10, 57, 3, 57, 5, 57, 744, 10, 57, 3, 57, 3, 57, 3,
+	58, 3, 58, 7, 58, 750, 10, 58, 12, 58, 14, 58, 753, 11, 58, 3, 58, 3, 58,
+	3, 59, 3, 59, 3, 59, 5, 59, 760, 10, 59, 3, 60, 3, 60, 3, 60, 3, 61, 7,
+	61, 766, 10, 61, 12, 61, 14, 61, 769, 11, 61, 3, 61, 3, 61, 3, 61, 3, 62,
+	3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 5, 62, 780, 10, 62, 3, 62, 3, 62, 3,
+	62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 5, 62, 790, 10, 62, 3, 62, 3, 62,
 	3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3,
-	62, 3, 62, 3, 62, 3, 62, 3, 62, 6, 62, 802, 10, 62, 13, 62, 14, 62, 803,
-	3, 62, 5, 62, 807, 10, 62, 3, 62, 5, 62, 810, 10, 62, 3, 62, 3, 62, 5,
-	62, 814, 10, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 5, 62,
-	823, 10, 62, 3, 62, 3, 62, 3, 62, 5, 62, 828, 10, 62, 3, 62, 3, 62, 3,
+	62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 6, 62, 812, 10, 62,
+	13, 62, 14, 62, 813, 3, 62, 5, 62, 817, 10, 62, 3, 62, 5, 62, 820, 10,
+	62, 3, 62, 3, 62, 5, 62, 824, 10, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62,
+	3, 62, 3, 62, 5, 62, 833, 10, 62, 3, 62, 3, 62, 3, 62, 5, 62, 838, 10,
 	62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62,
-	3, 62, 3, 62, 3, 62, 5, 62, 846, 10, 62, 3, 63, 7, 63, 849, 10, 63, 12,
-	63, 14, 63, 852, 11, 63, 3, 63, 3, 63, 5, 63, 856, 10, 63, 3, 64, 3, 64,
-	3, 64, 5, 64, 861, 10, 64, 3, 65, 3, 65, 3, 65, 5, 65, 866, 10, 65, 3,
-	66, 3, 66, 3, 66, 7, 66, 871, 10, 66, 12, 66, 14, 66, 874, 11, 66, 3, 66,
-	3, 66, 3, 66, 3, 66, 3, 66, 3, 67, 3, 67, 3, 67, 7, 67, 884, 10, 67, 12,
-	67, 14, 67, 887, 11, 67, 3, 68, 3, 68, 3, 68, 3, 69, 3, 69, 7, 69, 894,
-	10, 69, 12, 69, 14, 69, 897, 11, 69, 3, 70, 3, 70, 3, 70, 3, 70, 3, 71,
-	3, 71, 3, 71, 7, 71, 906, 10, 71, 12, 71, 14, 71, 909, 11, 71, 3, 71, 3,
-	71, 3, 71, 5, 71, 914, 10, 71, 3, 72, 3, 72, 5, 72, 918, 10, 72, 3, 72,
-	3, 72, 5, 72, 922, 10, 72, 3, 72, 3, 72, 5, 72, 926, 10, 72, 5, 72, 928,
-	10, 72, 3, 73, 3, 73, 5, 73, 932, 10, 73, 3, 74, 7, 74, 935, 10, 74, 12,
-	74, 14, 74, 938, 11, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 75, 3, 75,
-	3, 76, 3, 76, 3, 76, 3, 76, 3, 77, 3, 77, 3, 77, 7, 77, 954, 10, 77, 12,
-	77, 14, 77, 957, 11, 77, 3, 78, 3, 78, 3, 79, 3, 79, 3, 80, 3, 80, 3, 80,
-	3, 80, 3, 80, 3, 80, 5, 80, 969, 10, 80, 3, 81, 3, 81, 3, 82, 3, 82, 3,
-	82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82,
-	5, 82, 986, 10, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3,
-	82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 5, 82, 1002, 10, 82, 3, 82,
-	3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3,
-	82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82,
-	3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3,
-	82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82,
-	3, 82, 3, 82, 5, 82, 1049, 10, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3,
-	82, 7, 82, 1057, 10, 82, 12, 82, 14, 82, 1060, 11, 82, 3, 83, 3, 83, 3,
+	3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 5, 62, 856, 10, 62, 3, 63, 7,
+	63, 859, 10, 63, 12, 63, 14, 63, 862, 11, 63, 3, 63, 3, 63, 5, 63, 866,
+	10, 63, 3, 64, 3, 64, 3, 64, 5, 64, 871, 10, 64, 3, 65, 3, 65, 3, 65, 5,
+	65, 876, 10, 65, 3, 66, 3, 66, 3, 66, 7, 66, 881, 10, 66, 12, 66, 14, 66,
+	884, 11, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 67, 3, 67, 3, 67, 7,
+	67, 894, 10, 67, 12, 67, 14, 67, 897, 11, 67, 3, 68, 3, 68, 3, 68, 3, 69,
+	3, 69, 7, 69, 904, 10, 69, 12, 69, 14, 69, 907, 11, 69, 3, 70, 3, 70, 3,
+	70, 3, 70, 3, 71, 3, 71, 3, 71, 7, 71, 916, 10, 71, 12, 71, 14, 71, 919,
+	11, 71, 3, 71, 3, 71, 3, 71, 5, 71, 924, 10, 71, 3, 72, 3, 72, 5, 72, 928,
+	10, 72, 3, 73, 3, 73, 5, 73, 932, 10, 73, 3, 73, 3, 73, 5, 73, 936, 10,
+	73, 3, 73, 3, 73, 5, 73, 940, 10, 73, 5, 73, 942, 10, 73, 3, 74, 3, 74,
+	5, 74, 946, 10, 74, 3, 75, 7, 75, 949, 10, 75, 12, 75, 14, 75, 952, 11,
+	75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 76, 3, 76, 3, 77, 3, 77, 3, 77,
+	3, 77, 3, 78, 3, 78, 3, 78, 7, 78, 968, 10, 78, 12, 78, 14, 78, 971, 11,
+	78, 3, 79, 3, 79, 3, 80, 3, 80, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81,
+	5, 81, 983, 10, 81, 3, 82, 3, 82, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3,
+	83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 5, 83, 1000, 10, 83,
+	3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3,
+	83, 3, 83, 3, 83, 3, 83, 5, 83, 1016, 10, 83, 3, 83, 3, 83, 3, 83, 3, 83,
+	3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3,
 	83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83,
-	3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 5, 83, 1081, 10, 83, 3, 83, 3,
-	83, 3, 83, 5, 83, 1086, 10, 83, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84,
-	3, 84, 3, 84, 3, 84, 5, 84, 1097, 10, 84, 5, 84, 1099, 10, 84, 3, 85, 3,
-	85, 5, 85, 1103, 10, 85, 3, 85, 3, 85, 3, 85, 5, 85, 1108, 10, 85, 7, 85,
-	1110, 10, 85, 12, 85, 14, 85, 1113, 11, 85, 3, 85, 3, 85, 3, 85, 5, 85,
-	1118, 10, 85, 3, 86, 3, 86, 5, 86, 1122, 10, 86, 3, 86, 3, 86, 3, 87, 3,
-	87, 7, 87, 1128, 10, 87, 12, 87, 14, 87, 1131, 11, 87, 3, 87, 3, 87, 3,
-	87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 7, 87, 1142, 10, 87, 12,
-	87, 14, 87, 1145, 11, 87, 3, 87, 7, 87, 1148, 10, 87, 12, 87, 14, 87, 1151,
-	11, 87, 5, 87, 1153, 10, 87, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88,
-	3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 7, 88, 1166, 10, 88, 12, 88, 14, 88,
-	1169, 11, 88, 3, 88, 3, 88, 5, 88, 1173, 10, 88, 3, 89, 3, 89, 5, 89, 1177,
-	10, 89, 3, 90, 3, 90, 5, 90, 1181, 10, 90, 3, 91, 3, 91, 3, 91, 3, 91,
-	7, 91, 1187, 10, 91, 12, 91, 14, 91, 1190, 11, 91, 3, 91, 3, 91, 3, 92,
-	3, 92, 5, 92, 1196, 10, 92, 3, 93, 3, 93, 5, 93, 1200, 10, 93, 3, 94, 3,
-	94, 3, 94, 3, 95, 3, 95, 3, 95, 3, 95, 3, 96, 3, 96, 3, 96, 5, 96, 1212,
-	10, 96, 3, 97, 3, 97, 3, 97, 5, 97, 1217, 10, 97, 3, 98, 3, 98, 3, 98,
-	3, 98, 5, 98, 1223, 10, 98, 5, 98, 1225, 10, 98, 3, 99, 3, 99, 3, 99, 3,
-	99, 3, 99, 5, 99, 1232, 10, 99, 3, 100, 3, 100, 5, 100, 1236, 10, 100,
-	3, 100, 3, 100, 3, 101, 3, 101, 3, 101, 3, 101, 3, 102, 3, 102, 3, 102,
-	5, 102, 1247, 10, 102, 3, 102, 5, 102, 1250, 10, 102, 3, 102, 5, 102, 1253,
-	10, 102, 3, 102, 5, 102, 1256, 10, 102, 3, 102, 5, 102, 1259, 10, 102,
-	3, 102, 5, 102, 1262, 10, 102, 3, 102, 5, 102, 1265, 10, 102, 3, 103, 3,
-	103, 3, 103, 3, 104, 3, 104, 3, 104, 7, 104, 1273, 10, 104, 12, 104, 14,
-	104, 1276, 11, 104, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3,
-	105, 3, 105, 3, 105, 6, 105, 1287, 10, 105, 13, 105, 14, 105, 1288, 3,
-	105, 3, 105, 3, 105, 3, 105, 5, 105, 1295, 10, 105, 3, 106, 3, 106, 3,
-	106, 3, 106, 3, 106, 5, 106, 1302, 10, 106, 3, 107, 3, 107, 3, 108, 3,
-	108, 3, 108, 7, 108, 1309, 10, 108, 12, 108, 14, 108, 1312, 11, 108, 3,
-	108, 3, 108, 3, 108, 3, 108, 3, 108, 3, 108, 7, 108, 1320, 10, 108, 12,
-	108, 14, 108, 1323, 11, 108, 5, 108, 1325, 10, 108, 3, 108, 3, 108, 5,
-	108, 1329, 10, 108, 3, 109, 3, 109, 3, 110, 3, 110, 3, 110, 3, 111, 3,
-	111, 3, 111, 3, 111, 3, 111, 3, 111, 7, 111, 1342, 10, 111, 12, 111, 14,
-	111, 1345, 11, 111, 3, 112, 5, 112, 1348, 10, 112, 3, 112, 3, 112, 3, 112,
-	3, 112, 3, 112, 3, 112, 3, 112, 3, 112, 5, 112, 1358, 10, 112, 3, 113,
-	3, 113, 3, 113, 5, 113, 1363, 10, 113, 3, 114, 3, 114, 3, 114, 3, 114,
-	3, 114, 7, 114, 1370, 10, 114, 12, 114, 14, 114, 1373, 11, 114, 3, 114,
-	5, 114, 1376, 10, 114, 3, 114, 3, 114, 5, 114, 1380, 10, 114, 3, 115, 3,
-	115, 3, 115, 3, 116, 3, 116, 3, 116, 3, 116, 3, 116, 3, 116, 5, 116, 1391,
-	10, 116, 3, 117, 3, 117, 3, 117, 3, 117, 3, 117, 3, 118, 3, 118, 3, 119,
-	3, 119, 3, 119, 3, 119, 3, 119, 7, 119, 1405, 10, 119, 12, 119, 14, 119,
-	1408, 11, 119, 3, 119, 3, 119, 5, 119, 1412, 10, 119, 3, 120, 3, 120, 3,
-	121, 3, 121, 3, 121, 5, 121, 1419, 10, 121, 3, 122, 3, 122, 3, 122, 3,
-	122, 5, 122, 1425, 10, 122, 3, 123, 3, 123, 3, 123, 3, 123, 3, 124, 3,
-	124, 3, 124, 3, 124, 3, 124, 3, 124, 3, 124, 3, 124, 3, 124, 7, 124, 1440,
-	10, 124, 12, 124, 14, 124, 1443, 11, 124, 3, 125, 3, 125, 3, 125, 3, 125,
-	3, 125, 7, 125, 1450, 10, 125, 12, 125, 14, 125, 1453, 11, 125, 3, 125,
-	5, 125, 1456, 10, 125, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126,
-	3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126,
-	3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 5, 126, 1480, 10,
-	126, 3, 127, 3, 127, 3, 127, 2, 4, 162, 220, 128, 2, 4, 6, 8, 10, 12, 14,
-	16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50,
-	52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86,
-	88, 90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118,
-	120, 122, 124, 126, 128, 130, 132, 134, 136, 138, 140, 142, 144, 146, 148,
-	150, 152, 154, 156, 158, 160, 162, 164, 166, 168, 170, 172, 174, 176, 178,
-	180, 182, 184, 186, 188, 190, 192, 194, 196, 198, 200, 202, 204, 206, 208,
-	210, 212, 214, 216, 218, 220, 222, 224, 226, 228, 230, 232, 234, 236, 238,
-	240, 242, 244, 246, 248, 250, 252, 2, 22, 3, 2, 103, 104, 3, 2, 88, 92,
-	9, 2, 4, 5, 8, 8, 21, 21, 37, 39, 41, 41, 54, 57, 100, 100, 7, 2, 9, 9,
-	17, 17, 23, 23, 30, 31, 33, 33, 4, 2, 20, 20, 42, 42, 3, 2, 107, 111, 3,
-	2, 135, 136, 4, 2, 124, 124, 137, 138, 4, 2, 139, 140, 144, 144, 3, 2,
-	137, 138, 4, 2, 122, 123, 130, 131, 4, 2, 128, 129, 132, 132, 4, 2, 121,
-	121, 145, 155, 3, 2, 93, 94, 7, 2, 3, 3, 73, 73, 86, 86, 121, 123, 130,
-	132, 3, 2, 64, 65, 3, 2, 81, 82, 3, 2, 68, 69, 3, 2, 70, 71, 11, 2, 6,
-	7, 68, 68, 72, 72, 76, 78, 83, 83, 87, 87, 96, 99, 106, 106, 157, 157,
-	2, 1603, 2, 254, 3, 2, 2, 2, 4, 280, 3, 2, 2, 2, 6, 282, 3, 2, 2, 2, 8,
-	291, 3, 2, 2, 2, 10, 299, 3, 2, 2, 2, 12, 304, 3, 2, 2, 2, 14, 308, 3,
-	2, 2, 2, 16, 312, 3, 2, 2, 2, 18, 314, 3, 2, 2, 2, 20, 326, 3, 2, 2, 2,
-	22, 344, 3, 2, 2, 2, 24, 355, 3, 2, 2, 2, 26, 365, 3, 2, 2, 2, 28, 372,
-	3, 2, 2, 2, 30, 376, 3, 2, 2, 2, 32, 384, 3, 2, 2, 2, 34, 393, 3, 2, 2,
-	2, 36, 414, 3, 2, 2, 2, 38, 423, 3, 2, 2, 2, 40, 426, 3, 2, 2, 2, 42, 449,
-	3, 2, 2, 2, 44, 457, 3, 2, 2, 2, 46, 461, 3, 2, 2, 2, 48, 465, 3, 2, 2,
-	2, 50, 480, 3, 2, 2, 2, 52, 487, 3, 2, 2, 2, 54, 489, 3, 2, 2, 2, 56, 500,
-	3, 2, 2, 2, 58, 513, 3, 2, 2, 2, 60, 530, 3, 2, 2, 2, 62, 538, 3, 2, 2,
-	2, 64, 543, 3, 2, 2, 2, 66, 553, 3, 2, 2, 2, 68, 555, 3, 2, 2, 2, 70, 571,
-	3, 2, 2, 2, 72, 587, 3, 2, 2, 2, 74, 589, 3, 2, 2, 2, 76, 608, 3, 2, 2,
-	2, 78, 610, 3, 2, 2, 2, 80, 612, 3, 2, 2, 2, 82, 629, 3, 2, 2, 2, 84, 631,
-	3, 2, 2, 2, 86, 639, 3, 2, 2, 2, 88, 658, 3, 2, 2, 2, 90, 663, 3, 2, 2,
-	2, 92, 672, 3, 2, 2, 2, 94, 679, 3, 2, 2, 2, 96, 681, 3, 2, 2, 2, 98, 683,
-	3, 2, 2, 2, 100, 691, 3, 2, 2, 2, 102, 693, 3, 2, 2, 2, 104, 703, 3, 2,
-	2, 2, 106, 705, 3, 2, 2, 2, 108, 712, 3, 2, 2, 2, 110, 719, 3, 2, 2, 2,
-	112, 721, 3, 2, 2, 2, 114, 737, 3, 2, 2, 2, 116, 749, 3, 2, 2, 2, 118,
-	751, 3, 2, 2, 2, 120, 757, 3, 2, 2, 2, 122, 845, 3, 2, 2, 2, 124, 850,
-	3, 2, 2, 2, 126, 857, 3, 2, 2, 2, 128, 862, 3, 2, 2, 2, 130, 867, 3, 2,
-	2, 2, 132, 880, 3, 2, 2, 2, 134, 888, 3, 2, 2, 2, 136, 891, 3, 2, 2, 2,
-	138, 898, 3, 2, 2, 2, 140, 913, 3, 2, 2, 2, 142, 927, 3, 2, 2, 2, 144,
-	931, 3, 2, 2, 2, 146, 936, 3, 2, 2, 2, 148, 944, 3, 2, 2, 2, 150, 946,
-	3, 2, 2, 2, 152, 950, 3, 2, 2, 2, 154, 958, 3, 2, 2, 2, 156, 960, 3, 2,
-	2, 2, 158, 968, 3, 2, 2, 2, 160, 970, 3, 2, 2, 2, 162, 985, 3, 2, 2, 2,
-	164, 1085, 3, 2, 2, 2, 166, 1098, 3, 2, 2, 2, 168, 1117, 3, 2, 2, 2, 170,
-	1119, 3, 2, 2, 2, 172, 1152, 3, 2, 2, 2, 174, 1172, 3, 2, 2, 2, 176, 1176,
-	3, 2, 2, 2, 178, 1180, 3, 2, 2, 2, 180, 1182, 3, 2, 2, 2, 182, 1195, 3,
-	2, 2, 2, 184, 1197, 3, 2, 2, 2, 186, 1201, 3, 2, 2, 2, 188, 1204, 3, 2,
-	2, 2, 190, 1211, 3, 2, 2, 2, 192, 1216, 3, 2, 2, 2, 194, 1224, 3, 2, 2,
-	2, 196, 1231, 3, 2, 2, 2, 198, 1233, 3, 2, 2, 2, 200, 1239, 3, 2, 2, 2,
-	202, 1243, 3, 2, 2, 2, 204, 1266, 3, 2, 2, 2, 206, 1269, 3, 2, 2, 2, 208,
-	1294, 3, 2, 2, 2, 210, 1296, 3, 2, 2, 2, 212, 1303, 3, 2, 2, 2, 214, 1328,
-	3, 2, 2, 2, 216, 1330, 3, 2, 2, 2, 218, 1332, 3, 2, 2, 2, 220, 1335, 3,
-	2, 2, 2, 222, 1357, 3, 2, 2, 2, 224, 1359, 3, 2, 2, 2, 226, 1364, 3, 2,
-	2, 2, 228, 1381, 3, 2, 2, 2, 230, 1390, 3, 2, 2, 2, 232, 1392, 3, 2, 2,
-	2, 234, 1397, 3, 2, 2, 2, 236, 1399, 3, 2, 2, 2, 238, 1413, 3, 2, 2, 2,
-	240, 1415, 3, 2, 2, 2, 242, 1420, 3, 2, 2, 2, 244, 1426, 3, 2, 2, 2, 246,
-	1430, 3, 2, 2, 2, 248, 1444, 3, 2, 2, 2, 250, 1479, 3, 2, 2, 2, 252, 1481,
-	3, 2, 2, 2, 254, 255, 5, 4, 3, 2, 255, 256, 7, 2, 2, 3, 256, 3, 3, 2, 2,
-	2, 257, 259, 5, 14, 8, 2, 258, 257, 3, 2, 2, 2, 259, 262, 3, 2, 2, 2, 260,
-	258, 3, 2, 2, 2, 260, 261, 3, 2, 2, 2, 261, 263, 3, 2, 2, 2, 262, 260,
-	3, 2, 2, 2, 263, 281, 5, 18, 10, 2, 264, 266, 5, 14, 8, 2, 265, 264, 3,
-	2, 2, 2, 266, 269, 3, 2, 2, 2, 267, 265, 3, 2, 2, 2, 267, 268, 3, 2, 2,
-	2, 268, 270, 3, 2, 2, 2, 269, 267, 3, 2, 2, 2, 270, 281, 5, 20, 11, 2,
-	271, 273, 5, 14, 8, 2, 272, 271, 3, 2, 2, 2, 273, 276, 3, 2, 2, 2, 274,
-	272, 3, 2, 2, 2, 274, 275, 3, 2, 2, 2, 275, 277, 3, 2, 2, 2, 276, 274,
-	3, 2, 2, 2, 277, 281, 5, 28, 15, 2, 278, 281, 5, 6, 4, 2, 279, 281, 7,
-	118, 2, 2, 280, 260, 3, 2, 2, 2, 280, 267, 3, 2, 2, 2, 280, 274, 3, 2,
-	2, 2, 280, 278, 3, 2, 2, 2, 280, 279, 3, 2, 2, 2, 281, 5, 3, 2, 2, 2, 282,
-	283, 7, 101, 2, 2, 283, 284, 5, 250, 126, 2, 284, 285, 7, 102, 2, 2, 285,
-	286, 5, 250, 126, 2, 286, 287, 7, 112, 2, 2, 287, 288, 5, 8, 5, 2, 288,
-	289, 7, 113, 2, 2, 289, 290, 5, 114, 58, 2, 290, 7, 3, 2, 2, 2, 291, 296,
-	5, 10, 6, 2, 292, 293, 7, 119, 2, 2, 293, 295, 5, 10, 6, 2, 294, 292, 3,
-	2, 2, 2, 295, 298, 3, 2, 2, 2, 296, 294, 3, 2, 2, 2, 296, 297, 3, 2, 2,
-	2, 297, 9, 3, 2, 2, 2, 298, 296, 3, 2, 2, 2, 299, 300, 9, 2, 2, 2, 300,
-	301, 9, 3, 2, 2, 301, 11, 3, 2, 2, 2, 302, 305, 5, 14, 8, 2, 303, 305,
-	7, 47, 2, 2, 304, 302, 3, 2, 2, 2, 304, 303, 3, 2, 2, 2, 305, 13, 3, 2,
-	2, 2, 306, 309, 5, 102, 52, 2, 307, 309, 9, 4, 2, 2, 308, 306, 3, 2, 2,
-	2, 308, 307, 3, 2, 2, 2, 309, 15, 3, 2, 2, 2, 310, 313, 7, 21, 2, 2, 311,
-	313, 5, 102, 52, 2, 312, 310, 3, 2, 2, 2, 312, 311, 3, 2, 2, 2, 313, 17,
-	3, 2, 2, 2, 314, 315, 7, 12, 2, 2, 315, 318, 5, 250, 126, 2, 316, 317,
-	7, 20, 2, 2, 317, 319, 5, 72, 37, 2, 318, 316, 3, 2, 2, 2, 318, 319, 3,
-	2, 2, 2, 319, 322, 3, 2, 2, 2, 320, 321, 7, 27, 2, 2, 321, 323, 5, 30,
-	16, 2, 322, 320, 3, 2, 2, 2, 322, 323, 3, 2, 2, 2, 323, 324, 3, 2, 2, 2,
-	324, 325, 5, 32, 17, 2, 325, 19, 3, 2, 2, 2, 326, 327, 7, 19, 2, 2, 327,
-	330, 5, 250, 126, 2, 328, 329, 7, 27, 2, 2, 329, 331, 5, 30, 16, 2, 330,
-	328, 3, 2, 2, 2, 330, 331, 3, 2, 2, 2, 331, 332, 3, 2, 2, 2, 332, 334,
-	7, 114, 2, 2, 333, 335, 5, 22, 12, 2, 334, 333, 3, 2, 2, 2, 334, 335, 3,
-	2, 2, 2, 335, 337, 3, 2, 2, 2, 336, 338, 7, 119, 2, 2, 337, 336, 3, 2,
-	2, 2, 337, 338, 3, 2, 2, 2, 338, 340, 3, 2, 2, 2, 339, 341, 5, 26, 14,
-	2, 340, 339, 3, 2, 2, 2, 340, 341, 3, 2, 2, 2, 341, 342, 3, 2, 2, 2, 342,
-	343, 7, 115, 2, 2, 343, 21, 3, 2, 2, 2, 344, 349, 5, 24, 13, 2, 345, 346,
-	7, 119, 2, 2, 346, 348, 5, 24, 13, 2, 347, 345, 3, 2, 2, 2, 348, 351, 3,
-	2, 2, 2, 349, 347, 3, 2, 2, 2, 349, 350, 3, 2, 2, 2, 350, 23, 3, 2, 2,
-	2, 351, 349, 3, 2, 2, 2, 352, 354, 5, 102, 52, 2, 353, 352, 3, 2, 2, 2,
-	354, 357, 3, 2, 2, 2, 355, 353, 3, 2, 2, 2, 355, 356, 3, 2, 2, 2, 356,
-	358, 3, 2, 2, 2, 357, 355, 3, 2, 2, 2, 358, 360, 5, 250, 126, 2, 359, 361,
-	5, 198, 100, 2, 360, 359, 3, 2, 2, 2, 360, 361, 3, 2, 2, 2, 361, 363, 3,
-	2, 2, 2, 362, 364, 5, 32, 17, 2, 363, 362, 3, 2, 2, 2, 363, 364, 3, 2,
-	2, 2, 364, 25, 3, 2, 2, 2, 365, 369, 7, 118, 2, 2, 366, 368, 5, 36, 19,
-	2, 367, 366, 3, 2, 2, 2, 368, 371, 3, 2, 2, 2, 369, 367, 3, 2, 2, 2, 369,
-	370, 3, 2, 2, 2, 370, 27, 3, 2, 2, 2, 371, 369, 3, 2, 2, 2, 372, 373, 7,
-	32, 2, 2, 373, 374, 5, 250, 126, 2, 374, 375, 5, 34, 18, 2, 375, 29, 3,
-	2, 2, 2, 376, 381, 5, 72, 37, 2, 377, 378, 7, 119, 2, 2, 378, 380, 5, 72,
-	37, 2, 379, 377, 3, 2, 2, 2, 380, 383, 3, 2, 2, 2, 381, 379, 3, 2, 2, 2,
-	381, 382, 3, 2, 2, 2, 382, 31, 3, 2, 2, 2, 383, 381, 3, 2, 2, 2, 384, 388,
-	7, 114, 2, 2, 385, 387, 5, 36, 19, 2, 386, 385, 3, 2, 2, 2, 387, 390, 3,
-	2, 2, 2, 388, 386, 3, 2, 2, 2, 388, 389, 3, 2, 2, 2, 389, 391, 3, 2, 2,
-	2, 390, 388, 3, 2, 2, 2, 391, 392, 7, 115, 2, 2, 392, 33, 3, 2, 2, 2, 393,
-	397, 7, 114, 2, 2, 394, 396, 5, 50, 26, 2, 395, 394, 3, 2, 2, 2, 396, 399,
-	3, 2, 2, 2, 397, 395, 3, 2, 2, 2, 397, 398, 3, 2, 2, 2, 398, 400, 3, 2,
-	2, 2, 399, 397, 3, 2, 2, 2, 400, 401, 7, 115, 2, 2, 401, 35, 3, 2, 2, 2,
-	402, 415, 7, 118, 2, 2, 403, 405, 7, 41, 2, 2, 404, 403, 3, 2, 2, 2, 404,
-	405, 3, 2, 2, 2, 405, 406, 3, 2, 2, 2, 406, 415, 5, 114, 58, 2, 407, 409,
-	5, 12, 7, 2, 408, 407, 3, 2, 2, 2, 409, 412, 3, 2, 2, 2, 410, 408, 3, 2,
-	2, 2, 410, 411, 3, 2, 2, 2, 411, 413, 3, 2, 2, 2, 412, 410, 3, 2, 2, 2,
-	413, 415, 5, 38, 20, 2, 414, 402, 3, 2, 2, 2, 414, 404, 3, 2, 2, 2, 414,
-	410, 3, 2, 2, 2, 415, 37, 3, 2, 2, 2, 416, 424, 5, 40, 21, 2, 417, 424,
-	5, 44, 23, 2, 418, 424, 5, 42, 22, 2, 419, 424, 5, 28, 15, 2, 420, 424,
-	5, 18, 10, 2, 421, 424, 5, 20, 11, 2, 422, 424, 5, 46, 24, 2, 423, 416,
-	3, 2, 2, 2, 423, 417, 3, 2, 2, 2, 423, 418, 3, 2, 2, 2, 423, 419, 3, 2,
-	2, 2, 423, 420, 3, 2, 2, 2, 423, 421, 3, 2, 2, 2, 423, 422, 3, 2, 2, 2,
-	424, 39, 3, 2, 2, 2, 425, 427, 7, 4, 2, 2, 426, 425, 3, 2, 2, 2, 426, 427,
-	3, 2, 2, 2, 427, 430, 3, 2, 2, 2, 428, 431, 5, 72, 37, 2, 429, 431, 7,
-	49, 2, 2, 430, 428, 3, 2, 2, 2, 430, 429, 3, 2, 2, 2, 431, 432, 3, 2, 2,
-	2, 432, 433, 5, 250, 126, 2, 433, 438, 5, 86, 44, 2, 434, 435, 7, 116,
-	2, 2, 435, 437, 7, 117, 2, 2, 436, 434, 3, 2, 2, 2, 437, 440, 3, 2, 2,
-	2, 438, 436, 3, 2, 2, 2, 438, 439, 3, 2, 2, 2, 439, 443, 3, 2, 2, 2, 440,
-	438, 3, 2, 2, 2, 441, 442, 7, 46, 2, 2, 442, 444, 5, 84, 43, 2, 443, 441,
-	3, 2, 2, 2, 443, 444, 3, 2, 2, 2, 444, 447, 3, 2, 2, 2, 445, 448, 5, 94,
-	48, 2, 446, 448, 7, 118, 2, 2, 447, 445, 3, 2, 2, 2, 447, 446, 3, 2, 2,
-	2, 448, 41, 3, 2, 2, 2, 449, 450, 5, 250, 126, 2, 450, 453, 5, 86, 44,
-	2, 451, 452, 7, 46, 2, 2, 452, 454, 5, 84, 43, 2, 453, 451, 3, 2, 2, 2,
-	453, 454, 3, 2, 2, 2, 454, 455, 3, 2, 2, 2, 455, 456, 5, 96, 49, 2, 456,
-	43, 3, 2, 2, 2, 457, 458, 5, 72, 37, 2, 458, 459, 5, 60, 31, 2, 459, 460,
-	7, 118, 2, 2, 460, 45, 3, 2, 2, 2, 461, 462, 5, 72, 37, 2, 462, 463, 5,
-	64, 33, 2, 463, 464, 5, 48, 25, 2, 464, 47, 3, 2, 2, 2, 465, 466, 7, 114,
-	2, 2, 466, 468, 5, 124, 63, 2, 467, 469, 5, 124, 63, 2, 468, 467, 3, 2,
-	2, 2, 468, 469, 3, 2, 2, 2, 469, 470, 3, 2, 2, 2, 470, 471, 7, 115, 2,
-	2, 471, 49, 3, 2, 2, 2, 472, 474, 5, 12, 7, 2, 473, 472, 3, 2, 2, 2, 474,
-	477, 3, 2, 2, 2, 475, 473, 3, 2, 2, 2, 475, 476, 3, 2, 2, 2, 476, 478,
-	3, 2, 2, 2, 477, 475, 3, 2, 2, 2, 478, 481, 5, 52, 27, 2, 479, 481, 7,
-	118, 2, 2, 480, 475, 3, 2, 2, 2, 480, 479, 3, 2, 2, 2, 481, 51, 3, 2, 2,
-	2, 482, 488, 5, 54, 28, 2, 483, 488, 5, 58, 30, 2, 484, 488, 5, 28, 15,
-	2, 485, 488, 5, 18, 10, 2, 486, 488, 5, 20, 11, 2, 487, 482, 3, 2, 2, 2,
-	487, 483, 3, 2, 2, 2, 487, 484, 3, 2, 2, 2, 487, 485, 3, 2, 2, 2, 487,
-	486, 3, 2, 2, 2, 488, 53, 3, 2, 2, 2, 489, 490, 5, 72, 37, 2, 490, 495,
-	5, 56, 29, 2, 491, 492, 7, 119, 2, 2, 492, 494, 5, 56, 29, 2, 493, 491,
-	3, 2, 2, 2, 494, 497, 3, 2, 2, 2, 495, 493, 3, 2, 2, 2, 495, 496, 3, 2,
-	2, 2, 496, 498, 3, 2, 2, 2, 497, 495, 3, 2, 2, 2, 498, 499, 7, 118, 2,
-	2, 499, 55, 3, 2, 2, 2, 500, 505, 5, 250, 126, 2, 501, 502, 7, 116, 2,
-	2, 502, 504, 7, 117, 2, 2, 503, 501, 3, 2, 2, 2, 504, 507, 3, 2, 2, 2,
-	505, 503, 3, 2, 2, 2, 505, 506, 3, 2, 2, 2, 506, 508, 3, 2, 2, 2, 507,
-	505, 3, 2, 2, 2, 508, 509, 7, 121, 2, 2, 509, 510, 5, 66, 34, 2, 510, 57,
-	3, 2, 2, 2, 511, 514, 5, 72, 37, 2, 512, 514, 7, 49, 2, 2, 513, 511, 3,
-	2, 2, 2, 513, 512, 3, 2, 2, 2, 514, 515, 3, 2, 2, 2, 515, 516, 5, 250,
-	126, 2, 516, 521, 5, 86, 44, 2, 517, 518, 7, 116, 2, 2, 518, 520, 7, 117,
-	2, 2, 519, 517, 3, 2, 2, 2, 520, 523, 3, 2, 2, 2, 521, 519, 3, 2, 2, 2,
-	521, 522, 3, 2, 2, 2, 522, 526, 3, 2, 2, 2, 523, 521, 3, 2, 2, 2, 524,
-	525, 7, 46, 2, 2, 525, 527, 5, 84, 43, 2, 526, 524, 3, 2, 2, 2, 526, 527,
-	3, 2, 2, 2, 527, 528, 3, 2, 2, 2, 528, 529, 7, 118, 2, 2, 529, 59, 3, 2,
-	2, 2, 530, 535, 5, 62, 32, 2, 531, 532, 7, 119, 2, 2, 532, 534, 5, 62,
-	32, 2, 533, 531, 3, 2, 2, 2, 534, 537, 3, 2, 2, 2, 535, 533, 3, 2, 2, 2,
-	535, 536, 3, 2, 2, 2, 536, 61, 3, 2, 2, 2, 537, 535, 3, 2, 2, 2, 538, 541,
-	5, 64, 33, 2, 539, 540, 7, 121, 2, 2, 540, 542, 5, 66, 34, 2, 541, 539,
-	3, 2, 2, 2, 541, 542, 3, 2, 2, 2, 542, 63, 3, 2, 2, 2, 543, 548, 5, 250,
-	126, 2, 544, 545, 7, 116, 2, 2, 545, 547, 7, 117, 2, 2, 546, 544, 3, 2,
-	2, 2, 547, 550, 3, 2, 2, 2, 548, 546, 3, 2, 2, 2, 548, 549, 3, 2, 2, 2,
-	549, 65, 3, 2, 2, 2, 550, 548, 3, 2, 2, 2, 551, 554, 5, 68, 35, 2, 552,
-	554, 5, 162, 82, 2, 553, 551, 3, 2, 2, 2, 553, 552, 3, 2, 2, 2, 554, 67,
-	3, 2, 2, 2, 555, 567, 7, 114, 2, 2, 556, 561, 5, 66, 34, 2, 557, 558, 7,
-	119, 2, 2, 558, 560, 5, 66, 34, 2, 559, 557, 3, 2, 2, 2, 560, 563, 3, 2,
-	2, 2, 561, 559, 3, 2, 2, 2, 561, 562, 3, 2, 2, 2, 562, 565, 3, 2, 2, 2,
-	563, 561, 3, 2, 2, 2, 564, 566, 7, 119, 2, 2, 565, 564, 3, 2, 2, 2, 565,
-	566, 3, 2, 2, 2, 566, 568, 3, 2, 2, 2, 567, 556, 3, 2, 2, 2, 567, 568,
-	3, 2, 2, 2, 568, 569, 3, 2, 2, 2, 569, 570, 7, 115, 2, 2, 570, 69, 3, 2,
-	2, 2, 571, 572, 5, 250, 126, 2, 572, 71, 3, 2, 2, 2, 573, 577, 5, 76, 39,
-	2, 574, 576, 5, 74, 38, 2, 575, 574, 3, 2, 2, 2, 576, 579, 3, 2, 2, 2,
-	577, 575, 3, 2, 2, 2, 577, 578, 3, 2, 2, 2, 578, 588, 3, 2, 2, 2, 579,
-	577, 3, 2, 2, 2, 580, 584, 5, 78, 40, 2, 581, 583, 5, 74, 38, 2, 582, 581,
-	3, 2, 2, 2, 583, 586, 3, 2, 2, 2, 584, 582, 3, 2, 2, 2, 584, 585, 3, 2,
-	2, 2, 585, 588, 3, 2, 2, 2, 586, 584, 3, 2, 2, 2, 587, 573, 3, 2, 2, 2,
-	587, 580, 3, 2, 2, 2, 588, 73, 3, 2, 2, 2, 589, 590, 7, 116, 2, 2, 590,
-	591, 7, 117, 2, 2, 591, 75, 3, 2, 2, 2, 592, 594, 5, 252, 127, 2, 593,
-	595, 5, 80, 41, 2, 594, 593, 3, 2, 2, 2, 594, 595, 3, 2, 2, 2, 595, 603,
-	3, 2, 2, 2, 596, 597, 7, 120, 2, 2, 597, 599, 5, 252, 127, 2, 598, 600,
-	5, 80, 41, 2, 599, 598, 3, 2, 2, 2, 599, 600, 3, 2, 2, 2, 600, 602, 3,
-	2, 2, 2, 601, 596, 3, 2, 2, 2, 602, 605, 3, 2, 2, 2, 603, 601, 3, 2, 2,
-	2, 603, 604, 3, 2, 2, 2, 604, 609, 3, 2, 2, 2, 605, 603, 3, 2, 2, 2, 606,
-	607, 7, 6, 2, 2, 607, 609, 5, 80, 41, 2, 608, 592, 3, 2, 2, 2, 608, 606,
-	3, 2, 2, 2, 609, 77, 3, 2, 2, 2, 610, 611, 9, 5, 2, 2, 611, 79, 3, 2, 2,
-	2, 612, 613, 7, 123, 2, 2, 613, 618, 5, 82, 42, 2, 614, 615, 7, 119, 2,
-	2, 615, 617, 5, 82, 42, 2, 616, 614, 3, 2, 2, 2, 617, 620, 3, 2, 2, 2,
-	618, 616, 3, 2, 2, 2, 618, 619, 3, 2, 2, 2, 619, 621, 3, 2, 2, 2, 620,
-	618, 3, 2, 2, 2, 621, 622, 7, 122, 2, 2, 622, 81, 3, 2, 2, 2, 623, 630,
-	5, 72, 37, 2, 624, 627, 7, 126, 2, 2, 625, 626, 9, 6, 2, 2, 626, 628, 5,
-	72, 37, 2, 627, 625, 3, 2, 2, 2, 627, 628, 3, 2, 2, 2, 628, 630, 3, 2,
-	2, 2, 629, 623, 3, 2, 2, 2, 629, 624, 3, 2, 2, 2, 630, 83, 3, 2, 2, 2,
-	631, 636, 5, 98, 50, 2, 632, 633, 7, 119, 2, 2, 633, 635, 5, 98, 50, 2,
-	634, 632, 3, 2, 2, 2, 635, 638, 3, 2, 2, 2, 636, 634, 3, 2, 2, 2, 636,
-	637, 3, 2, 2, 2, 637, 85, 3, 2, 2, 2, 638, 636, 3, 2, 2, 2, 639, 641, 7,
-	112, 2, 2, 640, 642, 5, 88, 45, 2, 641, 640, 3, 2, 2, 2, 641, 642, 3, 2,
-	2, 2, 642, 643, 3, 2, 2, 2, 643, 644, 7, 113, 2, 2, 644, 87, 3, 2, 2, 2,
-	645, 650, 5, 90, 46, 2, 646, 647, 7, 119, 2, 2, 647, 649, 5, 90, 46, 2,
-	648, 646, 3, 2, 2, 2, 649, 652, 3, 2, 2, 2, 650, 648, 3, 2, 2, 2, 650,
-	651, 3, 2, 2, 2, 651, 655, 3, 2, 2, 2, 652, 650, 3, 2, 2, 2, 653, 654,
-	7, 119, 2, 2, 654, 656, 5, 92, 47, 2, 655, 653, 3, 2, 2, 2, 655, 656, 3,
-	2, 2, 2, 656, 659, 3, 2, 2, 2, 657, 659, 5, 92, 47, 2, 658, 645, 3, 2,
-	2, 2, 658, 657, 3, 2, 2, 2, 659, 89, 3, 2, 2, 2, 660, 662, 5, 16, 9, 2,
-	661, 660, 3, 2, 2, 2, 662, 665, 3, 2, 2, 2, 663, 661, 3, 2, 2, 2, 663,
-	664, 3, 2, 2, 2, 664, 666, 3, 2, 2, 2, 665, 663, 3, 2, 2, 2, 666, 667,
-	5, 72, 37, 2, 667, 668, 5, 64, 33, 2, 668, 91, 3, 2, 2, 2, 669, 671, 5,
-	16, 9, 2, 670, 669, 3, 2, 2, 2, 671, 674, 3, 2, 2, 2, 672, 670, 3, 2, 2,
-	2, 672, 673, 3, 2, 2, 2, 673, 675, 3, 2, 2, 2, 674, 672, 3, 2, 2, 2, 675,
-	676, 5, 72, 37, 2, 676, 677, 7, 159, 2, 2, 677, 678, 5, 64, 33, 2, 678,
-	93, 3, 2, 2, 2, 679, 680, 5, 114, 58, 2, 680, 95, 3, 2, 2, 2, 681, 682,
-	5, 114, 58, 2, 682, 97, 3, 2, 2, 2, 683, 688, 5, 250, 126, 2, 684, 685,
-	7, 120, 2, 2, 685, 687, 5, 250, 126, 2, 686, 684, 3, 2, 2, 2, 687, 690,
-	3, 2, 2, 2, 688, 686, 3, 2, 2, 2, 688, 689, 3, 2, 2, 2, 689, 99, 3, 2,
-	2, 2, 690, 688, 3, 2, 2, 2, 691, 692, 9, 7, 2, 2, 692, 101, 3, 2, 2, 2,
-	693, 694, 7, 158, 2, 2, 694, 701, 5, 104, 53, 2, 695, 698, 7, 112, 2, 2,
-	696, 699, 5, 106, 54, 2, 697, 699, 5, 110, 56, 2, 698, 696, 3, 2, 2, 2,
-	698, 697, 3, 2, 2, 2, 698, 699, 3, 2, 2, 2, 699, 700, 3, 2, 2, 2, 700,
-	702, 7, 113, 2, 2, 701, 695, 3, 2, 2, 2, 701, 702, 3, 2, 2, 2, 702, 103,
-	3, 2, 2, 2, 703, 704, 5, 98, 50, 2, 704, 105, 3, 2, 2, 2, 705, 709, 5,
-	108, 55, 2, 706, 708, 5, 108, 55, 2, 707, 706, 3, 2, 2, 2, 708, 711, 3,
-	2, 2, 2, 709, 707, 3, 2, 2, 2, 709, 710, 3, 2, 2, 2, 710, 107, 3, 2, 2,
-	2, 711, 709, 3, 2, 2, 2, 712, 713, 5, 250, 126, 2, 713, 714, 7, 121, 2,
-	2, 714, 715, 5, 110, 56, 2, 715, 109, 3, 2, 2, 2, 716, 720, 5, 162, 82,
-	2, 717, 720, 5, 102, 52, 2, 718, 720, 5, 112, 57, 2, 719, 716, 3, 2, 2,
-	2, 719, 717, 3, 2, 2, 2, 719, 718, 3, 2, 2, 2, 720, 111, 3, 2, 2, 2, 721,
-	730, 7, 114, 2, 2, 722, 727, 5, 110, 56, 2, 723, 724, 7, 119, 2, 2, 724,
-	726, 5, 110, 56, 2, 725, 723, 3, 2, 2, 2, 726, 729, 3, 2, 2, 2, 727, 725,
-	3, 2, 2, 2, 727, 728, 3, 2, 2, 2, 728, 731, 3, 2, 2, 2, 729, 727, 3, 2,
-	2, 2, 730, 722, 3, 2, 2, 2, 730, 731, 3, 2, 2, 2, 731, 733, 3, 2, 2, 2,
-	732, 734, 7, 119, 2, 2, 733, 732, 3, 2, 2, 2, 733, 734, 3, 2, 2, 2, 734,
-	735, 3, 2, 2, 2, 735, 736, 7, 115, 2, 2, 736, 113, 3, 2, 2, 2, 737, 741,
-	7, 114, 2, 2, 738, 740, 5, 116, 59, 2, 739, 738, 3, 2, 2, 2, 740, 743,
-	3, 2, 2, 2, 741, 739, 3, 2, 2, 2, 741, 742, 3, 2, 2, 2, 742, 744, 3, 2,
-	2, 2, 743, 741, 3, 2, 2, 2, 744, 745, 7, 115, 2, 2, 745, 115, 3, 2, 2,
-	2, 746, 750, 5, 118, 60, 2, 747, 750, 5, 122, 62, 2, 748, 750, 5, 4, 3,
-	2, 749, 746, 3, 2, 2, 2, 749, 747, 3, 2, 2, 2, 749, 748, 3, 2, 2, 2, 750,
-	117, 3, 2, 2, 2, 751, 752, 5, 120, 61, 2, 752, 753, 7, 118, 2, 2, 753,
-	119, 3, 2, 2, 2, 754, 756, 5, 16, 9, 2, 755, 754, 3, 2, 2, 2, 756, 759,
-	3, 2, 2, 2, 757, 755, 3, 2, 2, 2, 757, 758, 3, 2, 2, 2, 758, 760, 3, 2,
-	2, 2, 759, 757, 3, 2, 2, 2, 760, 761, 5, 72, 37, 2, 761, 762, 5, 60, 31,
-	2, 762, 121, 3, 2, 2, 2, 763, 846, 5, 114, 58, 2, 764, 765, 7, 25, 2, 2,
-	765, 766, 5, 150, 76, 2, 766, 769, 5, 122, 62, 2, 767, 768, 7, 18, 2, 2,
-	768, 770, 5, 122, 62, 2, 769, 767, 3, 2, 2, 2, 769, 770, 3, 2, 2, 2, 770,
-	846, 3, 2, 2, 2, 771, 772, 7, 52, 2, 2, 772, 773, 7, 102, 2, 2, 773, 774,
-	5, 162, 82, 2, 774, 775, 7, 114, 2, 2, 775, 779, 5, 136, 69, 2, 776, 777,
-	7, 53, 2, 2, 777, 778, 7, 18, 2, 2, 778, 780, 5, 114, 58, 2, 779, 776,
-	3, 2, 2, 2, 779, 780, 3, 2, 2, 2, 780, 781, 3, 2, 2, 2, 781, 782, 7, 115,
-	2, 2, 782, 846, 3, 2, 2, 2, 783, 784, 7, 24, 2, 2, 784, 785, 7, 112, 2,
-	2, 785, 786, 5, 142, 72, 2, 786, 787, 7, 113, 2, 2, 787, 788, 5, 122, 62,
-	2, 788, 846, 3, 2, 2, 2, 789, 790, 7, 51, 2, 2, 790, 791, 5, 150, 76, 2,
-	791, 792, 5, 122, 62, 2, 792, 846, 3, 2, 2, 2, 793, 794, 7, 16, 2, 2, 794,
-	795, 5, 122, 62, 2, 795, 796, 7, 51, 2, 2, 796, 797, 5, 150, 76, 2, 797,
-	846, 3, 2, 2, 2, 798, 799, 7, 48, 2, 2, 799, 809, 5, 114, 58, 2, 800, 802,
-	5, 130, 66, 2, 801, 800, 3, 2, 2, 2, 802, 803, 3, 2, 2, 2, 803, 801, 3,
-	2, 2, 2, 803, 804, 3, 2, 2, 2, 804, 806, 3, 2, 2, 2, 805, 807, 5, 134,
-	68, 2, 806, 805, 3, 2, 2, 2, 806, 807, 3, 2, 2, 2, 807, 810, 3, 2, 2, 2,
-	808, 810, 5, 134, 68, 2, 809, 801, 3, 2, 2, 2, 809, 808, 3, 2, 2, 2, 810,
-	846, 3, 2, 2, 2, 811, 813, 7, 40, 2, 2, 812, 814, 5, 162, 82, 2, 813, 812,
-	3, 2, 2, 2, 813, 814, 3, 2, 2, 2, 814, 815, 3, 2, 2, 2, 815, 846, 7, 118,
-	2, 2, 816, 817, 7, 45, 2, 2, 817, 818, 5, 162, 82, 2, 818, 819, 7, 118,
-	2, 2, 819, 846, 3, 2, 2, 2, 820, 822, 7, 10, 2, 2, 821, 823, 5, 250, 126,
-	2, 822, 821, 3, 2, 2, 2, 822, 823, 3, 2, 2, 2, 823, 824, 3, 2, 2, 2, 824,
-	846, 7, 118, 2, 2, 825, 827, 7, 14, 2, 2, 826, 828, 5, 250, 126, 2, 827,
-	826, 3, 2, 2, 2, 827, 828, 3, 2, 2, 2, 828, 829, 3, 2, 2, 2, 829, 846,
-	7, 118, 2, 2, 830, 846, 7, 118, 2, 2, 831, 832, 5, 154, 78, 2, 832, 833,
-	7, 118, 2, 2, 833, 846, 3, 2, 2, 2, 834, 835, 5, 160, 81, 2, 835, 836,
-	7, 118, 2, 2, 836, 846, 3, 2, 2, 2, 837, 838, 7, 106, 2, 2, 838, 839, 7,
-	120, 2, 2, 839, 840, 7, 105, 2, 2, 840, 841, 7, 112, 2, 2, 841, 842, 5,
-	162, 82, 2, 842, 843, 7, 113, 2, 2, 843, 844, 5, 114, 58, 2, 844, 846,
-	3, 2, 2, 2, 845, 763, 3, 2, 2, 2, 845, 764, 3, 2, 2, 2, 845, 771, 3, 2,
-	2, 2, 845, 783, 3, 2, 2, 2, 845, 789, 3, 2, 2, 2, 845, 793, 3, 2, 2, 2,
-	845, 798, 3, 2, 2, 2, 845, 811, 3, 2, 2, 2, 845, 816, 3, 2, 2, 2, 845,
-	820, 3, 2, 2, 2, 845, 825, 3, 2, 2, 2, 845, 830, 3, 2, 2, 2, 845, 831,
-	3, 2, 2, 2, 845, 834, 3, 2, 2, 2, 845, 837, 3, 2, 2, 2, 846, 123, 3, 2,
-	2, 2, 847, 849, 5, 12, 7, 2, 848, 847, 3, 2, 2, 2, 849, 852, 3, 2, 2, 2,
-	850, 848, 3, 2, 2, 2, 850, 851, 3, 2, 2, 2, 851, 855, 3, 2, 2, 2, 852,
-	850, 3, 2, 2, 2, 853, 856, 5, 126, 64, 2, 854, 856, 5, 128, 65, 2, 855,
-	853, 3, 2, 2, 2, 855, 854, 3, 2, 2, 2, 856, 125, 3, 2, 2, 2, 857, 860,
-	7, 7, 2, 2, 858, 861, 7, 118, 2, 2, 859, 861, 5, 94, 48, 2, 860, 858, 3,
-	2, 2, 2, 860, 859, 3, 2, 2, 2, 861, 127, 3, 2, 2, 2, 862, 865, 7, 6, 2,
-	2, 863, 866, 7, 118, 2, 2, 864, 866, 5, 94, 48, 2, 865, 863, 3, 2, 2, 2,
-	865, 864, 3, 2, 2, 2, 866, 129, 3, 2, 2, 2, 867, 868, 7, 11, 2, 2, 868,
-	872, 7, 112, 2, 2, 869, 871, 5, 16, 9, 2, 870, 869, 3, 2, 2, 2, 871, 874,
-	3, 2, 2, 2, 872, 870, 3, 2, 2, 2, 872, 873, 3, 2, 2, 2, 873, 875, 3, 2,
-	2, 2, 874, 872, 3, 2, 2, 2, 875, 876, 5, 132, 67, 2, 876, 877, 5, 250,
-	126, 2, 877, 878, 7, 113, 2, 2, 878, 879, 5, 114, 58, 2, 879, 131, 3, 2,
-	2, 2, 880, 885, 5, 98, 50, 2, 881, 882, 7, 142, 2, 2, 882, 884, 5, 98,
-	50, 2, 883, 881, 3, 2, 2, 2, 884, 887, 3, 2, 2, 2, 885, 883, 3, 2, 2, 2,
-	885, 886, 3, 2, 2, 2, 886, 133, 3, 2, 2, 2, 887, 885, 3, 2, 2, 2, 888,
-	889, 7, 22, 2, 2, 889, 890, 5, 114, 58, 2, 890, 135, 3, 2, 2, 2, 891, 895,
-	5, 138, 70, 2, 892, 894, 5, 138, 70, 2, 893, 892, 3, 2, 2, 2, 894, 897,
-	3, 2, 2, 2, 895, 893, 3, 2, 2, 2, 895, 896, 3, 2, 2, 2, 896, 137, 3, 2,
-	2, 2, 897, 895, 3, 2, 2, 2, 898, 899, 7, 53, 2, 2, 899, 900, 5, 140, 71,
-	2, 900, 901, 5, 114, 58, 2, 901, 139, 3, 2, 2, 2, 902, 907, 5, 100, 51,
-	2, 903, 904, 7, 119, 2, 2, 904, 906, 5, 100, 51, 2, 905, 903, 3, 2, 2,
-	2, 906, 909, 3, 2, 2, 2, 907, 905, 3, 2, 2, 2, 907, 908, 3, 2, 2, 2, 908,
-	914, 3, 2, 2, 2, 909, 907, 3, 2, 2, 2, 910, 911, 5, 72, 37, 2, 911, 912,
-	5, 250, 126, 2, 912, 914, 3, 2, 2, 2, 913, 902, 3, 2, 2, 2, 913, 910, 3,
-	2, 2, 2, 914, 141, 3, 2, 2, 2, 915, 928, 5, 146, 74, 2, 916, 918, 5, 144,
-	73, 2, 917, 916, 3, 2, 2, 2, 917, 918, 3, 2, 2, 2, 918, 919, 3, 2, 2, 2,
-	919, 921, 7, 118, 2, 2, 920, 922, 5, 162, 82, 2, 921, 920, 3, 2, 2, 2,
-	921, 922, 3, 2, 2, 2, 922, 923, 3, 2, 2, 2, 923, 925, 7, 118, 2, 2, 924,
-	926, 5, 148, 75, 2, 925, 924, 3, 2, 2, 2, 925, 926, 3, 2, 2, 2, 926, 928,
-	3, 2, 2, 2, 927, 915, 3, 2, 2, 2, 927, 917, 3, 2, 2, 2, 928, 143, 3, 2,
-	2, 2, 929, 932, 5, 120, 61, 2, 930, 932, 5, 152, 77, 2, 931, 929, 3, 2,
-	2, 2, 931, 930, 3, 2, 2, 2, 932, 145, 3, 2, 2, 2, 933, 935, 5, 16, 9, 2,
-	934, 933, 3, 2, 2, 2, 935, 938, 3, 2, 2, 2, 936, 934, 3, 2, 2, 2, 936,
-	937, 3, 2, 2, 2, 937, 939, 3, 2, 2, 2, 938, 936, 3, 2, 2, 2, 939, 940,
-	5, 72, 37, 2, 940, 941, 5, 64, 33, 2, 941, 942, 7, 127, 2, 2, 942, 943,
-	5, 162, 82, 2, 943, 147, 3, 2, 2, 2, 944, 945, 5, 152, 77, 2, 945, 149,
-	3, 2, 2, 2, 946, 947, 7, 112, 2, 2, 947, 948, 5, 162, 82, 2, 948, 949,
-	7, 113, 2, 2, 949, 151, 3, 2, 2, 2, 950, 955, 5, 162, 82, 2, 951, 952,
-	7, 119, 2, 2, 952, 954, 5, 162, 82, 2, 953, 951, 3, 2, 2, 2, 954, 957,
-	3, 2, 2, 2, 955, 953, 3, 2, 2, 2, 955, 956, 3, 2, 2, 2, 956, 153, 3, 2,
-	2, 2, 957, 955, 3, 2, 2, 2, 958, 959, 5, 162, 82, 2, 959, 155, 3, 2, 2,
-	2, 960, 961, 5, 162, 82, 2, 961, 157, 3, 2, 2, 2, 962, 963, 9, 3, 2, 2,
-	963, 969, 5, 162, 82, 2, 964, 965, 7, 89, 2, 2, 965, 966, 5, 162, 82, 2,
-	966, 967, 5, 250, 126, 2, 967, 969, 3, 2, 2, 2, 968, 962, 3, 2, 2, 2, 968,
-	964, 3, 2, 2, 2, 969, 159, 3, 2, 2, 2, 970, 971, 5, 158, 80, 2, 971, 161,
-	3, 2, 2, 2, 972, 973, 8, 82, 1, 2, 973, 986, 5, 164, 83, 2, 974, 975, 7,
-	35, 2, 2, 975, 986, 5, 166, 84, 2, 976, 977, 7, 112, 2, 2, 977, 978, 5,
-	72, 37, 2, 978, 979, 7, 113, 2, 2, 979, 980, 5, 162, 82, 19, 980, 986,
-	3, 2, 2, 2, 981, 982, 9, 8, 2, 2, 982, 986, 5, 162, 82, 17, 983, 984, 9,
-	9, 2, 2, 984, 986, 5, 162, 82, 16, 985, 972, 3, 2, 2, 2, 985, 974, 3, 2,
-	2, 2, 985, 976, 3, 2, 2, 2, 985, 981, 3, 2, 2, 2, 985, 983, 3, 2, 2, 2,
-	986, 1058, 3, 2, 2, 2, 987, 988, 12, 15, 2, 2, 988, 989, 9, 10, 2, 2, 989,
-	1057, 5, 162, 82, 16, 990, 991, 12, 14, 2, 2, 991, 992, 9, 11, 2, 2, 992,
-	1057, 5, 162, 82, 15, 993, 1001, 12, 13, 2, 2, 994, 995, 7, 123, 2, 2,
-	995, 1002, 7, 123, 2, 2, 996, 997, 7, 122, 2, 2, 997, 998, 7, 122, 2, 2,
-	998, 1002, 7, 122, 2, 2, 999, 1000, 7, 122, 2, 2, 1000, 1002, 7, 122, 2,
-	2, 1001, 994, 3, 2, 2, 2, 1001, 996, 3, 2, 2, 2, 1001, 999, 3, 2, 2, 2,
-	1002, 1003, 3, 2, 2, 2, 1003, 1057, 5, 162, 82, 14, 1004, 1005, 12, 12,
-	2, 2, 1005, 1006, 9, 12, 2, 2, 1006, 1057, 5, 162, 82, 13, 1007, 1008,
-	12, 10, 2, 2, 1008, 1009, 9, 13, 2, 2, 1009, 1057, 5, 162, 82, 11, 1010,
-	1011, 12, 9, 2, 2, 1011, 1012, 7, 141, 2, 2, 1012, 1057, 5, 162, 82, 10,
-	1013, 1014, 12, 8, 2, 2, 1014, 1015, 7, 143, 2, 2, 1015, 1057, 5, 162,
-	82, 9, 1016, 1017, 12, 7, 2, 2, 1017, 1018, 7, 142, 2, 2, 1018, 1057, 5,
-	162, 82, 8, 1019, 1020, 12, 6, 2, 2, 1020, 1021, 7, 133, 2, 2, 1021, 1057,
-	5, 162, 82, 7, 1022, 1023, 12, 5, 2, 2, 1023, 1024, 7, 134, 2, 2, 1024,
-	1057, 5, 162, 82, 6, 1025, 1026, 12, 4, 2, 2, 1026, 1027, 7, 126, 2, 2,
-	1027, 1028, 5, 162, 82, 2, 1028, 1029, 7, 127, 2, 2, 1029, 1030, 5, 162,
-	82, 5, 1030, 1057, 3, 2, 2, 2, 1031, 1032, 12, 3, 2, 2, 1032, 1033, 9,
-	14, 2, 2, 1033, 1057, 5, 162, 82, 3, 1034, 1035, 12, 24, 2, 2, 1035, 1036,
-	7, 120, 2, 2, 1036, 1057, 5, 250, 126, 2, 1037, 1038, 12, 23, 2, 2, 1038,
-	1039, 7, 120, 2, 2, 1039, 1057, 5, 186, 94, 2, 1040, 1041, 12, 22, 2, 2,
-	1041, 1042, 7, 116, 2, 2, 1042, 1043, 5, 162, 82, 2, 1043, 1044, 7, 117,
-	2, 2, 1044, 1057, 3, 2, 2, 2, 1045, 1046, 12, 21, 2, 2, 1046, 1048, 7,
-	112, 2, 2, 1047, 1049, 5, 152, 77, 2, 1048, 1047, 3, 2, 2, 2, 1048, 1049,
-	3, 2, 2, 2, 1049, 1050, 3, 2, 2, 2, 1050, 1057, 7, 113, 2, 2, 1051, 1052,
-	12, 18, 2, 2, 1052, 1057, 9, 8, 2, 2, 1053, 1054, 12, 11, 2, 2, 1054, 1055,
-	7, 29, 2, 2, 1055, 1057, 5, 72, 37, 2, 1056, 987, 3, 2, 2, 2, 1056, 990,
-	3, 2, 2, 2, 1056, 993, 3, 2, 2, 2, 1056, 1004, 3, 2, 2, 2, 1056, 1007,
-	3, 2, 2, 2, 1056, 1010, 3, 2, 2, 2, 1056, 1013, 3, 2, 2, 2, 1056, 1016,
-	3, 2, 2, 2, 1056, 1019, 3, 2, 2, 2, 1056, 1022, 3, 2, 2, 2, 1056, 1025,
-	3, 2, 2, 2, 1056, 1031, 3, 2, 2, 2, 1056, 1034, 3, 2, 2, 2, 1056, 1037,
-	3, 2, 2, 2, 1056, 1040, 3, 2, 2, 2, 1056, 1045, 3, 2, 2, 2, 1056, 1051,
-	3, 2, 2, 2, 1056, 1053, 3, 2, 2, 2, 1057, 1060, 3, 2, 2, 2, 1058, 1056,
-	3, 2, 2, 2, 1058, 1059, 3, 2, 2, 2, 1059, 163, 3, 2, 2, 2, 1060, 1058,
-	3, 2, 2, 2, 1061, 1062, 7, 112, 2, 2, 1062, 1063, 5, 162, 82, 2, 1063,
-	1064, 7, 113, 2, 2, 1064, 1086, 3, 2, 2, 2, 1065, 1086, 7, 44, 2, 2, 1066,
-	1086, 7, 42, 2, 2, 1067, 1086, 5, 100, 51, 2, 1068, 1086, 5, 250, 126,
-	2, 1069, 1070, 5, 72, 37, 2, 1070, 1071, 7, 120, 2, 2, 1071, 1072, 7, 12,
-	2, 2, 1072, 1086, 3, 2, 2, 2, 1073, 1074, 7, 49, 2, 2, 1074, 1075, 7, 120,
-	2, 2, 1075, 1086, 7, 12, 2, 2, 1076, 1080, 5, 188, 95, 2, 1077, 1081, 5,
-	196, 99, 2, 1078, 1079, 7, 44, 2, 2, 1079, 1081, 5, 198, 100, 2, 1080,
-	1077, 3, 2, 2, 2, 1080, 1078, 3, 2, 2, 2, 1081, 1086, 3, 2, 2, 2, 1082,
-	1086, 5, 200, 101, 2, 1083, 1086, 5, 244, 123, 2, 1084, 1086, 5, 78, 40,
-	2, 1085, 1061, 3, 2, 2, 2, 1085, 1065, 3, 2, 2, 2, 1085, 1066, 3, 2, 2,
-	2, 1085, 1067, 3, 2, 2, 2, 1085, 1068, 3, 2, 2, 2, 1085, 1069, 3, 2, 2,
-	2, 1085, 1073, 3, 2, 2, 2, 1085, 1076, 3, 2, 2, 2, 1085, 1082, 3, 2, 2,
-	2, 1085, 1083, 3, 2, 2, 2, 1085, 1084, 3, 2, 2, 2, 1086, 165, 3, 2, 2,
-	2, 1087, 1088, 5, 188, 95, 2, 1088, 1089, 5, 168, 85, 2, 1089, 1090, 5,
-	184, 93, 2, 1090, 1099, 3, 2, 2, 2, 1091, 1096, 5, 168, 85, 2, 1092, 1097,
-	5, 172, 87, 2, 1093, 1097, 5, 184, 93, 2, 1094, 1097, 5, 174, 88, 2, 1095,
-	1097, 5, 180, 91, 2, 1096, 1092, 3, 2, 2, 2, 1096, 1093, 3, 2, 2, 2, 1096,
-	1094, 3, 2, 2, 2, 1096, 1095, 3, 2, 2, 2, 1097, 1099, 3, 2, 2, 2, 1098,
-	1087, 3, 2, 2, 2, 1098, 1091, 3, 2, 2, 2, 1099, 167, 3, 2, 2, 2, 1100,
-	1102, 5, 250, 126, 2, 1101, 1103, 5, 190, 96, 2, 1102, 1101, 3, 2, 2, 2,
-	1102, 1103, 3, 2, 2, 2, 1103, 1111, 3, 2, 2, 2, 1104, 1105, 7, 120, 2,
-	2, 1105, 1107, 5, 250, 126, 2, 1106, 1108, 5, 190, 96, 2, 1107, 1106, 3,
-	2, 2, 2, 1107, 1108, 3, 2, 2, 2, 1108, 1110, 3, 2, 2, 2, 1109, 1104, 3,
-	2, 2, 2, 1110, 1113, 3, 2, 2, 2, 1111, 1109, 3, 2, 2, 2, 1111, 1112, 3,
-	2, 2, 2, 1112, 1118, 3, 2, 2, 2, 1113, 1111, 3, 2, 2, 2, 1114, 1118, 5,
-	78, 40, 2, 1115, 1116, 7, 6, 2, 2, 1116, 1118, 5, 190, 96, 2, 1117, 1100,
-	3, 2, 2, 2, 1117, 1114, 3, 2, 2, 2, 1117, 1115, 3, 2, 2, 2, 1118, 169,
-	3, 2, 2, 2, 1119, 1121, 5, 250, 126, 2, 1120, 1122, 5, 192, 97, 2, 1121,
-	1120, 3, 2, 2, 2, 1121, 1122, 3, 2, 2, 2, 1122, 1123, 3, 2, 2, 2, 1123,
-	1124, 5, 184, 93, 2, 1124, 171, 3, 2, 2, 2, 1125, 1129, 5, 74, 38, 2, 1126,
-	1128, 5, 74, 38, 2, 1127, 1126, 3, 2, 2, 2, 1128, 1131, 3, 2, 2, 2, 1129,
-	1127, 3, 2, 2, 2, 1129, 1130, 3, 2, 2, 2, 1130, 1132, 3, 2, 2, 2, 1131,
-	1129, 3, 2, 2, 2, 1132, 1133, 5, 68, 35, 2, 1133, 1153, 3, 2, 2, 2, 1134,
-	1135, 7, 116, 2, 2, 1135, 1136, 5, 162, 82, 2, 1136, 1143, 7, 117, 2, 2,
-	1137, 1138, 7, 116, 2, 2, 1138, 1139, 5, 162, 82, 2, 1139, 1140, 7, 117,
-	2, 2, 1140, 1142, 3, 2, 2, 2, 1141, 1137, 3, 2, 2, 2, 1142, 1145, 3, 2,
-	2, 2, 1143, 1141, 3, 2, 2, 2, 1143, 1144, 3, 2, 2, 2, 1144, 1149, 3, 2,
-	2, 2, 1145, 1143, 3, 2, 2, 2, 1146, 1148, 5, 74, 38, 2, 1147, 1146, 3,
-	2, 2, 2, 1148, 1151, 3, 2, 2, 2, 1149, 1147, 3, 2, 2, 2, 1149, 1150, 3,
-	2, 2, 2, 1150, 1153, 3, 2, 2, 2, 1151, 1149, 3, 2, 2, 2, 1152, 1125, 3,
-	2, 2, 2, 1152, 1134, 3, 2, 2, 2, 1153, 173, 3, 2, 2, 2, 1154, 1155, 7,
-	114, 2, 2, 1155, 1173, 7, 115, 2, 2, 1156, 1157, 7, 114, 2, 2, 1157, 1158,
-	5, 176, 89, 2, 1158, 1159, 7, 156, 2, 2, 1159, 1167, 5, 178, 90, 2, 1160,
-	1161, 7, 119, 2, 2, 1161, 1162, 5, 176, 89, 2, 1162, 1163, 7, 156, 2, 2,
-	1163, 1164, 5, 178, 90, 2, 1164, 1166, 3, 2, 2, 2, 1165, 1160, 3, 2, 2,
-	2, 1166, 1169, 3, 2, 2, 2, 1167, 1165, 3, 2, 2, 2, 1167, 1168, 3, 2, 2,
-	2, 1168, 1170, 3, 2, 2, 2, 1169, 1167, 3, 2, 2, 2, 1170, 1171, 7, 115,
-	2, 2, 1171, 1173, 3, 2, 2, 2, 1172, 1154, 3, 2, 2, 2, 1172, 1156, 3, 2,
-	2, 2, 1173, 175, 3, 2, 2, 2, 1174, 1177, 5, 250, 126, 2, 1175, 1177, 5,
-	162, 82, 2, 1176, 1174, 3, 2, 2, 2, 1176, 1175, 3, 2, 2, 2, 1177, 177,
-	3, 2, 2, 2, 1178, 1181, 5, 100, 51, 2, 1179, 1181, 5, 162, 82, 2, 1180,
-	1178, 3, 2, 2, 2, 1180, 1179, 3, 2, 2, 2, 1181, 179, 3, 2, 2, 2, 1182,
-	1183, 7, 114, 2, 2, 1183, 1188, 5, 182, 92, 2, 1184, 1185, 7, 119, 2, 2,
-	1185, 1187, 5, 182, 92, 2, 1186, 1184, 3, 2, 2, 2, 1187, 1190, 3, 2, 2,
-	2, 1188, 1186, 3, 2, 2, 2, 1188, 1189, 3, 2, 2, 2, 1189, 1191, 3, 2, 2,
-	2, 1190, 1188, 3, 2, 2, 2, 1191, 1192, 7, 115, 2, 2, 1192, 181, 3, 2, 2,
-	2, 1193, 1196, 5, 100, 51, 2, 1194, 1196, 5, 162, 82, 2, 1195, 1193, 3,
-	2, 2, 2, 1195, 1194, 3, 2, 2, 2, 1196, 183, 3, 2, 2, 2, 1197, 1199, 5,
-	198, 100, 2, 1198, 1200, 5, 32, 17, 2, 1199, 1198, 3, 2, 2, 2, 1199, 1200,
-	3, 2, 2, 2, 1200, 185, 3, 2, 2, 2, 1201, 1202, 5, 188, 95, 2, 1202, 1203,
-	5, 196, 99, 2, 1203, 187, 3, 2, 2, 2, 1204, 1205, 7, 123, 2, 2, 1205, 1206,
-	5, 30, 16, 2, 1206, 1207, 7, 122, 2, 2, 1207, 189, 3, 2, 2, 2, 1208, 1209,
-	7, 123, 2, 2, 1209, 1212, 7, 122, 2, 2, 1210, 1212, 5, 80, 41, 2, 1211,
-	1208, 3, 2, 2, 2, 1211, 1210, 3, 2, 2, 2, 1212, 191, 3, 2, 2, 2, 1213,
-	1214, 7, 123, 2, 2, 1214, 1217, 7, 122, 2, 2, 1215, 1217, 5, 188, 95, 2,
-	1216, 1213, 3, 2, 2, 2, 1216, 1215, 3, 2, 2, 2, 1217, 193, 3, 2, 2, 2,
-	1218, 1225, 5, 198, 100, 2, 1219, 1220, 7, 120, 2, 2, 1220, 1222, 5, 250,
-	126, 2, 1221, 1223, 5, 198, 100, 2, 1222, 1221, 3, 2, 2, 2, 1222, 1223,
-	3, 2, 2, 2, 1223, 1225, 3, 2, 2, 2, 1224, 1218, 3, 2, 2, 2, 1224, 1219,
-	3, 2, 2, 2, 1225, 195, 3, 2, 2, 2, 1226, 1227, 7, 42, 2, 2, 1227, 1232,
-	5, 194, 98, 2, 1228, 1229, 5, 250, 126, 2, 1229, 1230, 5, 198, 100, 2,
-	1230, 1232, 3, 2, 2, 2, 1231, 1226, 3, 2, 2, 2, 1231, 1228, 3, 2, 2, 2,
-	1232, 197, 3, 2, 2, 2, 1233, 1235, 7, 112, 2, 2, 1234, 1236, 5, 152, 77,
-	2, 1235, 1234, 3, 2, 2, 2, 1235, 1236, 3, 2, 2, 2, 1236, 1237, 3, 2, 2,
-	2, 1237, 1238, 7, 113, 2, 2, 1238, 199, 3, 2, 2, 2, 1239, 1240, 7, 116,
-	2, 2, 1240, 1241, 5, 202, 102, 2, 1241, 1242, 7, 117, 2, 2, 1242, 201,
-	3, 2, 2, 2, 1243, 1244, 5, 204, 103, 2, 1244, 1246, 5, 210, 106, 2, 1245,
-	1247, 5, 218, 110, 2, 1246, 1245, 3, 2, 2, 2, 1246, 1247, 3, 2, 2, 2, 1247,
-	1249, 3, 2, 2, 2, 1248, 1250, 5, 232, 117, 2, 1249, 1248, 3, 2, 2, 2, 1249,
-	1250, 3, 2, 2, 2, 1250, 1252, 3, 2, 2, 2, 1251, 1253, 5, 236, 119, 2, 1252,
-	1251, 3, 2, 2, 2, 1252, 1253, 3, 2, 2, 2, 1253, 1255, 3, 2, 2, 2, 1254,
-	1256, 5, 226, 114, 2, 1255, 1254, 3, 2, 2, 2, 1255, 1256, 3, 2, 2, 2, 1256,
-	1258, 3, 2, 2, 2, 1257, 1259, 5, 224, 113, 2, 1258, 1257, 3, 2, 2, 2, 1258,
-	1259, 3, 2, 2, 2, 1259, 1261, 3, 2, 2, 2, 1260, 1262, 5, 240, 121, 2, 1261,
-	1260, 3, 2, 2, 2, 1261, 1262, 3, 2, 2, 2, 1262, 1264, 3, 2, 2, 2, 1263,
-	1265, 5, 242, 122, 2, 1264, 1263, 3, 2, 2, 2, 1264, 1265, 3, 2, 2, 2, 1265,
-	203, 3, 2, 2, 2, 1266, 1267, 7, 58, 2, 2, 1267, 1268, 5, 206, 104, 2, 1268,
-	205, 3, 2, 2, 2, 1269, 1274, 5, 208, 105, 2, 1270, 1271, 7, 119, 2, 2,
-	1271, 1273, 5, 208, 105, 2, 1272, 1270, 3, 2, 2, 2, 1273, 1276, 3, 2, 2,
-	2, 1274, 1272, 3, 2, 2, 2, 1274, 1275, 3, 2, 2, 2, 1275, 207, 3, 2, 2,
-	2, 1276, 1274, 3, 2, 2, 2, 1277, 1295, 5, 214, 108, 2, 1278, 1295, 5, 216,
-	109, 2, 1279, 1280, 7, 67, 2, 2, 1280, 1286, 5, 214, 108, 2, 1281, 1282,
-	7, 53, 2, 2, 1282, 1283, 5, 250, 126, 2, 1283, 1284, 7, 87, 2, 2, 1284,
-	1285, 5, 206, 104, 2, 1285, 1287, 3, 2, 2, 2, 1286, 1281, 3, 2, 2, 2, 1287,
-	1288, 3, 2, 2, 2, 1288, 1286, 3, 2, 2, 2, 1288, 1289, 3, 2, 2, 2, 1289,
-	1290, 3, 2, 2, 2, 1290, 1291, 7, 18, 2, 2, 1291, 1292, 5, 206, 104, 2,
-	1292, 1293, 7, 74, 2, 2, 1293, 1295, 3, 2, 2, 2, 1294, 1277, 3, 2, 2, 2,
-	1294, 1278, 3, 2, 2, 2, 1294, 1279, 3, 2, 2, 2, 1295, 209, 3, 2, 2, 2,
-	1296, 1297, 7, 59, 2, 2, 1297, 1301, 5, 250, 126, 2, 1298, 1299, 7, 75,
-	2, 2, 1299, 1300, 7, 83, 2, 2, 1300, 1302, 5, 212, 107, 2, 1301, 1298,
-	3, 2, 2, 2, 1301, 1302, 3, 2, 2, 2, 1302, 211, 3, 2, 2, 2, 1303, 1304,
-	3, 2, 2, 2, 1304, 213, 3, 2, 2, 2, 1305, 1306, 5, 250, 126, 2, 1306, 1307,
-	7, 120, 2, 2, 1307, 1309, 3, 2, 2, 2, 1308, 1305, 3, 2, 2, 2, 1309, 1312,
-	3, 2, 2, 2, 1310, 1308, 3, 2, 2, 2, 1310, 1311, 3, 2, 2, 2, 1311, 1313,
-	3, 2, 2, 2, 1312, 1310, 3, 2, 2, 2, 1313, 1329, 5, 250, 126, 2, 1314, 1315,
-	5, 250, 126, 2, 1315, 1324, 7, 112, 2, 2, 1316, 1321, 5, 214, 108, 2, 1317,
-	1318, 7, 119, 2, 2, 1318, 1320, 5, 214, 108, 2, 1319, 1317, 3, 2, 2, 2,
-	1320, 1323, 3, 2, 2, 2, 1321, 1319, 3, 2, 2, 2, 1321, 1322, 3, 2, 2, 2,
-	1322, 1325, 3, 2, 2, 2, 1323, 1321, 3, 2, 2, 2, 1324, 1316, 3, 2, 2, 2,
-	1324, 1325, 3, 2, 2, 2, 1325, 1326, 3, 2, 2, 2, 1326, 1327, 7, 113, 2,
-	2, 1327, 1329, 3, 2, 2, 2, 1328, 1310, 3, 2, 2, 2, 1328, 1314, 3, 2, 2,
-	2, 1329, 215, 3, 2, 2, 2, 1330, 1331, 5, 202, 102, 2, 1331, 217, 3, 2,
-	2, 2, 1332, 1333, 7, 60, 2, 2, 1333, 1334, 5, 220, 111, 2, 1334, 219, 3,
-	2, 2, 2, 1335, 1336, 8, 111, 1, 2, 1336, 1337, 5, 222, 112, 2, 1337, 1343,
-	3, 2, 2, 2, 1338, 1339, 12, 3, 2, 2, 1339, 1340, 9, 15, 2, 2, 1340, 1342,
-	5, 220, 111, 4, 1341, 1338, 3, 2, 2, 2, 1342, 1345, 3, 2, 2, 2, 1343, 1341,
-	3, 2, 2, 2, 1343, 1344, 3, 2, 2, 2, 1344, 221, 3, 2, 2, 2, 1345, 1343,
-	3, 2, 2, 2, 1346, 1348, 7, 95, 2, 2, 1347, 1346, 3, 2, 2, 2, 1347, 1348,
-	3, 2, 2, 2, 1348, 1349, 3, 2, 2, 2, 1349, 1350, 5, 214, 108, 2, 1350, 1351,
-	9, 16, 2, 2, 1351, 1352, 5, 230, 116, 2, 1352, 1358, 3, 2, 2, 2, 1353,
-	1354, 7, 112, 2, 2, 1354, 1355, 5, 220, 111, 2, 1355, 1356, 7, 113, 2,
-	2, 1356, 1358, 3, 2, 2, 2, 1357, 1347, 3, 2, 2, 2, 1357, 1353, 3, 2, 2,
-	2, 1358, 223, 3, 2, 2, 2, 1359, 1362, 7, 61, 2, 2, 1360, 1363, 7, 107,
-	2, 2, 1361, 1363, 5, 228, 115, 2, 1362, 1360, 3, 2, 2, 2, 1362, 1361, 3,
-	2, 2, 2, 1363, 225, 3, 2, 2, 2, 1364, 1365, 7, 62, 2, 2, 1365, 1366, 7,
-	63, 2, 2, 1366, 1371, 5, 214, 108, 2, 1367, 1368, 7, 119, 2, 2, 1368, 1370,
-	5, 214, 108, 2, 1369, 1367, 3, 2, 2, 2, 1370, 1373, 3, 2, 2, 2, 1371, 1369,
-	3, 2, 2, 2, 1371, 1372, 3, 2, 2, 2, 1372, 1375, 3, 2, 2, 2, 1373, 1371,
-	3, 2, 2, 2, 1374, 1376, 9, 17, 2, 2, 1375, 1374, 3, 2, 2, 2, 1375, 1376,
-	3, 2, 2, 2, 1376, 1379, 3, 2, 2, 2, 1377, 1378, 7, 80, 2, 2, 1378, 1380,
-	9, 18, 2, 2, 1379, 1377, 3, 2, 2, 2, 1379, 1380, 3, 2, 2, 2, 1380, 227,
-	3, 2, 2, 2, 1381, 1382, 7, 127, 2, 2, 1382, 1383, 5, 162, 82, 2, 1383,
-	229, 3, 2, 2, 2, 1384, 1391, 5, 100, 51, 2, 1385, 1391, 5, 228, 115, 2,
-	1386, 1387, 5, 250, 126, 2, 1387, 1388, 7, 127, 2, 2, 1388, 1389, 5, 100,
-	51, 2, 1389, 1391, 3, 2, 2, 2, 1390, 1384, 3, 2, 2, 2, 1390, 1385, 3, 2,
-	2, 2, 1390, 1386, 3, 2, 2, 2, 1391, 231, 3, 2, 2, 2, 1392, 1393, 7, 66,
-	2, 2, 1393, 1394, 7, 76, 2, 2, 1394, 1395, 7, 77, 2, 2, 1395, 1396, 5,
-	234, 118, 2, 1396, 233, 3, 2, 2, 2, 1397, 1398, 3, 2, 2, 2, 1398, 235,
-	3, 2, 2, 2, 1399, 1400, 7, 78, 2, 2, 1400, 1401, 7, 63, 2, 2, 1401, 1406,
-	5, 214, 108, 2, 1402, 1403, 7, 119, 2, 2, 1403, 1405, 5, 214, 108, 2, 1404,
-	1402, 3, 2, 2, 2, 1405, 1408, 3, 2, 2, 2, 1406, 1404, 3, 2, 2, 2, 1406,
-	1407, 3, 2, 2, 2, 1407, 1411, 3, 2, 2, 2, 1408, 1406, 3, 2, 2, 2, 1409,
-	1410, 7, 79, 2, 2, 1410, 1412, 5, 238, 120, 2, 1411, 1409, 3, 2, 2, 2,
-	1411, 1412, 3, 2, 2, 2, 1412, 237, 3, 2, 2, 2, 1413, 1414, 5, 220, 111,
-	2, 1414, 239, 3, 2, 2, 2, 1415, 1418, 7, 72, 2, 2, 1416, 1419, 7, 107,
-	2, 2, 1417, 1419, 5, 228, 115, 2, 1418, 1416, 3, 2, 2, 2, 1418, 1417, 3,
-	2, 2, 2, 1419, 241, 3, 2, 2, 2, 1420, 1421, 7, 24, 2, 2, 1421, 1424, 9,
-	19, 2, 2, 1422, 1423, 7, 90, 2, 2, 1423, 1425, 9, 20, 2, 2, 1424, 1422,
-	3, 2, 2, 2, 1424, 1425, 3, 2, 2, 2, 1425, 243, 3, 2, 2, 2, 1426, 1427,
-	7, 116, 2, 2, 1427, 1428, 5, 246, 124, 2, 1428, 1429, 7, 117, 2, 2, 1429,
-	245, 3, 2, 2, 2, 1430, 1431, 7, 96, 2, 2, 1431, 1432, 5, 100, 51, 2, 1432,
-	1433, 7, 73, 2, 2, 1433, 1434, 7, 99, 2, 2, 1434, 1435, 7, 97, 2, 2, 1435,
-	1436, 7, 98, 2, 2, 1436, 1441, 5, 248, 125, 2, 1437, 1438, 7, 119, 2, 2,
-	1438, 1440, 5, 248, 125, 2, 1439, 1437, 3, 2, 2, 2, 1440, 1443, 3, 2, 2,
-	2, 1441, 1439, 3, 2, 2, 2, 1441, 1442, 3, 2, 2, 2, 1442, 247, 3, 2, 2,
-	2, 1443, 1441, 3, 2, 2, 2, 1444, 1455, 7, 157, 2, 2, 1445, 1446, 7, 112,
-	2, 2, 1446, 1451, 7, 157, 2, 2, 1447, 1448, 7, 119, 2, 2, 1448, 1450, 7,
-	157, 2, 2, 1449, 1447, 3, 2, 2, 2, 1450, 1453, 3, 2, 2, 2, 1451, 1449,
-	3, 2, 2, 2, 1451, 1452, 3, 2, 2, 2, 1452, 1454, 3, 2, 2, 2, 1453, 1451,
-	3, 2, 2, 2, 1454, 1456, 7, 113, 2, 2, 1455, 1445, 3, 2, 2, 2, 1455, 1456,
-	3, 2, 2, 2, 1456, 249, 3, 2, 2, 2, 1457, 1480, 7, 157, 2, 2, 1458, 1480,
-	7, 7, 2, 2, 1459, 1480, 7, 6, 2, 2, 1460, 1480, 7, 76, 2, 2, 1461, 1480,
-	7, 78, 2, 2, 1462, 1480, 7, 91, 2, 2, 1463, 1480, 7, 88, 2, 2, 1464, 1480,
-	7, 90, 2, 2, 1465, 1480, 7, 92, 2, 2, 1466, 1480, 7, 89, 2, 2, 1467, 1480,
-	7, 83, 2, 2, 1468, 1480, 7, 77, 2, 2, 1469, 1480, 7, 68, 2, 2, 1470, 1480,
-	7, 72, 2, 2, 1471, 1480, 7, 87, 2, 2, 1472, 1480, 7, 96, 2, 2, 1473, 1480,
-	7, 98, 2, 2, 1474, 1480, 7, 99, 2, 2, 1475, 1480, 7, 97, 2, 2, 1476, 1480,
-	7, 105, 2, 2, 1477, 1480, 7, 106, 2, 2, 1478, 1480, 5, 78, 40, 2, 1479,
-	1457, 3, 2, 2, 2, 1479, 1458, 3, 2, 2, 2, 1479, 1459, 3, 2, 2, 2, 1479,
-	1460, 3, 2, 2, 2, 1479, 1461, 3, 2, 2, 2, 1479, 1462, 3, 2, 2, 2, 1479,
-	1463, 3, 2, 2, 2, 1479, 1464, 3, 2, 2, 2, 1479, 1465, 3, 2, 2, 2, 1479,
-	1466, 3, 2, 2, 2, 1479, 1467, 3, 2, 2, 2, 1479, 1468, 3, 2, 2, 2, 1479,
-	1469, 3, 2, 2, 2, 1479, 1470, 3, 2, 2, 2, 1479, 1471, 3, 2, 2, 2, 1479,
-	1472, 3, 2, 2, 2, 1479, 1473, 3, 2, 2, 2, 1479, 1474, 3, 2, 2, 2, 1479,
-	1475, 3, 2, 2, 2, 1479, 1476, 3, 2, 2, 2, 1479, 1477, 3, 2, 2, 2, 1479,
-	1478, 3, 2, 2, 2, 1480, 251, 3, 2, 2, 2, 1481, 1482, 9, 21, 2, 2, 1482,
-	253, 3, 2, 2, 2, 166, 260, 267, 274, 280, 296, 304, 308, 312, 318, 322,
-	330, 334, 337, 340, 349, 355, 360, 363, 369, 381, 388, 397, 404, 410, 414,
-	423, 426, 430, 438, 443, 447, 453, 468, 475, 480, 487, 495, 505, 513, 521,
-	526, 535, 541, 548, 553, 561, 565, 567, 577, 584, 587, 594, 599, 603, 608,
-	618, 627, 629, 636, 641, 650, 655, 658, 663, 672, 688, 698, 701, 709, 719,
-	727, 730, 733, 741, 749, 757, 769, 779, 803, 806, 809, 813, 822, 827, 845,
-	850, 855, 860, 865, 872, 885, 895, 907, 913, 917, 921, 925, 927, 931, 936,
-	955, 968, 985, 1001, 1048, 1056, 1058, 1080, 1085, 1096, 1098, 1102, 1107,
-	1111, 1117, 1121, 1129, 1143, 1149, 1152, 1167, 1172, 1176, 1180, 1188,
-	1195, 1199, 1211, 1216, 1222, 1224, 1231, 1235, 1246, 1249, 1252, 1255,
-	1258, 1261, 1264, 1274, 1288, 1294, 1301, 1310, 1321, 1324, 1328, 1343,
-	1347, 1357, 1362, 1371, 1375, 1379, 1390, 1406, 1411, 1418, 1424, 1441,
-	1451, 1455, 1479,
+	3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3,
+	83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 5, 83,
+	1063, 10, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 7, 83, 1071, 10,
+	83, 12, 83, 14, 83, 1074, 11, 83, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3,
+	84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84,
+	3, 84, 3, 84, 3, 84, 5, 84, 1095, 10, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3,
+	84, 3, 84, 3, 84, 5, 84, 1104, 10, 84, 5, 84, 1106, 10, 84, 3, 85, 3, 85,
+	3, 85, 3, 85, 3, 85, 3, 85, 3, 85, 3, 85, 3, 85, 5, 85, 1117, 10, 85, 5,
+	85, 1119, 10, 85, 3, 86, 3, 86, 5, 86, 1123, 10, 86, 3, 86, 3, 86, 3, 86,
+	5, 86, 1128, 10, 86, 7, 86, 1130, 10, 86, 12, 86, 14, 86, 1133, 11, 86,
+	3, 86, 3, 86, 3, 86, 5, 86, 1138, 10, 86, 3, 87, 3, 87, 5, 87, 1142, 10,
+	87, 3, 87, 3, 87, 3, 88, 3, 88, 7, 88, 1148, 10, 88, 12, 88, 14, 88, 1151,
+	11, 88, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88,
+	7, 88, 1162, 10, 88, 12, 88, 14, 88, 1165, 11, 88, 3, 88, 7, 88, 1168,
+	10, 88, 12, 88, 14, 88, 1171, 11, 88, 5, 88, 1173, 10, 88, 3, 89, 3, 89,
+	3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 7, 89, 1186,
+	10, 89, 12, 89, 14, 89, 1189, 11, 89, 3, 89, 3, 89, 5, 89, 1193, 10, 89,
+	3, 90, 3, 90, 5, 90, 1197, 10, 90, 3, 91, 3, 91, 5, 91, 1201, 10, 91, 3,
+	92, 3, 92, 3, 92, 3, 92, 7, 92, 1207, 10, 92, 12, 92, 14, 92, 1210, 11,
+	92, 3, 92, 3, 92, 3, 93, 3, 93, 5, 93, 1216, 10, 93, 3, 94, 3, 94, 5, 94,
+	1220, 10, 94, 3, 95, 3, 95, 3, 95, 3, 96, 3, 96, 3, 96, 3, 96, 3, 97, 3,
+	97, 3, 97, 5, 97, 1232, 10, 97, 3, 98, 3, 98, 3, 98, 5, 98, 1237, 10, 98,
+	3, 99, 3, 99, 3, 99, 3, 99, 5, 99, 1243, 10, 99, 5, 99, 1245, 10, 99, 3,
+	100, 3, 100, 3, 100, 3, 100, 3, 100, 5, 100, 1252, 10, 100, 3, 101, 3,
+	101, 5, 101, 1256, 10, 101, 3, 101, 3, 101, 3, 102, 3, 102, 3, 102, 3,
+	102, 3, 103, 3, 103, 3, 103, 5, 103, 1267, 10, 103, 3, 103, 5, 103, 1270,
+	10, 103, 3, 103, 5, 103, 1273, 10, 103, 3, 103, 5, 103, 1276, 10, 103,
+	3, 103, 5, 103, 1279, 10, 103, 3, 103, 5, 103, 1282, 10, 103, 3, 103, 5,
+	103, 1285, 10, 103, 3, 103, 5, 103, 1288, 10, 103, 3, 104, 3, 104, 3, 104,
+	3, 105, 3, 105, 3, 105, 7, 105, 1296, 10, 105, 12, 105, 14, 105, 1299,
+	11, 105, 3, 106, 3, 106, 5, 106, 1303, 10, 106, 3, 106, 3, 106, 3, 106,
+	3, 106, 3, 106, 3, 106, 3, 106, 3, 106, 6, 106, 1313, 10, 106, 13, 106,
+	14, 106, 1314, 3, 106, 3, 106, 3, 106, 3, 106, 5, 106, 1321, 10, 106, 3,
+	107, 3, 107, 3, 107, 3, 107, 3, 107, 5, 107, 1328, 10, 107, 3, 108, 3,
+	108, 3, 109, 3, 109, 3, 109, 7, 109, 1335, 10, 109, 12, 109, 14, 109, 1338,
+	11, 109, 3, 109, 3, 109, 3, 109, 3, 109, 3, 109, 3, 109, 7, 109, 1346,
+	10, 109, 12, 109, 14, 109, 1349, 11, 109, 5, 109, 1351, 10, 109, 3, 109,
+	3, 109, 5, 109, 1355, 10, 109, 3, 110, 3, 110, 3, 110, 3, 110, 3, 111,
+	3, 111, 3, 111, 3, 112, 3, 112, 3, 112, 3, 112, 3, 112, 3, 112, 7, 112,
+	1370, 10, 112, 12, 112, 14, 112, 1373, 11, 112, 3, 113, 5, 113, 1376, 10,
+	113, 3, 113, 3, 113, 3, 113, 3, 113, 3, 113, 3, 113, 3, 113, 3, 113, 5,
+	113, 1386, 10, 113, 3, 114, 3, 114, 3, 114, 3, 114, 3, 114, 3, 114, 3,
+	114, 3, 114, 3, 114, 3, 114, 3, 114, 3, 114, 3, 114, 5, 114, 1401, 10,
+	114, 3, 115, 3, 115, 3, 115, 5, 115, 1406, 10, 115, 3, 116, 3, 116, 3,
+	116, 3, 116, 3, 116, 7, 116, 1413, 10, 116, 12, 116, 14, 116, 1416, 11,
+	116, 3, 117, 3, 117, 5, 117, 1420, 10, 117, 3, 117, 3, 117, 5, 117, 1424,
+	10, 117, 3, 118, 3, 118, 3, 118, 3, 119, 3, 119, 3, 119, 3, 119, 3, 119,
+	3, 119, 3, 119, 3, 119, 3, 119, 5, 119, 1438, 10, 119, 3, 120, 3, 120,
+	3, 120, 3, 120, 7, 120, 1444, 10, 120, 12, 120, 14, 120, 1447, 11, 120,
+	3, 120, 3, 120, 3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 122, 3, 122,
+	3, 123, 3, 123, 3, 123, 3, 123, 3, 123, 7, 123, 1463, 10, 123, 12, 123,
+	14, 123, 1466, 11, 123, 3, 123, 3, 123, 5, 123, 1470, 10, 123, 3, 124,
+	3, 124, 3, 125, 3, 125, 3, 125, 5, 125, 1477, 10, 125, 3, 126, 3, 126,
+	3, 126, 3, 126, 5, 126, 1483, 10, 126, 3, 127, 3, 127, 3, 127, 3, 128,
+	3, 128, 3, 128, 3, 128, 3, 129, 3, 129, 3, 129, 3, 129, 3, 129, 5, 129,
+	1497, 10, 129, 3, 129, 3, 129, 3, 129, 3, 129, 7, 129, 1503, 10, 129, 12,
+	129, 14, 129, 1506, 11, 129, 3, 130, 3, 130, 3, 130, 3, 130, 3, 130, 7,
+	130, 1513, 10, 130, 12, 130, 14, 130, 1516, 11, 130, 3, 130, 5, 130, 1519,
+	10, 130, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131,
+	3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131,
+	3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 5, 131,
+	1546, 10, 131, 3, 132, 3, 132, 3, 132, 2, 4, 164, 222, 133, 2, 4, 6, 8,
+	10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44,
+	46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80,
+	82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112,
+	114, 116, 118, 120, 122, 124, 126, 128, 130, 132, 134, 136, 138, 140, 142,
+	144, 146, 148, 150, 152, 154, 156, 158, 160, 162, 164, 166, 168, 170, 172,
+	174, 176, 178, 180, 182, 184, 186, 188, 190, 192, 194, 196, 198, 200, 202,
+	204, 206, 208, 210, 212, 214, 216, 218, 220, 222, 224, 226, 228, 230, 232,
+	234, 236, 238, 240, 242, 244, 246, 248, 250, 252, 254, 256, 258, 260, 262,
+	2, 22, 3, 2, 106, 107, 3, 2, 90, 94, 9, 2, 4, 5, 8, 8, 21, 21, 37, 39,
+	41, 41, 54, 57, 103, 103, 7, 2, 9, 9, 17, 17, 23, 23, 30, 31, 33, 33, 4,
+	2, 20, 20, 42, 42, 3, 2, 110, 114, 3, 2, 138, 139, 4, 2, 127, 127, 140,
+	141, 4, 2, 142, 143, 147, 147, 3, 2, 140, 141, 4, 2, 125, 126, 133, 134,
+	4, 2, 131, 132, 135, 135, 4, 2, 124, 124, 148, 158, 3, 2, 95, 96, 3, 2,
+	64, 65, 3, 2, 81, 82, 3, 2, 68, 69, 3, 2, 70, 71, 4, 2, 101, 101, 160,
+	160, 11, 2, 6, 7, 68, 68, 72, 72, 76, 78, 83, 83, 87, 89, 98, 102, 109,
+	109, 160, 160, 2, 1688, 2, 264, 3, 2, 2, 2, 4, 290, 3, 2, 2, 2, 6, 292,
+	3, 2, 2, 2, 8, 301, 3, 2, 2, 2, 10, 309, 3, 2, 2, 2, 12, 314, 3, 2, 2,
+	2, 14, 318, 3, 2, 2, 2, 16, 322, 3, 2, 2, 2, 18, 324, 3, 2, 2, 2, 20, 336,
+	3, 2, 2, 2, 22, 354, 3, 2, 2, 2, 24, 365, 3, 2, 2, 2, 26, 375, 3, 2, 2,
+	2, 28, 382, 3, 2, 2, 2, 30, 386, 3, 2, 2, 2, 32, 394, 3, 2, 2, 2, 34, 403,
+	3, 2, 2, 2, 36, 424, 3, 2, 2, 2, 38, 433, 3, 2, 2, 2, 40, 436, 3, 2, 2,
+	2, 42, 459, 3, 2, 2, 2, 44, 467, 3, 2, 2, 2, 46, 471, 3, 2, 2, 2, 48, 475,
+	3, 2, 2, 2, 50, 490, 3, 2, 2, 2, 52, 497, 3, 2, 2, 2, 54, 499, 3, 2, 2,
+	2, 56, 510, 3, 2, 2, 2, 58, 523, 3, 2, 2, 2, 60, 540, 3, 2, 2, 2, 62, 548,
+	3, 2, 2, 2, 64, 553, 3, 2, 2, 2, 66, 563, 3, 2, 2, 2, 68, 565, 3, 2, 2,
+	2, 70, 581, 3, 2, 2, 2, 72, 597, 3, 2, 2, 2, 74, 599, 3, 2, 2, 2, 76, 618,
+	3, 2, 2, 2, 78, 620, 3, 2, 2, 2, 80, 622, 3, 2, 2, 2, 82, 639, 3, 2, 2,
+	2, 84, 641, 3, 2, 2, 2, 86, 649, 3, 2, 2, 2, 88, 668, 3, 2, 2, 2, 90, 673,
+	3, 2, 2, 2, 92, 682, 3, 2, 2, 2, 94, 689, 3, 2, 2, 2, 96, 691, 3, 2, 2,
+	2, 98, 693, 3, 2, 2, 2, 100, 701, 3, 2, 2, 2, 102, 703, 3, 2, 2, 2, 104,
+	713, 3, 2, 2, 2, 106, 715, 3, 2, 2, 2, 108, 722, 3, 2, 2, 2, 110, 729,
+	3, 2, 2, 2, 112, 731, 3, 2, 2, 2, 114, 747, 3, 2, 2, 2, 116, 759, 3, 2,
+	2, 2, 118, 761, 3, 2, 2, 2, 120, 767, 3, 2, 2, 2, 122, 855, 3, 2, 2, 2,
+	124, 860, 3, 2, 2, 2, 126, 867, 3, 2, 2, 2, 128, 872, 3, 2, 2, 2, 130,
+	877, 3, 2, 2, 2, 132, 890, 3, 2, 2, 2, 134, 898, 3, 2, 2, 2, 136, 901,
+	3, 2, 2, 2, 138, 908, 3, 2, 2, 2, 140, 923, 3, 2, 2, 2, 142, 927, 3, 2,
+	2, 2, 144, 941, 3, 2, 2, 2, 146, 945, 3, 2, 2, 2, 148, 950, 3, 2, 2, 2,
+	150, 958, 3, 2, 2, 2, 152, 960, 3, 2, 2, 2, 154, 964, 3, 2, 2, 2, 156,
+	972, 3, 2, 2, 2, 158, 974, 3, 2, 2, 2, 160, 982, 3, 2, 2, 2, 162, 984,
+	3, 2, 2, 2, 164, 999, 3, 2, 2, 2, 166, 1105, 3, 2, 2, 2, 168, 1118, 3,
+	2, 2, 2, 170, 1137, 3, 2, 2, 2, 172, 1139, 3, 2, 2, 2, 174, 1172, 3, 2,
+	2, 2, 176, 1192, 3, 2, 2, 2, 178, 1196, 3, 2, 2, 2, 180, 1200, 3, 2, 2,
+	2, 182, 1202, 3, 2, 2, 2, 184, 1215, 3, 2, 2, 2, 186, 1217, 3, 2, 2, 2,
+	188, 1221, 3, 2, 2, 2, 190, 1224, 3, 2, 2, 2, 192, 1231, 3, 2, 2, 2, 194,
+	1236, 3, 2, 2, 2, 196, 1244, 3, 2, 2, 2, 198, 1251, 3, 2, 2, 2, 200, 1253,
+	3, 2, 2, 2, 202, 1259, 3, 2, 2, 2, 204, 1263, 3, 2, 2, 2, 206, 1289, 3,
+	2, 2, 2, 208, 1292, 3, 2, 2, 2, 210, 1320, 3, 2, 2, 2, 212, 1322, 3, 2,
+	2, 2, 214, 1329, 3, 2, 2, 2, 216, 1354, 3, 2, 2, 2, 218, 1356, 3, 2, 2,
+	2, 220, 1360, 3, 2, 2, 2, 222, 1363, 3, 2, 2, 2, 224, 1385, 3, 2, 2, 2,
+	226, 1400, 3, 2, 2, 2, 228, 1402, 3, 2, 2, 2, 230, 1407, 3, 2, 2, 2, 232,
+	1417, 3, 2, 2, 2, 234, 1425, 3, 2, 2, 2, 236, 1437, 3, 2, 2, 2, 238, 1439,
+	3, 2, 2, 2, 240, 1450, 3, 2, 2, 2, 242, 1455, 3, 2, 2, 2, 244, 1457, 3,
+	2, 2, 2, 246, 1471, 3, 2, 2, 2, 248, 1473, 3, 2, 2, 2, 250, 1478, 3, 2,
+	2, 2, 252, 1484, 3, 2, 2, 2, 254, 1487, 3, 2, 2, 2, 256, 1491, 3, 2, 2,
+	2, 258, 1507, 3, 2, 2, 2, 260, 1545, 3, 2, 2, 2, 262, 1547, 3, 2, 2, 2,
+	264, 265, 5, 4, 3, 2, 265, 266, 7, 2, 2, 3, 266, 3, 3, 2, 2, 2, 267, 269,
+	5, 14, 8, 2, 268, 267, 3, 2, 2, 2, 269, 272, 3, 2, 2, 2, 270, 268, 3, 2,
+	2, 2, 270, 271, 3, 2, 2, 2, 271, 273, 3, 2, 2, 2, 272, 270, 3, 2, 2, 2,
+	273, 291, 5, 18, 10, 2, 274, 276, 5, 14, 8, 2, 275, 274, 3, 2, 2, 2, 276,
+	279, 3, 2, 2, 2, 277, 275, 3, 2, 2, 2, 277, 278, 3, 2, 2, 2, 278, 280,
+	3, 2, 2, 2, 279, 277, 3, 2, 2, 2, 280, 291, 5, 20, 11, 2, 281, 283, 5,
+	14, 8, 2, 282, 281, 3, 2, 2, 2, 283, 286, 3, 2, 2, 2, 284, 282, 3, 2, 2,
+	2, 284, 285, 3, 2, 2, 2, 285, 287, 3, 2, 2, 2, 286, 284, 3, 2, 2, 2, 287,
+	291, 5, 28, 15, 2, 288, 291, 5, 6, 4, 2, 289, 291, 7, 121, 2, 2, 290, 270,
+	3, 2, 2, 2, 290, 277, 3, 2, 2, 2, 290, 284, 3, 2, 2, 2, 290, 288, 3, 2,
+	2, 2, 290, 289, 3, 2, 2, 2, 291, 5, 3, 2, 2, 2, 292, 293, 7, 104, 2, 2,
+	293, 294, 5, 260, 131, 2, 294, 295, 7, 105, 2, 2, 295, 296, 5, 260, 131,
+	2, 296, 297, 7, 115, 2, 2, 297, 298, 5, 8, 5, 2, 298, 299, 7, 116, 2, 2,
+	299, 300, 5, 114, 58, 2, 300, 7, 3, 2, 2, 2, 301, 306, 5, 10, 6, 2, 302,
+	303, 7, 122, 2, 2, 303, 305, 5, 10, 6, 2, 304, 302, 3, 2, 2, 2, 305, 308,
+	3, 2, 2, 2, 306, 304, 3, 2, 2, 2, 306, 307, 3, 2, 2, 2, 307, 9, 3, 2, 2,
+	2, 308, 306, 3, 2, 2, 2, 309, 310, 9, 2, 2, 2, 310, 311, 9, 3, 2, 2, 311,
+	11, 3, 2, 2, 2, 312, 315, 5, 14, 8, 2, 313, 315, 7, 47, 2, 2, 314, 312,
+	3, 2, 2, 2, 314, 313, 3, 2, 2, 2, 315, 13, 3, 2, 2, 2, 316, 319, 5, 102,
+	52, 2, 317, 319, 9, 4, 2, 2, 318, 316, 3, 2, 2, 2, 318, 317, 3, 2, 2, 2,
+	319, 15, 3, 2, 2, 2, 320, 323, 7, 21, 2, 2, 321, 323, 5, 102, 52, 2, 322,
+	320, 3, 2, 2, 2, 322, 321, 3, 2, 2, 2, 323, 17, 3, 2, 2, 2, 324, 325, 7,
+	12, 2, 2, 325, 328, 5, 260, 131, 2, 326, 327, 7, 20, 2, 2, 327, 329, 5,
+	72, 37, 2, 328, 326, 3, 2, 2, 2, 328, 329, 3, 2, 2, 2, 329, 332, 3, 2,
+	2, 2, 330, 331, 7, 27, 2, 2, 331, 333, 5, 30, 16, 2, 332, 330, 3, 2, 2,
+	2, 332, 333, 3, 2, 2, 2, 333, 334, 3, 2, 2, 2, 334, 335, 5, 32, 17, 2,
+	335, 19, 3, 2, 2, 2, 336, 337, 7, 19, 2, 2, 337, 340, 5, 260, 131, 2, 338,
+	339, 7, 27, 2, 2, 339, 341, 5, 30, 16, 2, 340, 338, 3, 2, 2, 2, 340, 341,
+	3, 2, 2, 2, 341, 342, 3, 2, 2, 2, 342, 344, 7, 117, 2, 2, 343, 345, 5,
+	22, 12, 2, 344, 343, 3, 2, 2, 2, 344, 345, 3, 2, 2, 2, 345, 347, 3, 2,
+	2, 2, 346, 348, 7, 122, 2, 2, 347, 346, 3, 2, 2, 2, 347, 348, 3, 2, 2,
+	2, 348, 350, 3, 2, 2, 2, 349, 351, 5, 26, 14, 2, 350, 349, 3, 2, 2, 2,
+	350, 351, 3, 2, 2, 2, 351, 352, 3, 2, 2, 2, 352, 353, 7, 118, 2, 2, 353,
+	21, 3, 2, 2, 2, 354, 359, 5, 24, 13, 2, 355, 356, 7, 122, 2, 2, 356, 358,
+	5, 24, 13, 2, 357, 355, 3, 2, 2, 2, 358, 361, 3, 2, 2, 2, 359, 357, 3,
+	2, 2, 2, 359, 360, 3, 2, 2, 2, 360, 23, 3, 2, 2, 2, 361, 359, 3, 2, 2,
+	2, 362, 364, 5, 102, 52, 2, 363, 362, 3, 2, 2, 2, 364, 367, 3, 2, 2, 2,
+	365, 363, 3, 2, 2, 2, 365, 366, 3, 2, 2, 2, 366, 368, 3, 2, 2, 2, 367,
+	365, 3, 2, 2, 2, 368, 370, 5, 260, 131, 2, 369, 371, 5, 200, 101, 2, 370,
+	369, 3, 2, 2, 2, 370, 371, 3, 2, 2, 2, 371, 373, 3, 2, 2, 2, 372, 374,
+	5, 32, 17, 2, 373, 372, 3, 2, 2, 2, 373, 374, 3, 2, 2, 2, 374, 25, 3, 2,
+	2, 2, 375, 379, 7, 121, 2, 2, 376, 378, 5, 36, 19, 2, 377, 376, 3, 2, 2,
+	2, 378, 381, 3, 2, 2, 2, 379, 377, 3, 2, 2, 2, 379, 380, 3, 2, 2, 2, 380,
+	27, 3, 2, 2, 2, 381, 379, 3, 2, 2, 2, 382, 383, 7, 32, 2, 2, 383, 384,
+	5, 260, 131, 2, 384, 385, 5, 34, 18, 2, 385, 29, 3, 2, 2, 2, 386, 391,
+	5, 72, 37, 2, 387, 388, 7, 122, 2, 2, 388, 390, 5, 72, 37, 2, 389, 387,
+	3, 2, 2, 2, 390, 393, 3, 2, 2, 2, 391, 389, 3, 2, 2, 2, 391, 392, 3, 2,
+	2, 2, 392, 31, 3, 2, 2, 2, 393, 391, 3, 2, 2, 2, 394, 398, 7, 117, 2, 2,
+	395, 397, 5, 36, 19, 2, 396, 395, 3, 2, 2, 2, 397, 400, 3, 2, 2, 2, 398,
+	396, 3, 2, 2, 2, 398, 399, 3, 2, 2, 2, 399, 401, 3, 2, 2, 2, 400, 398,
+	3, 2, 2, 2, 401, 402, 7, 118, 2, 2, 402, 33, 3, 2, 2, 2, 403, 407, 7, 117,
+	2, 2, 404, 406, 5, 50, 26, 2, 405, 404, 3, 2, 2, 2, 406, 409, 3, 2, 2,
+	2, 407, 405, 3, 2, 2, 2, 407, 408, 3, 2, 2, 2, 408, 410, 3, 2, 2, 2, 409,
+	407, 3, 2, 2, 2, 410, 411, 7, 118, 2, 2, 411, 35, 3, 2, 2, 2, 412, 425,
+	7, 121, 2, 2, 413, 415, 7, 41, 2, 2, 414, 413, 3, 2, 2, 2, 414, 415, 3,
+	2, 2, 2, 415, 416, 3, 2, 2, 2, 416, 425, 5, 114, 58, 2, 417, 419, 5, 12,
+	7, 2, 418, 417, 3, 2, 2, 2, 419, 422, 3, 2, 2, 2, 420, 418, 3, 2, 2, 2,
+	420, 421, 3, 2, 2, 2, 421, 423, 3, 2, 2, 2, 422, 420, 3, 2, 2, 2, 423,
+	425, 5, 38, 20, 2, 424, 412, 3, 2, 2, 2, 424, 414, 3, 2, 2, 2, 424, 420,
+	3, 2, 2, 2, 425, 37, 3, 2, 2, 2, 426, 434, 5, 40, 21, 2, 427, 434, 5, 44,
+	23, 2, 428, 434, 5, 42, 22, 2, 429, 434, 5, 28, 15, 2, 430, 434, 5, 18,
+	10, 2, 431, 434, 5, 20, 11, 2, 432, 434, 5, 46, 24, 2, 433, 426, 3, 2,
+	2, 2, 433, 427, 3, 2, 2, 2, 433, 428, 3, 2, 2, 2, 433, 429, 3, 2, 2, 2,
+	433, 430, 3, 2, 2, 2, 433, 431, 3, 2, 2, 2, 433, 432, 3, 2, 2, 2, 434,
+	39, 3, 2, 2, 2, 435, 437, 7, 4, 2, 2, 436, 435, 3, 2, 2, 2, 436, 437, 3,
+	2, 2, 2, 437, 440, 3, 2, 2, 2, 438, 441, 5, 72, 37, 2, 439, 441, 7, 49,
+	2, 2, 440, 438, 3, 2, 2, 2, 440, 439, 3, 2, 2, 2, 441, 442, 3, 2, 2, 2,
+	442, 443, 5, 260, 131, 2, 443, 448, 5, 86, 44, 2, 444, 445, 7, 119, 2,
+	2, 445, 447, 7, 120, 2, 2, 446, 444, 3, 2, 2, 2, 447, 450, 3, 2, 2, 2,
+	448, 446, 3, 2, 2, 2, 448, 449, 3, 2, 2, 2, 449, 453, 3, 2, 2, 2, 450,
+	448, 3, 2, 2, 2, 451, 452, 7, 46, 2, 2, 452, 454, 5, 84, 43, 2, 453, 451,
+	3, 2, 2, 2, 453, 454, 3, 2, 2, 2, 454, 457, 3, 2, 2, 2, 455, 458, 5, 94,
+	48, 2, 456, 458, 7, 121, 2, 2, 457, 455, 3, 2, 2, 2, 457, 456, 3, 2, 2,
+	2, 458, 41, 3, 2, 2, 2, 459, 460, 5, 260, 131, 2, 460, 463, 5, 86, 44,
+	2, 461, 462, 7, 46, 2, 2, 462, 464, 5, 84, 43, 2, 463, 461, 3, 2, 2, 2,
+	463, 464, 3, 2, 2, 2, 464, 465, 3, 2, 2, 2, 465, 466, 5, 96, 49, 2, 466,
+	43, 3, 2, 2, 2, 467, 468, 5, 72, 37, 2, 468, 469, 5, 60, 31, 2, 469, 470,
+	7, 121, 2, 2, 470, 45, 3, 2, 2, 2, 471, 472, 5, 72, 37, 2, 472, 473, 5,
+	64, 33, 2, 473, 474, 5, 48, 25, 2, 474, 47, 3, 2, 2, 2, 475, 476, 7, 117,
+	2, 2, 476, 478, 5, 124, 63, 2, 477, 479, 5, 124, 63, 2, 478, 477, 3, 2,
+	2, 2, 478, 479, 3, 2, 2, 2, 479, 480, 3, 2, 2, 2, 480, 481, 7, 118, 2,
+	2, 481, 49, 3, 2, 2, 2, 482, 484, 5, 12, 7, 2, 483, 482, 3, 2, 2, 2, 484,
+	487, 3, 2, 2, 2, 485, 483, 3, 2, 2, 2, 485, 486, 3, 2, 2, 2, 486, 488,
+	3, 2, 2, 2, 487, 485, 3, 2, 2, 2, 488, 491, 5, 52, 27, 2, 489, 491, 7,
+	121, 2, 2, 490, 485, 3, 2, 2, 2, 490, 489, 3, 2, 2, 2, 491, 51, 3, 2, 2,
+	2, 492, 498, 5, 54, 28, 2, 493, 498, 5, 58, 30, 2, 494, 498, 5, 28, 15,
+	2, 495, 498, 5, 18, 10, 2, 496, 498, 5, 20, 11, 2, 497, 492, 3, 2, 2, 2,
+	497, 493, 3, 2, 2, 2, 497, 494, 3, 2, 2, 2, 497, 495, 3, 2, 2, 2, 497,
+	496, 3, 2, 2, 2, 498, 53, 3, 2, 2, 2, 499, 500, 5, 72, 37, 2, 500, 505,
+	5, 56, 29, 2, 501, 502, 7, 122, 2, 2, 502, 504, 5, 56, 29, 2, 503, 501,
+	3, 2, 2, 2, 504, 507, 3, 2, 2, 2, 505, 503, 3, 2, 2, 2, 505, 506, 3, 2,
+	2, 2, 506, 508, 3, 2, 2, 2, 507, 505, 3, 2, 2, 2, 508, 509, 7, 121, 2,
+	2, 509, 55, 3, 2, 2, 2, 510, 515, 5, 260, 131, 2, 511, 512, 7, 119, 2,
+	2, 512, 514, 7, 120, 2, 2, 513, 511, 3, 2, 2, 2, 514, 517, 3, 2, 2, 2,
+	515, 513, 3, 2, 2, 2, 515, 516, 3, 2, 2, 2, 516, 518, 3, 2, 2, 2, 517,
+	515, 3, 2, 2, 2, 518, 519, 7, 124, 2, 2, 519, 520, 5, 66, 34, 2, 520, 57,
+	3, 2, 2, 2, 521, 524, 5, 72, 37, 2, 522, 524, 7, 49, 2, 2, 523, 521, 3,
+	2, 2, 2, 523, 522, 3, 2, 2, 2, 524, 525, 3, 2, 2, 2, 525, 526, 5, 260,
+	131, 2, 526, 531, 5, 86, 44, 2, 527, 528, 7, 119, 2, 2, 528, 530, 7, 120,
+	2, 2, 529, 527, 3, 2, 2, 2, 530, 533, 3, 2, 2, 2, 531, 529, 3, 2, 2, 2,
+	531, 532, 3, 2, 2, 2, 532, 536, 3, 2, 2, 2, 533, 531, 3, 2, 2, 2, 534,
+	535, 7, 46, 2, 2, 535, 537, 5, 84, 43, 2, 536, 534, 3, 2, 2, 2, 536, 537,
+	3, 2, 2, 2, 537, 538, 3, 2, 2, 2, 538, 539, 7, 121, 2, 2, 539, 59, 3, 2,
+	2, 2, 540, 545, 5, 62, 32, 2, 541, 542, 7, 122, 2, 2, 542, 544, 5, 62,
+	32, 2, 543, 541, 3, 2, 2, 2, 544, 547, 3, 2, 2, 2, 545, 543, 3, 2, 2, 2,
+	545, 546, 3, 2, 2, 2, 546, 61, 3, 2, 2, 2, 547, 545, 3, 2, 2, 2, 548, 551,
+	5, 64, 33, 2, 549, 550, 7, 124, 2, 2, 550, 552, 5, 66, 34, 2, 551, 549,
+	3, 2, 2, 2, 551, 552, 3, 2, 2, 2, 552, 63, 3, 2, 2, 2, 553, 558, 5, 260,
+	131, 2, 554, 555, 7, 119, 2, 2, 555, 557, 7, 120, 2, 2, 556, 554, 3, 2,
+	2, 2, 557, 560, 3, 2, 2, 2, 558, 556, 3, 2, 2, 2, 558, 559, 3, 2, 2, 2,
+	559, 65, 3, 2, 2, 2, 560, 558, 3, 2, 2, 2, 561, 564, 5, 68, 35, 2, 562,
+	564, 5, 164, 83, 2, 563, 561, 3, 2, 2, 2, 563, 562, 3, 2, 2, 2, 564, 67,
+	3, 2, 2, 2, 565, 577, 7, 117, 2, 2, 566, 571, 5, 66, 34, 2, 567, 568, 7,
+	122, 2, 2, 568, 570, 5, 66, 34, 2, 569, 567, 3, 2, 2, 2, 570, 573, 3, 2,
+	2, 2, 571, 569, 3, 2, 2, 2, 571, 572, 3, 2, 2, 2, 572, 575, 3, 2, 2, 2,
+	573, 571, 3, 2, 2, 2, 574, 576, 7, 122, 2, 2, 575, 574, 3, 2, 2, 2, 575,
+	576, 3, 2, 2, 2, 576, 578, 3, 2, 2, 2, 577, 566, 3, 2, 2, 2, 577, 578,
+	3, 2, 2, 2, 578, 579, 3, 2, 2, 2, 579, 580, 7, 118, 2, 2, 580, 69, 3, 2,
+	2, 2, 581, 582, 5, 260, 131, 2, 582, 71, 3, 2, 2, 2, 583, 587, 5, 76, 39,
+	2, 584, 586, 5, 74, 38, 2, 585, 584, 3, 2, 2, 2, 586, 589, 3, 2, 2, 2,
+	587, 585, 3, 2, 2, 2, 587, 588, 3, 2, 2, 2, 588, 598, 3, 2, 2, 2, 589,
+	587, 3, 2, 2, 2, 590, 594, 5, 78, 40, 2, 591, 593, 5, 74, 38, 2, 592, 591,
+	3, 2, 2, 2, 593, 596, 3, 2, 2, 2, 594, 592, 3, 2, 2, 2, 594, 595, 3, 2,
+	2, 2, 595, 598, 3, 2, 2, 2, 596, 594, 3, 2, 2, 2, 597, 583, 3, 2, 2, 2,
+	597, 590, 3, 2, 2, 2, 598, 73, 3, 2, 2, 2, 599, 600, 7, 119, 2, 2, 600,
+	601, 7, 120, 2, 2, 601, 75, 3, 2, 2, 2, 602, 604, 5, 262, 132, 2, 603,
+	605, 5, 80, 41, 2, 604, 603, 3, 2, 2, 2, 604, 605, 3, 2, 2, 2, 605, 613,
+	3, 2, 2, 2, 606, 607, 7, 123, 2, 2, 607, 609, 5, 262, 132, 2, 608, 610,
+	5, 80, 41, 2, 609, 608, 3, 2, 2, 2, 609, 610, 3, 2, 2, 2, 610, 612, 3,
+	2, 2, 2, 611, 606, 3, 2, 2, 2, 612, 615, 3, 2, 2, 2, 613, 611, 3, 2, 2,
+	2, 613, 614, 3, 2, 2, 2, 614, 619, 3, 2, 2, 2, 615, 613, 3, 2, 2, 2, 616,
+	617, 7, 6, 2, 2, 617, 619, 5, 80, 41, 2, 618, 602, 3, 2, 2, 2, 618, 616,
+	3, 2, 2, 2, 619, 77, 3, 2, 2, 2, 620, 621, 9, 5, 2, 2, 621, 79, 3, 2, 2,
+	2, 622, 623, 7, 126, 2, 2, 623, 628, 5, 82, 42, 2, 624, 625, 7, 122, 2,
+	2, 625, 627, 5, 82, 42, 2, 626, 624, 3, 2, 2, 2, 627, 630, 3, 2, 2, 2,
+	628, 626, 3, 2, 2, 2, 628, 629, 3, 2, 2, 2, 629, 631, 3, 2, 2, 2, 630,
+	628, 3, 2, 2, 2, 631, 632, 7, 125, 2, 2, 632, 81, 3, 2, 2, 2, 633, 640,
+	5, 72, 37, 2, 634, 637, 7, 129, 2, 2, 635, 636, 9, 6, 2, 2, 636, 638, 5,
+	72, 37, 2, 637, 635, 3, 2, 2, 2, 637, 638, 3, 2, 2, 2, 638, 640, 3, 2,
+	2, 2, 639, 633, 3, 2, 2, 2, 639, 634, 3, 2, 2, 2, 640, 83, 3, 2, 2, 2,
+	641, 646, 5, 98, 50, 2, 642, 643, 7, 122, 2, 2, 643, 645, 5, 98, 50, 2,
+	644, 642, 3, 2, 2, 2, 645, 648, 3, 2, 2, 2, 646, 644, 3, 2, 2, 2, 646,
+	647, 3, 2, 2, 2, 647, 85, 3, 2, 2, 2, 648, 646, 3, 2, 2, 2, 649, 651, 7,
+	115, 2, 2, 650, 652, 5, 88, 45, 2, 651, 650, 3, 2, 2, 2, 651, 652, 3, 2,
+	2, 2, 652, 653, 3, 2, 2, 2, 653, 654, 7, 116, 2, 2, 654, 87, 3, 2, 2, 2,
+	655, 660, 5, 90, 46, 2, 656, 657, 7, 122, 2, 2, 657, 659, 5, 90, 46, 2,
+	658, 656, 3, 2, 2, 2, 659, 662, 3, 2, 2, 2, 660, 658, 3, 2, 2, 2, 660,
+	661, 3, 2, 2, 2, 661, 665, 3, 2, 2, 2, 662, 660, 3, 2, 2, 2, 663, 664,
+	7, 122, 2, 2, 664, 666, 5, 92, 47, 2, 665, 663, 3, 2, 2, 2, 665, 666, 3,
+	2, 2, 2, 666, 669, 3, 2, 2, 2, 667, 669, 5, 92, 47, 2, 668, 655, 3, 2,
+	2, 2, 668, 667, 3, 2, 2, 2, 669, 89, 3, 2, 2, 2, 670, 672, 5, 16, 9, 2,
+	671, 670, 3, 2, 2, 2, 672, 675, 3, 2, 2, 2, 673, 671, 3, 2, 2, 2, 673,
+	674, 3, 2, 2, 2, 674, 676, 3, 2, 2, 2, 675, 673, 3, 2, 2, 2, 676, 677,
+	5, 72, 37, 2, 677, 678, 5, 64, 33, 2, 678, 91, 3, 2, 2, 2, 679, 681, 5,
+	16, 9, 2, 680, 679, 3, 2, 2, 2, 681, 684, 3, 2, 2, 2, 682, 680, 3, 2, 2,
+	2, 682, 683, 3, 2, 2, 2, 683, 685, 3, 2, 2, 2, 684, 682, 3, 2, 2, 2, 685,
+	686, 5, 72, 37, 2, 686, 687, 7, 162, 2, 2, 687, 688, 5, 64, 33, 2, 688,
+	93, 3, 2, 2, 2, 689, 690, 5, 114, 58, 2, 690, 95, 3, 2, 2, 2, 691, 692,
+	5, 114, 58, 2, 692, 97, 3, 2, 2, 2, 693, 698, 5, 260, 131, 2, 694, 695,
+	7, 123, 2, 2, 695, 697, 5, 260, 131, 2, 696, 694, 3, 2, 2, 2, 697, 700,
+	3, 2, 2, 2, 698, 696, 3, 2, 2, 2, 698, 699, 3, 2, 2, 2, 699, 99, 3, 2,
+	2, 2, 700, 698, 3, 2, 2, 2, 701, 702, 9, 7, 2, 2, 702, 101, 3, 2, 2, 2,
+	703, 704, 7, 161, 2, 2, 704, 711, 5, 104, 53, 2, 705, 708, 7, 115, 2, 2,
+	706, 709, 5, 106, 54, 2, 707, 709, 5, 110, 56, 2, 708, 706, 3, 2, 2, 2,
+	708, 707, 3, 2, 2, 2, 708, 709, 3, 2, 2, 2, 709, 710, 3, 2, 2, 2, 710,
+	712, 7, 116, 2, 2, 711, 705, 3, 2, 2, 2, 711, 712, 3, 2, 2, 2, 712, 103,
+	3, 2, 2, 2, 713, 714, 5, 98, 50, 2, 714, 105, 3, 2, 2, 2, 715, 719, 5,
+	108, 55, 2, 716, 718, 5, 108, 55, 2, 717, 716, 3, 2, 2, 2, 718, 721, 3,
+	2, 2, 2, 719, 717, 3, 2, 2, 2, 719, 720, 3, 2, 2, 2, 720, 107, 3, 2, 2,
+	2, 721, 719, 3, 2, 2, 2, 722, 723, 5, 260, 131, 2, 723, 724, 7, 124, 2,
+	2, 724, 725, 5, 110, 56, 2, 725, 109, 3, 2, 2, 2, 726, 730, 5, 164, 83,
+	2, 727, 730, 5, 102, 52, 2, 728, 730, 5, 112, 57, 2, 729, 726, 3, 2, 2,
+	2, 729, 727, 3, 2, 2, 2, 729, 728, 3, 2, 2, 2, 730, 111, 3, 2, 2, 2, 731,
+	740, 7, 117, 2, 2, 732, 737, 5, 110, 56, 2, 733, 734, 7, 122, 2, 2, 734,
+	736, 5, 110, 56, 2, 735, 733, 3, 2, 2, 2, 736, 739, 3, 2, 2, 2, 737, 735,
+	3, 2, 2, 2, 737, 738, 3, 2, 2, 2, 738, 741, 3, 2, 2, 2, 739, 737, 3, 2,
+	2, 2, 740, 732, 3, 2, 2, 2, 740, 741, 3, 2, 2, 2, 741, 743, 3, 2, 2, 2,
+	742, 744, 7, 122, 2, 2, 743, 742, 3, 2, 2, 2, 743, 744, 3, 2, 2, 2, 744,
+	745, 3, 2, 2, 2, 745, 746, 7, 118, 2, 2, 746, 113, 3, 2, 2, 2, 747, 751,
+	7, 117, 2, 2, 748, 750, 5, 116, 59, 2, 749, 748, 3, 2, 2, 2, 750, 753,
+	3, 2, 2, 2, 751, 749, 3, 2, 2, 2, 751, 752, 3, 2, 2, 2, 752, 754, 3, 2,
+	2, 2, 753, 751, 3, 2, 2, 2, 754, 755, 7, 118, 2, 2, 755, 115, 3, 2, 2,
+	2, 756, 760, 5, 118, 60, 2, 757, 760, 5, 122, 62, 2, 758, 760, 5, 4, 3,
+	2, 759, 756, 3, 2, 2, 2, 759, 757, 3, 2, 2, 2, 759, 758, 3, 2, 2, 2, 760,
+	117, 3, 2, 2, 2, 761, 762, 5, 120, 61, 2, 762, 763, 7, 121, 2, 2, 763,
+	119, 3, 2, 2, 2, 764, 766, 5, 16, 9, 2, 765, 764, 3, 2, 2, 2, 766, 769,
+	3, 2, 2, 2, 767, 765, 3, 2, 2, 2, 767, 768, 3, 2, 2, 2, 768, 770, 3, 2,
+	2, 2, 769, 767, 3, 2, 2, 2, 770, 771, 5, 72, 37, 2, 771, 772, 5, 60, 31,
+	2, 772, 121, 3, 2, 2, 2, 773, 856, 5, 114, 58, 2, 774, 775, 7, 25, 2, 2,
+	775, 776, 5, 152, 77, 2, 776, 779, 5, 122, 62, 2, 777, 778, 7, 18, 2, 2,
+	778, 780, 5, 122, 62, 2, 779, 777, 3, 2, 2, 2, 779, 780, 3, 2, 2, 2, 780,
+	856, 3, 2, 2, 2, 781, 782, 7, 52, 2, 2, 782, 783, 7, 105, 2, 2, 783, 784,
+	5, 164, 83, 2, 784, 785, 7, 117, 2, 2, 785, 789, 5, 136, 69, 2, 786, 787,
+	7, 53, 2, 2, 787, 788, 7, 18, 2, 2, 788, 790, 5, 114, 58, 2, 789, 786,
+	3, 2, 2, 2, 789, 790, 3, 2, 2, 2, 790, 791, 3, 2, 2, 2, 791, 792, 7, 118,
+	2, 2, 792, 856, 3, 2, 2, 2, 793, 794, 7, 24, 2, 2, 794, 795, 7, 115, 2,
+	2, 795, 796, 5, 144, 73, 2, 796, 797, 7, 116, 2, 2, 797, 798, 5, 122, 62,
+	2, 798, 856, 3, 2, 2, 2, 799, 800, 7, 51, 2, 2, 800, 801, 5, 152, 77, 2,
+	801, 802, 5, 122, 62, 2, 802, 856, 3, 2, 2, 2, 803, 804, 7, 16, 2, 2, 804,
+	805, 5, 122, 62, 2, 805, 806, 7, 51, 2, 2, 806, 807, 5, 152, 77, 2, 807,
+	856, 3, 2, 2, 2, 808, 809, 7, 48, 2, 2, 809, 819, 5, 114, 58, 2, 810, 812,
+	5, 130, 66, 2, 811, 810, 3, 2, 2, 2, 812, 813, 3, 2, 2, 2, 813, 811, 3,
+	2, 2, 2, 813, 814, 3, 2, 2, 2, 814, 816, 3, 2, 2, 2, 815, 817, 5, 134,
+	68, 2, 816, 815, 3, 2, 2, 2, 816, 817, 3, 2, 2, 2, 817, 820, 3, 2, 2, 2,
+	818, 820, 5, 134, 68, 2, 819, 811, 3, 2, 2, 2, 819, 818, 3, 2, 2, 2, 820,
+	856, 3, 2, 2, 2, 821, 823, 7, 40, 2, 2, 822, 824, 5, 164, 83, 2, 823, 822,
+	3, 2, 2, 2, 823, 824, 3, 2, 2, 2, 824, 825, 3, 2, 2, 2, 825, 856, 7, 121,
+	2, 2, 826, 827, 7, 45, 2, 2, 827, 828, 5, 164, 83, 2, 828, 829, 7, 121,
+	2, 2, 829, 856, 3, 2, 2, 2, 830, 832, 7, 10, 2, 2, 831, 833, 5, 260, 131,
+	2, 832, 831, 3, 2, 2, 2, 832, 833, 3, 2, 2, 2, 833, 834, 3, 2, 2, 2, 834,
+	856, 7, 121, 2, 2, 835, 837, 7, 14, 2, 2, 836, 838, 5, 260, 131, 2, 837,
+	836, 3, 2, 2, 2, 837, 838, 3, 2, 2, 2, 838, 839, 3, 2, 2, 2, 839, 856,
+	7, 121, 2, 2, 840, 856, 7, 121, 2, 2, 841, 842, 5, 156, 79, 2, 842, 843,
+	7, 121, 2, 2, 843, 856, 3, 2, 2, 2, 844, 845, 5, 162, 82, 2, 845, 846,
+	7, 121, 2, 2, 846, 856, 3, 2, 2, 2, 847, 848, 7, 109, 2, 2, 848, 849, 7,
+	123, 2, 2, 849, 850, 7, 108, 2, 2, 850, 851, 7, 115, 2, 2, 851, 852, 5,
+	164, 83, 2, 852, 853, 7, 116, 2, 2, 853, 854, 5, 114, 58, 2, 854, 856,
+	3, 2, 2, 2, 855, 773, 3, 2, 2, 2, 855, 774, 3, 2, 2, 2, 855, 781, 3, 2,
+	2, 2, 855, 793, 3, 2, 2, 2, 855, 799, 3, 2, 2, 2, 855, 803, 3, 2, 2, 2,
+	855, 808, 3, 2, 2, 2, 855, 821, 3, 2, 2, 2, 855, 826, 3, 2, 2, 2, 855,
+	830, 3, 2, 2, 2, 855, 835, 3, 2, 2, 2, 855, 840, 3, 2, 2, 2, 855, 841,
+	3, 2, 2, 2, 855, 844, 3, 2, 2, 2, 855, 847, 3, 2, 2, 2, 856, 123, 3, 2,
+	2, 2, 857, 859, 5, 12, 7, 2, 858, 857, 3, 2, 2, 2, 859, 862, 3, 2, 2, 2,
+	860, 858, 3, 2, 2, 2, 860, 861, 3, 2, 2, 2, 861, 865, 3, 2, 2, 2, 862,
+	860, 3, 2, 2, 2, 863, 866, 5, 126, 64, 2, 864, 866, 5, 128, 65, 2, 865,
+	863, 3, 2, 2, 2, 865, 864, 3, 2, 2, 2, 866, 125, 3, 2, 2, 2, 867, 870,
+	7, 7, 2, 2, 868, 871, 7, 121, 2, 2, 869, 871, 5, 94, 48, 2, 870, 868, 3,
+	2, 2, 2, 870, 869, 3, 2, 2, 2, 871, 127, 3, 2, 2, 2, 872, 875, 7, 6, 2,
+	2, 873, 876, 7, 121, 2, 2, 874, 876, 5, 94, 48, 2, 875, 873, 3, 2, 2, 2,
+	875, 874, 3, 2, 2, 2, 876, 129, 3, 2, 2, 2, 877, 878, 7, 11, 2, 2, 878,
+	882, 7, 115, 2, 2, 879, 881, 5, 16, 9, 2, 880, 879, 3, 2, 2, 2, 881, 884,
+	3, 2, 2, 2, 882, 880, 3, 2, 2, 2, 882, 883, 3, 2, 2, 2, 883, 885, 3, 2,
+	2, 2, 884, 882, 3, 2, 2, 2, 885, 886, 5, 132, 67, 2, 886, 887, 5, 260,
+	131, 2, 887, 888, 7, 116, 2, 2, 888, 889, 5, 114, 58, 2, 889, 131, 3, 2,
+	2, 2, 890, 895, 5, 98, 50, 2, 891, 892, 7, 145, 2, 2, 892, 894, 5, 98,
+	50, 2, 893, 891, 3, 2, 2, 2, 894, 897, 3, 2, 2, 2, 895, 893, 3, 2, 2, 2,
+	895, 896, 3, 2, 2, 2, 896, 133, 3, 2, 2, 2, 897, 895, 3, 2, 2, 2, 898,
+	899, 7, 22, 2, 2, 899, 900, 5, 114, 58, 2, 900, 135, 3, 2, 2, 2, 901, 905,
+	5, 138, 70, 2, 902, 904, 5, 138, 70, 2, 903, 902, 3, 2, 2, 2, 904, 907,
+	3, 2, 2, 2, 905, 903, 3, 2, 2, 2, 905, 906, 3, 2, 2, 2, 906, 137, 3, 2,
+	2, 2, 907, 905, 3, 2, 2, 2, 908, 909, 7, 53, 2, 2, 909, 910, 5, 140, 71,
+	2, 910, 911, 5, 114, 58, 2, 911, 139, 3, 2, 2, 2, 912, 917, 5, 142, 72,
+	2, 913, 914, 7, 122, 2, 2, 914, 916, 5, 142, 72, 2, 915, 913, 3, 2, 2,
+	2, 916, 919, 3, 2, 2, 2, 917, 915, 3, 2, 2, 2, 917, 918, 3, 2, 2, 2, 918,
+	924, 3, 2, 2, 2, 919, 917, 3, 2, 2, 2, 920, 921, 5, 72, 37, 2, 921, 922,
+	5, 260, 131, 2, 922, 924, 3, 2, 2, 2, 923, 912, 3, 2, 2, 2, 923, 920, 3,
+	2, 2, 2, 924, 141, 3, 2, 2, 2, 925, 928, 5, 100, 51, 2, 926, 928, 5, 260,
+	131, 2, 927, 925, 3, 2, 2, 2, 927, 926, 3, 2, 2, 2, 928, 143, 3, 2, 2,
+	2, 929, 942, 5, 148, 75, 2, 930, 932, 5, 146, 74, 2, 931, 930, 3, 2, 2,
+	2, 931, 932, 3, 2, 2, 2, 932, 933, 3, 2, 2, 2, 933, 935, 7, 121, 2, 2,
+	934, 936, 5, 164, 83, 2, 935, 934, 3, 2, 2, 2, 935, 936, 3, 2, 2, 2, 936,
+	937, 3, 2, 2, 2, 937, 939, 7, 121, 2, 2, 938, 940, 5, 150, 76, 2, 939,
+	938, 3, 2, 2, 2, 939, 940, 3, 2, 2, 2, 940, 942, 3, 2, 2, 2, 941, 929,
+	3, 2, 2, 2, 941, 931, 3, 2, 2, 2, 942, 145, 3, 2, 2, 2, 943, 946, 5, 120,
+	61, 2, 944, 946, 5, 154, 78, 2, 945, 943, 3, 2, 2, 2, 945, 944, 3, 2, 2,
+	2, 946, 147, 3, 2, 2, 2, 947, 949, 5, 16, 9, 2, 948, 947, 3, 2, 2, 2, 949,
+	952, 3, 2, 2, 2, 950, 948, 3, 2, 2, 2, 950, 951, 3, 2, 2, 2, 951, 953,
+	3, 2, 2, 2, 952, 950, 3, 2, 2, 2, 953, 954, 5, 72, 37, 2, 954, 955, 5,
+	64, 33, 2, 955, 956, 7, 130, 2, 2, 956, 957, 5, 164, 83, 2, 957, 149, 3,
+	2, 2, 2, 958, 959, 5, 154, 78, 2, 959, 151, 3, 2, 2, 2, 960, 961, 7, 115,
+	2, 2, 961, 962, 5, 164, 83, 2, 962, 963, 7, 116, 2, 2, 963, 153, 3, 2,
+	2, 2, 964, 969, 5, 164, 83, 2, 965, 966, 7, 122, 2, 2, 966, 968, 5, 164,
+	83, 2, 967, 965, 3, 2, 2, 2, 968, 971, 3, 2, 2, 2, 969, 967, 3, 2, 2, 2,
+	969, 970, 3, 2, 2, 2, 970, 155, 3, 2, 2, 2, 971, 969, 3, 2, 2, 2, 972,
+	973, 5, 164, 83, 2, 973, 157, 3, 2, 2, 2, 974, 975, 5, 164, 83, 2, 975,
+	159, 3, 2, 2, 2, 976, 977, 9, 3, 2, 2, 977, 983, 5, 164, 83, 2, 978, 979,
+	7, 91, 2, 2, 979, 980, 5, 164, 83, 2, 980, 981, 5, 260, 131, 2, 981, 983,
+	3, 2, 2, 2, 982, 976, 3, 2, 2, 2, 982, 978, 3, 2, 2, 2, 983, 161, 3, 2,
+	2, 2, 984, 985, 5, 160, 81, 2, 985, 163, 3, 2, 2, 2, 986, 987, 8, 83, 1,
+	2, 987, 1000, 5, 166, 84, 2, 988, 989, 7, 35, 2, 2, 989, 1000, 5, 168,
+	85, 2, 990, 991, 7, 115, 2, 2, 991, 992, 5, 72, 37, 2, 992, 993, 7, 116,
+	2, 2, 993, 994, 5, 164, 83, 19, 994, 1000, 3, 2, 2, 2, 995, 996, 9, 8,
+	2, 2, 996, 1000, 5, 164, 83, 17, 997, 998, 9, 9, 2, 2, 998, 1000, 5, 164,
+	83, 16, 999, 986, 3, 2, 2, 2, 999, 988, 3, 2, 2, 2, 999, 990, 3, 2, 2,
+	2, 999, 995, 3, 2, 2, 2, 999, 997, 3, 2, 2, 2, 1000, 1072, 3, 2, 2, 2,
+	1001, 1002, 12, 15, 2, 2, 1002, 1003, 9, 10, 2, 2, 1003, 1071, 5, 164,
+	83, 16, 1004, 1005, 12, 14, 2, 2, 1005, 1006, 9, 11, 2, 2, 1006, 1071,
+	5, 164, 83, 15, 1007, 1015, 12, 13, 2, 2, 1008, 1009, 7, 126, 2, 2, 1009,
+	1016, 7, 126, 2, 2, 1010, 1011, 7, 125, 2, 2, 1011, 1012, 7, 125, 2, 2,
+	1012, 1016, 7, 125, 2, 2, 1013, 1014, 7, 125, 2, 2, 1014, 1016, 7, 125,
+	2, 2, 1015, 1008, 3, 2, 2, 2, 1015, 1010, 3, 2, 2, 2, 1015, 1013, 3, 2,
+	2, 2, 1016, 1017, 3, 2, 2, 2, 1017, 1071, 5, 164, 83, 14, 1018, 1019, 12,
+	12, 2, 2, 1019, 1020, 9, 12, 2, 2, 1020, 1071, 5, 164, 83, 13, 1021, 1022,
+	12, 10, 2, 2, 1022, 1023, 9, 13, 2, 2, 1023, 1071, 5, 164, 83, 11, 1024,
+	1025, 12, 9, 2, 2, 1025, 1026, 7, 144, 2, 2, 1026, 1071, 5, 164, 83, 10,
+	1027, 1028, 12, 8, 2, 2, 1028, 1029, 7, 146, 2, 2, 1029, 1071, 5, 164,
+	83, 9, 1030, 1031, 12, 7, 2, 2, 1031, 1032, 7, 145, 2, 2, 1032, 1071, 5,
+	164, 83, 8, 1033, 1034, 12, 6, 2, 2, 1034, 1035, 7, 136, 2, 2, 1035, 1071,
+	5, 164, 83, 7, 1036, 1037, 12, 5, 2, 2, 1037, 1038, 7, 137, 2, 2, 1038,
+	1071, 5, 164, 83, 6, 1039, 1040, 12, 4, 2, 2, 1040, 1041, 7, 129, 2, 2,
+	1041, 1042, 5, 164, 83, 2, 1042, 1043, 7, 130, 2, 2, 1043, 1044, 5, 164,
+	83, 5, 1044, 1071, 3, 2, 2, 2, 1045, 1046, 12, 3, 2, 2, 1046, 1047, 9,
+	14, 2, 2, 1047, 1071, 5, 164, 83, 3, 1048, 1049, 12, 24, 2, 2, 1049, 1050,
+	7, 123, 2, 2, 1050, 1071, 5, 260, 131, 2, 1051, 1052, 12, 23, 2, 2, 1052,
+	1053, 7, 123, 2, 2, 1053, 1071, 5, 188, 95, 2, 1054, 1055, 12, 22, 2, 2,
+	1055, 1056, 7, 119, 2, 2, 1056, 1057, 5, 164, 83, 2, 1057, 1058, 7, 120,
+	2, 2, 1058, 1071, 3, 2, 2, 2, 1059, 1060, 12, 21, 2, 2, 1060, 1062, 7,
+	115, 2, 2, 1061, 1063, 5, 154, 78, 2, 1062, 1061, 3, 2, 2, 2, 1062, 1063,
+	3, 2, 2, 2, 1063, 1064, 3, 2, 2, 2, 1064, 1071, 7, 116, 2, 2, 1065, 1066,
+	12, 18, 2, 2, 1066, 1071, 9, 8, 2, 2, 1067, 1068, 12, 11, 2, 2, 1068, 1069,
+	7, 29, 2, 2, 1069, 1071, 5, 72, 37, 2, 1070, 1001, 3, 2, 2, 2, 1070, 1004,
+	3, 2, 2, 2, 1070, 1007, 3, 2, 2, 2, 1070, 1018, 3, 2, 2, 2, 1070, 1021,
+	3, 2, 2, 2, 1070, 1024, 3, 2, 2, 2, 1070, 1027, 3, 2, 2, 2, 1070, 1030,
+	3, 2, 2, 2, 1070, 1033, 3, 2, 2, 2, 1070, 1036, 3, 2, 2, 2, 1070, 1039,
+	3, 2, 2, 2, 1070, 1045, 3, 2, 2, 2, 1070, 1048, 3, 2, 2, 2, 1070, 1051,
+	3, 2, 2, 2, 1070, 1054, 3, 2, 2, 2, 1070, 1059, 3, 2, 2, 2, 1070, 1065,
+	3, 2, 2, 2, 1070, 1067, 3, 2, 2, 2, 1071, 1074, 3, 2, 2, 2, 1072, 1070,
+	3, 2, 2, 2, 1072, 1073, 3, 2, 2, 2, 1073, 165, 3, 2, 2, 2, 1074, 1072,
+	3, 2, 2, 2, 1075, 1076, 7, 115, 2, 2, 1076, 1077, 5, 164, 83, 2, 1077,
+	1078, 7, 116, 2, 2, 1078, 1106, 3, 2, 2, 2, 1079, 1106, 7, 44, 2, 2, 1080,
+	1106, 7, 42, 2, 2, 1081, 1106, 5, 100, 51, 2, 1082, 1106, 5, 260, 131,
+	2, 1083, 1084, 5, 72, 37, 2, 1084, 1085, 7, 123, 2, 2, 1085, 1086, 7, 12,
+	2, 2, 1086, 1106, 3, 2, 2, 2, 1087, 1088, 7, 49, 2, 2, 1088, 1089, 7, 123,
+	2, 2, 1089, 1106, 7, 12, 2, 2, 1090, 1094, 5, 190, 96, 2, 1091, 1095, 5,
+	198, 100, 2, 1092, 1093, 7, 44, 2, 2, 1093, 1095, 5, 200, 101, 2, 1094,
+	1091, 3, 2, 2, 2, 1094, 1092, 3, 2, 2, 2, 1095, 1106, 3, 2, 2, 2, 1096,
+	1106, 5, 202, 102, 2, 1097, 1106, 5, 254, 128, 2, 1098, 1106, 5, 78, 40,
+	2, 1099, 1100, 7, 104, 2, 2, 1100, 1103, 7, 123, 2, 2, 1101, 1104, 7, 35,
+	2, 2, 1102, 1104, 5, 260, 131, 2, 1103, 1101, 3, 2, 2, 2, 1103, 1102, 3,
+	2, 2, 2, 1104, 1106, 3, 2, 2, 2, 1105, 1075, 3, 2, 2, 2, 1105, 1079, 3,
+	2, 2, 2, 1105, 1080, 3, 2, 2, 2, 1105, 1081, 3, 2, 2, 2, 1105, 1082, 3,
+	2, 2, 2, 1105, 1083, 3, 2, 2, 2, 1105, 1087, 3, 2, 2, 2, 1105, 1090, 3,
+	2, 2, 2, 1105, 1096, 3, 2, 2, 2, 1105, 1097, 3, 2, 2, 2, 1105, 1098, 3,
+	2, 2, 2, 1105, 1099, 3, 2, 2, 2, 1106, 167, 3, 2, 2, 2, 1107, 1108, 5,
+	190, 96, 2, 1108, 1109, 5, 170, 86, 2, 1109, 1110, 5, 186, 94, 2, 1110,
+	1119, 3, 2, 2, 2, 1111, 1116, 5, 170, 86, 2, 1112, 1117, 5, 174, 88, 2,
+	1113, 1117, 5, 186, 94, 2, 1114, 1117, 5, 176, 89, 2, 1115, 1117, 5, 182,
+	92, 2, 1116, 1112, 3, 2, 2, 2, 1116, 1113, 3, 2, 2, 2, 1116, 1114, 3, 2,
+	2, 2, 1116, 1115, 3, 2, 2, 2, 1117, 1119, 3, 2, 2, 2, 1118, 1107, 3, 2,
+	2, 2, 1118, 1111, 3, 2, 2, 2, 1119, 169, 3, 2, 2, 2, 1120, 1122, 5, 260,
+	131, 2, 1121, 1123, 5, 192, 97, 2, 1122, 1121, 3, 2, 2, 2, 1122, 1123,
+	3, 2, 2, 2, 1123, 1131, 3, 2, 2, 2, 1124, 1125, 7, 123, 2, 2, 1125, 1127,
+	5, 260, 131, 2, 1126, 1128, 5, 192, 97, 2, 1127, 1126, 3, 2, 2, 2, 1127,
+	1128, 3, 2, 2, 2, 1128, 1130, 3, 2, 2, 2, 1129, 1124, 3, 2, 2, 2, 1130,
+	1133, 3, 2, 2, 2, 1131, 1129, 3, 2, 2, 2, 1131, 1132, 3, 2, 2, 2, 1132,
+	1138, 3, 2, 2, 2, 1133, 1131, 3, 2, 2, 2, 1134, 1138, 5, 78, 40, 2, 1135,
+	1136, 7, 6, 2, 2, 1136, 1138, 5, 192, 97, 2, 1137, 1120, 3, 2, 2, 2, 1137,
+	1134, 3, 2, 2, 2, 1137, 1135, 3, 2, 2, 2, 1138, 171, 3, 2, 2, 2, 1139,
+	1141, 5, 260, 131, 2, 1140, 1142, 5, 194, 98, 2, 1141, 1140, 3, 2, 2, 2,
+	1141, 1142, 3, 2, 2, 2, 1142, 1143, 3, 2, 2, 2, 1143, 1144, 5, 186, 94,
+	2, 1144, 173, 3, 2, 2, 2, 1145, 1149, 5, 74, 38, 2, 1146, 1148, 5, 74,
+	38, 2, 1147, 1146, 3, 2, 2, 2, 1148, 1151, 3, 2, 2, 2, 1149, 1147, 3, 2,
+	2, 2, 1149, 1150, 3, 2, 2, 2, 1150, 1152, 3, 2, 2, 2, 1151, 1149, 3, 2,
+	2, 2, 1152, 1153, 5, 68, 35, 2, 1153, 1173, 3, 2, 2, 2, 1154, 1155, 7,
+	119, 2, 2, 1155, 1156, 5, 164, 83, 2, 1156, 1163, 7, 120, 2, 2, 1157, 1158,
+	7, 119, 2, 2, 1158, 1159, 5, 164, 83, 2, 1159, 1160, 7, 120, 2, 2, 1160,
+	1162, 3, 2, 2, 2, 1161, 1157, 3, 2, 2, 2, 1162, 1165, 3, 2, 2, 2, 1163,
+	1161, 3, 2, 2, 2, 1163, 1164, 3, 2, 2, 2, 1164, 1169, 3, 2, 2, 2, 1165,
+	1163, 3, 2, 2, 2, 1166, 1168, 5, 74, 38, 2, 1167, 1166, 3, 2, 2, 2, 1168,
+	1171, 3, 2, 2, 2, 1169, 1167, 3, 2, 2, 2, 1169, 1170, 3, 2, 2, 2, 1170,
+	1173, 3, 2, 2, 2, 1171, 1169, 3, 2, 2, 2, 1172, 1145, 3, 2, 2, 2, 1172,
+	1154, 3, 2, 2, 2, 1173, 175, 3, 2, 2, 2, 1174, 1175, 7, 117, 2, 2, 1175,
+	1193, 7, 118, 2, 2, 1176, 1177, 7, 117, 2, 2, 1177, 1178, 5, 178, 90, 2,
+	1178, 1179, 7, 159, 2, 2, 1179, 1187, 5, 180, 91, 2, 1180, 1181, 7, 122,
+	2, 2, 1181, 1182, 5, 178, 90, 2, 1182, 1183, 7, 159, 2, 2, 1183, 1184,
+	5, 180, 91, 2, 1184, 1186, 3, 2, 2, 2, 1185, 1180, 3, 2, 2, 2, 1186, 1189,
+	3, 2, 2, 2, 1187, 1185, 3, 2, 2, 2, 1187, 1188, 3, 2, 2, 2, 1188, 1190,
+	3, 2, 2, 2, 1189, 1187, 3, 2, 2, 2, 1190, 1191, 7, 118, 2, 2, 1191, 1193,
+	3, 2, 2, 2, 1192, 1174, 3, 2, 2, 2, 1192, 1176, 3, 2, 2, 2, 1193, 177,
+	3, 2, 2, 2, 1194, 1197, 5, 260, 131, 2, 1195, 1197, 5, 164, 83, 2, 1196,
+	1194, 3, 2, 2, 2, 1196, 1195, 3, 2, 2, 2, 1197, 179, 3, 2, 2, 2, 1198,
+	1201, 5, 100, 51, 2, 1199, 1201, 5, 164, 83, 2, 1200, 1198, 3, 2, 2, 2,
+	1200, 1199, 3, 2, 2, 2, 1201, 181, 3, 2, 2, 2, 1202, 1203, 7, 117, 2, 2,
+	1203, 1208, 5, 184, 93, 2, 1204, 1205, 7, 122, 2, 2, 1205, 1207, 5, 184,
+	93, 2, 1206, 1204, 3, 2, 2, 2, 1207, 1210, 3, 2, 2, 2, 1208, 1206, 3, 2,
+	2, 2, 1208, 1209, 3, 2, 2, 2, 1209, 1211, 3, 2, 2, 2, 1210, 1208, 3, 2,
+	2, 2, 1211, 1212, 7, 118, 2, 2, 1212, 183, 3, 2, 2, 2, 1213, 1216, 5, 100,
+	51, 2, 1214, 1216, 5, 164, 83, 2, 1215, 1213, 3, 2, 2, 2, 1215, 1214, 3,
+	2, 2, 2, 1216, 185, 3, 2, 2, 2, 1217, 1219, 5, 200, 101, 2, 1218, 1220,
+	5, 32, 17, 2, 1219, 1218, 3, 2, 2, 2, 1219, 1220, 3, 2, 2, 2, 1220, 187,
+	3, 2, 2, 2, 1221, 1222, 5, 190, 96, 2, 1222, 1223, 5, 198, 100, 2, 1223,
+	189, 3, 2, 2, 2, 1224, 1225, 7, 126, 2, 2, 1225, 1226, 5, 30, 16, 2, 1226,
+	1227, 7, 125, 2, 2, 1227, 191, 3, 2, 2, 2, 1228, 1229, 7, 126, 2, 2, 1229,
+	1232, 7, 125, 2, 2, 1230, 1232, 5, 80, 41, 2, 1231, 1228, 3, 2, 2, 2, 1231,
+	1230, 3, 2, 2, 2, 1232, 193, 3, 2, 2, 2, 1233, 1234, 7, 126, 2, 2, 1234,
+	1237, 7, 125, 2, 2, 1235, 1237, 5, 190, 96, 2, 1236, 1233, 3, 2, 2, 2,
+	1236, 1235, 3, 2, 2, 2, 1237, 195, 3, 2, 2, 2, 1238, 1245, 5, 200, 101,
+	2, 1239, 1240, 7, 123, 2, 2, 1240, 1242, 5, 260, 131, 2, 1241, 1243, 5,
+	200, 101, 2, 1242, 1241, 3, 2, 2, 2, 1242, 1243, 3, 2, 2, 2, 1243, 1245,
+	3, 2, 2, 2, 1244, 1238, 3, 2, 2, 2, 1244, 1239, 3, 2, 2, 2, 1245, 197,
+	3, 2, 2, 2, 1246, 1247, 7, 42, 2, 2, 1247, 1252, 5, 196, 99, 2, 1248, 1249,
+	5, 260, 131, 2, 1249, 1250, 5, 200, 101, 2, 1250, 1252, 3, 2, 2, 2, 1251,
+	1246, 3, 2, 2, 2, 1251, 1248, 3, 2, 2, 2, 1252, 199, 3, 2, 2, 2, 1253,
+	1255, 7, 115, 2, 2, 1254, 1256, 5, 154, 78, 2, 1255, 1254, 3, 2, 2, 2,
+	1255, 1256, 3, 2, 2, 2, 1256, 1257, 3, 2, 2, 2, 1257, 1258, 7, 116, 2,
+	2, 1258, 201, 3, 2, 2, 2, 1259, 1260, 7, 119, 2, 2, 1260, 1261, 5, 204,
+	103, 2, 1261, 1262, 7, 120, 2, 2, 1262, 203, 3, 2, 2, 2, 1263, 1264, 5,
+	206, 104, 2, 1264, 1266, 5, 212, 107, 2, 1265, 1267, 5, 220, 111, 2, 1266,
+	1265, 3, 2, 2, 2, 1266, 1267, 3, 2, 2, 2, 1267, 1269, 3, 2, 2, 2, 1268,
+	1270, 5, 240, 121, 2, 1269, 1268, 3, 2, 2, 2, 1269, 1270, 3, 2, 2, 2, 1270,
+	1272, 3, 2, 2, 2, 1271, 1273, 5, 244, 123, 2, 1272, 1271, 3, 2, 2, 2, 1272,
+	1273, 3, 2, 2, 2, 1273, 1275, 3, 2, 2, 2, 1274, 1276, 5, 230, 116, 2, 1275,
+	1274, 3, 2, 2, 2, 1275, 1276, 3, 2, 2, 2, 1276, 1278, 3, 2, 2, 2, 1277,
+	1279, 5, 228, 115, 2, 1278, 1277, 3, 2, 2, 2, 1278, 1279, 3, 2, 2, 2, 1279,
+	1281, 3, 2, 2, 2, 1280, 1282, 5, 248, 125, 2, 1281, 1280, 3, 2, 2, 2, 1281,
+	1282, 3, 2, 2, 2, 1282, 1284, 3, 2, 2, 2, 1283, 1285, 5, 250, 126, 2, 1284,
+	1283, 3, 2, 2, 2, 1284, 1285, 3, 2, 2, 2, 1285, 1287, 3, 2, 2, 2, 1286,
+	1288, 5, 252, 127, 2, 1287, 1286, 3, 2, 2, 2, 1287, 1288, 3, 2, 2, 2, 1288,
+	205, 3, 2, 2, 2, 1289, 1290, 7, 58, 2, 2, 1290, 1291, 5, 208, 105, 2, 1291,
+	207, 3, 2, 2, 2, 1292, 1297, 5, 210, 106, 2, 1293, 1294, 7, 122, 2, 2,
+	1294, 1296, 5, 210, 106, 2, 1295, 1293, 3, 2, 2, 2, 1296, 1299, 3, 2, 2,
+	2, 1297, 1295, 3, 2, 2, 2, 1297, 1298, 3, 2, 2, 2, 1298, 209, 3, 2, 2,
+	2, 1299, 1297, 3, 2, 2, 2, 1300, 1302, 5, 216, 109, 2, 1301, 1303, 5, 260,
+	131, 2, 1302, 1301, 3, 2, 2, 2, 1302, 1303, 3, 2, 2, 2, 1303, 1321, 3,
+	2, 2, 2, 1304, 1321, 5, 218, 110, 2, 1305, 1306, 7, 67, 2, 2, 1306, 1312,
+	5, 216, 109, 2, 1307, 1308, 7, 53, 2, 2, 1308, 1309, 5, 260, 131, 2, 1309,
+	1310, 7, 89, 2, 2, 1310, 1311, 5, 208, 105, 2, 1311, 1313, 3, 2, 2, 2,
+	1312, 1307, 3, 2, 2, 2, 1313, 1314, 3, 2, 2, 2, 1314, 1312, 3, 2, 2, 2,
+	1314, 1315, 3, 2, 2, 2, 1315, 1316, 3, 2, 2, 2, 1316, 1317, 7, 18, 2, 2,
+	1317, 1318, 5, 208, 105, 2, 1318, 1319, 7, 74, 2, 2, 1319, 1321, 3, 2,
+	2, 2, 1320, 1300, 3, 2, 2, 2, 1320, 1304, 3, 2, 2, 2, 1320, 1305, 3, 2,
+	2, 2, 1321, 211, 3, 2, 2, 2, 1322, 1323, 7, 59, 2, 2, 1323, 1327, 5, 260,
+	131, 2, 1324, 1325, 7, 75, 2, 2, 1325, 1326, 7, 83, 2, 2, 1326, 1328, 5,
+	214, 108, 2, 1327, 1324, 3, 2, 2, 2, 1327, 1328, 3, 2, 2, 2, 1328, 213,
+	3, 2, 2, 2, 1329, 1330, 3, 2, 2, 2, 1330, 215, 3, 2, 2, 2, 1331, 1332,
+	5, 260, 131, 2, 1332, 1333, 7, 123, 2, 2, 1333, 1335, 3, 2, 2, 2, 1334,
+	1331, 3, 2, 2, 2, 1335, 1338, 3, 2, 2, 2, 1336, 1334, 3, 2, 2, 2, 1336,
+	1337, 3, 2, 2, 2, 1337, 1339, 3, 2, 2, 2, 1338, 1336, 3, 2, 2, 2, 1339,
+	1355, 5, 260, 131, 2, 1340, 1341, 5, 260, 131, 2, 1341, 1350, 7, 115, 2,
+	2, 1342, 1347, 5, 216, 109, 2, 1343, 1344, 7, 122, 2, 2, 1344, 1346, 5,
+	216, 109, 2, 1345, 1343, 3, 2, 2, 2, 1346, 1349, 3, 2, 2, 2, 1347, 1345,
+	3, 2, 2, 2, 1347, 1348, 3, 2, 2, 2, 1348, 1351, 3, 2, 2, 2, 1349, 1347,
+	3, 2, 2, 2, 1350, 1342, 3, 2, 2, 2, 1350, 1351, 3, 2, 2, 2, 1351, 1352,
+	3, 2, 2, 2, 1352, 1353, 7, 116, 2, 2, 1353, 1355, 3, 2, 2, 2, 1354, 1336,
+	3, 2, 2, 2, 1354, 1340, 3, 2, 2, 2, 1355, 217, 3, 2, 2, 2, 1356, 1357,
+	7, 115, 2, 2, 1357, 1358, 5, 204, 103, 2, 1358, 1359, 7, 116, 2, 2, 1359,
+	219, 3, 2, 2, 2, 1360, 1361, 7, 60, 2, 2, 1361, 1362, 5, 222, 112, 2, 1362,
+	221, 3, 2, 2, 2, 1363, 1364, 8, 112, 1, 2, 1364, 1365, 5, 224, 113, 2,
+	1365, 1371, 3, 2, 2, 2, 1366, 1367, 12, 3, 2, 2, 1367, 1368, 9, 15, 2,
+	2, 1368, 1370, 5, 222, 112, 4, 1369, 1366, 3, 2, 2, 2, 1370, 1373, 3, 2,
+	2, 2, 1371, 1369, 3, 2, 2, 2, 1371, 1372, 3, 2, 2, 2, 1372, 223, 3, 2,
+	2, 2, 1373, 1371, 3, 2, 2, 2, 1374, 1376, 7, 97, 2, 2, 1375, 1374, 3, 2,
+	2, 2, 1375, 1376, 3, 2, 2, 2, 1376, 1377, 3, 2, 2, 2, 1377, 1378, 5, 216,
+	109, 2, 1378, 1379, 5, 226, 114, 2, 1379, 1380, 5, 236, 119, 2, 1380, 1386,
+	3, 2, 2, 2, 1381, 1382, 7, 115, 2, 2, 1382, 1383, 5, 222, 112, 2, 1383,
+	1384, 7, 116, 2, 2, 1384, 1386, 3, 2, 2, 2, 1385, 1375, 3, 2, 2, 2, 1385,
+	1381, 3, 2, 2, 2, 1386, 225, 3, 2, 2, 2, 1387, 1401, 7, 124, 2, 2, 1388,
+	1401, 7, 126, 2, 2, 1389, 1401, 7, 125, 2, 2, 1390, 1401, 7, 133, 2, 2,
+	1391, 1401, 7, 134, 2, 2, 1392, 1401, 7, 135, 2, 2, 1393, 1401, 7, 3, 2,
+	2, 1394, 1401, 7, 86, 2, 2, 1395, 1401, 7, 73, 2, 2, 1396, 1397, 7, 97,
+	2, 2, 1397, 1401, 7, 73, 2, 2, 1398, 1401, 7, 87, 2, 2, 1399, 1401, 7,
+	88, 2, 2, 1400, 1387, 3, 2, 2, 2, 1400, 1388, 3, 2, 2, 2, 1400, 1389, 3,
+	2, 2, 2, 1400, 1390, 3, 2, 2, 2, 1400, 1391, 3, 2, 2, 2, 1400, 1392, 3,
+	2, 2, 2, 1400, 1393, 3, 2, 2, 2, 1400, 1394, 3, 2, 2, 2, 1400, 1395, 3,
+	2, 2, 2, 1400, 1396, 3, 2, 2, 2, 1400, 1398, 3, 2, 2, 2, 1400, 1399, 3,
+	2, 2, 2, 1401, 227, 3, 2, 2, 2, 1402, 1405, 7, 61, 2, 2, 1403, 1406, 7,
+	110, 2, 2, 1404, 1406, 5, 234, 118, 2, 1405, 1403, 3, 2, 2, 2, 1405, 1404,
+	3, 2, 2, 2, 1406, 229, 3, 2, 2, 2, 1407, 1408, 7, 62, 2, 2, 1408, 1409,
+	7, 63, 2, 2, 1409, 1414, 5, 232, 117, 2, 1410, 1411, 7, 122, 2, 2, 1411,
+	1413, 5, 232, 117, 2, 1412, 1410, 3, 2, 2, 2, 1413, 1416, 3, 2, 2, 2, 1414,
+	1412, 3, 2, 2, 2, 1414, 1415, 3, 2, 2, 2, 1415, 231, 3, 2, 2, 2, 1416,
+	1414, 3, 2, 2, 2, 1417, 1419, 5, 216, 109, 2, 1418, 1420, 9, 16, 2, 2,
+	1419, 1418, 3, 2, 2, 2, 1419, 1420, 3, 2, 2, 2, 1420, 1423, 3, 2, 2, 2,
+	1421, 1422, 7, 80, 2, 2, 1422, 1424, 9, 17, 2, 2, 1423, 1421, 3, 2, 2,
+	2, 1423, 1424, 3, 2, 2, 2, 1424, 233, 3, 2, 2, 2, 1425, 1426, 7, 130, 2,
+	2, 1426, 1427, 5, 164, 83, 2, 1427, 235, 3, 2, 2, 2, 1428, 1438, 5, 100,
+	51, 2, 1429, 1438, 5, 234, 118, 2, 1430, 1438, 5, 218, 110, 2, 1431, 1438,
+	5, 238, 120, 2, 1432, 1433, 5, 260, 131, 2, 1433, 1434, 7, 130, 2, 2, 1434,
+	1435, 5, 100, 51, 2, 1435, 1438, 3, 2, 2, 2, 1436, 1438, 5, 260, 131, 2,
+	1437, 1428, 3, 2, 2, 2, 1437, 1429, 3, 2, 2, 2, 1437, 1430, 3, 2, 2, 2,
+	1437, 1431, 3, 2, 2, 2, 1437, 1432, 3, 2, 2, 2, 1437, 1436, 3, 2, 2, 2,
+	1438, 237, 3, 2, 2, 2, 1439, 1440, 7, 115, 2, 2, 1440, 1445, 5, 100, 51,
+	2, 1441, 1442, 7, 122, 2, 2, 1442, 1444, 5, 100, 51, 2, 1443, 1441, 3,
+	2, 2, 2, 1444, 1447, 3, 2, 2, 2, 1445, 1443, 3, 2, 2, 2, 1445, 1446, 3,
+	2, 2, 2, 1446, 1448, 3, 2, 2, 2, 1447, 1445, 3, 2, 2, 2, 1448, 1449, 7,
+	116, 2, 2, 1449, 239, 3, 2, 2, 2, 1450, 1451, 7, 66, 2, 2, 1451, 1452,
+	7, 76, 2, 2, 1452, 1453, 7, 77, 2, 2, 1453, 1454, 5, 242, 122, 2, 1454,
+	241, 3, 2, 2, 2, 1455, 1456, 3, 2, 2, 2, 1456, 243, 3, 2, 2, 2, 1457, 1458,
+	7, 78, 2, 2, 1458, 1459, 7, 63, 2, 2, 1459, 1464, 5, 216, 109, 2, 1460,
+	1461, 7, 122, 2, 2, 1461, 1463, 5, 216, 109, 2, 1462, 1460, 3, 2, 2, 2,
+	1463, 1466, 3, 2, 2, 2, 1464, 1462, 3, 2, 2, 2, 1464, 1465, 3, 2, 2, 2,
+	1465, 1469, 3, 2, 2, 2, 1466, 1464, 3, 2, 2, 2, 1467, 1468, 7, 79, 2, 2,
+	1468, 1470, 5, 246, 124, 2, 1469, 1467, 3, 2, 2, 2, 1469, 1470, 3, 2, 2,
+	2, 1470, 245, 3, 2, 2, 2, 1471, 1472, 5, 222, 112, 2, 1472, 247, 3, 2,
+	2, 2, 1473, 1476, 7, 72, 2, 2, 1474, 1477, 7, 110, 2, 2, 1475, 1477, 5,
+	234, 118, 2, 1476, 1474, 3, 2, 2, 2, 1476, 1475, 3, 2, 2, 2, 1477, 249,
+	3, 2, 2, 2, 1478, 1479, 7, 24, 2, 2, 1479, 1482, 9, 18, 2, 2, 1480, 1481,
+	7, 92, 2, 2, 1481, 1483, 9, 19, 2, 2, 1482, 1480, 3, 2, 2, 2, 1482, 1483,
+	3, 2, 2, 2, 1483, 251, 3, 2, 2, 2, 1484, 1485, 7, 101, 2, 2, 1485, 1486,
+	7, 102, 2, 2, 1486, 253, 3, 2, 2, 2, 1487, 1488, 7, 119, 2, 2, 1488, 1489,
+	5, 256, 129, 2, 1489, 1490, 7, 120, 2, 2, 1490, 255, 3, 2, 2, 2, 1491,
+	1492, 7, 98, 2, 2, 1492, 1496, 5, 100, 51, 2, 1493, 1494, 7, 73, 2, 2,
+	1494, 1495, 9, 20, 2, 2, 1495, 1497, 7, 99, 2, 2, 1496, 1493, 3, 2, 2,
+	2, 1496, 1497, 3, 2, 2, 2, 1497, 1498, 3, 2, 2, 2, 1498, 1499, 7, 100,
+	2, 2, 1499, 1504, 5, 258, 130, 2, 1500, 1501, 7, 122, 2, 2, 1501, 1503,
+	5, 258, 130, 2, 1502, 1500, 3, 2, 2, 2, 1503, 1506, 3, 2, 2, 2, 1504, 1502,
+	3, 2, 2, 2, 1504, 1505, 3, 2, 2, 2, 1505, 257, 3, 2, 2, 2, 1506, 1504,
+	3, 2, 2, 2, 1507, 1518, 7, 160, 2, 2, 1508, 1509, 7, 115, 2, 2, 1509, 1514,
+	7, 160, 2, 2, 1510, 1511, 7, 122, 2, 2, 1511, 1513, 7, 160, 2, 2, 1512,
+	1510, 3, 2, 2, 2, 1513, 1516, 3, 2, 2, 2, 1514, 1512, 3, 2, 2, 2, 1514,
+	1515, 3, 2, 2, 2, 1515, 1517, 3, 2, 2, 2, 1516, 1514, 3, 2, 2, 2, 1517,
+	1519, 7, 116, 2, 2, 1518, 1508, 3, 2, 2, 2, 1518, 1519, 3, 2, 2, 2, 1519,
+	259, 3, 2, 2, 2, 1520, 1546, 7, 160, 2, 2, 1521, 1546, 7, 7, 2, 2, 1522,
+	1546, 7, 6, 2, 2, 1523, 1546, 7, 76, 2, 2, 1524, 1546, 7, 78, 2, 2, 1525,
+	1546, 7, 93, 2, 2, 1526, 1546, 7, 90, 2, 2, 1527, 1546, 7, 92, 2, 2, 1528,
+	1546, 7, 94, 2, 2, 1529, 1546, 7, 91, 2, 2, 1530, 1546, 7, 83, 2, 2, 1531,
+	1546, 7, 77, 2, 2, 1532, 1546, 7, 68, 2, 2, 1533, 1546, 7, 72, 2, 2, 1534,
+	1546, 7, 89, 2, 2, 1535, 1546, 7, 98, 2, 2, 1536, 1546, 7, 100, 2, 2, 1537,
+	1546, 7, 101, 2, 2, 1538, 1546, 7, 102, 2, 2, 1539, 1546, 7, 87, 2, 2,
+	1540, 1546, 7, 88, 2, 2, 1541, 1546, 7, 99, 2, 2, 1542, 1546, 7, 108, 2,
+	2, 1543, 1546, 7, 109, 2, 2, 1544, 1546, 5, 78, 40, 2, 1545, 1520, 3, 2,
+	2, 2, 1545, 1521, 3, 2, 2, 2, 1545, 1522, 3, 2, 2, 2, 1545, 1523, 3, 2,
+	2, 2, 1545, 1524, 3, 2, 2, 2, 1545, 1525, 3, 2, 2, 2, 1545, 1526, 3, 2,
+	2, 2, 1545, 1527, 3, 2, 2, 2, 1545, 1528, 3, 2, 2, 2, 1545, 1529, 3, 2,
+	2, 2, 1545, 1530, 3, 2, 2, 2, 1545, 1531, 3, 2, 2, 2, 1545, 1532, 3, 2,
+	2, 2, 1545, 1533, 3, 2, 2, 2, 1545, 1534, 3, 2, 2, 2, 1545, 1535, 3, 2,
+	2, 2, 1545, 1536, 3, 2, 2, 2, 1545, 1537, 3, 2, 2, 2, 1545, 1538, 3, 2,
+	2, 2, 1545, 1539, 3, 2, 2, 2, 1545, 1540, 3, 2, 2, 2, 1545, 1541, 3, 2,
+	2, 2, 1545, 1542, 3, 2, 2, 2, 1545, 1543, 3, 2, 2, 2, 1545, 1544, 3, 2,
+	2, 2, 1546, 261, 3, 2, 2, 2, 1547, 1548, 9, 21, 2, 2, 1548, 263, 3, 2,
+	2, 2, 173, 270, 277, 284, 290, 306, 314, 318, 322, 328, 332, 340, 344,
+	347, 350, 359, 365, 370, 373, 379, 391, 398, 407, 414, 420, 424, 433, 436,
+	440, 448, 453, 457, 463, 478, 485, 490, 497, 505, 515, 523, 531, 536, 545,
+	551, 558, 563, 571, 575, 577, 587, 594, 597, 604, 609, 613, 618, 628, 637,
+	639, 646, 651, 660, 665, 668, 673, 682, 698, 708, 711, 719, 729, 737, 740,
+	743, 751, 759, 767, 779, 789, 813, 816, 819, 823, 832, 837, 855, 860, 865,
+	870, 875, 882, 895, 905, 917, 923, 927, 931, 935, 939, 941, 945, 950, 969,
+	982, 999, 1015, 1062, 1070, 1072, 1094, 1103, 1105, 1116, 1118, 1122, 1127,
+	1131, 1137, 1141, 1149, 1163, 1169, 1172, 1187, 1192, 1196, 1200, 1208,
+	1215, 1219, 1231, 1236, 1242, 1244, 1251, 1255, 1266, 1269, 1272, 1275,
+	1278, 1281, 1284, 1287, 1297, 1302, 1314, 1320, 1327, 1336, 1347, 1350,
+	1354, 1371, 1375, 1385, 1400, 1405, 1414, 1419, 1423, 1437, 1445, 1464,
+	1469, 1476, 1482, 1496, 1504, 1514, 1518, 1545,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
@@ -743,12 +781,12 @@ var literalNames = []string{
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"", "", "", "'('", "')'", "'{'", "'}'", "'['", "']'", "';'", "','", "'.'",
-	"'='", "'>'", "'<'", "'!'", "'~'", "'?'", "':'", "'=='", "'==='", "'<='",
-	"'>='", "'!='", "'&&'", "'||'", "'++'", "'--'", "'+'", "'-'", "'*'", "'/'",
-	"'&'", "'|'", "'^'", "'%'", "'+='", "'-='", "'*='", "'/='", "'&='", "'|='",
-	"'^='", "'%='", "'<<='", "'>>='", "'>>>='", "'=>'", "", "'@'", "'...'",
-	"", "", "'/**'", "", "'/*'", "", "'''",
+	"", "", "", "", "", "", "'('", "')'", "'{'", "'}'", "'['", "']'", "';'",
+	"','", "'.'", "'='", "'>'", "'<'", "'!'", "'~'", "'?'", "':'", "'=='",
+	"'==='", "'<='", "'>='", "'!='", "'&&'", "'||'", "'++'", "'--'", "'+'",
+	"'-'", "'*'", "'/'", "'&'", "'|'", "'^'", "'%'", "'+='", "'-='", "'*='",
+	"'/='", "'&='", "'|='", "'^='", "'%='", "'<<='", "'>>='", "'>>>='", "'=>'",
+	"", "'@'", "'...'", "", "", "'/**'", "", "'/*'", "", "'''",
 }
 var symbolicNames = []string{
 	"", "", "OVERRIDE", "VIRTUAL", "SET", "GET", "ABSTRACT", "BOOLEAN", "BREAK",
@@ -762,18 +800,18 @@ var symbolicNames = []string{
 	"LIMIT", "ORDER", "BY", "ASC", "DESC", "WITH", "TYPEOF", "REFERENCE", "VIEW",
 	"VIEWSTAT", "TRACKING", "OFFSET", "IN", "END", "USING", "DATA", "CATEGORY",
 	"GROUP", "HAVING", "NULLS", "FIRST", "LAST", "SCOPE", "ROLLUP", "CUBE",
-	"LIKE", "THEN", "INSERT", "UPSERT", "UPDATE", "DELETE", "UNDELETE", "SOQL_AND",
-	"SOQL_OR", "SOQL_NOT", "FIND", "FIELDS", "RETURNING", "ALL", "TESTMETHOD",
-	"TRIGGER", "ON", "BEFORE", "AFTER", "RUNAS", "SYSTEM", "IntegerLiteral",
-	"FloatingPointLiteral", "BooleanLiteral", "StringLiteral", "NullLiteral",
-	"LPAREN", "RPAREN", "LBRACE", "RBRACE", "LBRACK", "RBRACK", "SEMI", "COMMA",
-	"DOT", "ASSIGN", "GT", "LT", "BANG", "TILDE", "QUESTION", "COLON", "EQUAL",
-	"T_EQUAL", "LE", "GE", "NOTEQUAL", "AND", "OR", "INC", "DEC", "ADD", "SUB",
-	"MUL", "DIV", "BITAND", "BITOR", "CARET", "MOD", "ADD_ASSIGN", "SUB_ASSIGN",
-	"MUL_ASSIGN", "DIV_ASSIGN", "AND_ASSIGN", "OR_ASSIGN", "XOR_ASSIGN", "MOD_ASSIGN",
-	"LSHIFT_ASSIGN", "RSHIFT_ASSIGN", "URSHIFT_ASSIGN", "LAMBDA_LIKE", "Identifier",
-	"AT", "ELLIPSIS", "WS", "APEXDOC_COMMENT", "APEXDOC_COMMENT_START", "COMMENT",
-	"COMMENT_START", "LINE_COMMENT", "QUOTE",
+	"LIKE", "INCLUDES", "EXCLUDES", "THEN", "INSERT", "UPSERT", "UPDATE", "DELETE",
+	"UNDELETE", "SOQL_AND", "SOQL_OR", "SOQL_NOT", "FIND", "FIELDS", "RETURNING",
+	"ALL", "ROWS", "TESTMETHOD", "TRIGGER", "ON", "BEFORE", "AFTER", "RUNAS",
+	"SYSTEM", "IntegerLiteral", "FloatingPointLiteral", "BooleanLiteral", "StringLiteral",
+	"NullLiteral", "LPAREN", "RPAREN", "LBRACE", "RBRACE", "LBRACK", "RBRACK",
+	"SEMI", "COMMA", "DOT", "ASSIGN", "GT", "LT", "BANG", "TILDE", "QUESTION",
+	"COLON", "EQUAL", "T_EQUAL", "LE", "GE", "NOTEQUAL", "AND", "OR", "INC",
+	"DEC", "ADD", "SUB", "MUL", "DIV", "BITAND", "BITOR", "CARET", "MOD", "ADD_ASSIGN",
+	"SUB_ASSIGN", "MUL_ASSIGN", "DIV_ASSIGN", "AND_ASSIGN", "OR_ASSIGN", "XOR_ASSIGN",
+	"MOD_ASSIGN", "LSHIFT_ASSIGN", "RSHIFT_ASSIGN", "URSHIFT_ASSIGN", "LAMBDA_LIKE",
+	"Identifier", "AT", "ELLIPSIS", "WS", "APEXDOC_COMMENT", "APEXDOC_COMMENT_START",
+	"COMMENT", "COMMENT_START", "LINE_COMMENT", "QUOTE",
 }
 
 var ruleNames = []string{
@@ -793,19 +831,20 @@ var ruleNames = []string{
 	"elementValuePairs", "elementValuePair", "elementValue", "elementValueArrayInitializer",
 	"block", "blockStatement", "localVariableDeclarationStatement", "localVariableDeclaration",
 	"statement", "propertyBlock", "getter", "setter", "catchClause", "catchType",
-	"finallyBlock", "whenStatements", "whenStatement", "whenExpression", "forControl",
-	"forInit", "enhancedForControl", "forUpdate", "parExpression", "expressionList",
-	"statementExpression", "constantExpression", "apexDbExpressionShort", "apexDbExpression",
-	"expression", "primary", "creator", "createdName", "innerCreator", "arrayCreatorRest",
-	"mapCreatorRest", "mapKey", "mapValue", "setCreatorRest", "setValue", "classCreatorRest",
-	"explicitGenericInvocation", "nonWildcardTypeArguments", "typeArgumentsOrDiamond",
-	"nonWildcardTypeArgumentsOrDiamond", "superSuffix", "explicitGenericInvocationSuffix",
-	"arguments", "soqlLiteral", "query", "selectClause", "fieldList", "selectField",
-	"fromClause", "filterScope", "soqlField", "subquery", "whereClause", "whereFields",
-	"whereField", "limitClause", "orderClause", "bindVariable", "soqlValue",
-	"withClause", "soqlFilteringExpression", "groupClause", "havingConditionExpression",
-	"offsetClause", "viewClause", "soslLiteral", "soslQuery", "soslReturningObject",
-	"apexIdentifier", "typeIdentifier",
+	"finallyBlock", "whenStatements", "whenStatement", "whenExpression", "whenValue",
+	"forControl", "forInit", "enhancedForControl", "forUpdate", "parExpression",
+	"expressionList", "statementExpression", "constantExpression", "apexDbExpressionShort",
+	"apexDbExpression", "expression", "primary", "creator", "createdName",
+	"innerCreator", "arrayCreatorRest", "mapCreatorRest", "mapKey", "mapValue",
+	"setCreatorRest", "setValue", "classCreatorRest", "explicitGenericInvocation",
+	"nonWildcardTypeArguments", "typeArgumentsOrDiamond", "nonWildcardTypeArgumentsOrDiamond",
+	"superSuffix", "explicitGenericInvocationSuffix", "arguments", "soqlLiteral",
+	"query", "selectClause", "fieldList", "selectField", "fromClause", "filterScope",
+	"soqlField", "subquery", "whereClause", "whereFields", "whereField", "soqlOperator",
+	"limitClause", "orderClause", "orderField", "bindVariable", "soqlValue",
+	"soqlValueList", "withClause", "soqlFilteringExpression", "groupClause",
+	"havingConditionExpression", "offsetClause", "viewClause", "allRowsClause",
+	"soslLiteral", "soslQuery", "soslReturningObject", "apexIdentifier", "typeIdentifier",
 }
 var decisionToDFA = make([]*antlr.DFA, len(deserializedATN.DecisionToState))
 
@@ -920,86 +959,89 @@ const (
 	apexParserROLLUP                = 82
 	apexParserCUBE                  = 83
 	apexParserLIKE                  = 84
-	apexParserTHEN                  = 85
-	apexParserINSERT                = 86
-	apexParserUPSERT                = 87
-	apexParserUPDATE                = 88
-	apexParserDELETE                = 89
-	apexParserUNDELETE              = 90
-	apexParserSOQL_AND              = 91
-	apexParserSOQL_OR               = 92
-	apexParserSOQL_NOT              = 93
-	apexParserFIND                  = 94
-	apexParserFIELDS                = 95
-	apexParserRETURNING             = 96
-	apexParserALL                   = 97
-	apexParserTESTMETHOD            = 98
-	apexParserTRIGGER               = 99
-	apexParserON                    = 100
-	apexParserBEFORE                = 101
-	apexParserAFTER                 = 102
-	apexParserRUNAS                 = 103
-	apexParserSYSTEM                = 104
-	apexParserIntegerLiteral        = 105
-	apexParserFloatingPointLiteral  = 106
-	apexParserBooleanLiteral        = 107
-	apexParserStringLiteral         = 108
-	apexParserNullLiteral           = 109
-	apexParserLPAREN                = 110
-	apexParserRPAREN                = 111
-	apexParserLBRACE                = 112
-	apexParserRBRACE                = 113
-	apexParserLBRACK                = 114
-	apexParserRBRACK                = 115
-	apexParserSEMI                  = 116
-	apexParserCOMMA                 = 117
-	apexParserDOT                   = 118
-	apexParserASSIGN                = 119
-	apexParserGT                    = 120
-	apexParserLT                    = 121
-	apexParserBANG                  = 122
-	apexParserTILDE                 = 123
-	apexParserQUESTION              = 124
-	apexParserCOLON                 = 125
-	apexParserEQUAL                 = 126
-	apexParserT_EQUAL               = 127
-	apexParserLE                    = 128
-	apexParserGE                    = 129
-	apexParserNOTEQUAL              = 130
-	apexParserAND                   = 131
-	apexParserOR                    = 132
-	apexParserINC                   = 133
-	apexParserDEC                   = 134
-	apexParserADD                   = 135
-	apexParserSUB                   = 136
-	apexParserMUL                   = 137
-	apexParserDIV                   = 138
-	apexParserBITAND                = 139
-	apexParserBITOR                 = 140
-	apexParserCARET                 = 141
-	apexParserMOD                   = 142
-	apexParserADD_ASSIGN            = 143
-	apexParserSUB_ASSIGN            = 144
-	apexParserMUL_ASSIGN            = 145
-	apexParserDIV_ASSIGN            = 146
-	apexParserAND_ASSIGN            = 147
-	apexParserOR_ASSIGN             = 148
-	apexParserXOR_ASSIGN            = 149
-	apexParserMOD_ASSIGN            = 150
-	apexParserLSHIFT_ASSIGN         = 151
-	apexParserRSHIFT_ASSIGN         = 152
-	apexParserURSHIFT_ASSIGN        = 153
-	apexParserLAMBDA_LIKE           = 154
-	apexParserIdentifier            = 155
-	apexParserAT                    = 156
-	apexParserELLIPSIS              = 157
-	apexParserWS                    = 158
-	apexParserAPEXDOC_COMMENT       = 159
-	apexParserAPEXDOC_COMMENT_START = 160
-	apexParserCOMMENT               = 161
-	apexParserCOMMENT_START         = 162
-	apexParserLINE_COMMENT          = 163
-	apexParserQUOTE                 = 164
+	apexParserINCLUDES              = 85
+	apexParserEXCLUDES              = 86
+	apexParserTHEN                  = 87
+	apexParserINSERT                = 88
+	apexParserUPSERT                = 89
+	apexParserUPDATE                = 90
+	apexParserDELETE                = 91
+	apexParserUNDELETE              = 92
+	apexParserSOQL_AND              = 93
+	apexParserSOQL_OR               = 94
+	apexParserSOQL_NOT              = 95
+	apexParserFIND                  = 96
+	apexParserFIELDS                = 97
+	apexParserRETURNING             = 98
+	apexParserALL                   = 99
+	apexParserROWS                  = 100
+	apexParserTESTMETHOD            = 101
+	apexParserTRIGGER               = 102
+	apexParserON                    = 103
+	apexParserBEFORE                = 104
+	apexParserAFTER                 = 105
+	apexParserRUNAS                 = 106
+	apexParserSYSTEM                = 107
+	apexParserIntegerLiteral        = 108
+	apexParserFloatingPointLiteral  = 109
+	apexParserBooleanLiteral        = 110
+	apexParserStringLiteral         = 111
+	apexParserNullLiteral           = 112
+	apexParserLPAREN                = 113
+	apexParserRPAREN                = 114
+	apexParserLBRACE                = 115
+	apexParserRBRACE                = 116
+	apexParserLBRACK                = 117
+	apexParserRBRACK                = 118
+	apexParserSEMI                  = 119
+	apexParserCOMMA                 = 120
+	apexParserDOT                   = 121
+	apexParserASSIGN                = 122
+	apexParserGT                    = 123
+	apexParserLT                    = 124
+	apexParserBANG                  = 125
+	apexParserTILDE                 = 126
+	apexParserQUESTION              = 127
+	apexParserCOLON                 = 128
+	apexParserEQUAL                 = 129
+	apexParserT_EQUAL               = 130
+	apexParserLE                    = 131
+	apexParserGE                    = 132
+	apexParserNOTEQUAL              = 133
+	apexParserAND                   = 134
+	apexParserOR                    = 135
+	apexParserINC                   = 136
+	apexParserDEC                   = 137
+	apexParserADD                   = 138
+	apexParserSUB                   = 139
+	apexParserMUL                   = 140
+	apexParserDIV                   = 141
+	apexParserBITAND                = 142
+	apexParserBITOR                 = 143
+	apexParserCARET                 = 144
+	apexParserMOD                   = 145
+	apexParserADD_ASSIGN            = 146
+	apexParserSUB_ASSIGN            = 147
+	apexParserMUL_ASSIGN            = 148
+	apexParserDIV_ASSIGN            = 149
+	apexParserAND_ASSIGN            = 150
+	apexParserOR_ASSIGN             = 151
+	apexParserXOR_ASSIGN            = 152
+	apexParserMOD_ASSIGN            = 153
+	apexParserLSHIFT_ASSIGN         = 154
+	apexParserRSHIFT_ASSIGN         = 155
+	apexParserURSHIFT_ASSIGN        = 156
+	apexParserLAMBDA_LIKE           = 157
+	apexParserIdentifier            = 158
+	apexParserAT                    = 159
+	apexParserELLIPSIS              = 160
+	apexParserWS                    = 161
+	apexParserAPEXDOC_COMMENT       = 162
+	apexParserAPEXDOC_COMMENT_START = 163
+	apexParserCOMMENT               = 164
+	apexParserCOMMENT_START         = 165
+	apexParserLINE_COMMENT          = 166
+	apexParserQUOTE                 = 167
 )
 
 // apexParser rules.
@@ -1074,62 +1116,67 @@ const (
 	apexParserRULE_whenStatements                    = 67
 	apexParserRULE_whenStatement                     = 68
 	apexParserRULE_whenExpression                    = 69
-	apexParserRULE_forControl                        = 70
-	apexParserRULE_forInit                           = 71
-	apexParserRULE_enhancedForControl                = 72
-	apexParserRULE_forUpdate                         = 73
-	apexParserRULE_parExpression                     = 74
-	apexParserRULE_expressionList                    = 75
-	apexParserRULE_statementExpression               = 76
-	apexParserRULE_constantExpression                = 77
-	apexParserRULE_apexDbExpressionShort             = 78
-	apexParserRULE_apexDbExpression                  = 79
-	apexParserRULE_expression                        = 80
-	apexParserRULE_primary                           = 81
-	apexParserRULE_creator                           = 82
-	apexParserRULE_createdName                       = 83
-	apexParserRULE_innerCreator                      = 84
-	apexParserRULE_arrayCreatorRest                  = 85
-	apexParserRULE_mapCreatorRest                    = 86
-	apexParserRULE_mapKey                            = 87
-	apexParserRULE_mapValue                          = 88
-	apexParserRULE_setCreatorRest                    = 89
-	apexParserRULE_setValue                          = 90
-	apexParserRULE_classCreatorRest                  = 91
-	apexParserRULE_explicitGenericInvocation         = 92
-	apexParserRULE_nonWildcardTypeArguments          = 93
-	apexParserRULE_typeArgumentsOrDiamond            = 94
-	apexParserRULE_nonWildcardTypeArgumentsOrDiamond = 95
-	apexParserRULE_superSuffix                       = 96
-	apexParserRULE_explicitGenericInvocationSuffix   = 97
-	apexParserRULE_arguments                         = 98
-	apexParserRULE_soqlLiteral                       = 99
-	apexParserRULE_query                             = 100
-	apexParserRULE_selectClause                      = 101
-	apexParserRULE_fieldList                         = 102
-	apexParserRULE_selectField                       = 103
-	apexParserRULE_fromClause                        = 104
-	apexParserRULE_filterScope                       = 105
-	apexParserRULE_soqlField                         = 106
-	apexParserRULE_subquery                          = 107
-	apexParserRULE_whereClause                       = 108
-	apexParserRULE_whereFields                       = 109
-	apexParserRULE_whereField                        = 110
-	apexParserRULE_limitClause                       = 111
-	apexParserRULE_orderClause                       = 112
-	apexParserRULE_bindVariable                      = 113
-	apexParserRULE_soqlValue                         = 114
-	apexParserRULE_withClause                        = 115
-	apexParserRULE_soqlFilteringExpression           = 116
-	apexParserRULE_groupClause                       = 117
-	apexParserRULE_havingConditionExpression         = 118
-	apexParserRULE_offsetClause                      = 119
-	apexParserRULE_viewClause                        = 120
-	apexParserRULE_soslLiteral                       = 121
-	apexParserRULE_soslQuery                         = 122
-	apexParserRULE_soslReturningObject               = 123
-	apexParserRULE_apexIdentifier                    = 124
-	apexParserRULE_typeIdentifier                    = 125
+	apexParserRULE_whenValue                         = 70
+	apexParserRULE_forControl                        = 71
+	apexParserRULE_forInit                           = 72
+	apexParserRULE_enhancedForControl                = 73
+	apexParserRULE_forUpdate                         = 74
+	apexParserRULE_parExpression                     = 75
+	apexParserRULE_expressionList                    = 76
+	apexParserRULE_statementExpression               = 77
+	apexParserRULE_constantExpression                = 78
+	apexParserRULE_apexDbExpressionShort             = 79
+	apexParserRULE_apexDbExpression                  = 80
+	apexParserRULE_expression                        = 81
+	apexParserRULE_primary                           = 82
+	apexParserRULE_creator                           = 83
+	apexParserRULE_createdName                       = 84
+	apexParserRULE_innerCreator                      = 85
+	apexParserRULE_arrayCreatorRest                  = 86
+	apexParserRULE_mapCreatorRest                    = 87
+	apexParserRULE_mapKey                            = 88
+	apexParserRULE_mapValue                          = 89
+	apexParserRULE_setCreatorRest                    = 90
+	apexParserRULE_setValue                          = 91
+	apexParserRULE_classCreatorRest                  = 92
+	apexParserRULE_explicitGenericInvocation         = 93
+	apexParserRULE_nonWildcardTypeArguments          = 94
+	apexParserRULE_typeArgumentsOrDiamond            = 95
+	apexParserRULE_nonWildcardTypeArgumentsOrDiamond = 96
+	apexParserRULE_superSuffix                       = 97
+	apexParserRULE_explicitGenericInvocationSuffix   = 98
+	apexParserRULE_arguments                         = 99
+	apexParserRULE_soqlLiteral                       = 100
+	apexParserRULE_query                             = 101
+	apexParserRULE_selectClause                      = 102
+	apexParserRULE_fieldList                         = 103
+	apexParserRULE_selectField                       = 104
+	apexParserRULE_fromClause                        = 105
+	apexParserRULE_filterScope                       = 106
+	apexParserRULE_soqlField                         = 107
+	apexParserRULE_subquery                          = 108
+	apexParserRULE_whereClause                       = 109
+	apexParserRULE_whereFields                       = 110
+	apexParserRULE_whereField                        = 111
+	apexParserRULE_soqlOperator                      = 112
+	apexParserRULE_limitClause                       = 113
+	apexParserRULE_orderClause                       = 114
+	apexParserRULE_orderField                        = 115
+	apexParserRULE_bindVariable                      = 116
+	apexParserRULE_soqlValue                         = 117
+	apexParserRULE_soqlValueList                     = 118
+	apexParserRULE_withClause                        = 119
+	apexParserRULE_soqlFilteringExpression           = 120
+	apexParserRULE_groupClause                       = 121
+	apexParserRULE_havingConditionExpression         = 122
+	apexParserRULE_offsetClause                      = 123
+	apexParserRULE_viewClause                        = 124
+	apexParserRULE_allRowsClause                     = 125
+	apexParserRULE_soslLiteral                       = 126
+	apexParserRULE_soslQuery                         = 127
+	apexParserRULE_soslReturningObject               = 128
+	apexParserRULE_apexIdentifier                    = 129
+	apexParserRULE_typeIdentifier                    = 130
 )
 
 // ICompilationUnitContext is an interface to support dynamic dispatch.
@@ -1236,11 +1283,11 @@ func (p *apexParser) CompilationUnit() (localctx ICompilationUnitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(252)
+		p.SetState(262)
 		p.TypeDeclaration()
 	}
 	{
-		p.SetState(253)
+		p.SetState(263)
 		p.Match(apexParserEOF)
 	}
 
@@ -1399,83 +1446,83 @@ func (p *apexParser) TypeDeclaration() (localctx ITypeDeclarationContext) {
 		}
 	}()
 
-	p.SetState(278)
+	p.SetState(288)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
-		p.SetState(258)
+		p.SetState(268)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserOVERRIDE)|(1<<apexParserVIRTUAL)|(1<<apexParserABSTRACT)|(1<<apexParserFINAL))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(apexParserPRIVATE-35))|(1<<(apexParserPROTECTED-35))|(1<<(apexParserPUBLIC-35))|(1<<(apexParserSTATIC-35))|(1<<(apexParserGLOBAL-35))|(1<<(apexParserWEBSERVICE-35))|(1<<(apexParserAPEX_WITH_SHARING-35))|(1<<(apexParserAPEX_WITHOUT_SHARING-35)))) != 0) || _la == apexParserTESTMETHOD || _la == apexParserAT {
 			{
-				p.SetState(255)
+				p.SetState(265)
 				p.ClassOrInterfaceModifier()
 			}
 
-			p.SetState(260)
+			p.SetState(270)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(261)
+			p.SetState(271)
 			p.ClassDeclaration()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(265)
+		p.SetState(275)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserOVERRIDE)|(1<<apexParserVIRTUAL)|(1<<apexParserABSTRACT)|(1<<apexParserFINAL))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(apexParserPRIVATE-35))|(1<<(apexParserPROTECTED-35))|(1<<(apexParserPUBLIC-35))|(1<<(apexParserSTATIC-35))|(1<<(apexParserGLOBAL-35))|(1<<(apexParserWEBSERVICE-35))|(1<<(apexParserAPEX_WITH_SHARING-35))|(1<<(apexParserAPEX_WITHOUT_SHARING-35)))) != 0) || _la == apexParserTESTMETHOD || _la == apexParserAT {
 			{
-				p.SetState(262)
+				p.SetState(272)
 				p.ClassOrInterfaceModifier()
 			}
 
-			p.SetState(267)
+			p.SetState(277)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(268)
+			p.SetState(278)
 			p.EnumDeclaration()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
-		p.SetState(272)
+		p.SetState(282)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserOVERRIDE)|(1<<apexParserVIRTUAL)|(1<<apexParserABSTRACT)|(1<<apexParserFINAL))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(apexParserPRIVATE-35))|(1<<(apexParserPROTECTED-35))|(1<<(apexParserPUBLIC-35))|(1<<(apexParserSTATIC-35))|(1<<(apexParserGLOBAL-35))|(1<<(apexParserWEBSERVICE-35))|(1<<(apexParserAPEX_WITH_SHARING-35))|(1<<(apexParserAPEX_WITHOUT_SHARING-35)))) != 0) || _la == apexParserTESTMETHOD || _la == apexParserAT {
 			{
-				p.SetState(269)
+				p.SetState(279)
 				p.ClassOrInterfaceModifier()
 			}
 
-			p.SetState(274)
+			p.SetState(284)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(275)
+			p.SetState(285)
 			p.InterfaceDeclaration()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(276)
+			p.SetState(286)
 			p.TriggerDeclaration()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(277)
+			p.SetState(287)
 			p.Match(apexParserSEMI)
 		}
 
@@ -1625,35 +1672,35 @@ func (p *apexParser) TriggerDeclaration() (localctx ITriggerDeclarationContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(280)
+		p.SetState(290)
 		p.Match(apexParserTRIGGER)
 	}
 	{
-		p.SetState(281)
+		p.SetState(291)
 		p.ApexIdentifier()
 	}
 	{
-		p.SetState(282)
+		p.SetState(292)
 		p.Match(apexParserON)
 	}
 	{
-		p.SetState(283)
+		p.SetState(293)
 		p.ApexIdentifier()
 	}
 	{
-		p.SetState(284)
+		p.SetState(294)
 		p.Match(apexParserLPAREN)
 	}
 	{
-		p.SetState(285)
+		p.SetState(295)
 		p.TriggerTimings()
 	}
 	{
-		p.SetState(286)
+		p.SetState(296)
 		p.Match(apexParserRPAREN)
 	}
 	{
-		p.SetState(287)
+		p.SetState(297)
 		p.Block()
 	}
 
@@ -1774,24 +1821,24 @@ func (p *apexParser) TriggerTimings() (localctx ITriggerTimingsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(289)
+		p.SetState(299)
 		p.TriggerTiming()
 	}
-	p.SetState(294)
+	p.SetState(304)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserCOMMA {
 		{
-			p.SetState(290)
+			p.SetState(300)
 			p.Match(apexParserCOMMA)
 		}
 		{
-			p.SetState(291)
+			p.SetState(301)
 			p.TriggerTiming()
 		}
 
-		p.SetState(296)
+		p.SetState(306)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -1940,7 +1987,7 @@ func (p *apexParser) TriggerTiming() (localctx ITriggerTimingContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(297)
+		p.SetState(307)
 
 		var _lt = p.GetTokenStream().LT(1)
 
@@ -1958,7 +2005,7 @@ func (p *apexParser) TriggerTiming() (localctx ITriggerTimingContext) {
 		}
 	}
 	{
-		p.SetState(298)
+		p.SetState(308)
 
 		var _lt = p.GetTokenStream().LT(1)
 
@@ -1966,7 +2013,7 @@ func (p *apexParser) TriggerTiming() (localctx ITriggerTimingContext) {
 
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la-86)&-(0x1f+1)) == 0 && ((1<<uint((_la-86)))&((1<<(apexParserINSERT-86))|(1<<(apexParserUPSERT-86))|(1<<(apexParserUPDATE-86))|(1<<(apexParserDELETE-86))|(1<<(apexParserUNDELETE-86)))) != 0) {
+		if !(((_la-88)&-(0x1f+1)) == 0 && ((1<<uint((_la-88)))&((1<<(apexParserINSERT-88))|(1<<(apexParserUPSERT-88))|(1<<(apexParserUPDATE-88))|(1<<(apexParserDELETE-88))|(1<<(apexParserUNDELETE-88)))) != 0) {
 			var _ri = p.GetErrorHandler().RecoverInline(p)
 
 			localctx.(*TriggerTimingContext).dml = _ri
@@ -2081,21 +2128,21 @@ func (p *apexParser) Modifier() (localctx IModifierContext) {
 		}
 	}()
 
-	p.SetState(302)
+	p.SetState(312)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserOVERRIDE, apexParserVIRTUAL, apexParserABSTRACT, apexParserFINAL, apexParserPRIVATE, apexParserPROTECTED, apexParserPUBLIC, apexParserSTATIC, apexParserGLOBAL, apexParserWEBSERVICE, apexParserAPEX_WITH_SHARING, apexParserAPEX_WITHOUT_SHARING, apexParserTESTMETHOD, apexParserAT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(300)
+			p.SetState(310)
 			p.ClassOrInterfaceModifier()
 		}
 
 	case apexParserTRANSIENT:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(301)
+			p.SetState(311)
 			p.Match(apexParserTRANSIENT)
 		}
 
@@ -2257,21 +2304,21 @@ func (p *apexParser) ClassOrInterfaceModifier() (localctx IClassOrInterfaceModif
 		}
 	}()
 
-	p.SetState(306)
+	p.SetState(316)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserAT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(304)
+			p.SetState(314)
 			p.Annotation()
 		}
 
 	case apexParserOVERRIDE, apexParserVIRTUAL, apexParserABSTRACT, apexParserFINAL, apexParserPRIVATE, apexParserPROTECTED, apexParserPUBLIC, apexParserSTATIC, apexParserGLOBAL, apexParserWEBSERVICE, apexParserAPEX_WITH_SHARING, apexParserAPEX_WITHOUT_SHARING, apexParserTESTMETHOD:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(305)
+			p.SetState(315)
 			_la = p.GetTokenStream().LA(1)
 
 			if !((((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserOVERRIDE)|(1<<apexParserVIRTUAL)|(1<<apexParserABSTRACT)|(1<<apexParserFINAL))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(apexParserPRIVATE-35))|(1<<(apexParserPROTECTED-35))|(1<<(apexParserPUBLIC-35))|(1<<(apexParserSTATIC-35))|(1<<(apexParserGLOBAL-35))|(1<<(apexParserWEBSERVICE-35))|(1<<(apexParserAPEX_WITH_SHARING-35))|(1<<(apexParserAPEX_WITHOUT_SHARING-35)))) != 0) || _la == apexParserTESTMETHOD) {
@@ -2391,21 +2438,21 @@ func (p *apexParser) VariableModifier() (localctx IVariableModifierContext) {
 		}
 	}()
 
-	p.SetState(310)
+	p.SetState(320)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserFINAL:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(308)
+			p.SetState(318)
 			p.Match(apexParserFINAL)
 		}
 
 	case apexParserAT:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(309)
+			p.SetState(319)
 			p.Annotation()
 		}
 
@@ -2559,45 +2606,45 @@ func (p *apexParser) ClassDeclaration() (localctx IClassDeclarationContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(312)
+		p.SetState(322)
 		p.Match(apexParserCLASS)
 	}
 	{
-		p.SetState(313)
+		p.SetState(323)
 		p.ApexIdentifier()
 	}
-	p.SetState(316)
+	p.SetState(326)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserEXTENDS {
 		{
-			p.SetState(314)
+			p.SetState(324)
 			p.Match(apexParserEXTENDS)
 		}
 		{
-			p.SetState(315)
+			p.SetState(325)
 			p.ApexType()
 		}
 
 	}
-	p.SetState(320)
+	p.SetState(330)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserIMPLEMENTS {
 		{
-			p.SetState(318)
+			p.SetState(328)
 			p.Match(apexParserIMPLEMENTS)
 		}
 		{
-			p.SetState(319)
+			p.SetState(329)
 			p.TypeList()
 		}
 
 	}
 	{
-		p.SetState(322)
+		p.SetState(332)
 		p.ClassBody()
 	}
 
@@ -2743,67 +2790,67 @@ func (p *apexParser) EnumDeclaration() (localctx IEnumDeclarationContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(324)
+		p.SetState(334)
 		p.Match(apexParserENUM)
 	}
 	{
-		p.SetState(325)
+		p.SetState(335)
 		p.ApexIdentifier()
 	}
-	p.SetState(328)
+	p.SetState(338)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserIMPLEMENTS {
 		{
-			p.SetState(326)
+			p.SetState(336)
 			p.Match(apexParserIMPLEMENTS)
 		}
 		{
-			p.SetState(327)
+			p.SetState(337)
 			p.TypeList()
 		}
 
 	}
 	{
-		p.SetState(330)
+		p.SetState(340)
 		p.Match(apexParserLBRACE)
 	}
-	p.SetState(332)
+	p.SetState(342)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || _la == apexParserRUNAS || _la == apexParserSYSTEM || _la == apexParserIdentifier || _la == apexParserAT {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserINCLUDES-66))|(1<<(apexParserEXCLUDES-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66)))) != 0) || (((_la-98)&-(0x1f+1)) == 0 && ((1<<uint((_la-98)))&((1<<(apexParserRETURNING-98))|(1<<(apexParserALL-98))|(1<<(apexParserROWS-98))|(1<<(apexParserRUNAS-98))|(1<<(apexParserSYSTEM-98)))) != 0) || _la == apexParserIdentifier || _la == apexParserAT {
 		{
-			p.SetState(331)
+			p.SetState(341)
 			p.EnumConstants()
 		}
 
 	}
-	p.SetState(335)
+	p.SetState(345)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserCOMMA {
 		{
-			p.SetState(334)
+			p.SetState(344)
 			p.Match(apexParserCOMMA)
 		}
 
 	}
-	p.SetState(338)
+	p.SetState(348)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserSEMI {
 		{
-			p.SetState(337)
+			p.SetState(347)
 			p.EnumBodyDeclarations()
 		}
 
 	}
 	{
-		p.SetState(340)
+		p.SetState(350)
 		p.Match(apexParserRBRACE)
 	}
 
@@ -2925,26 +2972,26 @@ func (p *apexParser) EnumConstants() (localctx IEnumConstantsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(342)
+		p.SetState(352)
 		p.EnumConstant()
 	}
-	p.SetState(347)
+	p.SetState(357)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 14, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(343)
+				p.SetState(353)
 				p.Match(apexParserCOMMA)
 			}
 			{
-				p.SetState(344)
+				p.SetState(354)
 				p.EnumConstant()
 			}
 
 		}
-		p.SetState(349)
+		p.SetState(359)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 14, p.GetParserRuleContext())
 	}
@@ -3095,42 +3142,42 @@ func (p *apexParser) EnumConstant() (localctx IEnumConstantContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(353)
+	p.SetState(363)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserAT {
 		{
-			p.SetState(350)
+			p.SetState(360)
 			p.Annotation()
 		}
 
-		p.SetState(355)
+		p.SetState(365)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(356)
+		p.SetState(366)
 		p.ApexIdentifier()
 	}
-	p.SetState(358)
+	p.SetState(368)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserLPAREN {
 		{
-			p.SetState(357)
+			p.SetState(367)
 			p.Arguments()
 		}
 
 	}
-	p.SetState(361)
+	p.SetState(371)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserLBRACE {
 		{
-			p.SetState(360)
+			p.SetState(370)
 			p.ClassBody()
 		}
 
@@ -3253,20 +3300,20 @@ func (p *apexParser) EnumBodyDeclarations() (localctx IEnumBodyDeclarationsConte
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(363)
+		p.SetState(373)
 		p.Match(apexParserSEMI)
 	}
-	p.SetState(367)
+	p.SetState(377)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserOVERRIDE)|(1<<apexParserVIRTUAL)|(1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserABSTRACT)|(1<<apexParserBOOLEAN)|(1<<apexParserCLASS)|(1<<apexParserDOUBLE)|(1<<apexParserENUM)|(1<<apexParserFINAL)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserINTERFACE)|(1<<apexParserLONG))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(apexParserPRIVATE-35))|(1<<(apexParserPROTECTED-35))|(1<<(apexParserPUBLIC-35))|(1<<(apexParserSTATIC-35))|(1<<(apexParserTRANSIENT-35))|(1<<(apexParserVOID-35))|(1<<(apexParserGLOBAL-35))|(1<<(apexParserWEBSERVICE-35))|(1<<(apexParserAPEX_WITH_SHARING-35))|(1<<(apexParserAPEX_WITHOUT_SHARING-35))|(1<<(apexParserREFERENCE-35)))) != 0) || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(apexParserOFFSET-70))|(1<<(apexParserDATA-70))|(1<<(apexParserCATEGORY-70))|(1<<(apexParserGROUP-70))|(1<<(apexParserSCOPE-70))|(1<<(apexParserTHEN-70))|(1<<(apexParserINSERT-70))|(1<<(apexParserUPSERT-70))|(1<<(apexParserUPDATE-70))|(1<<(apexParserDELETE-70))|(1<<(apexParserUNDELETE-70))|(1<<(apexParserFIND-70))|(1<<(apexParserFIELDS-70))|(1<<(apexParserRETURNING-70))|(1<<(apexParserALL-70))|(1<<(apexParserTESTMETHOD-70)))) != 0) || (((_la-103)&-(0x1f+1)) == 0 && ((1<<uint((_la-103)))&((1<<(apexParserRUNAS-103))|(1<<(apexParserSYSTEM-103))|(1<<(apexParserLBRACE-103))|(1<<(apexParserSEMI-103)))) != 0) || _la == apexParserIdentifier || _la == apexParserAT {
+	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserOVERRIDE)|(1<<apexParserVIRTUAL)|(1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserABSTRACT)|(1<<apexParserBOOLEAN)|(1<<apexParserCLASS)|(1<<apexParserDOUBLE)|(1<<apexParserENUM)|(1<<apexParserFINAL)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserINTERFACE)|(1<<apexParserLONG))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(apexParserPRIVATE-35))|(1<<(apexParserPROTECTED-35))|(1<<(apexParserPUBLIC-35))|(1<<(apexParserSTATIC-35))|(1<<(apexParserTRANSIENT-35))|(1<<(apexParserVOID-35))|(1<<(apexParserGLOBAL-35))|(1<<(apexParserWEBSERVICE-35))|(1<<(apexParserAPEX_WITH_SHARING-35))|(1<<(apexParserAPEX_WITHOUT_SHARING-35))|(1<<(apexParserREFERENCE-35)))) != 0) || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(apexParserOFFSET-70))|(1<<(apexParserDATA-70))|(1<<(apexParserCATEGORY-70))|(1<<(apexParserGROUP-70))|(1<<(apexParserSCOPE-70))|(1<<(apexParserINCLUDES-70))|(1<<(apexParserEXCLUDES-70))|(1<<(apexParserTHEN-70))|(1<<(apexParserINSERT-70))|(1<<(apexParserUPSERT-70))|(1<<(apexParserUPDATE-70))|(1<<(apexParserDELETE-70))|(1<<(apexParserUNDELETE-70))|(1<<(apexParserFIND-70))|(1<<(apexParserFIELDS-70))|(1<<(apexParserRETURNING-70))|(1<<(apexParserALL-70))|(1<<(apexParserROWS-70))|(1<<(apexParserTESTMETHOD-70)))) != 0) || (((_la-106)&-(0x1f+1)) == 0 && ((1<<uint((_la-106)))&((1<<(apexParserRUNAS-106))|(1<<(apexParserSYSTEM-106))|(1<<(apexParserLBRACE-106))|(1<<(apexParserSEMI-106)))) != 0) || _la == apexParserIdentifier || _la == apexParserAT {
 		{
-			p.SetState(364)
+			p.SetState(374)
 			p.ClassBodyDeclaration()
 		}
 
-		p.SetState(369)
+		p.SetState(379)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -3388,15 +3435,15 @@ func (p *apexParser) InterfaceDeclaration() (localctx IInterfaceDeclarationConte
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(370)
+		p.SetState(380)
 		p.Match(apexParserINTERFACE)
 	}
 	{
-		p.SetState(371)
+		p.SetState(381)
 		p.ApexIdentifier()
 	}
 	{
-		p.SetState(372)
+		p.SetState(382)
 		p.InterfaceBody()
 	}
 
@@ -3517,24 +3564,24 @@ func (p *apexParser) TypeList() (localctx ITypeListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(374)
+		p.SetState(384)
 		p.ApexType()
 	}
-	p.SetState(379)
+	p.SetState(389)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserCOMMA {
 		{
-			p.SetState(375)
+			p.SetState(385)
 			p.Match(apexParserCOMMA)
 		}
 		{
-			p.SetState(376)
+			p.SetState(386)
 			p.ApexType()
 		}
 
-		p.SetState(381)
+		p.SetState(391)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -3656,25 +3703,25 @@ func (p *apexParser) ClassBody() (localctx IClassBodyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(382)
+		p.SetState(392)
 		p.Match(apexParserLBRACE)
 	}
-	p.SetState(386)
+	p.SetState(396)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserOVERRIDE)|(1<<apexParserVIRTUAL)|(1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserABSTRACT)|(1<<apexParserBOOLEAN)|(1<<apexParserCLASS)|(1<<apexParserDOUBLE)|(1<<apexParserENUM)|(1<<apexParserFINAL)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserINTERFACE)|(1<<apexParserLONG))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(apexParserPRIVATE-35))|(1<<(apexParserPROTECTED-35))|(1<<(apexParserPUBLIC-35))|(1<<(apexParserSTATIC-35))|(1<<(apexParserTRANSIENT-35))|(1<<(apexParserVOID-35))|(1<<(apexParserGLOBAL-35))|(1<<(apexParserWEBSERVICE-35))|(1<<(apexParserAPEX_WITH_SHARING-35))|(1<<(apexParserAPEX_WITHOUT_SHARING-35))|(1<<(apexParserREFERENCE-35)))) != 0) || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(apexParserOFFSET-70))|(1<<(apexParserDATA-70))|(1<<(apexParserCATEGORY-70))|(1<<(apexParserGROUP-70))|(1<<(apexParserSCOPE-70))|(1<<(apexParserTHEN-70))|(1<<(apexParserINSERT-70))|(1<<(apexParserUPSERT-70))|(1<<(apexParserUPDATE-70))|(1<<(apexParserDELETE-70))|(1<<(apexParserUNDELETE-70))|(1<<(apexParserFIND-70))|(1<<(apexParserFIELDS-70))|(1<<(apexParserRETURNING-70))|(1<<(apexParserALL-70))|(1<<(apexParserTESTMETHOD-70)))) != 0) || (((_la-103)&-(0x1f+1)) == 0 && ((1<<uint((_la-103)))&((1<<(apexParserRUNAS-103))|(1<<(apexParserSYSTEM-103))|(1<<(apexParserLBRACE-103))|(1<<(apexParserSEMI-103)))) != 0) || _la == apexParserIdentifier || _la == apexParserAT {
+	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserOVERRIDE)|(1<<apexParserVIRTUAL)|(1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserABSTRACT)|(1<<apexParserBOOLEAN)|(1<<apexParserCLASS)|(1<<apexParserDOUBLE)|(1<<apexParserENUM)|(1<<apexParserFINAL)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserINTERFACE)|(1<<apexParserLONG))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(apexParserPRIVATE-35))|(1<<(apexParserPROTECTED-35))|(1<<(apexParserPUBLIC-35))|(1<<(apexParserSTATIC-35))|(1<<(apexParserTRANSIENT-35))|(1<<(apexParserVOID-35))|(1<<(apexParserGLOBAL-35))|(1<<(apexParserWEBSERVICE-35))|(1<<(apexParserAPEX_WITH_SHARING-35))|(1<<(apexParserAPEX_WITHOUT_SHARING-35))|(1<<(apexParserREFERENCE-35)))) != 0) || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(apexParserOFFSET-70))|(1<<(apexParserDATA-70))|(1<<(apexParserCATEGORY-70))|(1<<(apexParserGROUP-70))|(1<<(apexParserSCOPE-70))|(1<<(apexParserINCLUDES-70))|(1<<(apexParserEXCLUDES-70))|(1<<(apexParserTHEN-70))|(1<<(apexParserINSERT-70))|(1<<(apexParserUPSERT-70))|(1<<(apexParserUPDATE-70))|(1<<(apexParserDELETE-70))|(1<<(apexParserUNDELETE-70))|(1<<(apexParserFIND-70))|(1<<(apexParserFIELDS-70))|(1<<(apexParserRETURNING-70))|(1<<(apexParserALL-70))|(1<<(apexParserROWS-70))|(1<<(apexParserTESTMETHOD-70)))) != 0) || (((_la-106)&-(0x1f+1)) == 0 && ((1<<uint((_la-106)))&((1<<(apexParserRUNAS-106))|(1<<(apexParserSYSTEM-106))|(1<<(apexParserLBRACE-106))|(1<<(apexParserSEMI-106)))) != 0) || _la == apexParserIdentifier || _la == apexParserAT {
 		{
-			p.SetState(383)
+			p.SetState(393)
 			p.ClassBodyDeclaration()
 		}
 
-		p.SetState(388)
+		p.SetState(398)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(389)
+		p.SetState(399)
 		p.Match(apexParserRBRACE)
 	}
 
@@ -3795,25 +3842,25 @@ func (p *apexParser) InterfaceBody() (localctx IInterfaceBodyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(391)
+		p.SetState(401)
 		p.Match(apexParserLBRACE)
 	}
-	p.SetState(395)
+	p.SetState(405)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserOVERRIDE)|(1<<apexParserVIRTUAL)|(1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserABSTRACT)|(1<<apexParserBOOLEAN)|(1<<apexParserCLASS)|(1<<apexParserDOUBLE)|(1<<apexParserENUM)|(1<<apexParserFINAL)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserINTERFACE)|(1<<apexParserLONG))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(apexParserPRIVATE-35))|(1<<(apexParserPROTECTED-35))|(1<<(apexParserPUBLIC-35))|(1<<(apexParserSTATIC-35))|(1<<(apexParserTRANSIENT-35))|(1<<(apexParserVOID-35))|(1<<(apexParserGLOBAL-35))|(1<<(apexParserWEBSERVICE-35))|(1<<(apexParserAPEX_WITH_SHARING-35))|(1<<(apexParserAPEX_WITHOUT_SHARING-35))|(1<<(apexParserREFERENCE-35)))) != 0) || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(apexParserOFFSET-70))|(1<<(apexParserDATA-70))|(1<<(apexParserCATEGORY-70))|(1<<(apexParserGROUP-70))|(1<<(apexParserSCOPE-70))|(1<<(apexParserTHEN-70))|(1<<(apexParserFIND-70))|(1<<(apexParserFIELDS-70))|(1<<(apexParserRETURNING-70))|(1<<(apexParserALL-70))|(1<<(apexParserTESTMETHOD-70)))) != 0) || _la == apexParserSYSTEM || _la == apexParserSEMI || _la == apexParserIdentifier || _la == apexParserAT {
+	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserOVERRIDE)|(1<<apexParserVIRTUAL)|(1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserABSTRACT)|(1<<apexParserBOOLEAN)|(1<<apexParserCLASS)|(1<<apexParserDOUBLE)|(1<<apexParserENUM)|(1<<apexParserFINAL)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserINTERFACE)|(1<<apexParserLONG))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(apexParserPRIVATE-35))|(1<<(apexParserPROTECTED-35))|(1<<(apexParserPUBLIC-35))|(1<<(apexParserSTATIC-35))|(1<<(apexParserTRANSIENT-35))|(1<<(apexParserVOID-35))|(1<<(apexParserGLOBAL-35))|(1<<(apexParserWEBSERVICE-35))|(1<<(apexParserAPEX_WITH_SHARING-35))|(1<<(apexParserAPEX_WITHOUT_SHARING-35))|(1<<(apexParserREFERENCE-35)))) != 0) || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(apexParserOFFSET-70))|(1<<(apexParserDATA-70))|(1<<(apexParserCATEGORY-70))|(1<<(apexParserGROUP-70))|(1<<(apexParserSCOPE-70))|(1<<(apexParserINCLUDES-70))|(1<<(apexParserEXCLUDES-70))|(1<<(apexParserTHEN-70))|(1<<(apexParserFIND-70))|(1<<(apexParserFIELDS-70))|(1<<(apexParserRETURNING-70))|(1<<(apexParserALL-70))|(1<<(apexParserROWS-70))|(1<<(apexParserTESTMETHOD-70)))) != 0) || _la == apexParserSYSTEM || _la == apexParserSEMI || _la == apexParserIdentifier || _la == apexParserAT {
 		{
-			p.SetState(392)
+			p.SetState(402)
 			p.InterfaceBodyDeclaration()
 		}
 
-		p.SetState(397)
+		p.SetState(407)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(398)
+		p.SetState(408)
 		p.Match(apexParserRBRACE)
 	}
 
@@ -3958,54 +4005,54 @@ func (p *apexParser) ClassBodyDeclaration() (localctx IClassBodyDeclarationConte
 
 	var _alt int
 
-	p.SetState(412)
+	p.SetState(422)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 24, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(400)
+			p.SetState(410)
 			p.Match(apexParserSEMI)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(402)
+		p.SetState(412)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == apexParserSTATIC {
 			{
-				p.SetState(401)
+				p.SetState(411)
 				p.Match(apexParserSTATIC)
 			}
 
 		}
 		{
-			p.SetState(404)
+			p.SetState(414)
 			p.Block()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
-		p.SetState(408)
+		p.SetState(418)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 23, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(405)
+					p.SetState(415)
 					p.Modifier()
 				}
 
 			}
-			p.SetState(410)
+			p.SetState(420)
 			p.GetErrorHandler().Sync(p)
 			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 23, p.GetParserRuleContext())
 		}
 		{
-			p.SetState(411)
+			p.SetState(421)
 			p.MemberDeclaration()
 		}
 
@@ -4172,55 +4219,55 @@ func (p *apexParser) MemberDeclaration() (localctx IMemberDeclarationContext) {
 		}
 	}()
 
-	p.SetState(421)
+	p.SetState(431)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 25, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(414)
+			p.SetState(424)
 			p.MethodDeclaration()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(415)
+			p.SetState(425)
 			p.FieldDeclaration()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(416)
+			p.SetState(426)
 			p.ConstructorDeclaration()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(417)
+			p.SetState(427)
 			p.InterfaceDeclaration()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(418)
+			p.SetState(428)
 			p.ClassDeclaration()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(419)
+			p.SetState(429)
 			p.EnumDeclaration()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(420)
+			p.SetState(430)
 			p.PropertyDeclaration()
 		}
 
@@ -4381,30 +4428,30 @@ func (p *apexParser) MethodDeclaration() (localctx IMethodDeclarationContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(424)
+	p.SetState(434)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserOVERRIDE {
 		{
-			p.SetState(423)
+			p.SetState(433)
 			p.Match(apexParserOVERRIDE)
 		}
 
 	}
-	p.SetState(428)
+	p.SetState(438)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserSYSTEM, apexParserIdentifier:
+	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserINCLUDES, apexParserEXCLUDES, apexParserTHEN, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserROWS, apexParserSYSTEM, apexParserIdentifier:
 		{
-			p.SetState(426)
+			p.SetState(436)
 			p.ApexType()
 		}
 
 	case apexParserVOID:
 		{
-			p.SetState(427)
+			p.SetState(437)
 			p.Match(apexParserVOID)
 		}
 
@@ -4412,59 +4459,59 @@ func (p *apexParser) MethodDeclaration() (localctx IMethodDeclarationContext) {
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(430)
+		p.SetState(440)
 		p.ApexIdentifier()
 	}
 	{
-		p.SetState(431)
+		p.SetState(441)
 		p.FormalParameters()
 	}
-	p.SetState(436)
+	p.SetState(446)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserLBRACK {
 		{
-			p.SetState(432)
+			p.SetState(442)
 			p.Match(apexParserLBRACK)
 		}
 		{
-			p.SetState(433)
+			p.SetState(443)
 			p.Match(apexParserRBRACK)
 		}
 
-		p.SetState(438)
+		p.SetState(448)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(441)
+	p.SetState(451)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserTHROWS {
 		{
-			p.SetState(439)
+			p.SetState(449)
 			p.Match(apexParserTHROWS)
 		}
 		{
-			p.SetState(440)
+			p.SetState(450)
 			p.QualifiedNameList()
 		}
 
 	}
-	p.SetState(445)
+	p.SetState(455)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserLBRACE:
 		{
-			p.SetState(443)
+			p.SetState(453)
 			p.MethodBody()
 		}
 
 	case apexParserSEMI:
 		{
-			p.SetState(444)
+			p.SetState(454)
 			p.Match(apexParserSEMI)
 		}
 
@@ -4610,30 +4657,30 @@ func (p *apexParser) ConstructorDeclaration() (localctx IConstructorDeclarationC
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(447)
+		p.SetState(457)
 		p.ApexIdentifier()
 	}
 	{
-		p.SetState(448)
+		p.SetState(458)
 		p.FormalParameters()
 	}
-	p.SetState(451)
+	p.SetState(461)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserTHROWS {
 		{
-			p.SetState(449)
+			p.SetState(459)
 			p.Match(apexParserTHROWS)
 		}
 		{
-			p.SetState(450)
+			p.SetState(460)
 			p.QualifiedNameList()
 		}
 
 	}
 	{
-		p.SetState(453)
+		p.SetState(463)
 		p.ConstructorBody()
 	}
 
@@ -4750,15 +4797,15 @@ func (p *apexParser) FieldDeclaration() (localctx IFieldDeclarationContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(455)
+		p.SetState(465)
 		p.ApexType()
 	}
 	{
-		p.SetState(456)
+		p.SetState(466)
 		p.VariableDeclarators()
 	}
 	{
-		p.SetState(457)
+		p.SetState(467)
 		p.Match(apexParserSEMI)
 	}
 
@@ -4885,15 +4932,15 @@ func (p *apexParser) PropertyDeclaration() (localctx IPropertyDeclarationContext
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(459)
+		p.SetState(469)
 		p.ApexType()
 	}
 	{
-		p.SetState(460)
+		p.SetState(470)
 		p.VariableDeclaratorId()
 	}
 	{
-		p.SetState(461)
+		p.SetState(471)
 		p.PropertyBodyDeclaration()
 	}
 
@@ -5014,26 +5061,26 @@ func (p *apexParser) PropertyBodyDeclaration() (localctx IPropertyBodyDeclaratio
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(463)
+		p.SetState(473)
 		p.Match(apexParserLBRACE)
 	}
 	{
-		p.SetState(464)
+		p.SetState(474)
 		p.PropertyBlock()
 	}
-	p.SetState(466)
+	p.SetState(476)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserOVERRIDE)|(1<<apexParserVIRTUAL)|(1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserABSTRACT)|(1<<apexParserFINAL))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(apexParserPRIVATE-35))|(1<<(apexParserPROTECTED-35))|(1<<(apexParserPUBLIC-35))|(1<<(apexParserSTATIC-35))|(1<<(apexParserTRANSIENT-35))|(1<<(apexParserGLOBAL-35))|(1<<(apexParserWEBSERVICE-35))|(1<<(apexParserAPEX_WITH_SHARING-35))|(1<<(apexParserAPEX_WITHOUT_SHARING-35)))) != 0) || _la == apexParserTESTMETHOD || _la == apexParserAT {
 		{
-			p.SetState(465)
+			p.SetState(475)
 			p.PropertyBlock()
 		}
 
 	}
 	{
-		p.SetState(468)
+		p.SetState(478)
 		p.Match(apexParserRBRACE)
 	}
 
@@ -5162,35 +5209,35 @@ func (p *apexParser) InterfaceBodyDeclaration() (localctx IInterfaceBodyDeclarat
 		}
 	}()
 
-	p.SetState(478)
+	p.SetState(488)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case apexParserOVERRIDE, apexParserVIRTUAL, apexParserSET, apexParserGET, apexParserABSTRACT, apexParserBOOLEAN, apexParserCLASS, apexParserDOUBLE, apexParserENUM, apexParserFINAL, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserINTERFACE, apexParserLONG, apexParserPRIVATE, apexParserPROTECTED, apexParserPUBLIC, apexParserSTATIC, apexParserTRANSIENT, apexParserVOID, apexParserGLOBAL, apexParserWEBSERVICE, apexParserAPEX_WITH_SHARING, apexParserAPEX_WITHOUT_SHARING, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserTESTMETHOD, apexParserSYSTEM, apexParserIdentifier, apexParserAT:
+	case apexParserOVERRIDE, apexParserVIRTUAL, apexParserSET, apexParserGET, apexParserABSTRACT, apexParserBOOLEAN, apexParserCLASS, apexParserDOUBLE, apexParserENUM, apexParserFINAL, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserINTERFACE, apexParserLONG, apexParserPRIVATE, apexParserPROTECTED, apexParserPUBLIC, apexParserSTATIC, apexParserTRANSIENT, apexParserVOID, apexParserGLOBAL, apexParserWEBSERVICE, apexParserAPEX_WITH_SHARING, apexParserAPEX_WITHOUT_SHARING, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserINCLUDES, apexParserEXCLUDES, apexParserTHEN, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserROWS, apexParserTESTMETHOD, apexParserSYSTEM, apexParserIdentifier, apexParserAT:
 		p.EnterOuterAlt(localctx, 1)
-		p.SetState(473)
+		p.SetState(483)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserOVERRIDE)|(1<<apexParserVIRTUAL)|(1<<apexParserABSTRACT)|(1<<apexParserFINAL))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(apexParserPRIVATE-35))|(1<<(apexParserPROTECTED-35))|(1<<(apexParserPUBLIC-35))|(1<<(apexParserSTATIC-35))|(1<<(apexParserTRANSIENT-35))|(1<<(apexParserGLOBAL-35))|(1<<(apexParserWEBSERVICE-35))|(1<<(apexParserAPEX_WITH_SHARING-35))|(1<<(apexParserAPEX_WITHOUT_SHARING-35)))) != 0) || _la == apexParserTESTMETHOD || _la == apexParserAT {
 			{
-				p.SetState(470)
+				p.SetState(480)
 				p.Modifier()
 			}
 
-			p.SetState(475)
+			p.SetState(485)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(476)
+			p.SetState(486)
 			p.InterfaceMemberDeclaration()
 		}
 
 	case apexParserSEMI:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(477)
+			p.SetState(487)
 			p.Match(apexParserSEMI)
 		}
 
@@ -5339,41 +5386,41 @@ func (p *apexParser) InterfaceMemberDeclaration() (localctx IInterfaceMemberDecl
 		}
 	}()
 
-	p.SetState(485)
+	p.SetState(495)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 35, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(480)
+			p.SetState(490)
 			p.ConstDeclaration()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(481)
+			p.SetState(491)
 			p.InterfaceMethodDeclaration()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(482)
+			p.SetState(492)
 			p.InterfaceDeclaration()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(483)
+			p.SetState(493)
 			p.ClassDeclaration()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(484)
+			p.SetState(494)
 			p.EnumDeclaration()
 		}
 
@@ -5506,33 +5553,33 @@ func (p *apexParser) ConstDeclaration() (localctx IConstDeclarationContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(487)
+		p.SetState(497)
 		p.ApexType()
 	}
 	{
-		p.SetState(488)
+		p.SetState(498)
 		p.ConstantDeclarator()
 	}
-	p.SetState(493)
+	p.SetState(503)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserCOMMA {
 		{
-			p.SetState(489)
+			p.SetState(499)
 			p.Match(apexParserCOMMA)
 		}
 		{
-			p.SetState(490)
+			p.SetState(500)
 			p.ConstantDeclarator()
 		}
 
-		p.SetState(495)
+		p.SetState(505)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(496)
+		p.SetState(506)
 		p.Match(apexParserSEMI)
 	}
 
@@ -5650,33 +5697,33 @@ func (p *apexParser) ConstantDeclarator() (localctx IConstantDeclaratorContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(498)
+		p.SetState(508)
 		p.ApexIdentifier()
 	}
-	p.SetState(503)
+	p.SetState(513)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserLBRACK {
 		{
-			p.SetState(499)
+			p.SetState(509)
 			p.Match(apexParserLBRACK)
 		}
 		{
-			p.SetState(500)
+			p.SetState(510)
 			p.Match(apexParserRBRACK)
 		}
 
-		p.SetState(505)
+		p.SetState(515)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(506)
+		p.SetState(516)
 		p.Match(apexParserASSIGN)
 	}
 	{
-		p.SetState(507)
+		p.SetState(517)
 		p.VariableInitializer()
 	}
 
@@ -5821,19 +5868,19 @@ func (p *apexParser) InterfaceMethodDeclaration() (localctx IInterfaceMethodDecl
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(511)
+	p.SetState(521)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserSYSTEM, apexParserIdentifier:
+	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserINCLUDES, apexParserEXCLUDES, apexParserTHEN, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserROWS, apexParserSYSTEM, apexParserIdentifier:
 		{
-			p.SetState(509)
+			p.SetState(519)
 			p.ApexType()
 		}
 
 	case apexParserVOID:
 		{
-			p.SetState(510)
+			p.SetState(520)
 			p.Match(apexParserVOID)
 		}
 
@@ -5841,48 +5888,48 @@ func (p *apexParser) InterfaceMethodDeclaration() (localctx IInterfaceMethodDecl
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(513)
+		p.SetState(523)
 		p.ApexIdentifier()
 	}
 	{
-		p.SetState(514)
+		p.SetState(524)
 		p.FormalParameters()
 	}
-	p.SetState(519)
+	p.SetState(529)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserLBRACK {
 		{
-			p.SetState(515)
+			p.SetState(525)
 			p.Match(apexParserLBRACK)
 		}
 		{
-			p.SetState(516)
+			p.SetState(526)
 			p.Match(apexParserRBRACK)
 		}
 
-		p.SetState(521)
+		p.SetState(531)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(524)
+	p.SetState(534)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserTHROWS {
 		{
-			p.SetState(522)
+			p.SetState(532)
 			p.Match(apexParserTHROWS)
 		}
 		{
-			p.SetState(523)
+			p.SetState(533)
 			p.QualifiedNameList()
 		}
 
 	}
 	{
-		p.SetState(526)
+		p.SetState(536)
 		p.Match(apexParserSEMI)
 	}
 
@@ -6003,24 +6050,24 @@ func (p *apexParser) VariableDeclarators() (localctx IVariableDeclaratorsContext
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(528)
+		p.SetState(538)
 		p.VariableDeclarator()
 	}
-	p.SetState(533)
+	p.SetState(543)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserCOMMA {
 		{
-			p.SetState(529)
+			p.SetState(539)
 			p.Match(apexParserCOMMA)
 		}
 		{
-			p.SetState(530)
+			p.SetState(540)
 			p.VariableDeclarator()
 		}
 
-		p.SetState(535)
+		p.SetState(545)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -6139,20 +6186,20 @@ func (p *apexParser) VariableDeclarator() (localctx IVariableDeclaratorContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(536)
+		p.SetState(546)
 		p.VariableDeclaratorId()
 	}
-	p.SetState(539)
+	p.SetState(549)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserASSIGN {
 		{
-			p.SetState(537)
+			p.SetState(547)
 			p.Match(apexParserASSIGN)
 		}
 		{
-			p.SetState(538)
+			p.SetState(548)
 			p.VariableInitializer()
 		}
 
@@ -6262,24 +6309,24 @@ func (p *apexParser) VariableDeclaratorId() (localctx IVariableDeclaratorIdConte
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(541)
+		p.SetState(551)
 		p.ApexIdentifier()
 	}
-	p.SetState(546)
+	p.SetState(556)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserLBRACK {
 		{
-			p.SetState(542)
+			p.SetState(552)
 			p.Match(apexParserLBRACK)
 		}
 		{
-			p.SetState(543)
+			p.SetState(553)
 			p.Match(apexParserRBRACK)
 		}
 
-		p.SetState(548)
+		p.SetState(558)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -6395,21 +6442,21 @@ func (p *apexParser) VariableInitializer() (localctx IVariableInitializerContext
 		}
 	}()
 
-	p.SetState(551)
+	p.SetState(561)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserLBRACE:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(549)
+			p.SetState(559)
 			p.ArrayInitializer()
 		}
 
-	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserNEW, apexParserSUPER, apexParserTHIS, apexParserVOID, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserRUNAS, apexParserSYSTEM, apexParserIntegerLiteral, apexParserFloatingPointLiteral, apexParserBooleanLiteral, apexParserStringLiteral, apexParserNullLiteral, apexParserLPAREN, apexParserLBRACK, apexParserLT, apexParserBANG, apexParserINC, apexParserDEC, apexParserADD, apexParserSUB, apexParserIdentifier:
+	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserNEW, apexParserSUPER, apexParserTHIS, apexParserVOID, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserINCLUDES, apexParserEXCLUDES, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserROWS, apexParserTRIGGER, apexParserRUNAS, apexParserSYSTEM, apexParserIntegerLiteral, apexParserFloatingPointLiteral, apexParserBooleanLiteral, apexParserStringLiteral, apexParserNullLiteral, apexParserLPAREN, apexParserLBRACK, apexParserLT, apexParserBANG, apexParserINC, apexParserDEC, apexParserADD, apexParserSUB, apexParserIdentifier:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(550)
+			p.SetState(560)
 			p.expression(0)
 		}
 
@@ -6536,45 +6583,45 @@ func (p *apexParser) ArrayInitializer() (localctx IArrayInitializerContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(553)
+		p.SetState(563)
 		p.Match(apexParserLBRACE)
 	}
-	p.SetState(565)
+	p.SetState(575)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || (((_la-103)&-(0x1f+1)) == 0 && ((1<<uint((_la-103)))&((1<<(apexParserRUNAS-103))|(1<<(apexParserSYSTEM-103))|(1<<(apexParserIntegerLiteral-103))|(1<<(apexParserFloatingPointLiteral-103))|(1<<(apexParserBooleanLiteral-103))|(1<<(apexParserStringLiteral-103))|(1<<(apexParserNullLiteral-103))|(1<<(apexParserLPAREN-103))|(1<<(apexParserLBRACE-103))|(1<<(apexParserLBRACK-103))|(1<<(apexParserLT-103))|(1<<(apexParserBANG-103))|(1<<(apexParserINC-103))|(1<<(apexParserDEC-103)))) != 0) || (((_la-135)&-(0x1f+1)) == 0 && ((1<<uint((_la-135)))&((1<<(apexParserADD-135))|(1<<(apexParserSUB-135))|(1<<(apexParserIdentifier-135)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserINCLUDES-66))|(1<<(apexParserEXCLUDES-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66)))) != 0) || (((_la-98)&-(0x1f+1)) == 0 && ((1<<uint((_la-98)))&((1<<(apexParserRETURNING-98))|(1<<(apexParserALL-98))|(1<<(apexParserROWS-98))|(1<<(apexParserTRIGGER-98))|(1<<(apexParserRUNAS-98))|(1<<(apexParserSYSTEM-98))|(1<<(apexParserIntegerLiteral-98))|(1<<(apexParserFloatingPointLiteral-98))|(1<<(apexParserBooleanLiteral-98))|(1<<(apexParserStringLiteral-98))|(1<<(apexParserNullLiteral-98))|(1<<(apexParserLPAREN-98))|(1<<(apexParserLBRACE-98))|(1<<(apexParserLBRACK-98))|(1<<(apexParserLT-98))|(1<<(apexParserBANG-98)))) != 0) || (((_la-136)&-(0x1f+1)) == 0 && ((1<<uint((_la-136)))&((1<<(apexParserINC-136))|(1<<(apexParserDEC-136))|(1<<(apexParserADD-136))|(1<<(apexParserSUB-136))|(1<<(apexParserIdentifier-136)))) != 0) {
 		{
-			p.SetState(554)
+			p.SetState(564)
 			p.VariableInitializer()
 		}
-		p.SetState(559)
+		p.SetState(569)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 45, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(555)
+					p.SetState(565)
 					p.Match(apexParserCOMMA)
 				}
 				{
-					p.SetState(556)
+					p.SetState(566)
 					p.VariableInitializer()
 				}
 
 			}
-			p.SetState(561)
+			p.SetState(571)
 			p.GetErrorHandler().Sync(p)
 			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 45, p.GetParserRuleContext())
 		}
-		p.SetState(563)
+		p.SetState(573)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == apexParserCOMMA {
 			{
-				p.SetState(562)
+				p.SetState(572)
 				p.Match(apexParserCOMMA)
 			}
 
@@ -6582,7 +6629,7 @@ func (p *apexParser) ArrayInitializer() (localctx IArrayInitializerContext) {
 
 	}
 	{
-		p.SetState(567)
+		p.SetState(577)
 		p.Match(apexParserRBRACE)
 	}
 
@@ -6689,7 +6736,7 @@ func (p *apexParser) EnumConstantName() (localctx IEnumConstantNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(569)
+		p.SetState(579)
 		p.ApexIdentifier()
 	}
 
@@ -6829,29 +6876,29 @@ func (p *apexParser) ApexType() (localctx IApexTypeContext) {
 
 	var _alt int
 
-	p.SetState(585)
+	p.SetState(595)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case apexParserSET, apexParserGET, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserSYSTEM, apexParserIdentifier:
+	case apexParserSET, apexParserGET, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserINCLUDES, apexParserEXCLUDES, apexParserTHEN, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserROWS, apexParserSYSTEM, apexParserIdentifier:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(571)
+			p.SetState(581)
 			p.ClassOrInterfaceType()
 		}
-		p.SetState(575)
+		p.SetState(585)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 48, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(572)
+					p.SetState(582)
 					p.TypedArray()
 				}
 
 			}
-			p.SetState(577)
+			p.SetState(587)
 			p.GetErrorHandler().Sync(p)
 			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 48, p.GetParserRuleContext())
 		}
@@ -6859,22 +6906,22 @@ func (p *apexParser) ApexType() (localctx IApexTypeContext) {
 	case apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(578)
+			p.SetState(588)
 			p.PrimitiveType()
 		}
-		p.SetState(582)
+		p.SetState(592)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 49, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(579)
+					p.SetState(589)
 					p.TypedArray()
 				}
 
 			}
-			p.SetState(584)
+			p.SetState(594)
 			p.GetErrorHandler().Sync(p)
 			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 49, p.GetParserRuleContext())
 		}
@@ -6975,11 +7022,11 @@ func (p *apexParser) TypedArray() (localctx ITypedArrayContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(587)
+		p.SetState(597)
 		p.Match(apexParserLBRACK)
 	}
 	{
-		p.SetState(588)
+		p.SetState(598)
 		p.Match(apexParserRBRACK)
 	}
 
@@ -7126,52 +7173,52 @@ func (p *apexParser) ClassOrInterfaceType() (localctx IClassOrInterfaceTypeConte
 
 	var _alt int
 
-	p.SetState(606)
+	p.SetState(616)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 54, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(590)
+			p.SetState(600)
 			p.TypeIdentifier()
 		}
-		p.SetState(592)
+		p.SetState(602)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(591)
+				p.SetState(601)
 				p.TypeArguments()
 			}
 
 		}
-		p.SetState(601)
+		p.SetState(611)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(594)
+					p.SetState(604)
 					p.Match(apexParserDOT)
 				}
 				{
-					p.SetState(595)
+					p.SetState(605)
 					p.TypeIdentifier()
 				}
-				p.SetState(597)
+				p.SetState(607)
 				p.GetErrorHandler().Sync(p)
 
 				if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 52, p.GetParserRuleContext()) == 1 {
 					{
-						p.SetState(596)
+						p.SetState(606)
 						p.TypeArguments()
 					}
 
 				}
 
 			}
-			p.SetState(603)
+			p.SetState(613)
 			p.GetErrorHandler().Sync(p)
 			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext())
 		}
@@ -7179,11 +7226,11 @@ func (p *apexParser) ClassOrInterfaceType() (localctx IClassOrInterfaceTypeConte
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(604)
+			p.SetState(614)
 			p.Match(apexParserSET)
 		}
 		{
-			p.SetState(605)
+			p.SetState(615)
 			p.TypeArguments()
 		}
 
@@ -7307,7 +7354,7 @@ func (p *apexParser) PrimitiveType() (localctx IPrimitiveTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(608)
+		p.SetState(618)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) {
@@ -7435,33 +7482,33 @@ func (p *apexParser) TypeArguments() (localctx ITypeArgumentsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(610)
+		p.SetState(620)
 		p.Match(apexParserLT)
 	}
 	{
-		p.SetState(611)
+		p.SetState(621)
 		p.TypeArgument()
 	}
-	p.SetState(616)
+	p.SetState(626)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserCOMMA {
 		{
-			p.SetState(612)
+			p.SetState(622)
 			p.Match(apexParserCOMMA)
 		}
 		{
-			p.SetState(613)
+			p.SetState(623)
 			p.TypeArgument()
 		}
 
-		p.SetState(618)
+		p.SetState(628)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(619)
+		p.SetState(629)
 		p.Match(apexParserGT)
 	}
 
@@ -7575,30 +7622,30 @@ func (p *apexParser) TypeArgument() (localctx ITypeArgumentContext) {
 		}
 	}()
 
-	p.SetState(627)
+	p.SetState(637)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserSYSTEM, apexParserIdentifier:
+	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserINCLUDES, apexParserEXCLUDES, apexParserTHEN, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserROWS, apexParserSYSTEM, apexParserIdentifier:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(621)
+			p.SetState(631)
 			p.ApexType()
 		}
 
 	case apexParserQUESTION:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(622)
+			p.SetState(632)
 			p.Match(apexParserQUESTION)
 		}
-		p.SetState(625)
+		p.SetState(635)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == apexParserEXTENDS || _la == apexParserSUPER {
 			{
-				p.SetState(623)
+				p.SetState(633)
 				_la = p.GetTokenStream().LA(1)
 
 				if !(_la == apexParserEXTENDS || _la == apexParserSUPER) {
@@ -7609,7 +7656,7 @@ func (p *apexParser) TypeArgument() (localctx ITypeArgumentContext) {
 				}
 			}
 			{
-				p.SetState(624)
+				p.SetState(634)
 				p.ApexType()
 			}
 
@@ -7736,24 +7783,24 @@ func (p *apexParser) QualifiedNameList() (localctx IQualifiedNameListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(629)
+		p.SetState(639)
 		p.QualifiedName()
 	}
-	p.SetState(634)
+	p.SetState(644)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserCOMMA {
 		{
-			p.SetState(630)
+			p.SetState(640)
 			p.Match(apexParserCOMMA)
 		}
 		{
-			p.SetState(631)
+			p.SetState(641)
 			p.QualifiedName()
 		}
 
-		p.SetState(636)
+		p.SetState(646)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -7862,22 +7909,22 @@ func (p *apexParser) FormalParameters() (localctx IFormalParametersContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(637)
+		p.SetState(647)
 		p.Match(apexParserLPAREN)
 	}
-	p.SetState(639)
+	p.SetState(649)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFINAL)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || _la == apexParserSYSTEM || _la == apexParserIdentifier || _la == apexParserAT {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFINAL)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserINCLUDES-66))|(1<<(apexParserEXCLUDES-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66)))) != 0) || (((_la-98)&-(0x1f+1)) == 0 && ((1<<uint((_la-98)))&((1<<(apexParserRETURNING-98))|(1<<(apexParserALL-98))|(1<<(apexParserROWS-98))|(1<<(apexParserSYSTEM-98)))) != 0) || _la == apexParserIdentifier || _la == apexParserAT {
 		{
-			p.SetState(638)
+			p.SetState(648)
 			p.FormalParameterList()
 		}
 
 	}
 	{
-		p.SetState(641)
+		p.SetState(651)
 		p.Match(apexParserRPAREN)
 	}
 
@@ -8008,46 +8055,46 @@ func (p *apexParser) FormalParameterList() (localctx IFormalParameterListContext
 
 	var _alt int
 
-	p.SetState(656)
+	p.SetState(666)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(643)
+			p.SetState(653)
 			p.FormalParameter()
 		}
-		p.SetState(648)
+		p.SetState(658)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 60, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(644)
+					p.SetState(654)
 					p.Match(apexParserCOMMA)
 				}
 				{
-					p.SetState(645)
+					p.SetState(655)
 					p.FormalParameter()
 				}
 
 			}
-			p.SetState(650)
+			p.SetState(660)
 			p.GetErrorHandler().Sync(p)
 			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 60, p.GetParserRuleContext())
 		}
-		p.SetState(653)
+		p.SetState(663)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == apexParserCOMMA {
 			{
-				p.SetState(651)
+				p.SetState(661)
 				p.Match(apexParserCOMMA)
 			}
 			{
-				p.SetState(652)
+				p.SetState(662)
 				p.LastFormalParameter()
 			}
 
@@ -8056,7 +8103,7 @@ func (p *apexParser) FormalParameterList() (localctx IFormalParameterListContext
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(655)
+			p.SetState(665)
 			p.LastFormalParameter()
 		}
 
@@ -8198,26 +8245,26 @@ func (p *apexParser) FormalParameter() (localctx IFormalParameterContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(661)
+	p.SetState(671)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserFINAL || _la == apexParserAT {
 		{
-			p.SetState(658)
+			p.SetState(668)
 			p.VariableModifier()
 		}
 
-		p.SetState(663)
+		p.SetState(673)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(664)
+		p.SetState(674)
 		p.ApexType()
 	}
 	{
-		p.SetState(665)
+		p.SetState(675)
 		p.VariableDeclaratorId()
 	}
 
@@ -8357,30 +8404,30 @@ func (p *apexParser) LastFormalParameter() (localctx ILastFormalParameterContext
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(670)
+	p.SetState(680)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserFINAL || _la == apexParserAT {
 		{
-			p.SetState(667)
+			p.SetState(677)
 			p.VariableModifier()
 		}
 
-		p.SetState(672)
+		p.SetState(682)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(673)
+		p.SetState(683)
 		p.ApexType()
 	}
 	{
-		p.SetState(674)
+		p.SetState(684)
 		p.Match(apexParserELLIPSIS)
 	}
 	{
-		p.SetState(675)
+		p.SetState(685)
 		p.VariableDeclaratorId()
 	}
 
@@ -8487,7 +8534,7 @@ func (p *apexParser) MethodBody() (localctx IMethodBodyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(677)
+		p.SetState(687)
 		p.Block()
 	}
 
@@ -8594,7 +8641,7 @@ func (p *apexParser) ConstructorBody() (localctx IConstructorBodyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(679)
+		p.SetState(689)
 		p.Block()
 	}
 
@@ -8715,24 +8762,24 @@ func (p *apexParser) QualifiedName() (localctx IQualifiedNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(681)
+		p.SetState(691)
 		p.ApexIdentifier()
 	}
-	p.SetState(686)
+	p.SetState(696)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserDOT {
 		{
-			p.SetState(682)
+			p.SetState(692)
 			p.Match(apexParserDOT)
 		}
 		{
-			p.SetState(683)
+			p.SetState(693)
 			p.ApexIdentifier()
 		}
 
-		p.SetState(688)
+		p.SetState(698)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -8851,10 +8898,10 @@ func (p *apexParser) Literal() (localctx ILiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(689)
+		p.SetState(699)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la-105)&-(0x1f+1)) == 0 && ((1<<uint((_la-105)))&((1<<(apexParserIntegerLiteral-105))|(1<<(apexParserFloatingPointLiteral-105))|(1<<(apexParserBooleanLiteral-105))|(1<<(apexParserStringLiteral-105))|(1<<(apexParserNullLiteral-105)))) != 0) {
+		if !(((_la-108)&-(0x1f+1)) == 0 && ((1<<uint((_la-108)))&((1<<(apexParserIntegerLiteral-108))|(1<<(apexParserFloatingPointLiteral-108))|(1<<(apexParserBooleanLiteral-108))|(1<<(apexParserStringLiteral-108))|(1<<(apexParserNullLiteral-108)))) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -8986,40 +9033,40 @@ func (p *apexParser) Annotation() (localctx IAnnotationContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(691)
+		p.SetState(701)
 		p.Match(apexParserAT)
 	}
 	{
-		p.SetState(692)
+		p.SetState(702)
 		p.AnnotationName()
 	}
-	p.SetState(699)
+	p.SetState(709)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserLPAREN {
 		{
-			p.SetState(693)
+			p.SetState(703)
 			p.Match(apexParserLPAREN)
 		}
-		p.SetState(696)
+		p.SetState(706)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(694)
+				p.SetState(704)
 				p.ElementValuePairs()
 			}
 
 		} else if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext()) == 2 {
 			{
-				p.SetState(695)
+				p.SetState(705)
 				p.ElementValue()
 			}
 
 		}
 		{
-			p.SetState(698)
+			p.SetState(708)
 			p.Match(apexParserRPAREN)
 		}
 
@@ -9128,7 +9175,7 @@ func (p *apexParser) AnnotationName() (localctx IAnnotationNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(701)
+		p.SetState(711)
 		p.QualifiedName()
 	}
 
@@ -9249,20 +9296,20 @@ func (p *apexParser) ElementValuePairs() (localctx IElementValuePairsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(703)
+		p.SetState(713)
 		p.ElementValuePair()
 	}
-	p.SetState(707)
+	p.SetState(717)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || _la == apexParserRUNAS || _la == apexParserSYSTEM || _la == apexParserIdentifier {
+	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserINCLUDES-66))|(1<<(apexParserEXCLUDES-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66)))) != 0) || (((_la-98)&-(0x1f+1)) == 0 && ((1<<uint((_la-98)))&((1<<(apexParserRETURNING-98))|(1<<(apexParserALL-98))|(1<<(apexParserROWS-98))|(1<<(apexParserRUNAS-98))|(1<<(apexParserSYSTEM-98)))) != 0) || _la == apexParserIdentifier {
 		{
-			p.SetState(704)
+			p.SetState(714)
 			p.ElementValuePair()
 		}
 
-		p.SetState(709)
+		p.SetState(719)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -9380,15 +9427,15 @@ func (p *apexParser) ElementValuePair() (localctx IElementValuePairContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(710)
+		p.SetState(720)
 		p.ApexIdentifier()
 	}
 	{
-		p.SetState(711)
+		p.SetState(721)
 		p.Match(apexParserASSIGN)
 	}
 	{
-		p.SetState(712)
+		p.SetState(722)
 		p.ElementValue()
 	}
 
@@ -9513,28 +9560,28 @@ func (p *apexParser) ElementValue() (localctx IElementValueContext) {
 		}
 	}()
 
-	p.SetState(717)
+	p.SetState(727)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserNEW, apexParserSUPER, apexParserTHIS, apexParserVOID, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserRUNAS, apexParserSYSTEM, apexParserIntegerLiteral, apexParserFloatingPointLiteral, apexParserBooleanLiteral, apexParserStringLiteral, apexParserNullLiteral, apexParserLPAREN, apexParserLBRACK, apexParserLT, apexParserBANG, apexParserINC, apexParserDEC, apexParserADD, apexParserSUB, apexParserIdentifier:
+	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserNEW, apexParserSUPER, apexParserTHIS, apexParserVOID, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserINCLUDES, apexParserEXCLUDES, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserROWS, apexParserTRIGGER, apexParserRUNAS, apexParserSYSTEM, apexParserIntegerLiteral, apexParserFloatingPointLiteral, apexParserBooleanLiteral, apexParserStringLiteral, apexParserNullLiteral, apexParserLPAREN, apexParserLBRACK, apexParserLT, apexParserBANG, apexParserINC, apexParserDEC, apexParserADD, apexParserSUB, apexParserIdentifier:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(714)
+			p.SetState(724)
 			p.expression(0)
 		}
 
 	case apexParserAT:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(715)
+			p.SetState(725)
 			p.Annotation()
 		}
 
 	case apexParserLBRACE:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(716)
+			p.SetState(726)
 			p.ElementValueArrayInitializer()
 		}
 
@@ -9661,53 +9708,53 @@ func (p *apexParser) ElementValueArrayInitializer() (localctx IElementValueArray
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(719)
+		p.SetState(729)
 		p.Match(apexParserLBRACE)
 	}
-	p.SetState(728)
+	p.SetState(738)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || (((_la-103)&-(0x1f+1)) == 0 && ((1<<uint((_la-103)))&((1<<(apexParserRUNAS-103))|(1<<(apexParserSYSTEM-103))|(1<<(apexParserIntegerLiteral-103))|(1<<(apexParserFloatingPointLiteral-103))|(1<<(apexParserBooleanLiteral-103))|(1<<(apexParserStringLiteral-103))|(1<<(apexParserNullLiteral-103))|(1<<(apexParserLPAREN-103))|(1<<(apexParserLBRACE-103))|(1<<(apexParserLBRACK-103))|(1<<(apexParserLT-103))|(1<<(apexParserBANG-103))|(1<<(apexParserINC-103))|(1<<(apexParserDEC-103)))) != 0) || (((_la-135)&-(0x1f+1)) == 0 && ((1<<uint((_la-135)))&((1<<(apexParserADD-135))|(1<<(apexParserSUB-135))|(1<<(apexParserIdentifier-135))|(1<<(apexParserAT-135)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserINCLUDES-66))|(1<<(apexParserEXCLUDES-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66)))) != 0) || (((_la-98)&-(0x1f+1)) == 0 && ((1<<uint((_la-98)))&((1<<(apexParserRETURNING-98))|(1<<(apexParserALL-98))|(1<<(apexParserROWS-98))|(1<<(apexParserTRIGGER-98))|(1<<(apexParserRUNAS-98))|(1<<(apexParserSYSTEM-98))|(1<<(apexParserIntegerLiteral-98))|(1<<(apexParserFloatingPointLiteral-98))|(1<<(apexParserBooleanLiteral-98))|(1<<(apexParserStringLiteral-98))|(1<<(apexParserNullLiteral-98))|(1<<(apexParserLPAREN-98))|(1<<(apexParserLBRACE-98))|(1<<(apexParserLBRACK-98))|(1<<(apexParserLT-98))|(1<<(apexParserBANG-98)))) != 0) || (((_la-136)&-(0x1f+1)) == 0 && ((1<<uint((_la-136)))&((1<<(apexParserINC-136))|(1<<(apexParserDEC-136))|(1<<(apexParserADD-136))|(1<<(apexParserSUB-136))|(1<<(apexParserIdentifier-136))|(1<<(apexParserAT-136)))) != 0) {
 		{
-			p.SetState(720)
+			p.SetState(730)
 			p.ElementValue()
 		}
-		p.SetState(725)
+		p.SetState(735)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 70, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(721)
+					p.SetState(731)
 					p.Match(apexParserCOMMA)
 				}
 				{
-					p.SetState(722)
+					p.SetState(732)
 					p.ElementValue()
 				}
 
 			}
-			p.SetState(727)
+			p.SetState(737)
 			p.GetErrorHandler().Sync(p)
 			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 70, p.GetParserRuleContext())
 		}
 
 	}
-	p.SetState(731)
+	p.SetState(741)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserCOMMA {
 		{
-			p.SetState(730)
+			p.SetState(740)
 			p.Match(apexParserCOMMA)
 		}
 
 	}
 	{
-		p.SetState(733)
+		p.SetState(743)
 		p.Match(apexParserRBRACE)
 	}
 
@@ -9828,25 +9875,25 @@ func (p *apexParser) Block() (localctx IBlockContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(735)
+		p.SetState(745)
 		p.Match(apexParserLBRACE)
 	}
-	p.SetState(739)
+	p.SetState(749)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserOVERRIDE)|(1<<apexParserVIRTUAL)|(1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserABSTRACT)|(1<<apexParserBOOLEAN)|(1<<apexParserBREAK)|(1<<apexParserCLASS)|(1<<apexParserCONTINUE)|(1<<apexParserDO)|(1<<apexParserDOUBLE)|(1<<apexParserENUM)|(1<<apexParserFINAL)|(1<<apexParserFLOAT)|(1<<apexParserFOR)|(1<<apexParserIF)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserINTERFACE)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserPRIVATE-33))|(1<<(apexParserPROTECTED-33))|(1<<(apexParserPUBLIC-33))|(1<<(apexParserRETURN-33))|(1<<(apexParserSTATIC-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserTHROW-33))|(1<<(apexParserTRY-33))|(1<<(apexParserVOID-33))|(1<<(apexParserWHILE-33))|(1<<(apexParserSWITCH-33))|(1<<(apexParserGLOBAL-33))|(1<<(apexParserWEBSERVICE-33))|(1<<(apexParserAPEX_WITH_SHARING-33))|(1<<(apexParserAPEX_WITHOUT_SHARING-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || (((_la-98)&-(0x1f+1)) == 0 && ((1<<uint((_la-98)))&((1<<(apexParserTESTMETHOD-98))|(1<<(apexParserTRIGGER-98))|(1<<(apexParserRUNAS-98))|(1<<(apexParserSYSTEM-98))|(1<<(apexParserIntegerLiteral-98))|(1<<(apexParserFloatingPointLiteral-98))|(1<<(apexParserBooleanLiteral-98))|(1<<(apexParserStringLiteral-98))|(1<<(apexParserNullLiteral-98))|(1<<(apexParserLPAREN-98))|(1<<(apexParserLBRACE-98))|(1<<(apexParserLBRACK-98))|(1<<(apexParserSEMI-98))|(1<<(apexParserLT-98))|(1<<(apexParserBANG-98)))) != 0) || (((_la-133)&-(0x1f+1)) == 0 && ((1<<uint((_la-133)))&((1<<(apexParserINC-133))|(1<<(apexParserDEC-133))|(1<<(apexParserADD-133))|(1<<(apexParserSUB-133))|(1<<(apexParserIdentifier-133))|(1<<(apexParserAT-133)))) != 0) {
+	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserOVERRIDE)|(1<<apexParserVIRTUAL)|(1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserABSTRACT)|(1<<apexParserBOOLEAN)|(1<<apexParserBREAK)|(1<<apexParserCLASS)|(1<<apexParserCONTINUE)|(1<<apexParserDO)|(1<<apexParserDOUBLE)|(1<<apexParserENUM)|(1<<apexParserFINAL)|(1<<apexParserFLOAT)|(1<<apexParserFOR)|(1<<apexParserIF)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserINTERFACE)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserPRIVATE-33))|(1<<(apexParserPROTECTED-33))|(1<<(apexParserPUBLIC-33))|(1<<(apexParserRETURN-33))|(1<<(apexParserSTATIC-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserTHROW-33))|(1<<(apexParserTRY-33))|(1<<(apexParserVOID-33))|(1<<(apexParserWHILE-33))|(1<<(apexParserSWITCH-33))|(1<<(apexParserGLOBAL-33))|(1<<(apexParserWEBSERVICE-33))|(1<<(apexParserAPEX_WITH_SHARING-33))|(1<<(apexParserAPEX_WITHOUT_SHARING-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserINCLUDES-66))|(1<<(apexParserEXCLUDES-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66)))) != 0) || (((_la-98)&-(0x1f+1)) == 0 && ((1<<uint((_la-98)))&((1<<(apexParserRETURNING-98))|(1<<(apexParserALL-98))|(1<<(apexParserROWS-98))|(1<<(apexParserTESTMETHOD-98))|(1<<(apexParserTRIGGER-98))|(1<<(apexParserRUNAS-98))|(1<<(apexParserSYSTEM-98))|(1<<(apexParserIntegerLiteral-98))|(1<<(apexParserFloatingPointLiteral-98))|(1<<(apexParserBooleanLiteral-98))|(1<<(apexParserStringLiteral-98))|(1<<(apexParserNullLiteral-98))|(1<<(apexParserLPAREN-98))|(1<<(apexParserLBRACE-98))|(1<<(apexParserLBRACK-98))|(1<<(apexParserSEMI-98))|(1<<(apexParserLT-98))|(1<<(apexParserBANG-98)))) != 0) || (((_la-136)&-(0x1f+1)) == 0 && ((1<<uint((_la-136)))&((1<<(apexParserINC-136))|(1<<(apexParserDEC-136))|(1<<(apexParserADD-136))|(1<<(apexParserSUB-136))|(1<<(apexParserIdentifier-136))|(1<<(apexParserAT-136)))) != 0) {
 		{
-			p.SetState(736)
+			p.SetState(746)
 			p.BlockStatement()
 		}
 
-		p.SetState(741)
+		p.SetState(751)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(742)
+		p.SetState(752)
 		p.Match(apexParserRBRACE)
 	}
 
@@ -9971,27 +10018,27 @@ func (p *apexParser) BlockStatement() (localctx IBlockStatementContext) {
 		}
 	}()
 
-	p.SetState(747)
+	p.SetState(757)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 74, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(744)
+			p.SetState(754)
 			p.LocalVariableDeclarationStatement()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(745)
+			p.SetState(755)
 			p.Statement()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(746)
+			p.SetState(756)
 			p.TypeDeclaration()
 		}
 
@@ -10100,11 +10147,11 @@ func (p *apexParser) LocalVariableDeclarationStatement() (localctx ILocalVariabl
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(749)
+		p.SetState(759)
 		p.LocalVariableDeclaration()
 	}
 	{
-		p.SetState(750)
+		p.SetState(760)
 		p.Match(apexParserSEMI)
 	}
 
@@ -10244,26 +10291,26 @@ func (p *apexParser) LocalVariableDeclaration() (localctx ILocalVariableDeclarat
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(755)
+	p.SetState(765)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserFINAL || _la == apexParserAT {
 		{
-			p.SetState(752)
+			p.SetState(762)
 			p.VariableModifier()
 		}
 
-		p.SetState(757)
+		p.SetState(767)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(758)
+		p.SetState(768)
 		p.ApexType()
 	}
 	{
-		p.SetState(759)
+		p.SetState(769)
 		p.VariableDeclarators()
 	}
 
@@ -10555,40 +10602,40 @@ func (p *apexParser) Statement() (localctx IStatementContext) {
 		}
 	}()
 
-	p.SetState(843)
+	p.SetState(853)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 84, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(761)
+			p.SetState(771)
 			p.Block()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(762)
+			p.SetState(772)
 			p.Match(apexParserIF)
 		}
 		{
-			p.SetState(763)
+			p.SetState(773)
 			p.ParExpression()
 		}
 		{
-			p.SetState(764)
+			p.SetState(774)
 			p.Statement()
 		}
-		p.SetState(767)
+		p.SetState(777)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 76, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(765)
+				p.SetState(775)
 				p.Match(apexParserELSE)
 			}
 			{
-				p.SetState(766)
+				p.SetState(776)
 				p.Statement()
 			}
 
@@ -10597,142 +10644,142 @@ func (p *apexParser) Statement() (localctx IStatementContext) {
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(769)
+			p.SetState(779)
 			p.Match(apexParserSWITCH)
 		}
 		{
-			p.SetState(770)
+			p.SetState(780)
 			p.Match(apexParserON)
 		}
 		{
-			p.SetState(771)
+			p.SetState(781)
 			p.expression(0)
 		}
 		{
-			p.SetState(772)
+			p.SetState(782)
 			p.Match(apexParserLBRACE)
 		}
 		{
-			p.SetState(773)
+			p.SetState(783)
 			p.WhenStatements()
 		}
-		p.SetState(777)
+		p.SetState(787)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == apexParserWHEN {
 			{
-				p.SetState(774)
+				p.SetState(784)
 				p.Match(apexParserWHEN)
 			}
 			{
-				p.SetState(775)
+				p.SetState(785)
 				p.Match(apexParserELSE)
 			}
 			{
-				p.SetState(776)
+				p.SetState(786)
 				p.Block()
 			}
 
 		}
 		{
-			p.SetState(779)
+			p.SetState(789)
 			p.Match(apexParserRBRACE)
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(781)
+			p.SetState(791)
 			p.Match(apexParserFOR)
 		}
 		{
-			p.SetState(782)
+			p.SetState(792)
 			p.Match(apexParserLPAREN)
 		}
 		{
-			p.SetState(783)
+			p.SetState(793)
 			p.ForControl()
 		}
 		{
-			p.SetState(784)
+			p.SetState(794)
 			p.Match(apexParserRPAREN)
 		}
 		{
-			p.SetState(785)
+			p.SetState(795)
 			p.Statement()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(787)
+			p.SetState(797)
 			p.Match(apexParserWHILE)
 		}
 		{
-			p.SetState(788)
+			p.SetState(798)
 			p.ParExpression()
 		}
 		{
-			p.SetState(789)
+			p.SetState(799)
 			p.Statement()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(791)
+			p.SetState(801)
 			p.Match(apexParserDO)
 		}
 		{
-			p.SetState(792)
+			p.SetState(802)
 			p.Statement()
 		}
 		{
-			p.SetState(793)
+			p.SetState(803)
 			p.Match(apexParserWHILE)
 		}
 		{
-			p.SetState(794)
+			p.SetState(804)
 			p.ParExpression()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(796)
+			p.SetState(806)
 			p.Match(apexParserTRY)
 		}
 		{
-			p.SetState(797)
+			p.SetState(807)
 			p.Block()
 		}
-		p.SetState(807)
+		p.SetState(817)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case apexParserCATCH:
-			p.SetState(799)
+			p.SetState(809)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			for ok := true; ok; ok = _la == apexParserCATCH {
 				{
-					p.SetState(798)
+					p.SetState(808)
 					p.CatchClause()
 				}
 
-				p.SetState(801)
+				p.SetState(811)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 			}
-			p.SetState(804)
+			p.SetState(814)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == apexParserFINALLY {
 				{
-					p.SetState(803)
+					p.SetState(813)
 					p.FinallyBlock()
 				}
 
@@ -10740,7 +10787,7 @@ func (p *apexParser) Statement() (localctx IStatementContext) {
 
 		case apexParserFINALLY:
 			{
-				p.SetState(806)
+				p.SetState(816)
 				p.FinallyBlock()
 			}
 
@@ -10751,141 +10798,141 @@ func (p *apexParser) Statement() (localctx IStatementContext) {
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(809)
+			p.SetState(819)
 			p.Match(apexParserRETURN)
 		}
-		p.SetState(811)
+		p.SetState(821)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || (((_la-103)&-(0x1f+1)) == 0 && ((1<<uint((_la-103)))&((1<<(apexParserRUNAS-103))|(1<<(apexParserSYSTEM-103))|(1<<(apexParserIntegerLiteral-103))|(1<<(apexParserFloatingPointLiteral-103))|(1<<(apexParserBooleanLiteral-103))|(1<<(apexParserStringLiteral-103))|(1<<(apexParserNullLiteral-103))|(1<<(apexParserLPAREN-103))|(1<<(apexParserLBRACK-103))|(1<<(apexParserLT-103))|(1<<(apexParserBANG-103))|(1<<(apexParserINC-103))|(1<<(apexParserDEC-103)))) != 0) || (((_la-135)&-(0x1f+1)) == 0 && ((1<<uint((_la-135)))&((1<<(apexParserADD-135))|(1<<(apexParserSUB-135))|(1<<(apexParserIdentifier-135)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserINCLUDES-66))|(1<<(apexParserEXCLUDES-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66)))) != 0) || (((_la-98)&-(0x1f+1)) == 0 && ((1<<uint((_la-98)))&((1<<(apexParserRETURNING-98))|(1<<(apexParserALL-98))|(1<<(apexParserROWS-98))|(1<<(apexParserTRIGGER-98))|(1<<(apexParserRUNAS-98))|(1<<(apexParserSYSTEM-98))|(1<<(apexParserIntegerLiteral-98))|(1<<(apexParserFloatingPointLiteral-98))|(1<<(apexParserBooleanLiteral-98))|(1<<(apexParserStringLiteral-98))|(1<<(apexParserNullLiteral-98))|(1<<(apexParserLPAREN-98))|(1<<(apexParserLBRACK-98))|(1<<(apexParserLT-98))|(1<<(apexParserBANG-98)))) != 0) || (((_la-136)&-(0x1f+1)) == 0 && ((1<<uint((_la-136)))&((1<<(apexParserINC-136))|(1<<(apexParserDEC-136))|(1<<(apexParserADD-136))|(1<<(apexParserSUB-136))|(1<<(apexParserIdentifier-136)))) != 0) {
 			{
-				p.SetState(810)
+				p.SetState(820)
 				p.expression(0)
 			}
 
 		}
 		{
-			p.SetState(813)
+			p.SetState(823)
 			p.Match(apexParserSEMI)
 		}
 
 	case 9:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(814)
+			p.SetState(824)
 			p.Match(apexParserTHROW)
 		}
 		{
-			p.SetState(815)
+			p.SetState(825)
 			p.expression(0)
 		}
 		{
-			p.SetState(816)
+			p.SetState(826)
 			p.Match(apexParserSEMI)
 		}
 
 	case 10:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(818)
+			p.SetState(828)
 			p.Match(apexParserBREAK)
 		}
-		p.SetState(820)
+		p.SetState(830)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || _la == apexParserRUNAS || _la == apexParserSYSTEM || _la == apexParserIdentifier {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserINCLUDES-66))|(1<<(apexParserEXCLUDES-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66)))) != 0) || (((_la-98)&-(0x1f+1)) == 0 && ((1<<uint((_la-98)))&((1<<(apexParserRETURNING-98))|(1<<(apexParserALL-98))|(1<<(apexParserROWS-98))|(1<<(apexParserRUNAS-98))|(1<<(apexParserSYSTEM-98)))) != 0) || _la == apexParserIdentifier {
 			{
-				p.SetState(819)
+				p.SetState(829)
 				p.ApexIdentifier()
 			}
 
 		}
 		{
-			p.SetState(822)
+			p.SetState(832)
 			p.Match(apexParserSEMI)
 		}
 
 	case 11:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(823)
+			p.SetState(833)
 			p.Match(apexParserCONTINUE)
 		}
-		p.SetState(825)
+		p.SetState(835)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || _la == apexParserRUNAS || _la == apexParserSYSTEM || _la == apexParserIdentifier {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserINCLUDES-66))|(1<<(apexParserEXCLUDES-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66)))) != 0) || (((_la-98)&-(0x1f+1)) == 0 && ((1<<uint((_la-98)))&((1<<(apexParserRETURNING-98))|(1<<(apexParserALL-98))|(1<<(apexParserROWS-98))|(1<<(apexParserRUNAS-98))|(1<<(apexParserSYSTEM-98)))) != 0) || _la == apexParserIdentifier {
 			{
-				p.SetState(824)
+				p.SetState(834)
 				p.ApexIdentifier()
 			}
 
 		}
 		{
-			p.SetState(827)
+			p.SetState(837)
 			p.Match(apexParserSEMI)
 		}
 
 	case 12:
 		p.EnterOuterAlt(localctx, 12)
 		{
-			p.SetState(828)
+			p.SetState(838)
 			p.Match(apexParserSEMI)
 		}
 
 	case 13:
 		p.EnterOuterAlt(localctx, 13)
 		{
-			p.SetState(829)
+			p.SetState(839)
 			p.StatementExpression()
 		}
 		{
-			p.SetState(830)
+			p.SetState(840)
 			p.Match(apexParserSEMI)
 		}
 
 	case 14:
 		p.EnterOuterAlt(localctx, 14)
 		{
-			p.SetState(832)
+			p.SetState(842)
 			p.ApexDbExpression()
 		}
 		{
-			p.SetState(833)
+			p.SetState(843)
 			p.Match(apexParserSEMI)
 		}
 
 	case 15:
 		p.EnterOuterAlt(localctx, 15)
 		{
-			p.SetState(835)
+			p.SetState(845)
 			p.Match(apexParserSYSTEM)
 		}
 		{
-			p.SetState(836)
+			p.SetState(846)
 			p.Match(apexParserDOT)
 		}
 		{
-			p.SetState(837)
+			p.SetState(847)
 			p.Match(apexParserRUNAS)
 		}
 		{
-			p.SetState(838)
+			p.SetState(848)
 			p.Match(apexParserLPAREN)
 		}
 		{
-			p.SetState(839)
+			p.SetState(849)
 			p.expression(0)
 		}
 		{
-			p.SetState(840)
+			p.SetState(850)
 			p.Match(apexParserRPAREN)
 		}
 		{
-			p.SetState(841)
+			p.SetState(851)
 			p.Block()
 		}
 
@@ -11027,33 +11074,33 @@ func (p *apexParser) PropertyBlock() (localctx IPropertyBlockContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(848)
+	p.SetState(858)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserOVERRIDE)|(1<<apexParserVIRTUAL)|(1<<apexParserABSTRACT)|(1<<apexParserFINAL))) != 0) || (((_la-35)&-(0x1f+1)) == 0 && ((1<<uint((_la-35)))&((1<<(apexParserPRIVATE-35))|(1<<(apexParserPROTECTED-35))|(1<<(apexParserPUBLIC-35))|(1<<(apexParserSTATIC-35))|(1<<(apexParserTRANSIENT-35))|(1<<(apexParserGLOBAL-35))|(1<<(apexParserWEBSERVICE-35))|(1<<(apexParserAPEX_WITH_SHARING-35))|(1<<(apexParserAPEX_WITHOUT_SHARING-35)))) != 0) || _la == apexParserTESTMETHOD || _la == apexParserAT {
 		{
-			p.SetState(845)
+			p.SetState(855)
 			p.Modifier()
 		}
 
-		p.SetState(850)
+		p.SetState(860)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(853)
+	p.SetState(863)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserGET:
 		{
-			p.SetState(851)
+			p.SetState(861)
 			p.Getter()
 		}
 
 	case apexParserSET:
 		{
-			p.SetState(852)
+			p.SetState(862)
 			p.Setter()
 		}
 
@@ -11168,22 +11215,22 @@ func (p *apexParser) Getter() (localctx IGetterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(855)
+		p.SetState(865)
 		p.Match(apexParserGET)
 	}
-	p.SetState(858)
+	p.SetState(868)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserSEMI:
 		{
-			p.SetState(856)
+			p.SetState(866)
 			p.Match(apexParserSEMI)
 		}
 
 	case apexParserLBRACE:
 		{
-			p.SetState(857)
+			p.SetState(867)
 			p.MethodBody()
 		}
 
@@ -11298,22 +11345,22 @@ func (p *apexParser) Setter() (localctx ISetterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(860)
+		p.SetState(870)
 		p.Match(apexParserSET)
 	}
-	p.SetState(863)
+	p.SetState(873)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserSEMI:
 		{
-			p.SetState(861)
+			p.SetState(871)
 			p.Match(apexParserSEMI)
 		}
 
 	case apexParserLBRACE:
 		{
-			p.SetState(862)
+			p.SetState(872)
 			p.MethodBody()
 		}
 
@@ -11472,41 +11519,41 @@ func (p *apexParser) CatchClause() (localctx ICatchClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(865)
+		p.SetState(875)
 		p.Match(apexParserCATCH)
 	}
 	{
-		p.SetState(866)
+		p.SetState(876)
 		p.Match(apexParserLPAREN)
 	}
-	p.SetState(870)
+	p.SetState(880)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserFINAL || _la == apexParserAT {
 		{
-			p.SetState(867)
+			p.SetState(877)
 			p.VariableModifier()
 		}
 
-		p.SetState(872)
+		p.SetState(882)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(873)
+		p.SetState(883)
 		p.CatchType()
 	}
 	{
-		p.SetState(874)
+		p.SetState(884)
 		p.ApexIdentifier()
 	}
 	{
-		p.SetState(875)
+		p.SetState(885)
 		p.Match(apexParserRPAREN)
 	}
 	{
-		p.SetState(876)
+		p.SetState(886)
 		p.Block()
 	}
 
@@ -11627,24 +11674,24 @@ func (p *apexParser) CatchType() (localctx ICatchTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(878)
+		p.SetState(888)
 		p.QualifiedName()
 	}
-	p.SetState(883)
+	p.SetState(893)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserBITOR {
 		{
-			p.SetState(879)
+			p.SetState(889)
 			p.Match(apexParserBITOR)
 		}
 		{
-			p.SetState(880)
+			p.SetState(890)
 			p.QualifiedName()
 		}
 
-		p.SetState(885)
+		p.SetState(895)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -11756,11 +11803,11 @@ func (p *apexParser) FinallyBlock() (localctx IFinallyBlockContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(886)
+		p.SetState(896)
 		p.Match(apexParserFINALLY)
 	}
 	{
-		p.SetState(887)
+		p.SetState(897)
 		p.Block()
 	}
 
@@ -11882,22 +11929,22 @@ func (p *apexParser) WhenStatements() (localctx IWhenStatementsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(889)
+		p.SetState(899)
 		p.WhenStatement()
 	}
-	p.SetState(893)
+	p.SetState(903)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 91, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(890)
+				p.SetState(900)
 				p.WhenStatement()
 			}
 
 		}
-		p.SetState(895)
+		p.SetState(905)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 91, p.GetParserRuleContext())
 	}
@@ -12019,15 +12066,15 @@ func (p *apexParser) WhenStatement() (localctx IWhenStatementContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(896)
+		p.SetState(906)
 		p.Match(apexParserWHEN)
 	}
 	{
-		p.SetState(897)
+		p.SetState(907)
 		p.WhenExpression()
 	}
 	{
-		p.SetState(898)
+		p.SetState(908)
 		p.Block()
 	}
 
@@ -12072,27 +12119,27 @@ func NewWhenExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContex
 
 func (s *WhenExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *WhenExpressionContext) AllLiteral() []ILiteralContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ILiteralContext)(nil)).Elem())
-	var tst = make([]ILiteralContext, len(ts))
+func (s *WhenExpressionContext) AllWhenValue() []IWhenValueContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IWhenValueContext)(nil)).Elem())
+	var tst = make([]IWhenValueContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(ILiteralContext)
+			tst[i] = t.(IWhenValueContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *WhenExpressionContext) Literal(i int) ILiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILiteralContext)(nil)).Elem(), i)
+func (s *WhenExpressionContext) WhenValue(i int) IWhenValueContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IWhenValueContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ILiteralContext)
+	return t.(IWhenValueContext)
 }
 
 func (s *WhenExpressionContext) ApexType() IApexTypeContext {
@@ -12166,43 +12213,173 @@ func (p *apexParser) WhenExpression() (localctx IWhenExpressionContext) {
 		}
 	}()
 
-	p.SetState(911)
+	p.SetState(921)
 	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case apexParserIntegerLiteral, apexParserFloatingPointLiteral, apexParserBooleanLiteral, apexParserStringLiteral, apexParserNullLiteral:
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 93, p.GetParserRuleContext()) {
+	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(900)
-			p.Literal()
+			p.SetState(910)
+			p.WhenValue()
 		}
-		p.SetState(905)
+		p.SetState(915)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == apexParserCOMMA {
 			{
-				p.SetState(901)
+				p.SetState(911)
 				p.Match(apexParserCOMMA)
 			}
 			{
-				p.SetState(902)
-				p.Literal()
+				p.SetState(912)
+				p.WhenValue()
 			}
 
-			p.SetState(907)
+			p.SetState(917)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 
-	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserSYSTEM, apexParserIdentifier:
+	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(908)
+			p.SetState(918)
 			p.ApexType()
 		}
 		{
-			p.SetState(909)
+			p.SetState(919)
+			p.ApexIdentifier()
+		}
+
+	}
+
+	return localctx
+}
+
+// IWhenValueContext is an interface to support dynamic dispatch.
+type IWhenValueContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsWhenValueContext differentiates from other interfaces.
+	IsWhenValueContext()
+}
+
+type WhenValueContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyWhenValueContext() *WhenValueContext {
+	var p = new(WhenValueContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = apexParserRULE_whenValue
+	return p
+}
+
+func (*WhenValueContext) IsWhenValueContext() {}
+
+func NewWhenValueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WhenValueContext {
+	var p = new(WhenValueContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = apexParserRULE_whenValue
+
+	return p
+}
+
+func (s *WhenValueContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *WhenValueContext) Literal() ILiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILiteralContext)
+}
+
+func (s *WhenValueContext) ApexIdentifier() IApexIdentifierContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IApexIdentifierContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IApexIdentifierContext)
+}
+
+func (s *WhenValueContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *WhenValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *WhenValueContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(apexListener); ok {
+		listenerT.EnterWhenValue(s)
+	}
+}
+
+func (s *WhenValueContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(apexListener); ok {
+		listenerT.ExitWhenValue(s)
+	}
+}
+
+func (s *WhenValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case apexVisitor:
+		return t.VisitWhenValue(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *apexParser) WhenValue() (localctx IWhenValueContext) {
+	localctx = NewWhenValueContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 140, apexParserRULE_whenValue)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(925)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case apexParserIntegerLiteral, apexParserFloatingPointLiteral, apexParserBooleanLiteral, apexParserStringLiteral, apexParserNullLiteral:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(923)
+			p.Literal()
+		}
+
+	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserINCLUDES, apexParserEXCLUDES, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserROWS, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(924)
 			p.ApexIdentifier()
 		}
 
@@ -12323,7 +12500,7 @@ func (s *ForControlContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *apexParser) ForControl() (localctx IForControlContext) {
 	localctx = NewForControlContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 140, apexParserRULE_forControl)
+	p.EnterRule(localctx, 142, apexParserRULE_forControl)
 	var _la int
 
 	defer func() {
@@ -12342,55 +12519,55 @@ func (p *apexParser) ForControl() (localctx IForControlContext) {
 		}
 	}()
 
-	p.SetState(925)
+	p.SetState(939)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 97, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 98, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(913)
+			p.SetState(927)
 			p.EnhancedForControl()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(915)
+		p.SetState(929)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFINAL)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || (((_la-103)&-(0x1f+1)) == 0 && ((1<<uint((_la-103)))&((1<<(apexParserRUNAS-103))|(1<<(apexParserSYSTEM-103))|(1<<(apexParserIntegerLiteral-103))|(1<<(apexParserFloatingPointLiteral-103))|(1<<(apexParserBooleanLiteral-103))|(1<<(apexParserStringLiteral-103))|(1<<(apexParserNullLiteral-103))|(1<<(apexParserLPAREN-103))|(1<<(apexParserLBRACK-103))|(1<<(apexParserLT-103))|(1<<(apexParserBANG-103))|(1<<(apexParserINC-103))|(1<<(apexParserDEC-103)))) != 0) || (((_la-135)&-(0x1f+1)) == 0 && ((1<<uint((_la-135)))&((1<<(apexParserADD-135))|(1<<(apexParserSUB-135))|(1<<(apexParserIdentifier-135))|(1<<(apexParserAT-135)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFINAL)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserINCLUDES-66))|(1<<(apexParserEXCLUDES-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66)))) != 0) || (((_la-98)&-(0x1f+1)) == 0 && ((1<<uint((_la-98)))&((1<<(apexParserRETURNING-98))|(1<<(apexParserALL-98))|(1<<(apexParserROWS-98))|(1<<(apexParserTRIGGER-98))|(1<<(apexParserRUNAS-98))|(1<<(apexParserSYSTEM-98))|(1<<(apexParserIntegerLiteral-98))|(1<<(apexParserFloatingPointLiteral-98))|(1<<(apexParserBooleanLiteral-98))|(1<<(apexParserStringLiteral-98))|(1<<(apexParserNullLiteral-98))|(1<<(apexParserLPAREN-98))|(1<<(apexParserLBRACK-98))|(1<<(apexParserLT-98))|(1<<(apexParserBANG-98)))) != 0) || (((_la-136)&-(0x1f+1)) == 0 && ((1<<uint((_la-136)))&((1<<(apexParserINC-136))|(1<<(apexParserDEC-136))|(1<<(apexParserADD-136))|(1<<(apexParserSUB-136))|(1<<(apexParserIdentifier-136))|(1<<(apexParserAT-136)))) != 0) {
 			{
-				p.SetState(914)
+				p.SetState(928)
 				p.ForInit()
 			}
 
 		}
 		{
-			p.SetState(917)
+			p.SetState(931)
 			p.Match(apexParserSEMI)
 		}
-		p.SetState(919)
+		p.SetState(933)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || (((_la-103)&-(0x1f+1)) == 0 && ((1<<uint((_la-103)))&((1<<(apexParserRUNAS-103))|(1<<(apexParserSYSTEM-103))|(1<<(apexParserIntegerLiteral-103))|(1<<(apexParserFloatingPointLiteral-103))|(1<<(apexParserBooleanLiteral-103))|(1<<(apexParserStringLiteral-103))|(1<<(apexParserNullLiteral-103))|(1<<(apexParserLPAREN-103))|(1<<(apexParserLBRACK-103))|(1<<(apexParserLT-103))|(1<<(apexParserBANG-103))|(1<<(apexParserINC-103))|(1<<(apexParserDEC-103)))) != 0) || (((_la-135)&-(0x1f+1)) == 0 && ((1<<uint((_la-135)))&((1<<(apexParserADD-135))|(1<<(apexParserSUB-135))|(1<<(apexParserIdentifier-135)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserINCLUDES-66))|(1<<(apexParserEXCLUDES-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66)))) != 0) || (((_la-98)&-(0x1f+1)) == 0 && ((1<<uint((_la-98)))&((1<<(apexParserRETURNING-98))|(1<<(apexParserALL-98))|(1<<(apexParserROWS-98))|(1<<(apexParserTRIGGER-98))|(1<<(apexParserRUNAS-98))|(1<<(apexParserSYSTEM-98))|(1<<(apexParserIntegerLiteral-98))|(1<<(apexParserFloatingPointLiteral-98))|(1<<(apexParserBooleanLiteral-98))|(1<<(apexParserStringLiteral-98))|(1<<(apexParserNullLiteral-98))|(1<<(apexParserLPAREN-98))|(1<<(apexParserLBRACK-98))|(1<<(apexParserLT-98))|(1<<(apexParserBANG-98)))) != 0) || (((_la-136)&-(0x1f+1)) == 0 && ((1<<uint((_la-136)))&((1<<(apexParserINC-136))|(1<<(apexParserDEC-136))|(1<<(apexParserADD-136))|(1<<(apexParserSUB-136))|(1<<(apexParserIdentifier-136)))) != 0) {
 			{
-				p.SetState(918)
+				p.SetState(932)
 				p.expression(0)
 			}
 
 		}
 		{
-			p.SetState(921)
+			p.SetState(935)
 			p.Match(apexParserSEMI)
 		}
-		p.SetState(923)
+		p.SetState(937)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || (((_la-103)&-(0x1f+1)) == 0 && ((1<<uint((_la-103)))&((1<<(apexParserRUNAS-103))|(1<<(apexParserSYSTEM-103))|(1<<(apexParserIntegerLiteral-103))|(1<<(apexParserFloatingPointLiteral-103))|(1<<(apexParserBooleanLiteral-103))|(1<<(apexParserStringLiteral-103))|(1<<(apexParserNullLiteral-103))|(1<<(apexParserLPAREN-103))|(1<<(apexParserLBRACK-103))|(1<<(apexParserLT-103))|(1<<(apexParserBANG-103))|(1<<(apexParserINC-103))|(1<<(apexParserDEC-103)))) != 0) || (((_la-135)&-(0x1f+1)) == 0 && ((1<<uint((_la-135)))&((1<<(apexParserADD-135))|(1<<(apexParserSUB-135))|(1<<(apexParserIdentifier-135)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserINCLUDES-66))|(1<<(apexParserEXCLUDES-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66)))) != 0) || (((_la-98)&-(0x1f+1)) == 0 && ((1<<uint((_la-98)))&((1<<(apexParserRETURNING-98))|(1<<(apexParserALL-98))|(1<<(apexParserROWS-98))|(1<<(apexParserTRIGGER-98))|(1<<(apexParserRUNAS-98))|(1<<(apexParserSYSTEM-98))|(1<<(apexParserIntegerLiteral-98))|(1<<(apexParserFloatingPointLiteral-98))|(1<<(apexParserBooleanLiteral-98))|(1<<(apexParserStringLiteral-98))|(1<<(apexParserNullLiteral-98))|(1<<(apexParserLPAREN-98))|(1<<(apexParserLBRACK-98))|(1<<(apexParserLT-98))|(1<<(apexParserBANG-98)))) != 0) || (((_la-136)&-(0x1f+1)) == 0 && ((1<<uint((_la-136)))&((1<<(apexParserINC-136))|(1<<(apexParserDEC-136))|(1<<(apexParserADD-136))|(1<<(apexParserSUB-136))|(1<<(apexParserIdentifier-136)))) != 0) {
 			{
-				p.SetState(922)
+				p.SetState(936)
 				p.ForUpdate()
 			}
 
@@ -12491,7 +12668,7 @@ func (s *ForInitContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *apexParser) ForInit() (localctx IForInitContext) {
 	localctx = NewForInitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 142, apexParserRULE_forInit)
+	p.EnterRule(localctx, 144, apexParserRULE_forInit)
 
 	defer func() {
 		p.ExitRule()
@@ -12509,20 +12686,20 @@ func (p *apexParser) ForInit() (localctx IForInitContext) {
 		}
 	}()
 
-	p.SetState(929)
+	p.SetState(943)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 98, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 99, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(927)
+			p.SetState(941)
 			p.LocalVariableDeclaration()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(928)
+			p.SetState(942)
 			p.ExpressionList()
 		}
 
@@ -12654,7 +12831,7 @@ func (s *EnhancedForControlContext) Accept(visitor antlr.ParseTreeVisitor) inter
 
 func (p *apexParser) EnhancedForControl() (localctx IEnhancedForControlContext) {
 	localctx = NewEnhancedForControlContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 144, apexParserRULE_enhancedForControl)
+	p.EnterRule(localctx, 146, apexParserRULE_enhancedForControl)
 	var _la int
 
 	defer func() {
@@ -12674,34 +12851,34 @@ func (p *apexParser) EnhancedForControl() (localctx IEnhancedForControlContext)
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(934)
+	p.SetState(948)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserFINAL || _la == apexParserAT {
 		{
-			p.SetState(931)
+			p.SetState(945)
 			p.VariableModifier()
 		}
 
-		p.SetState(936)
+		p.SetState(950)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(937)
+		p.SetState(951)
 		p.ApexType()
 	}
 	{
-		p.SetState(938)
+		p.SetState(952)
 		p.VariableDeclaratorId()
 	}
 	{
-		p.SetState(939)
+		p.SetState(953)
 		p.Match(apexParserCOLON)
 	}
 	{
-		p.SetState(940)
+		p.SetState(954)
 		p.expression(0)
 	}
 
@@ -12788,7 +12965,7 @@ func (s *ForUpdateContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *apexParser) ForUpdate() (localctx IForUpdateContext) {
 	localctx = NewForUpdateContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 146, apexParserRULE_forUpdate)
+	p.EnterRule(localctx, 148, apexParserRULE_forUpdate)
 
 	defer func() {
 		p.ExitRule()
@@ -12808,7 +12985,7 @@ func (p *apexParser) ForUpdate() (localctx IForUpdateContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(942)
+		p.SetState(956)
 		p.ExpressionList()
 	}
 
@@ -12895,7 +13072,7 @@ func (s *ParExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *apexParser) ParExpression() (localctx IParExpressionContext) {
 	localctx = NewParExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 148, apexParserRULE_parExpression)
+	p.EnterRule(localctx, 150, apexParserRULE_parExpression)
 
 	defer func() {
 		p.ExitRule()
@@ -12915,15 +13092,15 @@ func (p *apexParser) ParExpression() (localctx IParExpressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(944)
+		p.SetState(958)
 		p.Match(apexParserLPAREN)
 	}
 	{
-		p.SetState(945)
+		p.SetState(959)
 		p.expression(0)
 	}
 	{
-		p.SetState(946)
+		p.SetState(960)
 		p.Match(apexParserRPAREN)
 	}
 
@@ -13023,7 +13200,7 @@ func (s *ExpressionListContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *apexParser) ExpressionList() (localctx IExpressionListContext) {
 	localctx = NewExpressionListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 150, apexParserRULE_expressionList)
+	p.EnterRule(localctx, 152, apexParserRULE_expressionList)
 	var _la int
 
 	defer func() {
@@ -13044,24 +13221,24 @@ func (p *apexParser) ExpressionList() (localctx IExpressionListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(948)
+		p.SetState(962)
 		p.expression(0)
 	}
-	p.SetState(953)
+	p.SetState(967)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserCOMMA {
 		{
-			p.SetState(949)
+			p.SetState(963)
 			p.Match(apexParserCOMMA)
 		}
 		{
-			p.SetState(950)
+			p.SetState(964)
 			p.expression(0)
 		}
 
-		p.SetState(955)
+		p.SetState(969)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -13149,7 +13326,7 @@ func (s *StatementExpressionContext) Accept(visitor antlr.ParseTreeVisitor) inte
 
 func (p *apexParser) StatementExpression() (localctx IStatementExpressionContext) {
 	localctx = NewStatementExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 152, apexParserRULE_statementExpression)
+	p.EnterRule(localctx, 154, apexParserRULE_statementExpression)
 
 	defer func() {
 		p.ExitRule()
@@ -13169,7 +13346,7 @@ func (p *apexParser) StatementExpression() (localctx IStatementExpressionContext
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(956)
+		p.SetState(970)
 		p.expression(0)
 	}
 
@@ -13256,7 +13433,7 @@ func (s *ConstantExpressionContext) Accept(visitor antlr.ParseTreeVisitor) inter
 
 func (p *apexParser) ConstantExpression() (localctx IConstantExpressionContext) {
 	localctx = NewConstantExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 154, apexParserRULE_constantExpression)
+	p.EnterRule(localctx, 156, apexParserRULE_constantExpression)
 
 	defer func() {
 		p.ExitRule()
@@ -13276,7 +13453,7 @@ func (p *apexParser) ConstantExpression() (localctx IConstantExpressionContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(958)
+		p.SetState(972)
 		p.expression(0)
 	}
 
@@ -13404,7 +13581,7 @@ func (s *ApexDbExpressionShortContext) Accept(visitor antlr.ParseTreeVisitor) in
 
 func (p *apexParser) ApexDbExpressionShort() (localctx IApexDbExpressionShortContext) {
 	localctx = NewApexDbExpressionShortContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 156, apexParserRULE_apexDbExpressionShort)
+	p.EnterRule(localctx, 158, apexParserRULE_apexDbExpressionShort)
 	var _la int
 
 	defer func() {
@@ -13423,13 +13600,13 @@ func (p *apexParser) ApexDbExpressionShort() (localctx IApexDbExpressionShortCon
 		}
 	}()
 
-	p.SetState(966)
+	p.SetState(980)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 101, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 102, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(960)
+			p.SetState(974)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -13437,7 +13614,7 @@ func (p *apexParser) ApexDbExpressionShort() (localctx IApexDbExpressionShortCon
 
 			_la = p.GetTokenStream().LA(1)
 
-			if !(((_la-86)&-(0x1f+1)) == 0 && ((1<<uint((_la-86)))&((1<<(apexParserINSERT-86))|(1<<(apexParserUPSERT-86))|(1<<(apexParserUPDATE-86))|(1<<(apexParserDELETE-86))|(1<<(apexParserUNDELETE-86)))) != 0) {
+			if !(((_la-88)&-(0x1f+1)) == 0 && ((1<<uint((_la-88)))&((1<<(apexParserINSERT-88))|(1<<(apexParserUPSERT-88))|(1<<(apexParserUPDATE-88))|(1<<(apexParserDELETE-88))|(1<<(apexParserUNDELETE-88)))) != 0) {
 				var _ri = p.GetErrorHandler().RecoverInline(p)
 
 				localctx.(*ApexDbExpressionShortContext).dml = _ri
@@ -13447,22 +13624,22 @@ func (p *apexParser) ApexDbExpressionShort() (localctx IApexDbExpressionShortCon
 			}
 		}
 		{
-			p.SetState(961)
+			p.SetState(975)
 			p.expression(0)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(962)
+			p.SetState(976)
 			p.Match(apexParserUPSERT)
 		}
 		{
-			p.SetState(963)
+			p.SetState(977)
 			p.expression(0)
 		}
 		{
-			p.SetState(964)
+			p.SetState(978)
 			p.ApexIdentifier()
 		}
 
@@ -13551,7 +13728,7 @@ func (s *ApexDbExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *apexParser) ApexDbExpression() (localctx IApexDbExpressionContext) {
 	localctx = NewApexDbExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 158, apexParserRULE_apexDbExpression)
+	p.EnterRule(localctx, 160, apexParserRULE_apexDbExpression)
 
 	defer func() {
 		p.ExitRule()
@@ -13571,7 +13748,7 @@ func (p *apexParser) ApexDbExpression() (localctx IApexDbExpressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(968)
+		p.SetState(982)
 		p.ApexDbExpressionShort()
 	}
 
@@ -14188,9 +14365,9 @@ func (s *CastExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 type ShiftExpressionContext struct {
 	*ExpressionContext
-	s121 antlr.Token
+	s124 antlr.Token
 	op   []antlr.Token
-	s120 antlr.Token
+	s123 antlr.Token
 }
 
 func NewShiftExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ShiftExpressionContext {
@@ -14203,13 +14380,13 @@ func NewShiftExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext)
 	return p
 }
 
-func (s *ShiftExpressionContext) GetS121() antlr.Token { return s.s121 }
+func (s *ShiftExpressionContext) GetS124() antlr.Token { return s.s124 }
 
-func (s *ShiftExpressionContext) GetS120() antlr.Token { return s.s120 }
+func (s *ShiftExpressionContext) GetS123() antlr.Token { return s.s123 }
 
-func (s *ShiftExpressionContext) SetS121(v antlr.Token) { s.s121 = v }
+func (s *ShiftExpressionContext) SetS124(v antlr.Token) { s.s124 = v }
 
-func (s *ShiftExpressionContext) SetS120(v antlr.Token) { s.s120 = v }
+func (s *ShiftExpressionContext) SetS123(v antlr.Token) { s.s123 = v }
 
 func (s *ShiftExpressionContext) GetOp() []antlr.Token { return s.op }
 
@@ -14388,8 +14565,8 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 	localctx = NewExpressionContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IExpressionContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 160
-	p.EnterRecursionRule(localctx, 160, apexParserRULE_expression, _p)
+	_startState := 162
+	p.EnterRecursionRule(localctx, 162, apexParserRULE_expression, _p)
 	var _la int
 
 	defer func() {
@@ -14411,16 +14588,16 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(983)
+	p.SetState(997)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 102, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 103, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewPrimaryExpressionContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 
 		{
-			p.SetState(971)
+			p.SetState(985)
 			p.Primary()
 		}
 
@@ -14429,11 +14606,11 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(972)
+			p.SetState(986)
 			p.Match(apexParserNEW)
 		}
 		{
-			p.SetState(973)
+			p.SetState(987)
 			p.Creator()
 		}
 
@@ -14442,19 +14619,19 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(974)
+			p.SetState(988)
 			p.Match(apexParserLPAREN)
 		}
 		{
-			p.SetState(975)
+			p.SetState(989)
 			p.ApexType()
 		}
 		{
-			p.SetState(976)
+			p.SetState(990)
 			p.Match(apexParserRPAREN)
 		}
 		{
-			p.SetState(977)
+			p.SetState(991)
 			p.expression(17)
 		}
 
@@ -14463,7 +14640,7 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(979)
+			p.SetState(993)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -14481,7 +14658,7 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 			}
 		}
 		{
-			p.SetState(980)
+			p.SetState(994)
 			p.expression(15)
 		}
 
@@ -14490,7 +14667,7 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(981)
+			p.SetState(995)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -14498,7 +14675,7 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 
 			_la = p.GetTokenStream().LA(1)
 
-			if !(((_la-122)&-(0x1f+1)) == 0 && ((1<<uint((_la-122)))&((1<<(apexParserBANG-122))|(1<<(apexParserADD-122))|(1<<(apexParserSUB-122)))) != 0) {
+			if !(((_la-125)&-(0x1f+1)) == 0 && ((1<<uint((_la-125)))&((1<<(apexParserBANG-125))|(1<<(apexParserADD-125))|(1<<(apexParserSUB-125)))) != 0) {
 				var _ri = p.GetErrorHandler().RecoverInline(p)
 
 				localctx.(*UnaryExpressionContext).op = _ri
@@ -14508,15 +14685,15 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 			}
 		}
 		{
-			p.SetState(982)
+			p.SetState(996)
 			p.expression(14)
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(1056)
+	p.SetState(1070)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 106, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 107, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -14524,19 +14701,19 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(1054)
+			p.SetState(1068)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 105, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 106, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(985)
+				p.SetState(999)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 13)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 13)", ""))
 				}
 				{
-					p.SetState(986)
+					p.SetState(1000)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -14544,7 +14721,7 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 
 					_la = p.GetTokenStream().LA(1)
 
-					if !(((_la-137)&-(0x1f+1)) == 0 && ((1<<uint((_la-137)))&((1<<(apexParserMUL-137))|(1<<(apexParserDIV-137))|(1<<(apexParserMOD-137)))) != 0) {
+					if !(((_la-140)&-(0x1f+1)) == 0 && ((1<<uint((_la-140)))&((1<<(apexParserMUL-140))|(1<<(apexParserDIV-140))|(1<<(apexParserMOD-140)))) != 0) {
 						var _ri = p.GetErrorHandler().RecoverInline(p)
 
 						localctx.(*OpExpressionContext).op = _ri
@@ -14554,20 +14731,20 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(987)
+					p.SetState(1001)
 					p.expression(14)
 				}
 
 			case 2:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(988)
+				p.SetState(1002)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 12)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 12)", ""))
 				}
 				{
-					p.SetState(989)
+					p.SetState(1003)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -14585,99 +14762,99 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(990)
+					p.SetState(1004)
 					p.expression(13)
 				}
 
 			case 3:
 				localctx = NewShiftExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(991)
+				p.SetState(1005)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 11)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 11)", ""))
 				}
-				p.SetState(999)
+				p.SetState(1013)
 				p.GetErrorHandler().Sync(p)
-				switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 103, p.GetParserRuleContext()) {
+				switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 104, p.GetParserRuleContext()) {
 				case 1:
 					{
-						p.SetState(992)
+						p.SetState(1006)
 
 						var _m = p.Match(apexParserLT)
 
-						localctx.(*ShiftExpressionContext).s121 = _m
+						localctx.(*ShiftExpressionContext).s124 = _m
 					}
-					localctx.(*ShiftExpressionContext).op = append(localctx.(*ShiftExpressionContext).op, localctx.(*ShiftExpressionContext).s121)
+					localctx.(*ShiftExpressionContext).op = append(localctx.(*ShiftExpressionContext).op, localctx.(*ShiftExpressionContext).s124)
 					{
-						p.SetState(993)
+						p.SetState(1007)
 
 						var _m = p.Match(apexParserLT)
 
-						localctx.(*ShiftExpressionContext).s121 = _m
+						localctx.(*ShiftExpressionContext).s124 = _m
 					}
-					localctx.(*ShiftExpressionContext).op = append(localctx.(*ShiftExpressionContext).op, localctx.(*ShiftExpressionContext).s121)
+					localctx.(*ShiftExpressionContext).op = append(localctx.(*ShiftExpressionContext).op, localctx.(*ShiftExpressionContext).s124)
 
 				case 2:
 					{
-						p.SetState(994)
+						p.SetState(1008)
 
 						var _m = p.Match(apexParserGT)
 
-						localctx.(*ShiftExpressionContext).s120 = _m
+						localctx.(*ShiftExpressionContext).s123 = _m
 					}
-					localctx.(*ShiftExpressionContext).op = append(localctx.(*ShiftExpressionContext).op, localctx.(*ShiftExpressionContext).s120)
+					localctx.(*ShiftExpressionContext).op = append(localctx.(*ShiftExpressionContext).op, localctx.(*ShiftExpressionContext).s123)
 					{
-						p.SetState(995)
+						p.SetState(1009)
 
 						var _m = p.Match(apexParserGT)
 
-						localctx.(*ShiftExpressionContext).s120 = _m
+						localctx.(*ShiftExpressionContext).s123 = _m
 					}
-					localctx.(*ShiftExpressionContext).op = append(localctx.(*ShiftExpressionContext).op, localctx.(*ShiftExpressionContext).s120)
+					localctx.(*ShiftExpressionContext).op = append(localctx.(*ShiftExpressionContext).op, localctx.(*ShiftExpressionContext).s123)
 					{
-						p.SetState(996)
+						p.SetState(1010)
 
 						var _m = p.Match(apexParserGT)
 
-						localctx.(*ShiftExpressionContext).s120 = _m
+						localctx.(*ShiftExpressionContext).s123 = _m
 					}
-					localctx.(*ShiftExpressionContext).op = append(localctx.(*ShiftExpressionContext).op, localctx.(*ShiftExpressionContext).s120)
+					localctx.(*ShiftExpressionContext).op = append(localctx.(*ShiftExpressionContext).op, localctx.(*ShiftExpressionContext).s123)
 
 				case 3:
 					{
-						p.SetState(997)
+						p.SetState(1011)
 
 						var _m = p.Match(apexParserGT)
 
-						localctx.(*ShiftExpressionContext).s120 = _m
+						localctx.(*ShiftExpressionContext).s123 = _m
 					}
-					localctx.(*ShiftExpressionContext).op = append(localctx.(*ShiftExpressionContext).op, localctx.(*ShiftExpressionContext).s120)
+					localctx.(*ShiftExpressionContext).op = append(localctx.(*ShiftExpressionContext).op, localctx.(*ShiftExpressionContext).s123)
 					{
-						p.SetState(998)
+						p.SetState(1012)
 
 						var _m = p.Match(apexParserGT)
 
-						localctx.(*ShiftExpressionContext).s120 = _m
+						localctx.(*ShiftExpressionContext).s123 = _m
 					}
-					localctx.(*ShiftExpressionContext).op = append(localctx.(*ShiftExpressionContext).op, localctx.(*ShiftExpressionContext).s120)
+					localctx.(*ShiftExpressionContext).op = append(localctx.(*ShiftExpressionContext).op, localctx.(*ShiftExpressionContext).s123)
 
 				}
 				{
-					p.SetState(1001)
+					p.SetState(1015)
 					p.expression(12)
 				}
 
 			case 4:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1002)
+				p.SetState(1016)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
 				}
 				{
-					p.SetState(1003)
+					p.SetState(1017)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -14685,7 +14862,7 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 
 					_la = p.GetTokenStream().LA(1)
 
-					if !(((_la-120)&-(0x1f+1)) == 0 && ((1<<uint((_la-120)))&((1<<(apexParserGT-120))|(1<<(apexParserLT-120))|(1<<(apexParserLE-120))|(1<<(apexParserGE-120)))) != 0) {
+					if !(((_la-123)&-(0x1f+1)) == 0 && ((1<<uint((_la-123)))&((1<<(apexParserGT-123))|(1<<(apexParserLT-123))|(1<<(apexParserLE-123))|(1<<(apexParserGE-123)))) != 0) {
 						var _ri = p.GetErrorHandler().RecoverInline(p)
 
 						localctx.(*OpExpressionContext).op = _ri
@@ -14695,20 +14872,20 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(1004)
+					p.SetState(1018)
 					p.expression(11)
 				}
 
 			case 5:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1005)
+				p.SetState(1019)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
 				}
 				{
-					p.SetState(1006)
+					p.SetState(1020)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -14716,7 +14893,7 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 
 					_la = p.GetTokenStream().LA(1)
 
-					if !(((_la-126)&-(0x1f+1)) == 0 && ((1<<uint((_la-126)))&((1<<(apexParserEQUAL-126))|(1<<(apexParserT_EQUAL-126))|(1<<(apexParserNOTEQUAL-126)))) != 0) {
+					if !(((_la-129)&-(0x1f+1)) == 0 && ((1<<uint((_la-129)))&((1<<(apexParserEQUAL-129))|(1<<(apexParserT_EQUAL-129))|(1<<(apexParserNOTEQUAL-129)))) != 0) {
 						var _ri = p.GetErrorHandler().RecoverInline(p)
 
 						localctx.(*OpExpressionContext).op = _ri
@@ -14726,148 +14903,148 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(1007)
+					p.SetState(1021)
 					p.expression(9)
 				}
 
 			case 6:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1008)
+				p.SetState(1022)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
 				}
 				{
-					p.SetState(1009)
+					p.SetState(1023)
 
 					var _m = p.Match(apexParserBITAND)
 
 					localctx.(*OpExpressionContext).op = _m
 				}
 				{
-					p.SetState(1010)
+					p.SetState(1024)
 					p.expression(8)
 				}
 
 			case 7:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1011)
+				p.SetState(1025)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
 				}
 				{
-					p.SetState(1012)
+					p.SetState(1026)
 
 					var _m = p.Match(apexParserCARET)
 
 					localctx.(*OpExpressionContext).op = _m
 				}
 				{
-					p.SetState(1013)
+					p.SetState(1027)
 					p.expression(7)
 				}
 
 			case 8:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1014)
+				p.SetState(1028)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
 				}
 				{
-					p.SetState(1015)
+					p.SetState(1029)
 
 					var _m = p.Match(apexParserBITOR)
 
 					localctx.(*OpExpressionContext).op = _m
 				}
 				{
-					p.SetState(1016)
+					p.SetState(1030)
 					p.expression(6)
 				}
 
 			case 9:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1017)
+				p.SetState(1031)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
 				}
 				{
-					p.SetState(1018)
+					p.SetState(1032)
 
 					var _m = p.Match(apexParserAND)
 
 					localctx.(*OpExpressionContext).op = _m
 				}
 				{
-					p.SetState(1019)
+					p.SetState(1033)
 					p.expression(5)
 				}
 
 			case 10:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1020)
+				p.SetState(1034)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
 				}
 				{
-					p.SetState(1021)
+					p.SetState(1035)
 
 					var _m = p.Match(apexParserOR)
 
 					localctx.(*OpExpressionContext).op = _m
 				}
 				{
-					p.SetState(1022)
+					p.SetState(1036)
 					p.expression(4)
 				}
 
 			case 11:
 				localctx = NewTernalyExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1023)
+				p.SetState(1037)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 				}
 				{
-					p.SetState(1024)
+					p.SetState(1038)
 
 					var _m = p.Match(apexParserQUESTION)
 
 					localctx.(*TernalyExpressionContext).op = _m
 				}
 				{
-					p.SetState(1025)
+					p.SetState(1039)
 					p.expression(0)
 				}
 				{
-					p.SetState(1026)
+					p.SetState(1040)
 					p.Match(apexParserCOLON)
 				}
 				{
-					p.SetState(1027)
+					p.SetState(1041)
 					p.expression(3)
 				}
 
 			case 12:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1029)
+				p.SetState(1043)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 				}
 				{
-					p.SetState(1030)
+					p.SetState(1044)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -14875,7 +15052,7 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 
 					_la = p.GetTokenStream().LA(1)
 
-					if !((((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(apexParserASSIGN-119))|(1<<(apexParserADD_ASSIGN-119))|(1<<(apexParserSUB_ASSIGN-119))|(1<<(apexParserMUL_ASSIGN-119))|(1<<(apexParserDIV_ASSIGN-119))|(1<<(apexParserAND_ASSIGN-119))|(1<<(apexParserOR_ASSIGN-119))|(1<<(apexParserXOR_ASSIGN-119))|(1<<(apexParserMOD_ASSIGN-119)))) != 0) || (((_la-151)&-(0x1f+1)) == 0 && ((1<<uint((_la-151)))&((1<<(apexParserLSHIFT_ASSIGN-151))|(1<<(apexParserRSHIFT_ASSIGN-151))|(1<<(apexParserURSHIFT_ASSIGN-151)))) != 0)) {
+					if !((((_la-122)&-(0x1f+1)) == 0 && ((1<<uint((_la-122)))&((1<<(apexParserASSIGN-122))|(1<<(apexParserADD_ASSIGN-122))|(1<<(apexParserSUB_ASSIGN-122))|(1<<(apexParserMUL_ASSIGN-122))|(1<<(apexParserDIV_ASSIGN-122))|(1<<(apexParserAND_ASSIGN-122))|(1<<(apexParserOR_ASSIGN-122))|(1<<(apexParserXOR_ASSIGN-122))|(1<<(apexParserMOD_ASSIGN-122)))) != 0) || (((_la-154)&-(0x1f+1)) == 0 && ((1<<uint((_la-154)))&((1<<(apexParserLSHIFT_ASSIGN-154))|(1<<(apexParserRSHIFT_ASSIGN-154))|(1<<(apexParserURSHIFT_ASSIGN-154)))) != 0)) {
 						var _ri = p.GetErrorHandler().RecoverInline(p)
 
 						localctx.(*OpExpressionContext).op = _ri
@@ -14885,103 +15062,103 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(1031)
+					p.SetState(1045)
 					p.expression(1)
 				}
 
 			case 13:
 				localctx = NewFieldAccessContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1032)
+				p.SetState(1046)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 22)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 22)", ""))
 				}
 				{
-					p.SetState(1033)
+					p.SetState(1047)
 					p.Match(apexParserDOT)
 				}
 				{
-					p.SetState(1034)
+					p.SetState(1048)
 					p.ApexIdentifier()
 				}
 
 			case 14:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1035)
+				p.SetState(1049)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 21)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 21)", ""))
 				}
 				{
-					p.SetState(1036)
+					p.SetState(1050)
 					p.Match(apexParserDOT)
 				}
 				{
-					p.SetState(1037)
+					p.SetState(1051)
 					p.ExplicitGenericInvocation()
 				}
 
 			case 15:
 				localctx = NewArrayAccessContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1038)
+				p.SetState(1052)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 20)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 20)", ""))
 				}
 				{
-					p.SetState(1039)
+					p.SetState(1053)
 					p.Match(apexParserLBRACK)
 				}
 				{
-					p.SetState(1040)
+					p.SetState(1054)
 					p.expression(0)
 				}
 				{
-					p.SetState(1041)
+					p.SetState(1055)
 					p.Match(apexParserRBRACK)
 				}
 
 			case 16:
 				localctx = NewMethodInvocationContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1043)
+				p.SetState(1057)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 19)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 19)", ""))
 				}
 				{
-					p.SetState(1044)
+					p.SetState(1058)
 					p.Match(apexParserLPAREN)
 				}
-				p.SetState(1046)
+				p.SetState(1060)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
-				if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || (((_la-103)&-(0x1f+1)) == 0 && ((1<<uint((_la-103)))&((1<<(apexParserRUNAS-103))|(1<<(apexParserSYSTEM-103))|(1<<(apexParserIntegerLiteral-103))|(1<<(apexParserFloatingPointLiteral-103))|(1<<(apexParserBooleanLiteral-103))|(1<<(apexParserStringLiteral-103))|(1<<(apexParserNullLiteral-103))|(1<<(apexParserLPAREN-103))|(1<<(apexParserLBRACK-103))|(1<<(apexParserLT-103))|(1<<(apexParserBANG-103))|(1<<(apexParserINC-103))|(1<<(apexParserDEC-103)))) != 0) || (((_la-135)&-(0x1f+1)) == 0 && ((1<<uint((_la-135)))&((1<<(apexParserADD-135))|(1<<(apexParserSUB-135))|(1<<(apexParserIdentifier-135)))) != 0) {
+				if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserINCLUDES-66))|(1<<(apexParserEXCLUDES-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66)))) != 0) || (((_la-98)&-(0x1f+1)) == 0 && ((1<<uint((_la-98)))&((1<<(apexParserRETURNING-98))|(1<<(apexParserALL-98))|(1<<(apexParserROWS-98))|(1<<(apexParserTRIGGER-98))|(1<<(apexParserRUNAS-98))|(1<<(apexParserSYSTEM-98))|(1<<(apexParserIntegerLiteral-98))|(1<<(apexParserFloatingPointLiteral-98))|(1<<(apexParserBooleanLiteral-98))|(1<<(apexParserStringLiteral-98))|(1<<(apexParserNullLiteral-98))|(1<<(apexParserLPAREN-98))|(1<<(apexParserLBRACK-98))|(1<<(apexParserLT-98))|(1<<(apexParserBANG-98)))) != 0) || (((_la-136)&-(0x1f+1)) == 0 && ((1<<uint((_la-136)))&((1<<(apexParserINC-136))|(1<<(apexParserDEC-136))|(1<<(apexParserADD-136))|(1<<(apexParserSUB-136))|(1<<(apexParserIdentifier-136)))) != 0) {
 					{
-						p.SetState(1045)
+						p.SetState(1059)
 						p.ExpressionList()
 					}
 
 				}
 				{
-					p.SetState(1048)
+					p.SetState(1062)
 					p.Match(apexParserRPAREN)
 				}
 
 			case 17:
 				localctx = NewPostUnaryExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1049)
+				p.SetState(1063)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 16)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 16)", ""))
 				}
 				{
-					p.SetState(1050)
+					p.SetState(1064)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -15002,29 +15179,29 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 			case 18:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1051)
+				p.SetState(1065)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
 				}
 				{
-					p.SetState(1052)
+					p.SetState(1066)
 
 					var _m = p.Match(apexParserINSTANCEOF)
 
 					localctx.(*OpExpressionContext).op = _m
 				}
 				{
-					p.SetState(1053)
+					p.SetState(1067)
 					p.ApexType()
 				}
 
 			}
 
 		}
-		p.SetState(1058)
+		p.SetState(1072)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 106, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 107, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -15184,6 +15361,14 @@ func (s *PrimaryContext) PrimitiveType() IPrimitiveTypeContext {
 	return t.(IPrimitiveTypeContext)
 }
 
+func (s *PrimaryContext) TRIGGER() antlr.TerminalNode {
+	return s.GetToken(apexParserTRIGGER, 0)
+}
+
+func (s *PrimaryContext) NEW() antlr.TerminalNode {
+	return s.GetToken(apexParserNEW, 0)
+}
+
 func (s *PrimaryContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -15216,7 +15401,7 @@ func (s *PrimaryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *apexParser) Primary() (localctx IPrimaryContext) {
 	localctx = NewPrimaryContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 162, apexParserRULE_primary)
+	p.EnterRule(localctx, 164, apexParserRULE_primary)
 
 	defer func() {
 		p.ExitRule()
@@ -15234,105 +15419,105 @@ func (p *apexParser) Primary() (localctx IPrimaryContext) {
 		}
 	}()
 
-	p.SetState(1083)
+	p.SetState(1103)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 108, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 110, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1059)
+			p.SetState(1073)
 			p.Match(apexParserLPAREN)
 		}
 		{
-			p.SetState(1060)
+			p.SetState(1074)
 			p.expression(0)
 		}
 		{
-			p.SetState(1061)
+			p.SetState(1075)
 			p.Match(apexParserRPAREN)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1063)
+			p.SetState(1077)
 			p.Match(apexParserTHIS)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1064)
+			p.SetState(1078)
 			p.Match(apexParserSUPER)
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(1065)
+			p.SetState(1079)
 			p.Literal()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(1066)
+			p.SetState(1080)
 			p.ApexIdentifier()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(1067)
+			p.SetState(1081)
 			p.ApexType()
 		}
 		{
-			p.SetState(1068)
+			p.SetState(1082)
 			p.Match(apexParserDOT)
 		}
 		{
-			p.SetState(1069)
+			p.SetState(1083)
 			p.Match(apexParserCLASS)
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(1071)
+			p.SetState(1085)
 			p.Match(apexParserVOID)
 		}
 		{
-			p.SetState(1072)
+			p.SetState(1086)
 			p.Match(apexParserDOT)
 		}
 		{
-			p.SetState(1073)
+			p.SetState(1087)
 			p.Match(apexParserCLASS)
 		}
 
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(1074)
+			p.SetState(1088)
 			p.NonWildcardTypeArguments()
 		}
-		p.SetState(1078)
+		p.SetState(1092)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
-		case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserSUPER, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
+		case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserSUPER, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserINCLUDES, apexParserEXCLUDES, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserROWS, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
 			{
-				p.SetState(1075)
+				p.SetState(1089)
 				p.ExplicitGenericInvocationSuffix()
 			}
 
 		case apexParserTHIS:
 			{
-				p.SetState(1076)
+				p.SetState(1090)
 				p.Match(apexParserTHIS)
 			}
 			{
-				p.SetState(1077)
+				p.SetState(1091)
 				p.Arguments()
 			}
 
@@ -15343,24 +15528,54 @@ func (p *apexParser) Primary() (localctx IPrimaryContext) {
 	case 9:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(1080)
+			p.SetState(1094)
 			p.SoqlLiteral()
 		}
 
 	case 10:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(1081)
+			p.SetState(1095)
 			p.SoslLiteral()
 		}
 
 	case 11:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(1082)
+			p.SetState(1096)
 			p.PrimitiveType()
 		}
 
+	case 12:
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(1097)
+			p.Match(apexParserTRIGGER)
+		}
+		{
+			p.SetState(1098)
+			p.Match(apexParserDOT)
+		}
+		p.SetState(1101)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case apexParserNEW:
+			{
+				p.SetState(1099)
+				p.Match(apexParserNEW)
+			}
+
+		case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserINCLUDES, apexParserEXCLUDES, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserROWS, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
+			{
+				p.SetState(1100)
+				p.ApexIdentifier()
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+
 	}
 
 	return localctx
@@ -15496,7 +15711,7 @@ func (s *CreatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *apexParser) Creator() (localctx ICreatorContext) {
 	localctx = NewCreatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 164, apexParserRULE_creator)
+	p.EnterRule(localctx, 166, apexParserRULE_creator)
 
 	defer func() {
 		p.ExitRule()
@@ -15514,55 +15729,55 @@ func (p *apexParser) Creator() (localctx ICreatorContext) {
 		}
 	}()
 
-	p.SetState(1096)
+	p.SetState(1116)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserLT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1085)
+			p.SetState(1105)
 			p.NonWildcardTypeArguments()
 		}
 		{
-			p.SetState(1086)
+			p.SetState(1106)
 			p.CreatedName()
 		}
 		{
-			p.SetState(1087)
+			p.SetState(1107)
 			p.ClassCreatorRest()
 		}
 
-	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
+	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserINCLUDES, apexParserEXCLUDES, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserROWS, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1089)
+			p.SetState(1109)
 			p.CreatedName()
 		}
-		p.SetState(1094)
+		p.SetState(1114)
 		p.GetErrorHandler().Sync(p)
-		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 109, p.GetParserRuleContext()) {
+		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 111, p.GetParserRuleContext()) {
 		case 1:
 			{
-				p.SetState(1090)
+				p.SetState(1110)
 				p.ArrayCreatorRest()
 			}
 
 		case 2:
 			{
-				p.SetState(1091)
+				p.SetState(1111)
 				p.ClassCreatorRest()
 			}
 
 		case 3:
 			{
-				p.SetState(1092)
+				p.SetState(1112)
 				p.MapCreatorRest()
 			}
 
 		case 4:
 			{
-				p.SetState(1093)
+				p.SetState(1113)
 				p.SetCreatorRest()
 			}
 
@@ -15705,7 +15920,7 @@ func (s *CreatedNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *apexParser) CreatedName() (localctx ICreatedNameContext) {
 	localctx = NewCreatedNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 166, apexParserRULE_createdName)
+	p.EnterRule(localctx, 168, apexParserRULE_createdName)
 	var _la int
 
 	defer func() {
@@ -15724,52 +15939,52 @@ func (p *apexParser) CreatedName() (localctx ICreatedNameContext) {
 		}
 	}()
 
-	p.SetState(1115)
+	p.SetState(1135)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 114, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 116, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1098)
+			p.SetState(1118)
 			p.ApexIdentifier()
 		}
-		p.SetState(1100)
+		p.SetState(1120)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == apexParserLT {
 			{
-				p.SetState(1099)
+				p.SetState(1119)
 				p.TypeArgumentsOrDiamond()
 			}
 
 		}
-		p.SetState(1109)
+		p.SetState(1129)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == apexParserDOT {
 			{
-				p.SetState(1102)
+				p.SetState(1122)
 				p.Match(apexParserDOT)
 			}
 			{
-				p.SetState(1103)
+				p.SetState(1123)
 				p.ApexIdentifier()
 			}
-			p.SetState(1105)
+			p.SetState(1125)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == apexParserLT {
 				{
-					p.SetState(1104)
+					p.SetState(1124)
 					p.TypeArgumentsOrDiamond()
 				}
 
 			}
 
-			p.SetState(1111)
+			p.SetState(1131)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
@@ -15777,18 +15992,18 @@ func (p *apexParser) CreatedName() (localctx ICreatedNameContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1112)
+			p.SetState(1132)
 			p.PrimitiveType()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1113)
+			p.SetState(1133)
 			p.Match(apexParserSET)
 		}
 		{
-			p.SetState(1114)
+			p.SetState(1134)
 			p.TypeArgumentsOrDiamond()
 		}
 
@@ -15897,7 +16112,7 @@ func (s *InnerCreatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *apexParser) InnerCreator() (localctx IInnerCreatorContext) {
 	localctx = NewInnerCreatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 168, apexParserRULE_innerCreator)
+	p.EnterRule(localctx, 170, apexParserRULE_innerCreator)
 	var _la int
 
 	defer func() {
@@ -15918,22 +16133,22 @@ func (p *apexParser) InnerCreator() (localctx IInnerCreatorContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1117)
+		p.SetState(1137)
 		p.ApexIdentifier()
 	}
-	p.SetState(1119)
+	p.SetState(1139)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserLT {
 		{
-			p.SetState(1118)
+			p.SetState(1138)
 			p.NonWildcardTypeArgumentsOrDiamond()
 		}
 
 	}
 	{
-		p.SetState(1121)
+		p.SetState(1141)
 		p.ClassCreatorRest()
 	}
 
@@ -16066,7 +16281,7 @@ func (s *ArrayCreatorRestContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *apexParser) ArrayCreatorRest() (localctx IArrayCreatorRestContext) {
 	localctx = NewArrayCreatorRestContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 170, apexParserRULE_arrayCreatorRest)
+	p.EnterRule(localctx, 172, apexParserRULE_arrayCreatorRest)
 	var _la int
 
 	defer func() {
@@ -16087,87 +16302,87 @@ func (p *apexParser) ArrayCreatorRest() (localctx IArrayCreatorRestContext) {
 
 	var _alt int
 
-	p.SetState(1150)
+	p.SetState(1170)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 119, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 121, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1123)
+			p.SetState(1143)
 			p.TypedArray()
 		}
-		p.SetState(1127)
+		p.SetState(1147)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == apexParserLBRACK {
 			{
-				p.SetState(1124)
+				p.SetState(1144)
 				p.TypedArray()
 			}
 
-			p.SetState(1129)
+			p.SetState(1149)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(1130)
+			p.SetState(1150)
 			p.ArrayInitializer()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1132)
+			p.SetState(1152)
 			p.Match(apexParserLBRACK)
 		}
 		{
-			p.SetState(1133)
+			p.SetState(1153)
 			p.expression(0)
 		}
 		{
-			p.SetState(1134)
+			p.SetState(1154)
 			p.Match(apexParserRBRACK)
 		}
-		p.SetState(1141)
+		p.SetState(1161)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 117, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 119, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(1135)
+					p.SetState(1155)
 					p.Match(apexParserLBRACK)
 				}
 				{
-					p.SetState(1136)
+					p.SetState(1156)
 					p.expression(0)
 				}
 				{
-					p.SetState(1137)
+					p.SetState(1157)
 					p.Match(apexParserRBRACK)
 				}
 
 			}
-			p.SetState(1143)
+			p.SetState(1163)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 117, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 119, p.GetParserRuleContext())
 		}
-		p.SetState(1147)
+		p.SetState(1167)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 118, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 120, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(1144)
+					p.SetState(1164)
 					p.TypedArray()
 				}
 
 			}
-			p.SetState(1149)
+			p.SetState(1169)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 118, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 120, p.GetParserRuleContext())
 		}
 
 	}
@@ -16291,7 +16506,7 @@ func (s *MapCreatorRestContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *apexParser) MapCreatorRest() (localctx IMapCreatorRestContext) {
 	localctx = NewMapCreatorRestContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 172, apexParserRULE_mapCreatorRest)
+	p.EnterRule(localctx, 174, apexParserRULE_mapCreatorRest)
 	var _la int
 
 	defer func() {
@@ -16310,66 +16525,66 @@ func (p *apexParser) MapCreatorRest() (localctx IMapCreatorRestContext) {
 		}
 	}()
 
-	p.SetState(1170)
+	p.SetState(1190)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 121, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 123, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1152)
+			p.SetState(1172)
 			p.Match(apexParserLBRACE)
 		}
 		{
-			p.SetState(1153)
+			p.SetState(1173)
 			p.Match(apexParserRBRACE)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1154)
+			p.SetState(1174)
 			p.Match(apexParserLBRACE)
 		}
 		{
-			p.SetState(1155)
+			p.SetState(1175)
 			p.MapKey()
 		}
 		{
-			p.SetState(1156)
+			p.SetState(1176)
 			p.Match(apexParserLAMBDA_LIKE)
 		}
 		{
-			p.SetState(1157)
+			p.SetState(1177)
 			p.MapValue()
 		}
-		p.SetState(1165)
+		p.SetState(1185)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == apexParserCOMMA {
 			{
-				p.SetState(1158)
+				p.SetState(1178)
 				p.Match(apexParserCOMMA)
 			}
 			{
-				p.SetState(1159)
+				p.SetState(1179)
 				p.MapKey()
 			}
 			{
-				p.SetState(1160)
+				p.SetState(1180)
 				p.Match(apexParserLAMBDA_LIKE)
 			}
 			{
-				p.SetState(1161)
+				p.SetState(1181)
 				p.MapValue()
 			}
 
-			p.SetState(1167)
+			p.SetState(1187)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(1168)
+			p.SetState(1188)
 			p.Match(apexParserRBRACE)
 		}
 
@@ -16468,7 +16683,7 @@ func (s *MapKeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *apexParser) MapKey() (localctx IMapKeyContext) {
 	localctx = NewMapKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 174, apexParserRULE_mapKey)
+	p.EnterRule(localctx, 176, apexParserRULE_mapKey)
 
 	defer func() {
 		p.ExitRule()
@@ -16486,20 +16701,20 @@ func (p *apexParser) MapKey() (localctx IMapKeyContext) {
 		}
 	}()
 
-	p.SetState(1174)
+	p.SetState(1194)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 122, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 124, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1172)
+			p.SetState(1192)
 			p.ApexIdentifier()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1173)
+			p.SetState(1193)
 			p.expression(0)
 		}
 
@@ -16598,7 +16813,7 @@ func (s *MapValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *apexParser) MapValue() (localctx IMapValueContext) {
 	localctx = NewMapValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 176, apexParserRULE_mapValue)
+	p.EnterRule(localctx, 178, apexParserRULE_mapValue)
 
 	defer func() {
 		p.ExitRule()
@@ -16616,20 +16831,20 @@ func (p *apexParser) MapValue() (localctx IMapValueContext) {
 		}
 	}()
 
-	p.SetState(1178)
+	p.SetState(1198)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 123, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 125, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1176)
+			p.SetState(1196)
 			p.Literal()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1177)
+			p.SetState(1197)
 			p.expression(0)
 		}
 
@@ -16731,7 +16946,7 @@ func (s *SetCreatorRestContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *apexParser) SetCreatorRest() (localctx ISetCreatorRestContext) {
 	localctx = NewSetCreatorRestContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 178, apexParserRULE_setCreatorRest)
+	p.EnterRule(localctx, 180, apexParserRULE_setCreatorRest)
 	var _la int
 
 	defer func() {
@@ -16752,33 +16967,33 @@ func (p *apexParser) SetCreatorRest() (localctx ISetCreatorRestContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1180)
+		p.SetState(1200)
 		p.Match(apexParserLBRACE)
 	}
 	{
-		p.SetState(1181)
+		p.SetState(1201)
 		p.SetValue()
 	}
-	p.SetState(1186)
+	p.SetState(1206)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserCOMMA {
 		{
-			p.SetState(1182)
+			p.SetState(1202)
 			p.Match(apexParserCOMMA)
 		}
 		{
-			p.SetState(1183)
+			p.SetState(1203)
 			p.SetValue()
 		}
 
-		p.SetState(1188)
+		p.SetState(1208)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(1189)
+		p.SetState(1209)
 		p.Match(apexParserRBRACE)
 	}
 
@@ -16875,7 +17090,7 @@ func (s *SetValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *apexParser) SetValue() (localctx ISetValueContext) {
 	localctx = NewSetValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 180, apexParserRULE_setValue)
+	p.EnterRule(localctx, 182, apexParserRULE_setValue)
 
 	defer func() {
 		p.ExitRule()
@@ -16893,20 +17108,20 @@ func (p *apexParser) SetValue() (localctx ISetValueContext) {
 		}
 	}()
 
-	p.SetState(1193)
+	p.SetState(1213)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 125, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 127, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1191)
+			p.SetState(1211)
 			p.Literal()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1192)
+			p.SetState(1212)
 			p.expression(0)
 		}
 
@@ -17005,7 +17220,7 @@ func (s *ClassCreatorRestContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *apexParser) ClassCreatorRest() (localctx IClassCreatorRestContext) {
 	localctx = NewClassCreatorRestContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 182, apexParserRULE_classCreatorRest)
+	p.EnterRule(localctx, 184, apexParserRULE_classCreatorRest)
 
 	defer func() {
 		p.ExitRule()
@@ -17025,15 +17240,15 @@ func (p *apexParser) ClassCreatorRest() (localctx IClassCreatorRestContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1195)
+		p.SetState(1215)
 		p.Arguments()
 	}
-	p.SetState(1197)
+	p.SetState(1217)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 126, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 128, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(1196)
+			p.SetState(1216)
 			p.ClassBody()
 		}
 
@@ -17132,7 +17347,7 @@ func (s *ExplicitGenericInvocationContext) Accept(visitor antlr.ParseTreeVisitor
 
 func (p *apexParser) ExplicitGenericInvocation() (localctx IExplicitGenericInvocationContext) {
 	localctx = NewExplicitGenericInvocationContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 184, apexParserRULE_explicitGenericInvocation)
+	p.EnterRule(localctx, 186, apexParserRULE_explicitGenericInvocation)
 
 	defer func() {
 		p.ExitRule()
@@ -17152,11 +17367,11 @@ func (p *apexParser) ExplicitGenericInvocation() (localctx IExplicitGenericInvoc
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1199)
+		p.SetState(1219)
 		p.NonWildcardTypeArguments()
 	}
 	{
-		p.SetState(1200)
+		p.SetState(1220)
 		p.ExplicitGenericInvocationSuffix()
 	}
 
@@ -17243,7 +17458,7 @@ func (s *NonWildcardTypeArgumentsContext) Accept(visitor antlr.ParseTreeVisitor)
 
 func (p *apexParser) NonWildcardTypeArguments() (localctx INonWildcardTypeArgumentsContext) {
 	localctx = NewNonWildcardTypeArgumentsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 186, apexParserRULE_nonWildcardTypeArguments)
+	p.EnterRule(localctx, 188, apexParserRULE_nonWildcardTypeArguments)
 
 	defer func() {
 		p.ExitRule()
@@ -17263,15 +17478,15 @@ func (p *apexParser) NonWildcardTypeArguments() (localctx INonWildcardTypeArgume
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1202)
+		p.SetState(1222)
 		p.Match(apexParserLT)
 	}
 	{
-		p.SetState(1203)
+		p.SetState(1223)
 		p.TypeList()
 	}
 	{
-		p.SetState(1204)
+		p.SetState(1224)
 		p.Match(apexParserGT)
 	}
 
@@ -17358,7 +17573,7 @@ func (s *TypeArgumentsOrDiamondContext) Accept(visitor antlr.ParseTreeVisitor) i
 
 func (p *apexParser) TypeArgumentsOrDiamond() (localctx ITypeArgumentsOrDiamondContext) {
 	localctx = NewTypeArgumentsOrDiamondContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 188, apexParserRULE_typeArgumentsOrDiamond)
+	p.EnterRule(localctx, 190, apexParserRULE_typeArgumentsOrDiamond)
 
 	defer func() {
 		p.ExitRule()
@@ -17376,24 +17591,24 @@ func (p *apexParser) TypeArgumentsOrDiamond() (localctx ITypeArgumentsOrDiamondC
 		}
 	}()
 
-	p.SetState(1209)
+	p.SetState(1229)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 127, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 129, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1206)
+			p.SetState(1226)
 			p.Match(apexParserLT)
 		}
 		{
-			p.SetState(1207)
+			p.SetState(1227)
 			p.Match(apexParserGT)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1208)
+			p.SetState(1228)
 			p.TypeArguments()
 		}
 
@@ -17482,7 +17697,7 @@ func (s *NonWildcardTypeArgumentsOrDiamondContext) Accept(visitor antlr.ParseTre
 
 func (p *apexParser) NonWildcardTypeArgumentsOrDiamond() (localctx INonWildcardTypeArgumentsOrDiamondContext) {
 	localctx = NewNonWildcardTypeArgumentsOrDiamondContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 190, apexParserRULE_nonWildcardTypeArgumentsOrDiamond)
+	p.EnterRule(localctx, 192, apexParserRULE_nonWildcardTypeArgumentsOrDiamond)
 
 	defer func() {
 		p.ExitRule()
@@ -17500,24 +17715,24 @@ func (p *apexParser) NonWildcardTypeArgumentsOrDiamond() (localctx INonWildcardT
 		}
 	}()
 
-	p.SetState(1214)
+	p.SetState(1234)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 128, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 130, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1211)
+			p.SetState(1231)
 			p.Match(apexParserLT)
 		}
 		{
-			p.SetState(1212)
+			p.SetState(1232)
 			p.Match(apexParserGT)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1213)
+			p.SetState(1233)
 			p.NonWildcardTypeArguments()
 		}
 
@@ -17616,7 +17831,7 @@ func (s *SuperSuffixContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *apexParser) SuperSuffix() (localctx ISuperSuffixContext) {
 	localctx = NewSuperSuffixContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 192, apexParserRULE_superSuffix)
+	p.EnterRule(localctx, 194, apexParserRULE_superSuffix)
 
 	defer func() {
 		p.ExitRule()
@@ -17634,33 +17849,33 @@ func (p *apexParser) SuperSuffix() (localctx ISuperSuffixContext) {
 		}
 	}()
 
-	p.SetState(1222)
+	p.SetState(1242)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserLPAREN:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1216)
+			p.SetState(1236)
 			p.Arguments()
 		}
 
 	case apexParserDOT:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1217)
+			p.SetState(1237)
 			p.Match(apexParserDOT)
 		}
 		{
-			p.SetState(1218)
+			p.SetState(1238)
 			p.ApexIdentifier()
 		}
-		p.SetState(1220)
+		p.SetState(1240)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 129, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 131, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(1219)
+				p.SetState(1239)
 				p.Arguments()
 			}
 
@@ -17777,7 +17992,7 @@ func (s *ExplicitGenericInvocationSuffixContext) Accept(visitor antlr.ParseTreeV
 
 func (p *apexParser) ExplicitGenericInvocationSuffix() (localctx IExplicitGenericInvocationSuffixContext) {
 	localctx = NewExplicitGenericInvocationSuffixContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 194, apexParserRULE_explicitGenericInvocationSuffix)
+	p.EnterRule(localctx, 196, apexParserRULE_explicitGenericInvocationSuffix)
 
 	defer func() {
 		p.ExitRule()
@@ -17795,29 +18010,29 @@ func (p *apexParser) ExplicitGenericInvocationSuffix() (localctx IExplicitGeneri
 		}
 	}()
 
-	p.SetState(1229)
+	p.SetState(1249)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserSUPER:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1224)
+			p.SetState(1244)
 			p.Match(apexParserSUPER)
 		}
 		{
-			p.SetState(1225)
+			p.SetState(1245)
 			p.SuperSuffix()
 		}
 
-	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
+	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserINCLUDES, apexParserEXCLUDES, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserROWS, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1226)
+			p.SetState(1246)
 			p.ApexIdentifier()
 		}
 		{
-			p.SetState(1227)
+			p.SetState(1247)
 			p.Arguments()
 		}
 
@@ -17908,7 +18123,7 @@ func (s *ArgumentsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *apexParser) Arguments() (localctx IArgumentsContext) {
 	localctx = NewArgumentsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 196, apexParserRULE_arguments)
+	p.EnterRule(localctx, 198, apexParserRULE_arguments)
 	var _la int
 
 	defer func() {
@@ -17929,22 +18144,22 @@ func (p *apexParser) Arguments() (localctx IArgumentsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1231)
+		p.SetState(1251)
 		p.Match(apexParserLPAREN)
 	}
-	p.SetState(1233)
+	p.SetState(1253)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || (((_la-103)&-(0x1f+1)) == 0 && ((1<<uint((_la-103)))&((1<<(apexParserRUNAS-103))|(1<<(apexParserSYSTEM-103))|(1<<(apexParserIntegerLiteral-103))|(1<<(apexParserFloatingPointLiteral-103))|(1<<(apexParserBooleanLiteral-103))|(1<<(apexParserStringLiteral-103))|(1<<(apexParserNullLiteral-103))|(1<<(apexParserLPAREN-103))|(1<<(apexParserLBRACK-103))|(1<<(apexParserLT-103))|(1<<(apexParserBANG-103))|(1<<(apexParserINC-103))|(1<<(apexParserDEC-103)))) != 0) || (((_la-135)&-(0x1f+1)) == 0 && ((1<<uint((_la-135)))&((1<<(apexParserADD-135))|(1<<(apexParserSUB-135))|(1<<(apexParserIdentifier-135)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserINCLUDES-66))|(1<<(apexParserEXCLUDES-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66)))) != 0) || (((_la-98)&-(0x1f+1)) == 0 && ((1<<uint((_la-98)))&((1<<(apexParserRETURNING-98))|(1<<(apexParserALL-98))|(1<<(apexParserROWS-98))|(1<<(apexParserTRIGGER-98))|(1<<(apexParserRUNAS-98))|(1<<(apexParserSYSTEM-98))|(1<<(apexParserIntegerLiteral-98))|(1<<(apexParserFloatingPointLiteral-98))|(1<<(apexParserBooleanLiteral-98))|(1<<(apexParserStringLiteral-98))|(1<<(apexParserNullLiteral-98))|(1<<(apexParserLPAREN-98))|(1<<(apexParserLBRACK-98))|(1<<(apexParserLT-98))|(1<<(apexParserBANG-98)))) != 0) || (((_la-136)&-(0x1f+1)) == 0 && ((1<<uint((_la-136)))&((1<<(apexParserINC-136))|(1<<(apexParserDEC-136))|(1<<(apexParserADD-136))|(1<<(apexParserSUB-136))|(1<<(apexParserIdentifier-136)))) != 0) {
 		{
-			p.SetState(1232)
+			p.SetState(1252)
 			p.ExpressionList()
 		}
 
 	}
 	{
-		p.SetState(1235)
+		p.SetState(1255)
 		p.Match(apexParserRPAREN)
 	}
 
@@ -18031,7 +18246,7 @@ func (s *SoqlLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *apexParser) SoqlLiteral() (localctx ISoqlLiteralContext) {
 	localctx = NewSoqlLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 198, apexParserRULE_soqlLiteral)
+	p.EnterRule(localctx, 200, apexParserRULE_soqlLiteral)
 
 	defer func() {
 		p.ExitRule()
@@ -18051,15 +18266,15 @@ func (p *apexParser) SoqlLiteral() (localctx ISoqlLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1237)
+		p.SetState(1257)
 		p.Match(apexParserLBRACK)
 	}
 	{
-		p.SetState(1238)
+		p.SetState(1258)
 		p.Query()
 	}
 	{
-		p.SetState(1239)
+		p.SetState(1259)
 		p.Match(apexParserRBRACK)
 	}
 
@@ -18194,6 +18409,16 @@ func (s *QueryContext) ViewClause() IViewClauseContext {
 	return t.(IViewClauseContext)
 }
 
+func (s *QueryContext) AllRowsClause() IAllRowsClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAllRowsClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAllRowsClauseContext)
+}
+
 func (s *QueryContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -18226,7 +18451,7 @@ func (s *QueryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *apexParser) Query() (localctx IQueryContext) {
 	localctx = NewQueryContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 200, apexParserRULE_query)
+	p.EnterRule(localctx, 202, apexParserRULE_query)
 	var _la int
 
 	defer func() {
@@ -18247,89 +18472,100 @@ func (p *apexParser) Query() (localctx IQueryContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1241)
+		p.SetState(1261)
 		p.SelectClause()
 	}
 	{
-		p.SetState(1242)
+		p.SetState(1262)
 		p.FromClause()
 	}
-	p.SetState(1244)
+	p.SetState(1264)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserWHERE {
 		{
-			p.SetState(1243)
+			p.SetState(1263)
 			p.WhereClause()
 		}
 
 	}
-	p.SetState(1247)
+	p.SetState(1267)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserWITH {
 		{
-			p.SetState(1246)
+			p.SetState(1266)
 			p.WithClause()
 		}
 
 	}
-	p.SetState(1250)
+	p.SetState(1270)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserGROUP {
 		{
-			p.SetState(1249)
+			p.SetState(1269)
 			p.GroupClause()
 		}
 
 	}
-	p.SetState(1253)
+	p.SetState(1273)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserORDER {
 		{
-			p.SetState(1252)
+			p.SetState(1272)
 			p.OrderClause()
 		}
 
 	}
-	p.SetState(1256)
+	p.SetState(1276)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserLIMIT {
 		{
-			p.SetState(1255)
+			p.SetState(1275)
 			p.LimitClause()
 		}
 
 	}
-	p.SetState(1259)
+	p.SetState(1279)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserOFFSET {
 		{
-			p.SetState(1258)
+			p.SetState(1278)
 			p.OffsetClause()
 		}
 
 	}
-	p.SetState(1262)
+	p.SetState(1282)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserFOR {
 		{
-			p.SetState(1261)
+			p.SetState(1281)
 			p.ViewClause()
 		}
 
+	}
+	p.SetState(1285)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == apexParserALL {
+		{
+			p.SetState(1284)
+			p.AllRowsClause()
+		}
+
 	}
 
 	return localctx
@@ -18419,7 +18655,7 @@ func (s *SelectClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *apexParser) SelectClause() (localctx ISelectClauseContext) {
 	localctx = NewSelectClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 202, apexParserRULE_selectClause)
+	p.EnterRule(localctx, 204, apexParserRULE_selectClause)
 
 	defer func() {
 		p.ExitRule()
@@ -18439,11 +18675,11 @@ func (p *apexParser) SelectClause() (localctx ISelectClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1264)
+		p.SetState(1287)
 		p.Match(apexParserSELECT)
 	}
 	{
-		p.SetState(1265)
+		p.SetState(1288)
 		p.FieldList()
 	}
 
@@ -18551,7 +18787,7 @@ func (s *FieldListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *apexParser) FieldList() (localctx IFieldListContext) {
 	localctx = NewFieldListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 204, apexParserRULE_fieldList)
+	p.EnterRule(localctx, 206, apexParserRULE_fieldList)
 	var _la int
 
 	defer func() {
@@ -18572,24 +18808,24 @@ func (p *apexParser) FieldList() (localctx IFieldListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1267)
+		p.SetState(1290)
 		p.SelectField()
 	}
-	p.SetState(1272)
+	p.SetState(1295)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserCOMMA {
 		{
-			p.SetState(1268)
+			p.SetState(1291)
 			p.Match(apexParserCOMMA)
 		}
 		{
-			p.SetState(1269)
+			p.SetState(1292)
 			p.SelectField()
 		}
 
-		p.SetState(1274)
+		p.SetState(1297)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -18604,6 +18840,12 @@ type ISelectFieldContext interface {
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
+	// GetAlias returns the alias rule contexts.
+	GetAlias() IApexIdentifierContext
+
+	// SetAlias sets the alias rule contexts.
+	SetAlias(IApexIdentifierContext)
+
 	// IsSelectFieldContext differentiates from other interfaces.
 	IsSelectFieldContext()
 }
@@ -18611,6 +18853,7 @@ type ISelectFieldContext interface {
 type SelectFieldContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
+	alias  IApexIdentifierContext
 }
 
 func NewEmptySelectFieldContext() *SelectFieldContext {
@@ -18635,6 +18878,10 @@ func NewSelectFieldContext(parser antlr.Parser, parent antlr.ParserRuleContext,
 
 func (s *SelectFieldContext) GetParser() antlr.Parser { return s.parser }
 
+func (s *SelectFieldContext) GetAlias() IApexIdentifierContext { return s.alias }
+
+func (s *SelectFieldContext) SetAlias(v IApexIdentifierContext) { s.alias = v }
+
 func (s *SelectFieldContext) SoqlField() ISoqlFieldContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISoqlFieldContext)(nil)).Elem(), 0)
 
@@ -18645,6 +18892,29 @@ func (s *SelectFieldContext) SoqlField() ISoqlFieldContext {
 	return t.(ISoqlFieldContext)
 }
 
+func (s *SelectFieldContext) AllApexIdentifier() []IApexIdentifierContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IApexIdentifierContext)(nil)).Elem())
+	var tst = make([]IApexIdentifierContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IApexIdentifierContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SelectFieldContext) ApexIdentifier(i int) IApexIdentifierContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IApexIdentifierContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IApexIdentifierContext)
+}
+
 func (s *SelectFieldContext) Subquery() ISubqueryContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISubqueryContext)(nil)).Elem(), 0)
 
@@ -18698,29 +18968,6 @@ func (s *SelectFieldContext) WHEN(i int) antlr.TerminalNode {
 	return s.GetToken(apexParserWHEN, i)
 }
 
-func (s *SelectFieldContext) AllApexIdentifier() []IApexIdentifierContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IApexIdentifierContext)(nil)).Elem())
-	var tst = make([]IApexIdentifierContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IApexIdentifierContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *SelectFieldContext) ApexIdentifier(i int) IApexIdentifierContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IApexIdentifierContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IApexIdentifierContext)
-}
-
 func (s *SelectFieldContext) AllTHEN() []antlr.TerminalNode {
 	return s.GetTokens(apexParserTHEN)
 }
@@ -18761,7 +19008,7 @@ func (s *SelectFieldContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *apexParser) SelectField() (localctx ISelectFieldContext) {
 	localctx = NewSelectFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 206, apexParserRULE_selectField)
+	p.EnterRule(localctx, 208, apexParserRULE_selectField)
 	var _la int
 
 	defer func() {
@@ -18780,70 +19027,84 @@ func (p *apexParser) SelectField() (localctx ISelectFieldContext) {
 		}
 	}()
 
-	p.SetState(1292)
+	p.SetState(1318)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
+	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserINCLUDES, apexParserEXCLUDES, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserROWS, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1275)
+			p.SetState(1298)
 			p.SoqlField()
 		}
+		p.SetState(1300)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserINCLUDES-66))|(1<<(apexParserEXCLUDES-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66)))) != 0) || (((_la-98)&-(0x1f+1)) == 0 && ((1<<uint((_la-98)))&((1<<(apexParserRETURNING-98))|(1<<(apexParserALL-98))|(1<<(apexParserROWS-98))|(1<<(apexParserRUNAS-98))|(1<<(apexParserSYSTEM-98)))) != 0) || _la == apexParserIdentifier {
+			{
+				p.SetState(1299)
+
+				var _x = p.ApexIdentifier()
+
+				localctx.(*SelectFieldContext).alias = _x
+			}
+
+		}
 
-	case apexParserSELECT:
+	case apexParserLPAREN:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1276)
+			p.SetState(1302)
 			p.Subquery()
 		}
 
 	case apexParserTYPEOF:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1277)
+			p.SetState(1303)
 			p.Match(apexParserTYPEOF)
 		}
 		{
-			p.SetState(1278)
+			p.SetState(1304)
 			p.SoqlField()
 		}
-		p.SetState(1284)
+		p.SetState(1310)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for ok := true; ok; ok = _la == apexParserWHEN {
 			{
-				p.SetState(1279)
+				p.SetState(1305)
 				p.Match(apexParserWHEN)
 			}
 			{
-				p.SetState(1280)
+				p.SetState(1306)
 				p.ApexIdentifier()
 			}
 			{
-				p.SetState(1281)
+				p.SetState(1307)
 				p.Match(apexParserTHEN)
 			}
 			{
-				p.SetState(1282)
+				p.SetState(1308)
 				p.FieldList()
 			}
 
-			p.SetState(1286)
+			p.SetState(1312)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(1288)
+			p.SetState(1314)
 			p.Match(apexParserELSE)
 		}
 		{
-			p.SetState(1289)
+			p.SetState(1315)
 			p.FieldList()
 		}
 		{
-			p.SetState(1290)
+			p.SetState(1316)
 			p.Match(apexParserEND)
 		}
 
@@ -18956,7 +19217,7 @@ func (s *FromClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *apexParser) FromClause() (localctx IFromClauseContext) {
 	localctx = NewFromClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 208, apexParserRULE_fromClause)
+	p.EnterRule(localctx, 210, apexParserRULE_fromClause)
 	var _la int
 
 	defer func() {
@@ -18977,28 +19238,28 @@ func (p *apexParser) FromClause() (localctx IFromClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1294)
+		p.SetState(1320)
 		p.Match(apexParserFROM)
 	}
 	{
-		p.SetState(1295)
+		p.SetState(1321)
 		p.ApexIdentifier()
 	}
-	p.SetState(1299)
+	p.SetState(1325)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserUSING {
 		{
-			p.SetState(1296)
+			p.SetState(1322)
 			p.Match(apexParserUSING)
 		}
 		{
-			p.SetState(1297)
+			p.SetState(1323)
 			p.Match(apexParserSCOPE)
 		}
 		{
-			p.SetState(1298)
+			p.SetState(1324)
 			p.FilterScope()
 		}
 
@@ -19076,7 +19337,7 @@ func (s *FilterScopeContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *apexParser) FilterScope() (localctx IFilterScopeContext) {
 	localctx = NewFilterScopeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 210, apexParserRULE_filterScope)
+	p.EnterRule(localctx, 212, apexParserRULE_filterScope)
 
 	defer func() {
 		p.ExitRule()
@@ -19311,7 +19572,7 @@ func (s *SoqlFunctionCallContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *apexParser) SoqlField() (localctx ISoqlFieldContext) {
 	localctx = NewSoqlFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 212, apexParserRULE_soqlField)
+	p.EnterRule(localctx, 214, apexParserRULE_soqlField)
 	var _la int
 
 	defer func() {
@@ -19332,34 +19593,34 @@ func (p *apexParser) SoqlField() (localctx ISoqlFieldContext) {
 
 	var _alt int
 
-	p.SetState(1326)
+	p.SetState(1352)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 147, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 151, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewSoqlFieldReferenceContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
-		p.SetState(1308)
+		p.SetState(1334)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 144, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 148, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(1303)
+					p.SetState(1329)
 					p.ApexIdentifier()
 				}
 				{
-					p.SetState(1304)
+					p.SetState(1330)
 					p.Match(apexParserDOT)
 				}
 
 			}
-			p.SetState(1310)
+			p.SetState(1336)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 144, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 148, p.GetParserRuleContext())
 		}
 		{
-			p.SetState(1311)
+			p.SetState(1337)
 			p.ApexIdentifier()
 		}
 
@@ -19367,44 +19628,44 @@ func (p *apexParser) SoqlField() (localctx ISoqlFieldContext) {
 		localctx = NewSoqlFunctionCallContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1312)
+			p.SetState(1338)
 			p.ApexIdentifier()
 		}
 		{
-			p.SetState(1313)
+			p.SetState(1339)
 			p.Match(apexParserLPAREN)
 		}
-		p.SetState(1322)
+		p.SetState(1348)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || _la == apexParserRUNAS || _la == apexParserSYSTEM || _la == apexParserIdentifier {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserINCLUDES-66))|(1<<(apexParserEXCLUDES-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66)))) != 0) || (((_la-98)&-(0x1f+1)) == 0 && ((1<<uint((_la-98)))&((1<<(apexParserRETURNING-98))|(1<<(apexParserALL-98))|(1<<(apexParserROWS-98))|(1<<(apexParserRUNAS-98))|(1<<(apexParserSYSTEM-98)))) != 0) || _la == apexParserIdentifier {
 			{
-				p.SetState(1314)
+				p.SetState(1340)
 				p.SoqlField()
 			}
-			p.SetState(1319)
+			p.SetState(1345)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			for _la == apexParserCOMMA {
 				{
-					p.SetState(1315)
+					p.SetState(1341)
 					p.Match(apexParserCOMMA)
 				}
 				{
-					p.SetState(1316)
+					p.SetState(1342)
 					p.SoqlField()
 				}
 
-				p.SetState(1321)
+				p.SetState(1347)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 			}
 
 		}
 		{
-			p.SetState(1324)
+			p.SetState(1350)
 			p.Match(apexParserRPAREN)
 		}
 
@@ -19493,7 +19754,7 @@ func (s *SubqueryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *apexParser) Subquery() (localctx ISubqueryContext) {
 	localctx = NewSubqueryContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 214, apexParserRULE_subquery)
+	p.EnterRule(localctx, 216, apexParserRULE_subquery)
 
 	defer func() {
 		p.ExitRule()
@@ -19513,9 +19774,17 @@ func (p *apexParser) Subquery() (localctx ISubqueryContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1328)
+		p.SetState(1354)
+		p.Match(apexParserLPAREN)
+	}
+	{
+		p.SetState(1355)
 		p.Query()
 	}
+	{
+		p.SetState(1356)
+		p.Match(apexParserRPAREN)
+	}
 
 	return localctx
 }
@@ -19604,7 +19873,7 @@ func (s *WhereClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *apexParser) WhereClause() (localctx IWhereClauseContext) {
 	localctx = NewWhereClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 216, apexParserRULE_whereClause)
+	p.EnterRule(localctx, 218, apexParserRULE_whereClause)
 
 	defer func() {
 		p.ExitRule()
@@ -19624,11 +19893,11 @@ func (p *apexParser) WhereClause() (localctx IWhereClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1330)
+		p.SetState(1358)
 		p.Match(apexParserWHERE)
 	}
 	{
-		p.SetState(1331)
+		p.SetState(1359)
 		p.whereFields(0)
 	}
 
@@ -19765,8 +20034,8 @@ func (p *apexParser) whereFields(_p int) (localctx IWhereFieldsContext) {
 	localctx = NewWhereFieldsContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IWhereFieldsContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 218
-	p.EnterRecursionRule(localctx, 218, apexParserRULE_whereFields, _p)
+	_startState := 220
+	p.EnterRecursionRule(localctx, 220, apexParserRULE_whereFields, _p)
 	var _la int
 
 	defer func() {
@@ -19789,14 +20058,14 @@ func (p *apexParser) whereFields(_p int) (localctx IWhereFieldsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1334)
+		p.SetState(1362)
 		p.WhereField()
 	}
 
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(1341)
+	p.SetState(1369)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 148, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 152, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -19806,13 +20075,13 @@ func (p *apexParser) whereFields(_p int) (localctx IWhereFieldsContext) {
 			_prevctx = localctx
 			localctx = NewWhereFieldsContext(p, _parentctx, _parentState)
 			p.PushNewRecursionContext(localctx, _startState, apexParserRULE_whereFields)
-			p.SetState(1336)
+			p.SetState(1364)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 			}
 			{
-				p.SetState(1337)
+				p.SetState(1365)
 
 				var _lt = p.GetTokenStream().LT(1)
 
@@ -19830,14 +20099,14 @@ func (p *apexParser) whereFields(_p int) (localctx IWhereFieldsContext) {
 				}
 			}
 			{
-				p.SetState(1338)
+				p.SetState(1366)
 				p.whereFields(2)
 			}
 
 		}
-		p.SetState(1343)
+		p.SetState(1371)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 148, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 152, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -19850,12 +20119,6 @@ type IWhereFieldContext interface {
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// GetOp returns the op token.
-	GetOp() antlr.Token
-
-	// SetOp sets the op token.
-	SetOp(antlr.Token)
-
 	// IsWhereFieldContext differentiates from other interfaces.
 	IsWhereFieldContext()
 }
@@ -19863,7 +20126,6 @@ type IWhereFieldContext interface {
 type WhereFieldContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
-	op     antlr.Token
 }
 
 func NewEmptyWhereFieldContext() *WhereFieldContext {
@@ -19888,10 +20150,6 @@ func NewWhereFieldContext(parser antlr.Parser, parent antlr.ParserRuleContext, i
 
 func (s *WhereFieldContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *WhereFieldContext) GetOp() antlr.Token { return s.op }
-
-func (s *WhereFieldContext) SetOp(v antlr.Token) { s.op = v }
-
 func (s *WhereFieldContext) SoqlField() ISoqlFieldContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISoqlFieldContext)(nil)).Elem(), 0)
 
@@ -19902,22 +20160,24 @@ func (s *WhereFieldContext) SoqlField() ISoqlFieldContext {
 	return t.(ISoqlFieldContext)
 }
 
-func (s *WhereFieldContext) SoqlValue() ISoqlValueContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISoqlValueContext)(nil)).Elem(), 0)
+func (s *WhereFieldContext) SoqlOperator() ISoqlOperatorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISoqlOperatorContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ISoqlValueContext)
+	return t.(ISoqlOperatorContext)
 }
 
-func (s *WhereFieldContext) LIKE() antlr.TerminalNode {
-	return s.GetToken(apexParserLIKE, 0)
-}
+func (s *WhereFieldContext) SoqlValue() ISoqlValueContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISoqlValueContext)(nil)).Elem(), 0)
 
-func (s *WhereFieldContext) IN() antlr.TerminalNode {
-	return s.GetToken(apexParserIN, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISoqlValueContext)
 }
 
 func (s *WhereFieldContext) SOQL_NOT() antlr.TerminalNode {
@@ -19966,7 +20226,7 @@ func (s *WhereFieldContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *apexParser) WhereField() (localctx IWhereFieldContext) {
 	localctx = NewWhereFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 220, apexParserRULE_whereField)
+	p.EnterRule(localctx, 222, apexParserRULE_whereField)
 	var _la int
 
 	defer func() {
@@ -19985,62 +20245,48 @@ func (p *apexParser) WhereField() (localctx IWhereFieldContext) {
 		}
 	}()
 
-	p.SetState(1355)
+	p.SetState(1383)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserSOQL_NOT, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
+	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserINCLUDES, apexParserEXCLUDES, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserSOQL_NOT, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserROWS, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
 		p.EnterOuterAlt(localctx, 1)
-		p.SetState(1345)
+		p.SetState(1373)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == apexParserSOQL_NOT {
 			{
-				p.SetState(1344)
+				p.SetState(1372)
 				p.Match(apexParserSOQL_NOT)
 			}
 
 		}
 		{
-			p.SetState(1347)
+			p.SetState(1375)
 			p.SoqlField()
 		}
 		{
-			p.SetState(1348)
-
-			var _lt = p.GetTokenStream().LT(1)
-
-			localctx.(*WhereFieldContext).op = _lt
-
-			_la = p.GetTokenStream().LA(1)
-
-			if !(_la == apexParserT__0 || _la == apexParserIN || _la == apexParserLIKE || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(apexParserASSIGN-119))|(1<<(apexParserGT-119))|(1<<(apexParserLT-119))|(1<<(apexParserLE-119))|(1<<(apexParserGE-119))|(1<<(apexParserNOTEQUAL-119)))) != 0)) {
-				var _ri = p.GetErrorHandler().RecoverInline(p)
-
-				localctx.(*WhereFieldContext).op = _ri
-			} else {
-				p.GetErrorHandler().ReportMatch(p)
-				p.Consume()
-			}
+			p.SetState(1376)
+			p.SoqlOperator()
 		}
 		{
-			p.SetState(1349)
+			p.SetState(1377)
 			p.SoqlValue()
 		}
 
 	case apexParserLPAREN:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1351)
+			p.SetState(1379)
 			p.Match(apexParserLPAREN)
 		}
 		{
-			p.SetState(1352)
+			p.SetState(1380)
 			p.whereFields(0)
 		}
 		{
-			p.SetState(1353)
+			p.SetState(1381)
 			p.Match(apexParserRPAREN)
 		}
 
@@ -20051,95 +20297,97 @@ func (p *apexParser) WhereField() (localctx IWhereFieldContext) {
 	return localctx
 }
 
-// ILimitClauseContext is an interface to support dynamic dispatch.
-type ILimitClauseContext interface {
+// ISoqlOperatorContext is an interface to support dynamic dispatch.
+type ISoqlOperatorContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsLimitClauseContext differentiates from other interfaces.
-	IsLimitClauseContext()
+	// IsSoqlOperatorContext differentiates from other interfaces.
+	IsSoqlOperatorContext()
 }
 
-type LimitClauseContext struct {
+type SoqlOperatorContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyLimitClauseContext() *LimitClauseContext {
-	var p = new(LimitClauseContext)
+func NewEmptySoqlOperatorContext() *SoqlOperatorContext {
+	var p = new(SoqlOperatorContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = apexParserRULE_limitClause
+	p.RuleIndex = apexParserRULE_soqlOperator
 	return p
 }
 
-func (*LimitClauseContext) IsLimitClauseContext() {}
+func (*SoqlOperatorContext) IsSoqlOperatorContext() {}
 
-func NewLimitClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LimitClauseContext {
-	var p = new(LimitClauseContext)
+func NewSoqlOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SoqlOperatorContext {
+	var p = new(SoqlOperatorContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = apexParserRULE_limitClause
+	p.RuleIndex = apexParserRULE_soqlOperator
 
 	return p
 }
 
-func (s *LimitClauseContext) GetParser() antlr.Parser { return s.parser }
+func (s *SoqlOperatorContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *LimitClauseContext) LIMIT() antlr.TerminalNode {
-	return s.GetToken(apexParserLIMIT, 0)
+func (s *SoqlOperatorContext) LIKE() antlr.TerminalNode {
+	return s.GetToken(apexParserLIKE, 0)
 }
 
-func (s *LimitClauseContext) IntegerLiteral() antlr.TerminalNode {
-	return s.GetToken(apexParserIntegerLiteral, 0)
+func (s *SoqlOperatorContext) IN() antlr.TerminalNode {
+	return s.GetToken(apexParserIN, 0)
 }
 
-func (s *LimitClauseContext) BindVariable() IBindVariableContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBindVariableContext)(nil)).Elem(), 0)
+func (s *SoqlOperatorContext) SOQL_NOT() antlr.TerminalNode {
+	return s.GetToken(apexParserSOQL_NOT, 0)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *SoqlOperatorContext) INCLUDES() antlr.TerminalNode {
+	return s.GetToken(apexParserINCLUDES, 0)
+}
 
-	return t.(IBindVariableContext)
+func (s *SoqlOperatorContext) EXCLUDES() antlr.TerminalNode {
+	return s.GetToken(apexParserEXCLUDES, 0)
 }
 
-func (s *LimitClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *SoqlOperatorContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *LimitClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SoqlOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *LimitClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SoqlOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(apexListener); ok {
-		listenerT.EnterLimitClause(s)
+		listenerT.EnterSoqlOperator(s)
 	}
 }
 
-func (s *LimitClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SoqlOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(apexListener); ok {
-		listenerT.ExitLimitClause(s)
+		listenerT.ExitSoqlOperator(s)
 	}
 }
 
-func (s *LimitClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SoqlOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case apexVisitor:
-		return t.VisitLimitClause(s)
+		return t.VisitSoqlOperator(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *apexParser) LimitClause() (localctx ILimitClauseContext) {
-	localctx = NewLimitClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 222, apexParserRULE_limitClause)
+func (p *apexParser) SoqlOperator() (localctx ISoqlOperatorContext) {
+	localctx = NewSoqlOperatorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 224, apexParserRULE_soqlOperator)
 
 	defer func() {
 		p.ExitRule()
@@ -20157,52 +20405,245 @@ func (p *apexParser) LimitClause() (localctx ILimitClauseContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(1357)
-		p.Match(apexParserLIMIT)
-	}
-	p.SetState(1360)
+	p.SetState(1398)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case apexParserIntegerLiteral:
+	case apexParserASSIGN:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1358)
-			p.Match(apexParserIntegerLiteral)
+			p.SetState(1385)
+			p.Match(apexParserASSIGN)
 		}
 
-	case apexParserCOLON:
+	case apexParserLT:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1359)
-			p.BindVariable()
+			p.SetState(1386)
+			p.Match(apexParserLT)
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-	}
-
-	return localctx
-}
+	case apexParserGT:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(1387)
+			p.Match(apexParserGT)
+		}
 
-// IOrderClauseContext is an interface to support dynamic dispatch.
-type IOrderClauseContext interface {
-	antlr.ParserRuleContext
+	case apexParserLE:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(1388)
+			p.Match(apexParserLE)
+		}
+
+	case apexParserGE:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(1389)
+			p.Match(apexParserGE)
+		}
+
+	case apexParserNOTEQUAL:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(1390)
+			p.Match(apexParserNOTEQUAL)
+		}
+
+	case apexParserT__0:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(1391)
+			p.Match(apexParserT__0)
+		}
+
+	case apexParserLIKE:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(1392)
+			p.Match(apexParserLIKE)
+		}
+
+	case apexParserIN:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(1393)
+			p.Match(apexParserIN)
+		}
+
+	case apexParserSOQL_NOT:
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(1394)
+			p.Match(apexParserSOQL_NOT)
+		}
+		{
+			p.SetState(1395)
+			p.Match(apexParserIN)
+		}
+
+	case apexParserINCLUDES:
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(1396)
+			p.Match(apexParserINCLUDES)
+		}
+
+	case apexParserEXCLUDES:
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(1397)
+			p.Match(apexParserEXCLUDES)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// ILimitClauseContext is an interface to support dynamic dispatch.
+type ILimitClauseContext interface {
+	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// GetAsc_desc returns the asc_desc token.
-	GetAsc_desc() antlr.Token
+	// IsLimitClauseContext differentiates from other interfaces.
+	IsLimitClauseContext()
+}
 
-	// GetNulls returns the nulls token.
-	GetNulls() antlr.Token
+type LimitClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
-	// SetAsc_desc sets the asc_desc token.
-	SetAsc_desc(antlr.Token)
+func NewEmptyLimitClauseContext() *LimitClauseContext {
+	var p = new(LimitClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = apexParserRULE_limitClause
+	return p
+}
 
-	// SetNulls sets the nulls token.
-	SetNulls(antlr.Token)
+func (*LimitClauseContext) IsLimitClauseContext() {}
+
+func NewLimitClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LimitClauseContext {
+	var p = new(LimitClauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = apexParserRULE_limitClause
+
+	return p
+}
+
+func (s *LimitClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *LimitClauseContext) LIMIT() antlr.TerminalNode {
+	return s.GetToken(apexParserLIMIT, 0)
+}
+
+func (s *LimitClauseContext) IntegerLiteral() antlr.TerminalNode {
+	return s.GetToken(apexParserIntegerLiteral, 0)
+}
+
+func (s *LimitClauseContext) BindVariable() IBindVariableContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBindVariableContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBindVariableContext)
+}
+
+func (s *LimitClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LimitClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *LimitClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(apexListener); ok {
+		listenerT.EnterLimitClause(s)
+	}
+}
+
+func (s *LimitClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(apexListener); ok {
+		listenerT.ExitLimitClause(s)
+	}
+}
+
+func (s *LimitClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case apexVisitor:
+		return t.VisitLimitClause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *apexParser) LimitClause() (localctx ILimitClauseContext) {
+	localctx = NewLimitClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 226, apexParserRULE_limitClause)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1400)
+		p.Match(apexParserLIMIT)
+	}
+	p.SetState(1403)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case apexParserIntegerLiteral:
+		{
+			p.SetState(1401)
+			p.Match(apexParserIntegerLiteral)
+		}
+
+	case apexParserCOLON:
+		{
+			p.SetState(1402)
+			p.BindVariable()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IOrderClauseContext is an interface to support dynamic dispatch.
+type IOrderClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
 	// IsOrderClauseContext differentiates from other interfaces.
 	IsOrderClauseContext()
@@ -20210,9 +20651,7 @@ type IOrderClauseContext interface {
 
 type OrderClauseContext struct {
 	*antlr.BaseParserRuleContext
-	parser   antlr.Parser
-	asc_desc antlr.Token
-	nulls    antlr.Token
+	parser antlr.Parser
 }
 
 func NewEmptyOrderClauseContext() *OrderClauseContext {
@@ -20237,14 +20676,6 @@ func NewOrderClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext,
 
 func (s *OrderClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *OrderClauseContext) GetAsc_desc() antlr.Token { return s.asc_desc }
-
-func (s *OrderClauseContext) GetNulls() antlr.Token { return s.nulls }
-
-func (s *OrderClauseContext) SetAsc_desc(v antlr.Token) { s.asc_desc = v }
-
-func (s *OrderClauseContext) SetNulls(v antlr.Token) { s.nulls = v }
-
 func (s *OrderClauseContext) ORDER() antlr.TerminalNode {
 	return s.GetToken(apexParserORDER, 0)
 }
@@ -20253,47 +20684,27 @@ func (s *OrderClauseContext) BY() antlr.TerminalNode {
 	return s.GetToken(apexParserBY, 0)
 }
 
-func (s *OrderClauseContext) AllSoqlField() []ISoqlFieldContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISoqlFieldContext)(nil)).Elem())
-	var tst = make([]ISoqlFieldContext, len(ts))
+func (s *OrderClauseContext) AllOrderField() []IOrderFieldContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IOrderFieldContext)(nil)).Elem())
+	var tst = make([]IOrderFieldContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(ISoqlFieldContext)
+			tst[i] = t.(IOrderFieldContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *OrderClauseContext) SoqlField(i int) ISoqlFieldContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISoqlFieldContext)(nil)).Elem(), i)
+func (s *OrderClauseContext) OrderField(i int) IOrderFieldContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOrderFieldContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ISoqlFieldContext)
-}
-
-func (s *OrderClauseContext) NULLS() antlr.TerminalNode {
-	return s.GetToken(apexParserNULLS, 0)
-}
-
-func (s *OrderClauseContext) ASC() antlr.TerminalNode {
-	return s.GetToken(apexParserASC, 0)
-}
-
-func (s *OrderClauseContext) DESC() antlr.TerminalNode {
-	return s.GetToken(apexParserDESC, 0)
-}
-
-func (s *OrderClauseContext) LAST() antlr.TerminalNode {
-	return s.GetToken(apexParserLAST, 0)
-}
-
-func (s *OrderClauseContext) FIRST() antlr.TerminalNode {
-	return s.GetToken(apexParserFIRST, 0)
+	return t.(IOrderFieldContext)
 }
 
 func (s *OrderClauseContext) GetRuleContext() antlr.RuleContext {
@@ -20328,7 +20739,7 @@ func (s *OrderClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *apexParser) OrderClause() (localctx IOrderClauseContext) {
 	localctx = NewOrderClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 224, apexParserRULE_orderClause)
+	p.EnterRule(localctx, 228, apexParserRULE_orderClause)
 	var _la int
 
 	defer func() {
@@ -20347,59 +20758,205 @@ func (p *apexParser) OrderClause() (localctx IOrderClauseContext) {
 		}
 	}()
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1362)
+		p.SetState(1405)
 		p.Match(apexParserORDER)
 	}
 	{
-		p.SetState(1363)
+		p.SetState(1406)
 		p.Match(apexParserBY)
 	}
 	{
-		p.SetState(1364)
-		p.SoqlField()
+		p.SetState(1407)
+		p.OrderField()
 	}
-	p.SetState(1369)
+	p.SetState(1412)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 152, p.GetParserRuleContext())
-
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(1365)
-				p.Match(apexParserCOMMA)
-			}
-			{
-				p.SetState(1366)
-				p.SoqlField()
-			}
+	_la = p.GetTokenStream().LA(1)
 
+	for _la == apexParserCOMMA {
+		{
+			p.SetState(1408)
+			p.Match(apexParserCOMMA)
 		}
-		p.SetState(1371)
+		{
+			p.SetState(1409)
+			p.OrderField()
+		}
+
+		p.SetState(1414)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 152, p.GetParserRuleContext())
+		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(1373)
+
+	return localctx
+}
+
+// IOrderFieldContext is an interface to support dynamic dispatch.
+type IOrderFieldContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetAsc_desc returns the asc_desc token.
+	GetAsc_desc() antlr.Token
+
+	// GetNulls returns the nulls token.
+	GetNulls() antlr.Token
+
+	// SetAsc_desc sets the asc_desc token.
+	SetAsc_desc(antlr.Token)
+
+	// SetNulls sets the nulls token.
+	SetNulls(antlr.Token)
+
+	// IsOrderFieldContext differentiates from other interfaces.
+	IsOrderFieldContext()
+}
+
+type OrderFieldContext struct {
+	*antlr.BaseParserRuleContext
+	parser   antlr.Parser
+	asc_desc antlr.Token
+	nulls    antlr.Token
+}
+
+func NewEmptyOrderFieldContext() *OrderFieldContext {
+	var p = new(OrderFieldContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = apexParserRULE_orderField
+	return p
+}
+
+func (*OrderFieldContext) IsOrderFieldContext() {}
+
+func NewOrderFieldContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OrderFieldContext {
+	var p = new(OrderFieldContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = apexParserRULE_orderField
+
+	return p
+}
+
+func (s *OrderFieldContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *OrderFieldContext) GetAsc_desc() antlr.Token { return s.asc_desc }
+
+func (s *OrderFieldContext) GetNulls() antlr.Token { return s.nulls }
+
+func (s *OrderFieldContext) SetAsc_desc(v antlr.Token) { s.asc_desc = v }
+
+func (s *OrderFieldContext) SetNulls(v antlr.Token) { s.nulls = v }
+
+func (s *OrderFieldContext) SoqlField() ISoqlFieldContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISoqlFieldContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISoqlFieldContext)
+}
+
+func (s *OrderFieldContext) NULLS() antlr.TerminalNode {
+	return s.GetToken(apexParserNULLS, 0)
+}
+
+func (s *OrderFieldContext) ASC() antlr.TerminalNode {
+	return s.GetToken(apexParserASC, 0)
+}
+
+func (s *OrderFieldContext) DESC() antlr.TerminalNode {
+	return s.GetToken(apexParserDESC, 0)
+}
+
+func (s *OrderFieldContext) LAST() antlr.TerminalNode {
+	return s.GetToken(apexParserLAST, 0)
+}
+
+func (s *OrderFieldContext) FIRST() antlr.TerminalNode {
+	return s.GetToken(apexParserFIRST, 0)
+}
+
+func (s *OrderFieldContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OrderFieldContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *OrderFieldContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(apexListener); ok {
+		listenerT.EnterOrderField(s)
+	}
+}
+
+func (s *OrderFieldContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(apexListener); ok {
+		listenerT.ExitOrderField(s)
+	}
+}
+
+func (s *OrderFieldContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case apexVisitor:
+		return t.VisitOrderField(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *apexParser) OrderField() (localctx IOrderFieldContext) {
+	localctx = NewOrderFieldContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 230, apexParserRULE_orderField)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1415)
+		p.SoqlField()
+	}
+	p.SetState(1417)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserASC || _la == apexParserDESC {
 		{
-			p.SetState(1372)
+			p.SetState(1416)
 
 			var _lt = p.GetTokenStream().LT(1)
 
-			localctx.(*OrderClauseContext).asc_desc = _lt
+			localctx.(*OrderFieldContext).asc_desc = _lt
 
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == apexParserASC || _la == apexParserDESC) {
 				var _ri = p.GetErrorHandler().RecoverInline(p)
 
-				localctx.(*OrderClauseContext).asc_desc = _ri
+				localctx.(*OrderFieldContext).asc_desc = _ri
 			} else {
 				p.GetErrorHandler().ReportMatch(p)
 				p.Consume()
@@ -20407,28 +20964,28 @@ func (p *apexParser) OrderClause() (localctx IOrderClauseContext) {
 		}
 
 	}
-	p.SetState(1377)
+	p.SetState(1421)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserNULLS {
 		{
-			p.SetState(1375)
+			p.SetState(1419)
 			p.Match(apexParserNULLS)
 		}
 		{
-			p.SetState(1376)
+			p.SetState(1420)
 
 			var _lt = p.GetTokenStream().LT(1)
 
-			localctx.(*OrderClauseContext).nulls = _lt
+			localctx.(*OrderFieldContext).nulls = _lt
 
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == apexParserFIRST || _la == apexParserLAST) {
 				var _ri = p.GetErrorHandler().RecoverInline(p)
 
-				localctx.(*OrderClauseContext).nulls = _ri
+				localctx.(*OrderFieldContext).nulls = _ri
 			} else {
 				p.GetErrorHandler().ReportMatch(p)
 				p.Consume()
@@ -20524,7 +21081,7 @@ func (s *BindVariableContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *apexParser) BindVariable() (localctx IBindVariableContext) {
 	localctx = NewBindVariableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 226, apexParserRULE_bindVariable)
+	p.EnterRule(localctx, 232, apexParserRULE_bindVariable)
 
 	defer func() {
 		p.ExitRule()
@@ -20544,11 +21101,11 @@ func (p *apexParser) BindVariable() (localctx IBindVariableContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1379)
+		p.SetState(1423)
 		p.Match(apexParserCOLON)
 	}
 	{
-		p.SetState(1380)
+		p.SetState(1424)
 		p.expression(0)
 	}
 
@@ -20613,6 +21170,26 @@ func (s *SoqlValueContext) BindVariable() IBindVariableContext {
 	return t.(IBindVariableContext)
 }
 
+func (s *SoqlValueContext) Subquery() ISubqueryContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISubqueryContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISubqueryContext)
+}
+
+func (s *SoqlValueContext) SoqlValueList() ISoqlValueListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISoqlValueListContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISoqlValueListContext)
+}
+
 func (s *SoqlValueContext) ApexIdentifier() IApexIdentifierContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IApexIdentifierContext)(nil)).Elem(), 0)
 
@@ -20627,39 +21204,209 @@ func (s *SoqlValueContext) COLON() antlr.TerminalNode {
 	return s.GetToken(apexParserCOLON, 0)
 }
 
-func (s *SoqlValueContext) GetRuleContext() antlr.RuleContext {
+func (s *SoqlValueContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SoqlValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SoqlValueContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(apexListener); ok {
+		listenerT.EnterSoqlValue(s)
+	}
+}
+
+func (s *SoqlValueContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(apexListener); ok {
+		listenerT.ExitSoqlValue(s)
+	}
+}
+
+func (s *SoqlValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case apexVisitor:
+		return t.VisitSoqlValue(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *apexParser) SoqlValue() (localctx ISoqlValueContext) {
+	localctx = NewSoqlValueContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 234, apexParserRULE_soqlValue)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(1435)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 160, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(1426)
+			p.Literal()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1427)
+			p.BindVariable()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(1428)
+			p.Subquery()
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(1429)
+			p.SoqlValueList()
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(1430)
+			p.ApexIdentifier()
+		}
+		{
+			p.SetState(1431)
+			p.Match(apexParserCOLON)
+		}
+		{
+			p.SetState(1432)
+			p.Literal()
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(1434)
+			p.ApexIdentifier()
+		}
+
+	}
+
+	return localctx
+}
+
+// ISoqlValueListContext is an interface to support dynamic dispatch.
+type ISoqlValueListContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSoqlValueListContext differentiates from other interfaces.
+	IsSoqlValueListContext()
+}
+
+type SoqlValueListContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySoqlValueListContext() *SoqlValueListContext {
+	var p = new(SoqlValueListContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = apexParserRULE_soqlValueList
+	return p
+}
+
+func (*SoqlValueListContext) IsSoqlValueListContext() {}
+
+func NewSoqlValueListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SoqlValueListContext {
+	var p = new(SoqlValueListContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = apexParserRULE_soqlValueList
+
+	return p
+}
+
+func (s *SoqlValueListContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SoqlValueListContext) AllLiteral() []ILiteralContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ILiteralContext)(nil)).Elem())
+	var tst = make([]ILiteralContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ILiteralContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SoqlValueListContext) Literal(i int) ILiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILiteralContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILiteralContext)
+}
+
+func (s *SoqlValueListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SoqlValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SoqlValueListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SoqlValueContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SoqlValueListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(apexListener); ok {
-		listenerT.EnterSoqlValue(s)
+		listenerT.EnterSoqlValueList(s)
 	}
 }
 
-func (s *SoqlValueContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SoqlValueListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(apexListener); ok {
-		listenerT.ExitSoqlValue(s)
+		listenerT.ExitSoqlValueList(s)
 	}
 }
 
-func (s *SoqlValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SoqlValueListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case apexVisitor:
-		return t.VisitSoqlValue(s)
+		return t.VisitSoqlValueList(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *apexParser) SoqlValue() (localctx ISoqlValueContext) {
-	localctx = NewSoqlValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 228, apexParserRULE_soqlValue)
+func (p *apexParser) SoqlValueList() (localctx ISoqlValueListContext) {
+	localctx = NewSoqlValueListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 236, apexParserRULE_soqlValueList)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -20677,41 +21424,36 @@ func (p *apexParser) SoqlValue() (localctx ISoqlValueContext) {
 		}
 	}()
 
-	p.SetState(1388)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1437)
+		p.Match(apexParserLPAREN)
+	}
+	{
+		p.SetState(1438)
+		p.Literal()
+	}
+	p.SetState(1443)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case apexParserIntegerLiteral, apexParserFloatingPointLiteral, apexParserBooleanLiteral, apexParserStringLiteral, apexParserNullLiteral:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(1382)
-			p.Literal()
-		}
-
-	case apexParserCOLON:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(1383)
-			p.BindVariable()
-		}
-
-	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(1384)
-			p.ApexIdentifier()
-		}
+	for _la == apexParserCOMMA {
 		{
-			p.SetState(1385)
-			p.Match(apexParserCOLON)
+			p.SetState(1439)
+			p.Match(apexParserCOMMA)
 		}
 		{
-			p.SetState(1386)
+			p.SetState(1440)
 			p.Literal()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetState(1445)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(1446)
+		p.Match(apexParserRPAREN)
 	}
 
 	return localctx
@@ -20809,7 +21551,7 @@ func (s *WithClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *apexParser) WithClause() (localctx IWithClauseContext) {
 	localctx = NewWithClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 230, apexParserRULE_withClause)
+	p.EnterRule(localctx, 238, apexParserRULE_withClause)
 
 	defer func() {
 		p.ExitRule()
@@ -20829,19 +21571,19 @@ func (p *apexParser) WithClause() (localctx IWithClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1390)
+		p.SetState(1448)
 		p.Match(apexParserWITH)
 	}
 	{
-		p.SetState(1391)
+		p.SetState(1449)
 		p.Match(apexParserDATA)
 	}
 	{
-		p.SetState(1392)
+		p.SetState(1450)
 		p.Match(apexParserCATEGORY)
 	}
 	{
-		p.SetState(1393)
+		p.SetState(1451)
 		p.SoqlFilteringExpression()
 	}
 
@@ -20917,7 +21659,7 @@ func (s *SoqlFilteringExpressionContext) Accept(visitor antlr.ParseTreeVisitor)
 
 func (p *apexParser) SoqlFilteringExpression() (localctx ISoqlFilteringExpressionContext) {
 	localctx = NewSoqlFilteringExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 232, apexParserRULE_soqlFilteringExpression)
+	p.EnterRule(localctx, 240, apexParserRULE_soqlFilteringExpression)
 
 	defer func() {
 		p.ExitRule()
@@ -21055,7 +21797,7 @@ func (s *GroupClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *apexParser) GroupClause() (localctx IGroupClauseContext) {
 	localctx = NewGroupClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 234, apexParserRULE_groupClause)
+	p.EnterRule(localctx, 242, apexParserRULE_groupClause)
 	var _la int
 
 	defer func() {
@@ -21074,52 +21816,48 @@ func (p *apexParser) GroupClause() (localctx IGroupClauseContext) {
 		}
 	}()
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1397)
+		p.SetState(1455)
 		p.Match(apexParserGROUP)
 	}
 	{
-		p.SetState(1398)
+		p.SetState(1456)
 		p.Match(apexParserBY)
 	}
 	{
-		p.SetState(1399)
+		p.SetState(1457)
 		p.SoqlField()
 	}
-	p.SetState(1404)
+	p.SetState(1462)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 156, p.GetParserRuleContext())
-
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(1400)
-				p.Match(apexParserCOMMA)
-			}
-			{
-				p.SetState(1401)
-				p.SoqlField()
-			}
+	_la = p.GetTokenStream().LA(1)
 
+	for _la == apexParserCOMMA {
+		{
+			p.SetState(1458)
+			p.Match(apexParserCOMMA)
 		}
-		p.SetState(1406)
+		{
+			p.SetState(1459)
+			p.SoqlField()
+		}
+
+		p.SetState(1464)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 156, p.GetParserRuleContext())
+		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(1409)
+	p.SetState(1467)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserHAVING {
 		{
-			p.SetState(1407)
+			p.SetState(1465)
 			p.Match(apexParserHAVING)
 		}
 		{
-			p.SetState(1408)
+			p.SetState(1466)
 			p.HavingConditionExpression()
 		}
 
@@ -21208,7 +21946,7 @@ func (s *HavingConditionExpressionContext) Accept(visitor antlr.ParseTreeVisitor
 
 func (p *apexParser) HavingConditionExpression() (localctx IHavingConditionExpressionContext) {
 	localctx = NewHavingConditionExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 236, apexParserRULE_havingConditionExpression)
+	p.EnterRule(localctx, 244, apexParserRULE_havingConditionExpression)
 
 	defer func() {
 		p.ExitRule()
@@ -21228,7 +21966,7 @@ func (p *apexParser) HavingConditionExpression() (localctx IHavingConditionExpre
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1411)
+		p.SetState(1469)
 		p.whereFields(0)
 	}
 
@@ -21323,7 +22061,7 @@ func (s *OffsetClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *apexParser) OffsetClause() (localctx IOffsetClauseContext) {
 	localctx = NewOffsetClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 238, apexParserRULE_offsetClause)
+	p.EnterRule(localctx, 246, apexParserRULE_offsetClause)
 
 	defer func() {
 		p.ExitRule()
@@ -21343,22 +22081,22 @@ func (p *apexParser) OffsetClause() (localctx IOffsetClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1413)
+		p.SetState(1471)
 		p.Match(apexParserOFFSET)
 	}
-	p.SetState(1416)
+	p.SetState(1474)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserIntegerLiteral:
 		{
-			p.SetState(1414)
+			p.SetState(1472)
 			p.Match(apexParserIntegerLiteral)
 		}
 
 	case apexParserCOLON:
 		{
-			p.SetState(1415)
+			p.SetState(1473)
 			p.BindVariable()
 		}
 
@@ -21463,7 +22201,7 @@ func (s *ViewClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *apexParser) ViewClause() (localctx IViewClauseContext) {
 	localctx = NewViewClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 240, apexParserRULE_viewClause)
+	p.EnterRule(localctx, 248, apexParserRULE_viewClause)
 	var _la int
 
 	defer func() {
@@ -21484,11 +22222,11 @@ func (p *apexParser) ViewClause() (localctx IViewClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1418)
+		p.SetState(1476)
 		p.Match(apexParserFOR)
 	}
 	{
-		p.SetState(1419)
+		p.SetState(1477)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == apexParserREFERENCE || _la == apexParserVIEW) {
@@ -21498,17 +22236,17 @@ func (p *apexParser) ViewClause() (localctx IViewClauseContext) {
 			p.Consume()
 		}
 	}
-	p.SetState(1422)
+	p.SetState(1480)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserUPDATE {
 		{
-			p.SetState(1420)
+			p.SetState(1478)
 			p.Match(apexParserUPDATE)
 		}
 		{
-			p.SetState(1421)
+			p.SetState(1479)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == apexParserVIEWSTAT || _la == apexParserTRACKING) {
@@ -21524,6 +22262,115 @@ func (p *apexParser) ViewClause() (localctx IViewClauseContext) {
 	return localctx
 }
 
+// IAllRowsClauseContext is an interface to support dynamic dispatch.
+type IAllRowsClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsAllRowsClauseContext differentiates from other interfaces.
+	IsAllRowsClauseContext()
+}
+
+type AllRowsClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAllRowsClauseContext() *AllRowsClauseContext {
+	var p = new(AllRowsClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = apexParserRULE_allRowsClause
+	return p
+}
+
+func (*AllRowsClauseContext) IsAllRowsClauseContext() {}
+
+func NewAllRowsClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AllRowsClauseContext {
+	var p = new(AllRowsClauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = apexParserRULE_allRowsClause
+
+	return p
+}
+
+func (s *AllRowsClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AllRowsClauseContext) ALL() antlr.TerminalNode {
+	return s.GetToken(apexParserALL, 0)
+}
+
+func (s *AllRowsClauseContext) ROWS() antlr.TerminalNode {
+	return s.GetToken(apexParserROWS, 0)
+}
+
+func (s *AllRowsClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AllRowsClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AllRowsClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(apexListener); ok {
+		listenerT.EnterAllRowsClause(s)
+	}
+}
+
+func (s *AllRowsClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(apexListener); ok {
+		listenerT.ExitAllRowsClause(s)
+	}
+}
+
+func (s *AllRowsClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case apexVisitor:
+		return t.VisitAllRowsClause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *apexParser) AllRowsClause() (localctx IAllRowsClauseContext) {
+	localctx = NewAllRowsClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 250, apexParserRULE_allRowsClause)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1482)
+		p.Match(apexParserALL)
+	}
+	{
+		p.SetState(1483)
+		p.Match(apexParserROWS)
+	}
+
+	return localctx
+}
+
 // ISoslLiteralContext is an interface to support dynamic dispatch.
 type ISoslLiteralContext interface {
 	antlr.ParserRuleContext
@@ -21604,7 +22451,7 @@ func (s *SoslLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *apexParser) SoslLiteral() (localctx ISoslLiteralContext) {
 	localctx = NewSoslLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 242, apexParserRULE_soslLiteral)
+	p.EnterRule(localctx, 252, apexParserRULE_soslLiteral)
 
 	defer func() {
 		p.ExitRule()
@@ -21624,15 +22471,15 @@ func (p *apexParser) SoslLiteral() (localctx ISoslLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1424)
+		p.SetState(1485)
 		p.Match(apexParserLBRACK)
 	}
 	{
-		p.SetState(1425)
+		p.SetState(1486)
 		p.SoslQuery()
 	}
 	{
-		p.SetState(1426)
+		p.SetState(1487)
 		p.Match(apexParserRBRACK)
 	}
 
@@ -21646,13 +22493,20 @@ type ISoslQueryContext interface {
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
+	// GetSearchGroup returns the searchGroup token.
+	GetSearchGroup() antlr.Token
+
+	// SetSearchGroup sets the searchGroup token.
+	SetSearchGroup(antlr.Token)
+
 	// IsSoslQueryContext differentiates from other interfaces.
 	IsSoslQueryContext()
 }
 
 type SoslQueryContext struct {
 	*antlr.BaseParserRuleContext
-	parser antlr.Parser
+	parser      antlr.Parser
+	searchGroup antlr.Token
 }
 
 func NewEmptySoslQueryContext() *SoslQueryContext {
@@ -21677,6 +22531,10 @@ func NewSoslQueryContext(parser antlr.Parser, parent antlr.ParserRuleContext, in
 
 func (s *SoslQueryContext) GetParser() antlr.Parser { return s.parser }
 
+func (s *SoslQueryContext) GetSearchGroup() antlr.Token { return s.searchGroup }
+
+func (s *SoslQueryContext) SetSearchGroup(v antlr.Token) { s.searchGroup = v }
+
 func (s *SoslQueryContext) FIND() antlr.TerminalNode {
 	return s.GetToken(apexParserFIND, 0)
 }
@@ -21691,18 +22549,6 @@ func (s *SoslQueryContext) Literal() ILiteralContext {
 	return t.(ILiteralContext)
 }
 
-func (s *SoslQueryContext) IN() antlr.TerminalNode {
-	return s.GetToken(apexParserIN, 0)
-}
-
-func (s *SoslQueryContext) ALL() antlr.TerminalNode {
-	return s.GetToken(apexParserALL, 0)
-}
-
-func (s *SoslQueryContext) FIELDS() antlr.TerminalNode {
-	return s.GetToken(apexParserFIELDS, 0)
-}
-
 func (s *SoslQueryContext) RETURNING() antlr.TerminalNode {
 	return s.GetToken(apexParserRETURNING, 0)
 }
@@ -21730,6 +22576,22 @@ func (s *SoslQueryContext) SoslReturningObject(i int) ISoslReturningObjectContex
 	return t.(ISoslReturningObjectContext)
 }
 
+func (s *SoslQueryContext) IN() antlr.TerminalNode {
+	return s.GetToken(apexParserIN, 0)
+}
+
+func (s *SoslQueryContext) FIELDS() antlr.TerminalNode {
+	return s.GetToken(apexParserFIELDS, 0)
+}
+
+func (s *SoslQueryContext) ALL() antlr.TerminalNode {
+	return s.GetToken(apexParserALL, 0)
+}
+
+func (s *SoslQueryContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(apexParserIdentifier, 0)
+}
+
 func (s *SoslQueryContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -21762,7 +22624,7 @@ func (s *SoslQueryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *apexParser) SoslQuery() (localctx ISoslQueryContext) {
 	localctx = NewSoslQueryContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 244, apexParserRULE_soslQuery)
+	p.EnterRule(localctx, 254, apexParserRULE_soslQuery)
 	var _la int
 
 	defer func() {
@@ -21783,48 +22645,69 @@ func (p *apexParser) SoslQuery() (localctx ISoslQueryContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1428)
+		p.SetState(1489)
 		p.Match(apexParserFIND)
 	}
 	{
-		p.SetState(1429)
+		p.SetState(1490)
 		p.Literal()
 	}
-	{
-		p.SetState(1430)
-		p.Match(apexParserIN)
-	}
-	{
-		p.SetState(1431)
-		p.Match(apexParserALL)
-	}
-	{
-		p.SetState(1432)
-		p.Match(apexParserFIELDS)
+	p.SetState(1494)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == apexParserIN {
+		{
+			p.SetState(1491)
+			p.Match(apexParserIN)
+		}
+		{
+			p.SetState(1492)
+
+			var _lt = p.GetTokenStream().LT(1)
+
+			localctx.(*SoslQueryContext).searchGroup = _lt
+
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == apexParserALL || _la == apexParserIdentifier) {
+				var _ri = p.GetErrorHandler().RecoverInline(p)
+
+				localctx.(*SoslQueryContext).searchGroup = _ri
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(1493)
+			p.Match(apexParserFIELDS)
+		}
+
 	}
 	{
-		p.SetState(1433)
+		p.SetState(1496)
 		p.Match(apexParserRETURNING)
 	}
 	{
-		p.SetState(1434)
+		p.SetState(1497)
 		p.SoslReturningObject()
 	}
-	p.SetState(1439)
+	p.SetState(1502)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserCOMMA {
 		{
-			p.SetState(1435)
+			p.SetState(1498)
 			p.Match(apexParserCOMMA)
 		}
 		{
-			p.SetState(1436)
+			p.SetState(1499)
 			p.SoslReturningObject()
 		}
 
-		p.SetState(1441)
+		p.SetState(1504)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -21910,7 +22793,7 @@ func (s *SoslReturningObjectContext) Accept(visitor antlr.ParseTreeVisitor) inte
 
 func (p *apexParser) SoslReturningObject() (localctx ISoslReturningObjectContext) {
 	localctx = NewSoslReturningObjectContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 246, apexParserRULE_soslReturningObject)
+	p.EnterRule(localctx, 256, apexParserRULE_soslReturningObject)
 	var _la int
 
 	defer func() {
@@ -21931,42 +22814,42 @@ func (p *apexParser) SoslReturningObject() (localctx ISoslReturningObjectContext
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1442)
+		p.SetState(1505)
 		p.Match(apexParserIdentifier)
 	}
-	p.SetState(1453)
+	p.SetState(1516)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserLPAREN {
 		{
-			p.SetState(1443)
+			p.SetState(1506)
 			p.Match(apexParserLPAREN)
 		}
 		{
-			p.SetState(1444)
+			p.SetState(1507)
 			p.Match(apexParserIdentifier)
 		}
-		p.SetState(1449)
+		p.SetState(1512)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == apexParserCOMMA {
 			{
-				p.SetState(1445)
+				p.SetState(1508)
 				p.Match(apexParserCOMMA)
 			}
 			{
-				p.SetState(1446)
+				p.SetState(1509)
 				p.Match(apexParserIdentifier)
 			}
 
-			p.SetState(1451)
+			p.SetState(1514)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(1452)
+			p.SetState(1515)
 			p.Match(apexParserRPAREN)
 		}
 
@@ -22085,6 +22968,18 @@ func (s *ApexIdentifierContext) ALL() antlr.TerminalNode {
 	return s.GetToken(apexParserALL, 0)
 }
 
+func (s *ApexIdentifierContext) ROWS() antlr.TerminalNode {
+	return s.GetToken(apexParserROWS, 0)
+}
+
+func (s *ApexIdentifierContext) INCLUDES() antlr.TerminalNode {
+	return s.GetToken(apexParserINCLUDES, 0)
+}
+
+func (s *ApexIdentifierContext) EXCLUDES() antlr.TerminalNode {
+	return s.GetToken(apexParserEXCLUDES, 0)
+}
+
 func (s *ApexIdentifierContext) FIELDS() antlr.TerminalNode {
 	return s.GetToken(apexParserFIELDS, 0)
 }
@@ -22139,7 +23034,7 @@ func (s *ApexIdentifierContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *apexParser) ApexIdentifier() (localctx IApexIdentifierContext) {
 	localctx = NewApexIdentifierContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 248, apexParserRULE_apexIdentifier)
+	p.EnterRule(localctx, 258, apexParserRULE_apexIdentifier)
 
 	defer func() {
 		p.ExitRule()
@@ -22157,161 +23052,182 @@ func (p *apexParser) ApexIdentifier() (localctx IApexIdentifierContext) {
 		}
 	}()
 
-	p.SetState(1477)
+	p.SetState(1543)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserIdentifier:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1455)
+			p.SetState(1518)
 			p.Match(apexParserIdentifier)
 		}
 
 	case apexParserGET:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1456)
+			p.SetState(1519)
 			p.Match(apexParserGET)
 		}
 
 	case apexParserSET:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1457)
+			p.SetState(1520)
 			p.Match(apexParserSET)
 		}
 
 	case apexParserDATA:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(1458)
+			p.SetState(1521)
 			p.Match(apexParserDATA)
 		}
 
 	case apexParserGROUP:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(1459)
+			p.SetState(1522)
 			p.Match(apexParserGROUP)
 		}
 
 	case apexParserDELETE:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(1460)
+			p.SetState(1523)
 			p.Match(apexParserDELETE)
 		}
 
 	case apexParserINSERT:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(1461)
+			p.SetState(1524)
 			p.Match(apexParserINSERT)
 		}
 
 	case apexParserUPDATE:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(1462)
+			p.SetState(1525)
 			p.Match(apexParserUPDATE)
 		}
 
 	case apexParserUNDELETE:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(1463)
+			p.SetState(1526)
 			p.Match(apexParserUNDELETE)
 		}
 
 	case apexParserUPSERT:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(1464)
+			p.SetState(1527)
 			p.Match(apexParserUPSERT)
 		}
 
 	case apexParserSCOPE:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(1465)
+			p.SetState(1528)
 			p.Match(apexParserSCOPE)
 		}
 
 	case apexParserCATEGORY:
 		p.EnterOuterAlt(localctx, 12)
 		{
-			p.SetState(1466)
+			p.SetState(1529)
 			p.Match(apexParserCATEGORY)
 		}
 
 	case apexParserREFERENCE:
 		p.EnterOuterAlt(localctx, 13)
 		{
-			p.SetState(1467)
+			p.SetState(1530)
 			p.Match(apexParserREFERENCE)
 		}
 
 	case apexParserOFFSET:
 		p.EnterOuterAlt(localctx, 14)
 		{
-			p.SetState(1468)
+			p.SetState(1531)
 			p.Match(apexParserOFFSET)
 		}
 
 	case apexParserTHEN:
 		p.EnterOuterAlt(localctx, 15)
 		{
-			p.SetState(1469)
+			p.SetState(1532)
 			p.Match(apexParserTHEN)
 		}
 
 	case apexParserFIND:
 		p.EnterOuterAlt(localctx, 16)
 		{
-			p.SetState(1470)
+			p.SetState(1533)
 			p.Match(apexParserFIND)
 		}
 
 	case apexParserRETURNING:
 		p.EnterOuterAlt(localctx, 17)
 		{
-			p.SetState(1471)
+			p.SetState(1534)
 			p.Match(apexParserRETURNING)
 		}
 
 	case apexParserALL:
 		p.EnterOuterAlt(localctx, 18)
 		{
-			p.SetState(1472)
+			p.SetState(1535)
 			p.Match(apexParserALL)
 		}
 
-	case apexParserFIELDS:
+	case apexParserROWS:
 		p.EnterOuterAlt(localctx, 19)
 		{
-			p.SetState(1473)
+			p.SetState(1536)
+			p.Match(apexParserROWS)
+		}
+
+	case apexParserINCLUDES:
+		p.EnterOuterAlt(localctx, 20)
+		{
+			p.SetState(1537)
+			p.Match(apexParserINCLUDES)
+		}
+
+	case apexParserEXCLUDES:
+		p.EnterOuterAlt(localctx, 21)
+		{
+			p.SetState(1538)
+			p.Match(apexParserEXCLUDES)
+		}
+
+	case apexParserFIELDS:
+		p.EnterOuterAlt(localctx, 22)
+		{
+			p.SetState(1539)
 			p.Match(apexParserFIELDS)
 		}
 
 	case apexParserRUNAS:
-		p.EnterOuterAlt(localctx, 20)
+		p.EnterOuterAlt(localctx, 23)
 		{
-			p.SetState(1474)
+			p.SetState(1540)
 			p.Match(apexParserRUNAS)
 		}
 
 	case apexParserSYSTEM:
-		p.EnterOuterAlt(localctx, 21)
+		p.EnterOuterAlt(localctx, 24)
 		{
-			p.SetState(1475)
+			p.SetState(1541)
 			p.Match(apexParserSYSTEM)
 		}
 
 	case apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG:
-		p.EnterOuterAlt(localctx, 22)
+		p.EnterOuterAlt(localctx, 25)
 		{
-			p.SetState(1476)
+			p.SetState(1542)
 			p.PrimitiveType()
 		}
 
@@ -22412,6 +23328,18 @@ func (s *TypeIdentifierContext) ALL() antlr.TerminalNode {
 	return s.GetToken(apexParserALL, 0)
 }
 
+func (s *TypeIdentifierContext) ROWS() antlr.TerminalNode {
+	return s.GetToken(apexParserROWS, 0)
+}
+
+func (s *TypeIdentifierContext) INCLUDES() antlr.TerminalNode {
+	return s.GetToken(apexParserINCLUDES, 0)
+}
+
+func (s *TypeIdentifierContext) EXCLUDES() antlr.TerminalNode {
+	return s.GetToken(apexParserEXCLUDES, 0)
+}
+
 func (s *TypeIdentifierContext) FIELDS() antlr.TerminalNode {
 	return s.GetToken(apexParserFIELDS, 0)
 }
@@ -22452,7 +23380,7 @@ func (s *TypeIdentifierContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *apexParser) TypeIdentifier() (localctx ITypeIdentifierContext) {
 	localctx = NewTypeIdentifierContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 250, apexParserRULE_typeIdentifier)
+	p.EnterRule(localctx, 260, apexParserRULE_typeIdentifier)
 	var _la int
 
 	defer func() {
@@ -22473,10 +23401,10 @@ func (p *apexParser) TypeIdentifier() (localctx ITypeIdentifierContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1479)
+		p.SetState(1545)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(_la == apexParserSET || _la == apexParserGET || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || _la == apexParserSYSTEM || _la == apexParserIdentifier) {
+		if !(_la == apexParserSET || _la == apexParserGET || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserINCLUDES-66))|(1<<(apexParserEXCLUDES-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66)))) != 0) || (((_la-98)&-(0x1f+1)) == 0 && ((1<<uint((_la-98)))&((1<<(apexParserRETURNING-98))|(1<<(apexParserALL-98))|(1<<(apexParserROWS-98))|(1<<(apexParserSYSTEM-98)))) != 0) || _la == apexParserIdentifier) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -22489,14 +23417,14 @@ func (p *apexParser) TypeIdentifier() (localctx ITypeIdentifierContext) {
 
 func (p *apexParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 80:
+	case 81:
 		var t *ExpressionContext = nil
 		if localctx != nil {
 			t = localctx.(*ExpressionContext)
 		}
 		return p.Expression_Sempred(t, predIndex)
 
-	case 109:
+	case 110:
 		var t *WhereFieldsContext = nil
 		if localctx != nil {
 			t = localctx.(*WhereFieldsContext)
@@ -218,6 +218,9 @@ type apexVisitor interface {
 	// Visit a parse tree produced by apexParser#whenExpression.
 	VisitWhenExpression(ctx *WhenExpressionContext) interface{}
 
+	// Visit a parse tree produced by apexParser#whenValue.
+	VisitWhenValue(ctx *WhenValueContext) interface{}
+
 	// Visit a parse tree produced by apexParser#forControl.
 	VisitForControl(ctx *ForControlContext) interface{}
 
@@ -377,18 +380,27 @@ type apexVisitor interface {
 	// Visit a parse tree produced by apexParser#whereField.
 	VisitWhereField(ctx *WhereFieldContext) interface{}
 
+	// Visit a parse tree produced by apexParser#soqlOperator.
+	VisitSoqlOperator(ctx *SoqlOperatorContext) interface{}
+
 	// Visit a parse tree produced by apexParser#limitClause.
 	VisitLimitClause(ctx *LimitClauseContext) interface{}
 
 	// Visit a parse tree produced by apexParser#orderClause.
 	VisitOrderClause(ctx *OrderClauseContext) interface{}
 
+	// Visit a parse tree produced by apexParser#orderField.
+	VisitOrderField(ctx *OrderFieldContext) interface{}
+
 	// Visit a parse tree produced by apexParser#bindVariable.
 	VisitBindVariable(ctx *BindVariableContext) interface{}
 
 	// Visit a parse tree produced by apexParser#soqlValue.
 	VisitSoqlValue(ctx *SoqlValueContext) interface{}
 
+	// Visit a parse tree produced by apexParser#soqlValueList.
+	VisitSoqlValueList(ctx *SoqlValueListContext) interface{}
+
 	// Visit a parse tree produced by apexParser#withClause.
 	VisitWithClause(ctx *WithClauseContext) interface{}
 
@@ -407,6 +419,9 @@ type apexVisitor interface {
 	// Visit a parse tree produced by apexParser#viewClause.
 	VisitViewClause(ctx *ViewClauseContext) interface{}
 
+	// Visit a parse tree produced by apexParser#allRowsClause.
+	VisitAllRowsClause(ctx *AllRowsClauseContext) interface{}
+
 	// Visit a parse tree produced by apexParser#soslLiteral.
 	VisitSoslLiteral(ctx *SoslLiteralContext) interface{}
 
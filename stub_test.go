@@ -0,0 +1,23 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestCreateStub(t *testing.T) {
+	report, err := runLandTest(t, "-d", "fixtures/stub")
+	if err != nil {
+		t.Errorf("expected no error, actual %v", err)
+	}
+	expectPassed(
+		t,
+		report,
+		"StubTest",
+		// the stub returns the value of handleMethodCall
+		"returnsValueOfProvider",
+		// handleMethodCall gets the name, the return type, the parameter types and names, and the arguments
+		"passesMethodSignature",
+		// the method of the stubbed class does not run, and the fields of the stub are null
+		"doesNotRunStubbedMethod",
+	)
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/tzmfreedom/goland/builtin"
+)
+
+func TestTestDataFactory(t *testing.T) {
+	report, err := runLandTest(t, "-d", "fixtures/test_data")
+	if err != nil {
+		t.Errorf("expected no error, actual %v", err)
+	}
+	expectPassed(t, report, "FactoryTest", "createsRecords", "createsRecord")
+}
+
+func TestGenTestData(t *testing.T) {
+	f, err := ioutil.TempFile("", "land_testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+
+	if err := newApp().Run([]string{"land", "db:create", "--database", f.Name()}); err != nil {
+		t.Fatal(err)
+	}
+	if err := newApp().Run([]string{"land", "gen:testdata", "--database", f.Name(), "-s", "Account,Contact", "-n", "2"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := newApp().Run([]string{"land", "gen:testdata", "--database", f.Name(), "-s", "Unknown"}); err == nil {
+		t.Error("expected the error of the unknown sobject")
+	}
+
+	driver := builtin.NewSqliteDriver(f.Name())
+	defer driver.Close()
+	for _, sObjectType := range []string{"Account", "Contact"} {
+		records, err := driver.SelectRaw(sObjectType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(records) != 2 {
+			t.Errorf("expected 2 %s records, actual %d", sObjectType, len(records))
+		}
+	}
+}
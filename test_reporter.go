@@ -0,0 +1,348 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/tzmfreedom/goland/ast"
+	"github.com/tzmfreedom/goland/builtin"
+)
+
+const defaultReporter = "text"
+
+// TestResult is the result of a test method
+type TestResult struct {
+	ClassName  string
+	MethodName string
+	Location   *ast.Location
+	Duration   time.Duration
+	Failures   []*builtin.TestError
+	// Error is the uncaught exception or the runtime error which stops the test method
+	Error error
+}
+
+func (r *TestResult) Passed() bool {
+	return r.Error == nil && len(r.Failures) == 0
+}
+
+// TestClassResult is the result of the test methods in a class, Duration includes @testSetup methods
+type TestClassResult struct {
+	Name     string
+	Duration time.Duration
+	Tests    []*TestResult
+}
+
+func (r *TestClassResult) Count(f func(*TestResult) bool) int {
+	n := 0
+	for _, test := range r.Tests {
+		if f(test) {
+			n++
+		}
+	}
+	return n
+}
+
+func isFailure(r *TestResult) bool {
+	return r.Error == nil && len(r.Failures) > 0
+}
+
+func isError(r *TestResult) bool {
+	return r.Error != nil
+}
+
+func countFailedTests(results []*TestClassResult) int {
+	failed := 0
+	for _, result := range results {
+		failed += result.Count(func(r *TestResult) bool { return !r.Passed() })
+	}
+	return failed
+}
+
+type TestReporter interface {
+	Report(w io.Writer, results []*TestClassResult) error
+}
+
+var testReporters = map[string]TestReporter{
+	defaultReporter: &textReporter{},
+	"junit":         &junitReporter{},
+	"json":          &jsonReporter{},
+	"tap":           &tapReporter{},
+}
+
+// writeTestReport writes the report to the output file, or stdout when output is empty
+func writeTestReport(reporter TestReporter, output string, results []*TestClassResult) error {
+	if output == "" {
+		return reporter.Report(os.Stdout, results)
+	}
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return reporter.Report(f, results)
+}
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+func failureLocation(e *builtin.TestError) *ast.Location {
+	if e.Node == nil {
+		return nil
+	}
+	return e.Node.GetLocation()
+}
+
+func formatLocation(loc *ast.Location) string {
+	if loc == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d:%d", loc.FileName, loc.Line, loc.Column)
+}
+
+// textReporter writes the summary, the details are written as the progress
+type textReporter struct{}
+
+func (r *textReporter) Report(w io.Writer, results []*TestClassResult) error {
+	tests, failed := 0, 0
+	var duration time.Duration
+	for _, result := range results {
+		tests += len(result.Tests)
+		failed += result.Count(func(r *TestResult) bool { return !r.Passed() })
+		duration += result.Duration
+	}
+	_, err := fmt.Fprintf(w, "%d tests, %d failures (%ss)\n", tests, failed, seconds(duration))
+	return err
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name          `xml:"testsuites"`
+	Tests    int               `xml:"tests,attr"`
+	Failures int               `xml:"failures,attr"`
+	Errors   int               `xml:"errors,attr"`
+	Time     string            `xml:"time,attr"`
+	Suites   []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Time     string           `xml:"time,attr"`
+	Cases    []*junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string          `xml:"classname,attr"`
+	Name      string          `xml:"name,attr"`
+	File      string          `xml:"file,attr,omitempty"`
+	Line      int             `xml:"line,attr,omitempty"`
+	Time      string          `xml:"time,attr"`
+	Failures  []*junitFailure `xml:"failure"`
+	Error     *junitFailure   `xml:"error"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Body    string `xml:",chardata"`
+}
+
+type junitReporter struct{}
+
+func (r *junitReporter) Report(w io.Writer, results []*TestClassResult) error {
+	suites := &junitTestSuites{Suites: []*junitTestSuite{}}
+	var duration time.Duration
+	for _, result := range results {
+		suite := &junitTestSuite{
+			Name:     result.Name,
+			Tests:    len(result.Tests),
+			Failures: result.Count(isFailure),
+			Errors:   result.Count(isError),
+			Time:     seconds(result.Duration),
+		}
+		for _, test := range result.Tests {
+			testCase := &junitTestCase{
+				ClassName: test.ClassName,
+				Name:      test.MethodName,
+				Time:      seconds(test.Duration),
+			}
+			if test.Location != nil {
+				testCase.File = test.Location.FileName
+				testCase.Line = test.Location.Line
+			}
+			for _, failure := range test.Failures {
+				testCase.Failures = append(testCase.Failures, &junitFailure{
+					Message: failure.Reason,
+					Type:    "System.AssertException",
+					Body:    fmt.Sprintf("%s\n%s", formatLocation(failureLocation(failure)), failure.Message),
+				})
+			}
+			if test.Error != nil {
+				testCase.Error = &junitFailure{
+					Message: test.Error.Error(),
+					Type:    "Error",
+					Body:    errorString(test.Error),
+				}
+			}
+			suite.Cases = append(suite.Cases, testCase)
+		}
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Errors += suite.Errors
+		duration += result.Duration
+		suites.Suites = append(suites.Suites, suite)
+	}
+	suites.Time = seconds(duration)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+type jsonTestReport struct {
+	Tests   int              `json:"tests"`
+	Passed  int              `json:"passed"`
+	Failed  int              `json:"failed"`
+	Time    float64          `json:"time"`
+	Classes []*jsonTestClass `json:"classes"`
+}
+
+type jsonTestClass struct {
+	Name  string            `json:"name"`
+	Time  float64           `json:"time"`
+	Tests []*jsonTestMethod `json:"tests"`
+}
+
+type jsonTestMethod struct {
+	Name     string             `json:"name"`
+	Status   string             `json:"status"`
+	Time     float64            `json:"time"`
+	File     string             `json:"file,omitempty"`
+	Line     int                `json:"line,omitempty"`
+	Failures []*jsonTestFailure `json:"failures"`
+	Error    string             `json:"error,omitempty"`
+}
+
+type jsonTestFailure struct {
+	Message string `json:"message"`
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+}
+
+type jsonReporter struct{}
+
+func (r *jsonReporter) Report(w io.Writer, results []*TestClassResult) error {
+	report := &jsonTestReport{Classes: []*jsonTestClass{}}
+	var duration time.Duration
+	for _, result := range results {
+		class := &jsonTestClass{
+			Name:  result.Name,
+			Time:  result.Duration.Seconds(),
+			Tests: []*jsonTestMethod{},
+		}
+		for _, test := range result.Tests {
+			method := &jsonTestMethod{
+				Name:     test.MethodName,
+				Status:   testStatus(test),
+				Time:     test.Duration.Seconds(),
+				Failures: []*jsonTestFailure{},
+			}
+			if test.Location != nil {
+				method.File = test.Location.FileName
+				method.Line = test.Location.Line
+			}
+			for _, failure := range test.Failures {
+				f := &jsonTestFailure{Message: failure.Reason}
+				if loc := failureLocation(failure); loc != nil {
+					f.File = loc.FileName
+					f.Line = loc.Line
+					f.Column = loc.Column
+				}
+				method.Failures = append(method.Failures, f)
+			}
+			if test.Error != nil {
+				method.Error = test.Error.Error()
+			}
+			class.Tests = append(class.Tests, method)
+		}
+		report.Tests += len(result.Tests)
+		report.Failed += result.Count(func(r *TestResult) bool { return !r.Passed() })
+		duration += result.Duration
+		report.Classes = append(report.Classes, class)
+	}
+	report.Passed = report.Tests - report.Failed
+	report.Time = duration.Seconds()
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+func testStatus(r *TestResult) string {
+	switch {
+	case isError(r):
+		return "error"
+	case isFailure(r):
+		return "failed"
+	}
+	return "passed"
+}
+
+// tapReporter writes the report in TAP version 13, failures are written as YAML blocks
+type tapReporter struct{}
+
+func (r *tapReporter) Report(w io.Writer, results []*TestClassResult) error {
+	tests := []*TestResult{}
+	for _, result := range results {
+		tests = append(tests, result.Tests...)
+	}
+	lines := []string{
+		"TAP version 13",
+		fmt.Sprintf("1..%d", len(tests)),
+	}
+	for i, test := range tests {
+		status := "ok"
+		if !test.Passed() {
+			status = "not ok"
+		}
+		lines = append(lines, fmt.Sprintf("%s %d - %s.%s # time=%.3fms", status, i+1, test.ClassName, test.MethodName, float64(test.Duration)/float64(time.Millisecond)))
+		if test.Passed() {
+			continue
+		}
+		lines = append(lines, "  ---")
+		if test.Error != nil {
+			lines = append(lines, fmt.Sprintf("  message: %s", yamlString(test.Error.Error())))
+			lines = append(lines, "  severity: error")
+		}
+		if len(test.Failures) > 0 {
+			lines = append(lines, "  severity: fail", "  failures:")
+		}
+		for _, failure := range test.Failures {
+			lines = append(lines, fmt.Sprintf("    - message: %s", yamlString(failure.Reason)))
+			lines = append(lines, fmt.Sprintf("      at: %s", yamlString(formatLocation(failureLocation(failure)))))
+		}
+		lines = append(lines, "  ...")
+	}
+	_, err := io.WriteString(w, strings.Join(lines, "\n")+"\n")
+	return err
+}
+
+func yamlString(s string) string {
+	encoded, _ := json.Marshal(s)
+	return string(encoded)
+}
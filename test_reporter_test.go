@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/tzmfreedom/goland/ast"
+	"github.com/tzmfreedom/goland/builtin"
+)
+
+func newTestResults() []*TestClassResult {
+	return []*TestClassResult{
+		{
+			Name:     "FooTest",
+			Duration: 1500 * time.Millisecond,
+			Tests: []*TestResult{
+				{
+					ClassName:  "FooTest",
+					MethodName: "passes",
+					Location:   &ast.Location{FileName: "foo_test.cls", Line: 3},
+					Duration:   250 * time.Millisecond,
+				},
+				{
+					ClassName:  "FooTest",
+					MethodName: "fails",
+					Location:   &ast.Location{FileName: "foo_test.cls", Line: 8},
+					Duration:   500 * time.Millisecond,
+					Failures: []*builtin.TestError{
+						{
+							Node: &ast.MethodInvocation{
+								Location: &ast.Location{FileName: "foo_test.cls", Line: 10, Column: 8},
+							},
+							Message: "      expected: 1\n      actual:   2",
+							Reason:  "Assertion Failed: Expected: 1, Actual: 2",
+						},
+					},
+				},
+				{
+					ClassName:  "FooTest",
+					MethodName: "errors",
+					Location:   &ast.Location{FileName: "foo_test.cls", Line: 14},
+					Duration:   750 * time.Millisecond,
+					Error:      errors.New("System.LimitException: Too many SOQL queries: 101"),
+				},
+			},
+		},
+	}
+}
+
+func TestTestReporters(t *testing.T) {
+	testCases := []struct {
+		Reporter string
+		Expected string
+	}{
+		{
+			"text",
+			"3 tests, 2 failures (1.500s)\n",
+		},
+		{
+			"junit",
+			`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites tests="3" failures="1" errors="1" time="1.500">
+  <testsuite name="FooTest" tests="3" failures="1" errors="1" time="1.500">
+    <testcase classname="FooTest" name="passes" file="foo_test.cls" line="3" time="0.250"></testcase>
+    <testcase classname="FooTest" name="fails" file="foo_test.cls" line="8" time="0.500">
+      <failure message="Assertion Failed: Expected: 1, Actual: 2" type="System.AssertException">foo_test.cls:10:8&#xA;      expected: 1&#xA;      actual:   2</failure>
+    </testcase>
+    <testcase classname="FooTest" name="errors" file="foo_test.cls" line="14" time="0.750">
+      <error message="System.LimitException: Too many SOQL queries: 101" type="Error">System.LimitException: Too many SOQL queries: 101</error>
+    </testcase>
+  </testsuite>
+</testsuites>
+`,
+		},
+		{
+			"json",
+			`{
+  "tests": 3,
+  "passed": 1,
+  "failed": 2,
+  "time": 1.5,
+  "classes": [
+    {
+      "name": "FooTest",
+      "time": 1.5,
+      "tests": [
+        {
+          "name": "passes",
+          "status": "passed",
+          "time": 0.25,
+          "file": "foo_test.cls",
+          "line": 3,
+          "failures": []
+        },
+        {
+          "name": "fails",
+          "status": "failed",
+          "time": 0.5,
+          "file": "foo_test.cls",
+          "line": 8,
+          "failures": [
+            {
+              "message": "Assertion Failed: Expected: 1, Actual: 2",
+              "file": "foo_test.cls",
+              "line": 10,
+              "column": 8
+            }
+          ]
+        },
+        {
+          "name": "errors",
+          "status": "error",
+          "time": 0.75,
+          "file": "foo_test.cls",
+          "line": 14,
+          "failures": [],
+          "error": "System.LimitException: Too many SOQL queries: 101"
+        }
+      ]
+    }
+  ]
+}
+`,
+		},
+		{
+			"tap",
+			`TAP version 13
+1..3
+ok 1 - FooTest.passes # time=250.000ms
+not ok 2 - FooTest.fails # time=500.000ms
+  ---
+  severity: fail
+  failures:
+    - message: "Assertion Failed: Expected: 1, Actual: 2"
+      at: "foo_test.cls:10:8"
+  ...
+not ok 3 - FooTest.errors # time=750.000ms
+  ---
+  message: "System.LimitException: Too many SOQL queries: 101"
+  severity: error
+  ...
+`,
+		},
+	}
+	for _, testCase := range testCases {
+		buf := new(bytes.Buffer)
+		if err := testReporters[testCase.Reporter].Report(buf, newTestResults()); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != testCase.Expected {
+			t.Errorf("%s: expected\n%s\nactual\n%s", testCase.Reporter, testCase.Expected, buf.String())
+		}
+	}
+}
+
+// TestExitCode runs land in the subprocess, because main exits the process when the command fails
+func TestExitCode(t *testing.T) {
+	if directory := os.Getenv("LAND_TEST_EXIT_CODE_DIRECTORY"); directory != "" {
+		setup()
+		os.Args = []string{"land", "test", "--database", "memory", "--reporter", "tap", "-d", directory}
+		main()
+		return
+	}
+
+	testCases := []struct {
+		Directory string
+		Success   bool
+	}{
+		{"fixtures/reporter/pass", true},
+		{"fixtures/reporter/fail", false},
+	}
+	for _, testCase := range testCases {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestExitCode$")
+		cmd.Env = append(os.Environ(), "LAND_TEST_EXIT_CODE_DIRECTORY="+testCase.Directory)
+		err := cmd.Run()
+		if testCase.Success && err != nil {
+			t.Errorf("%s: expected exit code 0, actual %v", testCase.Directory, err)
+		}
+		if !testCase.Success {
+			exitError, ok := err.(*exec.ExitError)
+			if !ok || exitError.Success() {
+				t.Errorf("%s: expected non-zero exit code, actual %v", testCase.Directory, err)
+			}
+		}
+	}
+}
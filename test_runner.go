@@ -0,0 +1,270 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tzmfreedom/goland/ast"
+	"github.com/tzmfreedom/goland/builtin"
+	"github.com/tzmfreedom/goland/interpreter"
+)
+
+// testRunner runs test classes in the transactions of its own database.
+// Each test method gets a new interpreter, so runners share nothing but the classes.
+type testRunner struct {
+	classTypes []*ast.ClassType
+	database   builtin.Driver
+	selection  *testSelection
+	// options are applied to all interpreters of the runner, such as subscribing the coverage
+	options []func(*interpreter.Interpreter)
+}
+
+func newTestRunner(classTypes []*ast.ClassType, database builtin.Driver, selection *testSelection, options ...func(*interpreter.Interpreter)) *testRunner {
+	return &testRunner{
+		classTypes: classTypes,
+		database:   database,
+		selection:  selection,
+		options:    options,
+	}
+}
+
+// runTests runs the test classes by parallel runners, each of them has the clone of the database.
+// The results are in the order of the classes, and the progress of a class is written when the class finishes.
+func runTests(classTypes []*ast.ClassType, selection *testSelection, parallel int, out io.Writer, options ...func(*interpreter.Interpreter)) ([]*TestClassResult, error) {
+	if parallel <= 1 {
+		runner := newTestRunner(classTypes, builtin.DatabaseDriver, selection, options...)
+		results := []*TestClassResult{}
+		i := 1
+		for _, classType := range classTypes {
+			result, err := runner.runClass(classType, i, out)
+			if err != nil {
+				return nil, err
+			}
+			i += len(result.Tests)
+			results = append(results, result)
+		}
+		return results, nil
+	}
+
+	runners := make([]*testRunner, parallel)
+	for i := range runners {
+		database, err := builtin.DatabaseDriver.Clone()
+		if err != nil {
+			return nil, err
+		}
+		defer database.Close()
+		runners[i] = newTestRunner(classTypes, database, selection, options...)
+	}
+
+	type job struct {
+		index     int
+		classType *ast.ClassType
+		number    int
+	}
+	jobs := make(chan job)
+	results := make([]*TestClassResult, len(classTypes))
+	errs := make([]error, len(classTypes))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, runner := range runners {
+		wg.Add(1)
+		go func(runner *testRunner) {
+			defer wg.Done()
+			for j := range jobs {
+				buf := new(bytes.Buffer)
+				results[j.index], errs[j.index] = runner.runClass(j.classType, j.number, buf)
+				mu.Lock()
+				out.Write(buf.Bytes())
+				mu.Unlock()
+			}
+		}(runner)
+	}
+	number := 1
+	for i, classType := range classTypes {
+		jobs <- job{index: i, classType: classType, number: number}
+		number += countTestMethods(classType, selection)
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+func countTestMethods(classType *ast.ClassType, selection *testSelection) int {
+	n := 0
+	for _, methods := range classType.StaticMethods.All() {
+		for _, m := range methods {
+			if m.IsTestMethod() && !m.IsAnnotated("testSetup") && selection.selects(classType, m) {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// invoke invokes the action by a new interpreter which uses the database of the runner
+func (r *testRunner) invoke(action string, options ...func(*interpreter.Interpreter)) error {
+	all := []func(*interpreter.Interpreter){
+		func(i *interpreter.Interpreter) {
+			i.Extra["database"] = r.database
+			i.Extra["stdout"] = new(bytes.Buffer)
+			i.Extra["running_test"] = true
+		},
+	}
+	all = append(all, r.options...)
+	all = append(all, options...)
+	return invokeAction(action, r.classTypes, all...)
+}
+
+// runClass runs @testSetup methods once and each test method on the snapshot of the data created by them.
+// Tests without SeeAllData=true can not see the org data. The test methods are numbered from i.
+func (r *testRunner) runClass(classType *ast.ClassType, i int, out io.Writer) (*TestClassResult, error) {
+	setupMethods := []*ast.Method{}
+	isolatedTests := []*ast.Method{}
+	seeAllDataTests := []*ast.Method{}
+	classSeeAllData := seeAllData(classType.AnnotationParameter("isTest", "SeeAllData"))
+	for _, methods := range classType.StaticMethods.All() {
+		for _, m := range methods {
+			switch {
+			case m.IsAnnotated("testSetup"):
+				setupMethods = append(setupMethods, m)
+			case !m.IsTestMethod(), !r.selection.selects(classType, m):
+			case classSeeAllData || seeAllData(m.AnnotationParameter("isTest", "SeeAllData")):
+				seeAllDataTests = append(seeAllDataTests, m)
+			default:
+				isolatedTests = append(isolatedTests, m)
+			}
+		}
+	}
+	result := &TestClassResult{Name: classType.Name}
+	startedAt := time.Now()
+	defer func() {
+		result.Duration = time.Since(startedAt)
+	}()
+	for _, group := range []struct {
+		tests      []*ast.Method
+		seeAllData bool
+	}{
+		{isolatedTests, false},
+		{seeAllDataTests, true},
+	} {
+		tests, err := r.runGroup(classType, setupMethods, group.tests, group.seeAllData, i+len(result.Tests), out)
+		result.Tests = append(result.Tests, tests...)
+		if err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+func seeAllData(value ast.Node, ok bool) bool {
+	if !ok {
+		return false
+	}
+	literal, ok := value.(*ast.BooleanLiteral)
+	return ok && literal.Value
+}
+
+func (r *testRunner) runGroup(classType *ast.ClassType, setupMethods, tests []*ast.Method, seeAllData bool, i int, out io.Writer) ([]*TestResult, error) {
+	if len(tests) == 0 {
+		return nil, nil
+	}
+	if err := r.database.Begin(); err != nil {
+		return nil, err
+	}
+	defer r.database.Rollback()
+	if !seeAllData {
+		if err := r.database.ClearRecords(); err != nil {
+			return nil, err
+		}
+	}
+	for _, m := range setupMethods {
+		if err := r.invoke(fmt.Sprintf("%s#%s", classType.Name, m.Name)); err != nil {
+			// the tests can not run without the records of @testSetup, they are errored by its failure
+			results := make([]*TestResult, len(tests))
+			for j, m := range tests {
+				fmt.Fprintf(out, "(%d) %s#%s: ", i+j, classType.Name, m.Name)
+				results[j] = &TestResult{
+					ClassName:  classType.Name,
+					MethodName: m.Name,
+					Location:   m.Location,
+					Error:      err,
+				}
+				writeTestResult(out, results[j])
+			}
+			return results, nil
+		}
+	}
+	if err := r.database.SetSavepoint("test_setup"); err != nil {
+		return nil, err
+	}
+	results := []*TestResult{}
+	for _, m := range tests {
+		results = append(results, r.runTest(classType, m, i, out))
+		i++
+		if err := r.database.RollbackToSavepoint("test_setup"); err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+// runTest runs the test method and writes the progress to out.
+// The error which is not an assertion failure is recorded as the error of the result.
+func (r *testRunner) runTest(classType *ast.ClassType, m *ast.Method, i int, out io.Writer) *TestResult {
+	action := fmt.Sprintf("%s#%s", classType.Name, m.Name)
+	fmt.Fprintf(out, "(%d) %s: ", i, action)
+	var ret *interpreter.Interpreter
+	startedAt := time.Now()
+	err := r.invoke(action, func(i *interpreter.Interpreter) {
+		ret = i
+	})
+	result := &TestResult{
+		ClassName:  classType.Name,
+		MethodName: m.Name,
+		Location:   m.Location,
+		Duration:   time.Since(startedAt),
+	}
+	if ret != nil {
+		if failures, ok := ret.Extra["errors"].([]*builtin.TestError); ok {
+			result.Failures = failures
+		}
+	}
+	if _, ok := err.(*builtin.TestError); err != nil && !ok {
+		result.Error = err
+	}
+	writeTestResult(out, result)
+	return result
+}
+
+// writeTestResult writes the error, the failures or pass of the test result
+func writeTestResult(out io.Writer, result *TestResult) {
+	if result.Error != nil {
+		fmt.Fprintln(out, "")
+		fmt.Fprintf(out, builtin.ErrorColor, fmt.Sprintf("    Error: %s\n", strings.Replace(errorString(result.Error), "\n", "\n           ", -1)))
+	} else if len(result.Failures) > 0 {
+		fmt.Fprintln(out, "")
+		for _, error := range result.Failures {
+			loc := error.Node.GetLocation()
+			str := fmt.Sprintf("  %s at %d:%d\n", loc.FileName, loc.Line, loc.Column)
+			fmt.Fprintf(out, builtin.NoticeColor, str)
+			str = fmt.Sprintf(`    Failure/Error: %s
+
+%s
+`, ast.ToString(error.Node), error.Message)
+			fmt.Fprintf(out, builtin.ErrorColor, str)
+		}
+	} else {
+		fmt.Fprintf(out, builtin.InfoColor, "pass\n")
+	}
+	fmt.Fprintln(out, "")
+}
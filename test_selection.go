@@ -0,0 +1,212 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/tzmfreedom/goland/ast"
+	"gopkg.in/urfave/cli.v1"
+)
+
+const defaultTestResultsFile = ".land_test_results.json"
+
+var classSelectionFlag = cli.StringSliceFlag{
+	Name:  "class",
+	Usage: "name of the test class to run, which can be given more than once or separated by commas",
+}
+
+var runSelectionFlag = cli.StringFlag{
+	Name:  "run",
+	Usage: "glob of Class.method to run such as 'AccountServiceTest.test*', separated by commas",
+}
+
+var annotatedSelectionFlag = cli.BoolFlag{
+	Name:  "annotated",
+	Usage: "run only the test classes annotated with @isTest",
+}
+
+var failedSelectionFlag = cli.BoolFlag{
+	Name:  "failed",
+	Usage: "run only the tests which failed last time",
+}
+
+var testResultsFileFlag = cli.StringFlag{
+	Name:  "results-file",
+	Usage: "file to persist the failed tests for --failed",
+	Value: defaultTestResultsFile,
+}
+
+// testSelection selects the test methods to run, all conditions must be satisfied.
+// nil selection selects all test methods.
+type testSelection struct {
+	classes   []string
+	patterns  []string
+	annotated bool
+	// failed is the set of Class.method which failed last time, nil means the tests are not limited to them
+	failed map[string]bool
+}
+
+func newTestSelection(c *cli.Context) (*testSelection, error) {
+	s := &testSelection{
+		annotated: c.Bool("annotated"),
+	}
+	for _, class := range c.StringSlice("class") {
+		s.classes = append(s.classes, splitList(class)...)
+	}
+	s.patterns = splitList(c.String("run"))
+	if c.Bool("failed") {
+		failed, err := loadFailedTests(c.String("results-file"))
+		if err != nil {
+			return nil, err
+		}
+		s.failed = map[string]bool{}
+		for _, name := range failed {
+			s.failed[strings.ToLower(name)] = true
+		}
+	}
+	return s, nil
+}
+
+func splitList(value string) []string {
+	values := []string{}
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+func testName(classType *ast.ClassType, m *ast.Method) string {
+	return classType.Name + "." + m.Name
+}
+
+// selectsClass returns whether the test methods of the class can be selected
+func (s *testSelection) selectsClass(classType *ast.ClassType) bool {
+	if s == nil {
+		return true
+	}
+	if s.annotated && !classType.IsAnnotated("isTest") {
+		return false
+	}
+	if len(s.classes) == 0 {
+		return true
+	}
+	for _, class := range s.classes {
+		if strings.EqualFold(class, classType.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// selects returns whether the test method is selected.
+// The pattern without `.` matches the class name, otherwise it matches Class.method.
+func (s *testSelection) selects(classType *ast.ClassType, m *ast.Method) bool {
+	if s == nil {
+		return true
+	}
+	if !s.selectsClass(classType) {
+		return false
+	}
+	if s.failed != nil && !s.failed[strings.ToLower(testName(classType, m))] {
+		return false
+	}
+	if len(s.patterns) == 0 {
+		return true
+	}
+	for _, pattern := range s.patterns {
+		name := testName(classType, m)
+		if !strings.Contains(pattern, ".") {
+			name = classType.Name
+		}
+		if ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(name)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+type testResultsFile struct {
+	Failed []string `json:"failed"`
+}
+
+// saveFailedTests persists the failed tests for --failed.
+// The failures of the tests which did not run this time are kept.
+func saveFailedTests(file string, results []*TestClassResult) error {
+	failedTests := map[string]string{}
+	if previous, err := loadFailedTests(file); err == nil {
+		for _, name := range previous {
+			failedTests[strings.ToLower(name)] = name
+		}
+	}
+	for _, result := range results {
+		for _, test := range result.Tests {
+			name := test.ClassName + "." + test.MethodName
+			if test.Passed() {
+				delete(failedTests, strings.ToLower(name))
+			} else {
+				failedTests[strings.ToLower(name)] = name
+			}
+		}
+	}
+	failed := []string{}
+	for _, name := range failedTests {
+		failed = append(failed, name)
+	}
+	sort.Strings(failed)
+	b, err := json.MarshalIndent(&testResultsFile{Failed: failed}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, append(b, '\n'), 0644)
+}
+
+func loadFailedTests(file string) ([]string, error) {
+	b, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("no test results in %s, run the tests without --failed first", file)
+	}
+	if err != nil {
+		return nil, err
+	}
+	results := &testResultsFile{}
+	if err := json.Unmarshal(b, results); err != nil {
+		return nil, err
+	}
+	return results.Failed, nil
+}
+
+// referencingTestClasses returns the test classes which reference the class by name in their source.
+// The test class itself is returned when the class is a test class.
+func referencingTestClasses(classTypes []*ast.ClassType, classType *ast.ClassType) []*ast.ClassType {
+	if isTestClass(classType) {
+		return []*ast.ClassType{classType}
+	}
+	reference := regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(classType.Name) + `\b`)
+	testClasses := []*ast.ClassType{}
+	for _, t := range classTypes {
+		if !isTestClass(t) || t.Location == nil {
+			continue
+		}
+		src, err := ioutil.ReadFile(t.Location.FileName)
+		if err != nil {
+			continue
+		}
+		if reference.Match(src) {
+			testClasses = append(testClasses, t)
+		}
+	}
+	return testClasses
+}
+
+// isTestClass returns whether the class is annotated with @isTest or has test methods
+func isTestClass(classType *ast.ClassType) bool {
+	return classType.IsAnnotated("isTest") || countTestMethods(classType, nil) > 0
+}
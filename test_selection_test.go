@@ -0,0 +1,86 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func selectedTests(report *jsonTestReport) string {
+	names := []string{}
+	for name := range testMethodResults(report) {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+func TestTestSelection(t *testing.T) {
+	testCases := []struct {
+		Args     []string
+		Expected string
+	}{
+		{
+			[]string{"--class", "ParallelBTest"},
+			"ParallelBTest.insertsFirst,ParallelBTest.insertsSecond",
+		},
+		{
+			[]string{"--class", "ParallelATest,parallelctest"},
+			"ParallelATest.insertsFirst,ParallelATest.insertsSecond,ParallelCTest.insertsFirst,ParallelCTest.insertsSecond",
+		},
+		{
+			[]string{"--run", "*.insertsFirst"},
+			"ParallelATest.insertsFirst,ParallelBTest.insertsFirst,ParallelCTest.insertsFirst,ParallelDTest.insertsFirst",
+		},
+		{
+			// the glob without the dot matches the class name
+			[]string{"--run", "ParallelD*"},
+			"ParallelDTest.insertsFirst,ParallelDTest.insertsSecond",
+		},
+		{
+			[]string{"--class", "ParallelATest", "--run", "*.*Second"},
+			"ParallelATest.insertsSecond",
+		},
+	}
+	for _, testCase := range testCases {
+		report, err := runLandTest(t, append([]string{"-d", "fixtures/parallel"}, testCase.Args...)...)
+		if err != nil {
+			t.Errorf("%v: expected no error, actual %v", testCase.Args, err)
+		}
+		if actual := selectedTests(report); actual != testCase.Expected {
+			t.Errorf("%v: expected %s, actual %s", testCase.Args, testCase.Expected, actual)
+		}
+	}
+}
+
+func TestFailedTestSelection(t *testing.T) {
+	f, err := ioutil.TempFile("", "land_results")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+
+	failed := "SelectionTest.assertEqualsFails,SelectionTest.assertFails,SelectionTest.notEqualsFails"
+	testCases := []struct {
+		Args     []string
+		Expected string
+	}{
+		// the failed tests are persisted to the results file
+		{[]string{}, failed + ",SelectionTest.passes"},
+		{[]string{"--failed"}, failed},
+		// the failures of the tests which do not run are kept
+		{[]string{"--run", "SelectionTest.passes"}, "SelectionTest.passes"},
+		{[]string{"--failed"}, failed},
+		{[]string{"--failed", "--run", "*.assert*"}, "SelectionTest.assertEqualsFails,SelectionTest.assertFails"},
+	}
+	for _, testCase := range testCases {
+		args := append([]string{"-d", "fixtures/selection", "--results-file", f.Name()}, testCase.Args...)
+		report, _ := runLandTest(t, args...)
+		if actual := selectedTests(report); actual != testCase.Expected {
+			t.Errorf("%v: expected %s, actual %s", testCase.Args, testCase.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTestSetup(t *testing.T) {
+	report, err := runLandTest(t, "-d", "fixtures/test_setup")
+	if err != nil {
+		t.Errorf("expected no error, actual %v", err)
+	}
+	// the @testSetup method is not the test method
+	if report.Tests != 5 {
+		t.Errorf("expected 5 tests, actual %d", report.Tests)
+	}
+	methods := testMethodResults(report)
+	for _, name := range []string{
+		// each test method sees the records of @testSetup, the changes of the other test methods are rolled back
+		"changesData",
+		"seesSetupData",
+		// Test.startTest gives fresh limits, Test.stopTest restores them
+		"freshLimits",
+		// Test.stopTest runs the future methods and the queueable jobs
+		"flushesAsyncJobs",
+		"runningTest",
+	} {
+		method, ok := methods["SetupTest."+name]
+		if !ok {
+			t.Errorf("%s: not run", name)
+			continue
+		}
+		if method.Status != "passed" {
+			t.Errorf("%s: expected passed, actual %s %v %s", name, method.Status, method.Failures, method.Error)
+		}
+	}
+}
+
+func TestTestSetupError(t *testing.T) {
+	report, err := runLandTest(t, "-d", "fixtures/test_setup_error")
+	if err == nil || err.Error() != "2 of 3 tests failed" {
+		t.Errorf("expected the error of the failed tests, actual %v", err)
+	}
+	methods := testMethodResults(report)
+	// the tests of the class are errored by the failure of @testSetup
+	for _, name := range []string{"FailingSetupTest.first", "FailingSetupTest.second"} {
+		method, ok := methods[name]
+		if !ok {
+			t.Errorf("%s: not run", name)
+			continue
+		}
+		if method.Status != "error" || !strings.HasPrefix(method.Error, "System.NullPointerException") {
+			t.Errorf("%s: expected the error of @testSetup, actual %s %s", name, method.Status, method.Error)
+		}
+	}
+	// the next class runs
+	if method, ok := methods["PassingTest.passes"]; !ok || method.Status != "passed" {
+		t.Errorf("PassingTest.passes: expected passed, actual %v", method)
+	}
+}
@@ -4,11 +4,16 @@ import (
 	"errors"
 
 	"github.com/tzmfreedom/goland/ast"
+	"github.com/tzmfreedom/goland/builtin"
 )
 
 type SoqlChecker struct{}
 
 func (v *SoqlChecker) VisitClassType(n *ast.ClassType) (interface{}, error) {
+	// the enum has no apex code
+	if builtin.IsEnum(n) {
+		return nil, nil
+	}
 	for _, methods := range n.InstanceMethods.All() {
 		for _, method := range methods {
 			_, err := method.Statements.Accept(v)
@@ -44,6 +49,10 @@ func (v *SoqlChecker) VisitInterfaceDeclaration(n *ast.InterfaceDeclaration) (in
 	return ast.VisitInterfaceDeclaration(v, n)
 }
 
+func (v *SoqlChecker) VisitEnumDeclaration(n *ast.EnumDeclaration) (interface{}, error) {
+	return ast.VisitEnumDeclaration(v, n)
+}
+
 func (v *SoqlChecker) VisitIntegerLiteral(n *ast.IntegerLiteral) (interface{}, error) {
 	return ast.VisitIntegerLiteral(v, n)
 }